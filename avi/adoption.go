@@ -0,0 +1,168 @@
+// Copyright 2022 VMware, Inc.
+// SPDX-License-Identifier: Mozilla Public License 2.0
+
+package avi
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// Adoption modes decide what APICreateOrUpdate does when a resource without uuid finds an
+// existing object with the same name on the controller.
+const (
+	// adoptionModeAdopt takes over the existing object.
+	adoptionModeAdopt = "adopt"
+	// adoptionModeFail refuses to touch the existing object and asks for it to be imported.
+	adoptionModeFail = "fail"
+	// adoptionModeMarked takes over the existing object only if it carries the provider marker.
+	adoptionModeMarked = "adopt_marked"
+)
+
+var adoptionModes = []string{adoptionModeAdopt, adoptionModeFail, adoptionModeMarked}
+
+// The provider marker is the label written into the markers of the objects created or updated in
+// adopt_marked mode, for the object types that support markers. Its value is the owner id of the
+// provider, so that several configurations can tell their objects apart.
+const (
+	providerMarkerKey    = "created_by"
+	defaultProviderOwner = "terraform-provider-avi"
+)
+
+// addAdoptionModeSchema adds the adoption_mode argument to every resource that can be matched
+// to an existing object by name. The argument is not part of the object schemas used for the
+// api payload, so it is never sent to the controller.
+func addAdoptionModeSchema(resources map[string]*schema.Resource) {
+	for _, r := range resources {
+		if _, ok := r.Schema["name"]; !ok {
+			continue
+		}
+		if _, ok := r.Schema["adoption_mode"]; ok {
+			continue
+		}
+		r.Schema["adoption_mode"] = &schema.Schema{
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringInSlice(adoptionModes, false),
+			Description:  "Overrides avi_adoption_mode of the provider for this resource.",
+		}
+	}
+}
+
+// resourceAdoptionMode returns the adoption mode of the resource, falling back to the provider
+// setting.
+func resourceAdoptionMode(d *schema.ResourceData, meta interface{}) string {
+	if mode, ok := d.GetOk("adoption_mode"); ok {
+		return mode.(string)
+	}
+	if mode := meta.(*ProviderClient).AdoptionMode; mode != "" {
+		return mode
+	}
+	return adoptionModeAdopt
+}
+
+// checkAdoption returns an error diagnostic when the adoption mode of the resource does not allow
+// taking over the existing object found by name.
+func checkAdoption(d *schema.ResourceData, meta interface{}, objType string, existingObj interface{}) diag.Diagnostics {
+	mode := resourceAdoptionMode(d, meta)
+	if mode == adoptionModeAdopt {
+		return nil
+	}
+	if mode == adoptionModeMarked && hasProviderMarker(existingObj, providerOwner(meta)) {
+		return nil
+	}
+	obj := existingObj.(map[string]interface{})
+	detail := fmt.Sprintf("%s %q already exists on the controller with uuid %v. Import it with "+
+		"\"terraform import\" to manage it, or set adoption_mode to %q.", objType, obj["name"], obj["uuid"],
+		adoptionModeAdopt)
+	if mode == adoptionModeMarked {
+		detail = fmt.Sprintf("%s %q already exists on the controller with uuid %v and was not created by "+
+			"this provider. Import it with \"terraform import\" to manage it.", objType, obj["name"], obj["uuid"])
+	}
+	return diag.Diagnostics{{
+		Severity:      diag.Error,
+		Summary:       objType + " already exists",
+		Detail:        detail,
+		AttributePath: cty.GetAttrPath("name"),
+	}}
+}
+
+// providerOwner returns the owner id of the provider written in the provider marker.
+func providerOwner(meta interface{}) string {
+	if owner := meta.(*ProviderClient).OwnerID; owner != "" {
+		return owner
+	}
+	return defaultProviderOwner
+}
+
+func isProviderMarker(marker interface{}, owner string) bool {
+	m, ok := marker.(map[string]interface{})
+	if !ok || m["key"] != providerMarkerKey {
+		return false
+	}
+	values, _ := m["values"].([]interface{})
+	for _, v := range values {
+		if v == owner {
+			return true
+		}
+	}
+	return false
+}
+
+// hasProviderMarker reports whether the api object carries the provider marker of owner.
+func hasProviderMarker(obj interface{}, owner string) bool {
+	m, ok := obj.(map[string]interface{})
+	if !ok {
+		return false
+	}
+	markers, _ := m["markers"].([]interface{})
+	for _, marker := range markers {
+		if isProviderMarker(marker, owner) {
+			return true
+		}
+	}
+	return false
+}
+
+// addProviderMarker adds the provider marker of owner to the api payload of object types that
+// support markers. Only adopt_marked mode needs the marker, the other modes leave the markers as
+// configured.
+func addProviderMarker(data interface{}, s map[string]*schema.Schema, owner string) {
+	m, ok := data.(map[string]interface{})
+	if _, hasMarkers := s["markers"]; !ok || !hasMarkers || hasProviderMarker(m, owner) {
+		return
+	}
+	markers, _ := m["markers"].([]interface{})
+	m["markers"] = append(markers, map[string]interface{}{
+		"key":    providerMarkerKey,
+		"values": []interface{}{owner},
+	})
+}
+
+// stripProviderMarker removes the provider marker of owner from an api response so that it does
+// not show up as a difference against the configuration.
+func stripProviderMarker(obj interface{}, owner string) {
+	m, ok := obj.(map[string]interface{})
+	if !ok {
+		return
+	}
+	markers, ok := m["markers"].([]interface{})
+	if !ok {
+		return
+	}
+	kept := make([]interface{}, 0, len(markers))
+	for _, marker := range markers {
+		if !isProviderMarker(marker, owner) {
+			kept = append(kept, marker)
+		}
+	}
+	if len(kept) == 0 {
+		delete(m, "markers")
+	} else {
+		m["markers"] = kept
+	}
+}
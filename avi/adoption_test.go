@@ -0,0 +1,94 @@
+// Copyright 2022 VMware, Inc.
+// SPDX-License-Identifier: Mozilla Public License 2.0
+
+package avi
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAPICreateOrUpdateAdoption(t *testing.T) {
+	fc := newFakeController()
+	defer fc.Close()
+	client := newFakeControllerClient(t, fc)
+	ctx := context.Background()
+	var shared map[string]interface{}
+	if err := client.AviSession.Post("api/pool", map[string]interface{}{"name": "shared-pool"}, &shared); err != nil {
+		t.Fatalf("failed to create pool: %v", err)
+	}
+	s := ResourcePoolSchema()
+	resourceSchema := ResourcePoolSchema()
+	addAdoptionModeSchema(map[string]*schema.Resource{"avi_pool": {Schema: resourceSchema}})
+
+	for _, mode := range []string{adoptionModeFail, adoptionModeMarked} {
+		client.AdoptionMode = mode
+		d := schema.TestResourceDataRaw(t, resourceSchema, map[string]interface{}{"name": "shared-pool"})
+		if diags := APICreateOrUpdate(ctx, d, client, "pool", s); !diags.HasError() {
+			t.Errorf("mode %s adopted an object not created by the provider", mode)
+		}
+		if d.Id() != "" {
+			t.Errorf("mode %s set the id of the existing object", mode)
+		}
+	}
+
+	client.AdoptionMode = adoptionModeAdopt
+	d := schema.TestResourceDataRaw(t, resourceSchema, map[string]interface{}{
+		"name":          "shared-pool",
+		"adoption_mode": adoptionModeFail,
+	})
+	if diags := APICreateOrUpdate(ctx, d, client, "pool", s); !diags.HasError() {
+		t.Errorf("adoption_mode of the resource did not override the provider setting")
+	}
+	d = schema.TestResourceDataRaw(t, resourceSchema, map[string]interface{}{"name": "shared-pool"})
+	if diags := APICreateOrUpdate(ctx, d, client, "pool", s); diags.HasError() {
+		t.Fatalf("adopt mode failed: %v", diags)
+	}
+	if d.Get("uuid") != shared["uuid"] {
+		t.Errorf("adopt mode did not take over %v, got %v", shared["uuid"], d.Get("uuid"))
+	}
+
+	var created interface{}
+	if err := client.AviSession.Get("api/pool/"+shared["uuid"].(string), &created); err != nil {
+		t.Fatalf("failed to read pool: %v", err)
+	}
+	if hasProviderMarker(created, defaultProviderOwner) {
+		t.Errorf("adopt mode wrote the provider marker: %v", created)
+	}
+
+	client.AdoptionMode = adoptionModeMarked
+	client.OwnerID = "team-a"
+	d = schema.TestResourceDataRaw(t, resourceSchema, map[string]interface{}{"name": "tf-pool"})
+	if diags := APICreateOrUpdate(ctx, d, client, "pool", s); diags.HasError() {
+		t.Fatalf("create failed: %v", diags)
+	}
+	if err := client.AviSession.Get("api/pool/"+d.Get("uuid").(string), &created); err != nil {
+		t.Fatalf("failed to read pool: %v", err)
+	}
+	if !hasProviderMarker(created, "team-a") {
+		t.Errorf("created pool does not carry the provider marker of its owner: %v", created)
+	}
+	if diags := APIRead(ctx, d, client, "pool", s); diags.HasError() {
+		t.Fatalf("read failed: %v", diags)
+	}
+	if markers := d.Get("markers").([]interface{}); len(markers) != 0 {
+		t.Errorf("provider marker was not stripped from state: %v", markers)
+	}
+
+	client.OwnerID = "team-b"
+	other := schema.TestResourceDataRaw(t, resourceSchema, map[string]interface{}{"name": "tf-pool"})
+	if diags := APICreateOrUpdate(ctx, other, client, "pool", s); !diags.HasError() {
+		t.Errorf("adopt_marked mode adopted an object of another owner")
+	}
+
+	client.OwnerID = "team-a"
+	adopted := schema.TestResourceDataRaw(t, resourceSchema, map[string]interface{}{"name": "tf-pool"})
+	if diags := APICreateOrUpdate(ctx, adopted, client, "pool", s); diags.HasError() {
+		t.Fatalf("adopt_marked mode failed for an object created by the provider: %v", diags)
+	}
+	if adopted.Get("uuid") != d.Get("uuid") {
+		t.Errorf("adopt_marked mode did not take over %v", d.Get("uuid"))
+	}
+}
@@ -0,0 +1,223 @@
+// Copyright 2022 VMware, Inc.
+// SPDX-License-Identifier: Mozilla Public License 2.0
+
+package avi
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// auditLogEnv names the file that the audit log of controller requests is appended to.
+const auditLogEnv = "AVI_AUDIT_LOG"
+
+const auditRedacted = "<redacted>"
+
+// auditEntry is one line of the audit log.
+type auditEntry struct {
+	Time      string      `json:"time"`
+	Method    string      `json:"method"`
+	Path      string      `json:"path"`
+	Query     string      `json:"query,omitempty"`
+	Tenant    string      `json:"tenant,omitempty"`
+	Status    int         `json:"status"`
+	LatencyMs float64     `json:"latency_ms"`
+	Error     string      `json:"error,omitempty"`
+	Request   interface{} `json:"request,omitempty"`
+	Response  interface{} `json:"response,omitempty"`
+}
+
+// auditLogger appends audit entries as JSON lines to a file.
+type auditLogger struct {
+	mu   sync.Mutex
+	file *os.File
+}
+
+var (
+	auditLoggersMu sync.Mutex
+	auditLoggers   = map[string]*auditLogger{}
+)
+
+// openAuditLog returns the logger for path. Every session of the provider shares the same logger
+// for a path so that the lines of concurrent requests do not interleave.
+func openAuditLog(path string) (*auditLogger, error) {
+	auditLoggersMu.Lock()
+	defer auditLoggersMu.Unlock()
+	if logger, ok := auditLoggers[path]; ok {
+		return logger, nil
+	}
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return nil, err
+	}
+	logger := &auditLogger{file: file}
+	auditLoggers[path] = logger
+	return logger, nil
+}
+
+func (logger *auditLogger) write(entry auditEntry) {
+	line, err := json.Marshal(entry)
+	if err != nil {
+		log.Printf("[WARN] failed to encode audit log entry: %v", err)
+		return
+	}
+	logger.mu.Lock()
+	defer logger.mu.Unlock()
+	if _, err := logger.file.Write(append(line, '\n')); err != nil {
+		log.Printf("[WARN] failed to write audit log: %v", err)
+	}
+}
+
+// auditRoundTripper records every request sent to the controller and its response in the audit
+// log. JSON bodies are logged with their sensitive fields redacted, other bodies are left out.
+type auditRoundTripper struct {
+	next http.RoundTripper
+	log  *auditLogger
+}
+
+func (rt *auditRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	entry := auditEntry{
+		Time:   time.Now().UTC().Format(time.RFC3339Nano),
+		Method: req.Method,
+		Path:   req.URL.Path,
+		Query:  req.URL.RawQuery,
+		Tenant: req.Header.Get("X-Avi-Tenant"),
+	}
+	if req.Body != nil && isJSONContent(req.Header) {
+		body, err := ioutil.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		req = req.Clone(req.Context())
+		req.Body = ioutil.NopCloser(bytes.NewReader(body))
+		req.GetBody = func() (io.ReadCloser, error) {
+			return ioutil.NopCloser(bytes.NewReader(body)), nil
+		}
+		entry.Request = auditBody(auditObjType(req.URL.Path), body)
+	}
+	start := time.Now()
+	resp, err := rt.next.RoundTrip(req)
+	entry.LatencyMs = float64(time.Since(start)) / float64(time.Millisecond)
+	if err != nil {
+		entry.Error = err.Error()
+		rt.log.write(entry)
+		return resp, err
+	}
+	entry.Status = resp.StatusCode
+	if isJSONContent(resp.Header) {
+		body, readErr := ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+		resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+		if readErr != nil {
+			entry.Error = readErr.Error()
+		}
+		entry.Response = auditBody(auditObjType(req.URL.Path), body)
+	}
+	rt.log.write(entry)
+	return resp, nil
+}
+
+// withAuditLog returns the wrapper of the round trips of a transport that writes every request to
+// the audit log at path.
+func withAuditLog(path string) (func(http.RoundTripper) http.RoundTripper, error) {
+	logger, err := openAuditLog(path)
+	if err != nil {
+		return nil, err
+	}
+	return func(next http.RoundTripper) http.RoundTripper {
+		return &auditRoundTripper{next: next, log: logger}
+	}, nil
+}
+
+func isJSONContent(header http.Header) bool {
+	return strings.Contains(header.Get("Content-Type"), "json")
+}
+
+// auditBody decodes a JSON body for the audit log with its sensitive fields redacted. objType is
+// the type of the object of the request, or empty.
+func auditBody(objType string, body []byte) interface{} {
+	if len(body) == 0 {
+		return nil
+	}
+	var data interface{}
+	if err := json.Unmarshal(body, &data); err != nil {
+		return nil
+	}
+	return redactSensitive(objType, data)
+}
+
+// auditObjType returns the object type of a request to the api path, or empty.
+func auditObjType(path string) string {
+	parts := strings.Split(strings.Trim(path, "/"), "/")
+	if len(parts) < 2 || parts[0] != "api" {
+		return ""
+	}
+	return parts[1]
+}
+
+// loginFields are the fields of the login request that hold credentials, besides the password.
+var loginFields = map[string]bool{
+	"token": true,
+}
+
+// redactSensitive returns a copy of data, an object of type objType in Avi JSON, with the values
+// of the fields of the registry of sensitive fields replaced. When objType is empty, only the
+// fields that are secret wherever they appear are redacted.
+func redactSensitive(objType string, data interface{}) interface{} {
+	path := ""
+	if objType != "" {
+		path = "avi_" + objType
+	}
+	return redactSensitivePath(path, data)
+}
+
+// redactSensitiveField returns the value of the field name with redactSensitive applied, for the
+// fields logged on their own without the type of their object.
+func redactSensitiveField(name string, value interface{}) interface{} {
+	if isSensitiveField(name, "") && value != nil {
+		return auditRedacted
+	}
+	return redactSensitivePath("", value)
+}
+
+func redactSensitivePath(path string, data interface{}) interface{} {
+	switch value := data.(type) {
+	case map[string]interface{}:
+		redacted := make(map[string]interface{}, len(value))
+		for key, item := range value {
+			fieldPath := ""
+			if path != "" {
+				fieldPath = path + "." + key
+			}
+			if isSensitiveField(key, fieldPath) && item != nil {
+				redacted[key] = auditRedacted
+				continue
+			}
+			redacted[key] = redactSensitivePath(fieldPath, item)
+		}
+		return redacted
+	case []interface{}:
+		redacted := make([]interface{}, len(value))
+		for i, item := range value {
+			redacted[i] = redactSensitivePath(path, item)
+		}
+		return redacted
+	default:
+		return data
+	}
+}
+
+// isSensitiveField reports whether the field name, at the dotted path fieldPath if known, is in
+// the registry of sensitive fields that also marks the schema fields Sensitive.
+func isSensitiveField(name, fieldPath string) bool {
+	return sensitiveFields[name] || loginFields[name] || (fieldPath != "" && sensitiveFields[fieldPath])
+}
@@ -0,0 +1,138 @@
+// Copyright 2022 VMware, Inc.
+// SPDX-License-Identifier: Mozilla Public License 2.0
+
+package avi
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os/exec"
+	"strings"
+
+	"github.com/vmware/alb-sdk/go/clients"
+	"github.com/vmware/alb-sdk/go/session"
+)
+
+// processCredentials is the JSON printed by avi_credential_process. Fields left empty keep the
+// value configured in the provider.
+type processCredentials struct {
+	Username  string `json:"username"`
+	Password  string `json:"password"`
+	AuthToken string `json:"authtoken"`
+}
+
+// loadCredentials fills in the password of config from its password file and the credentials
+// printed by its credential process. Both are read again on every call, so that rotated
+// credentials are picked up when the session is re-authenticated.
+func loadCredentials(ctx context.Context, config *Credentials) error {
+	if config.PasswordFile != "" {
+		password, err := ioutil.ReadFile(config.PasswordFile)
+		if err != nil {
+			return fmt.Errorf("failed to read avi_password_file: %s", err)
+		}
+		config.Password = strings.TrimRight(string(password), "\r\n")
+	}
+	if config.CredentialProcess != "" {
+		creds, err := runCredentialProcess(ctx, config.CredentialProcess)
+		if err != nil {
+			return err
+		}
+		if creds.Username != "" {
+			config.Username = creds.Username
+		}
+		if creds.Password != "" {
+			config.Password = creds.Password
+		}
+		if creds.AuthToken != "" {
+			config.AuthToken = creds.AuthToken
+		}
+	}
+	return nil
+}
+
+// runCredentialProcess runs command, split at whitespace without a shell, and decodes the
+// credentials it prints.
+func runCredentialProcess(ctx context.Context, command string) (processCredentials, error) {
+	var creds processCredentials
+	args := strings.Fields(command)
+	if len(args) == 0 {
+		return creds, errors.New("avi_credential_process is empty")
+	}
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, args[0], args[1:]...)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return creds, fmt.Errorf("avi_credential_process %s failed: %s: %s", args[0], err,
+			strings.TrimSpace(stderr.String()))
+	}
+	if err := json.Unmarshal(stdout.Bytes(), &creds); err != nil {
+		return creds, fmt.Errorf("avi_credential_process %s printed invalid credentials: %s", args[0], err)
+	}
+	if creds.Password == "" && creds.AuthToken == "" {
+		return creds, fmt.Errorf("avi_credential_process %s printed neither password nor authtoken", args[0])
+	}
+	return creds, nil
+}
+
+// reauthenticate replaces the session of client by a new one, logged in with freshly loaded
+// credentials. generation is the session generation seen by the caller when its request was
+// rejected; if another request has already replaced that session, nothing is done.
+func (client *ProviderClient) reauthenticate(ctx context.Context, generation int) error {
+	client.sessionMu.Lock()
+	defer client.sessionMu.Unlock()
+	if client.sessionGeneration != generation {
+		return nil
+	}
+	log.Printf("[INFO] Re-authenticating Avi session for user %s\n", client.Credentials.Username)
+	return client.replaceSessionLocked(ctx, client.Credentials)
+}
+
+// credentials returns the credentials of the current session of client.
+func (client *ProviderClient) credentials() Credentials {
+	client.sessionMu.RLock()
+	defer client.sessionMu.RUnlock()
+	return client.Credentials
+}
+
+// setPassword makes client log in with password when its session is re-authenticated, after
+// the password of the provider user was changed.
+func (client *ProviderClient) setPassword(password string) {
+	client.sessionMu.Lock()
+	defer client.sessionMu.Unlock()
+	client.Credentials.Password = password
+}
+
+// replaceSessionLocked logs in to the controller of config with freshly loaded credentials and
+// makes the new session the session of client. The caller holds sessionMu.
+func (client *ProviderClient) replaceSessionLocked(ctx context.Context, config Credentials) error {
+	if err := loadCredentials(ctx, &config); err != nil {
+		return err
+	}
+	options, err := aviSessionOptions(config, client.throttle)
+	if err != nil {
+		return err
+	}
+	options = append(options, failoverSessionOptions(len(client.controllers) > 1)...)
+	aviClient, err := clients.NewAviClient(aviControllerAddress(config), config.Username,
+		append(options, session.SetLazyAuthentication(false))...)
+	if err != nil {
+		return newAPIError(err)
+	}
+	client.AviClient = aviClient
+	client.Credentials = config
+	client.sessionGeneration++
+	return nil
+}
+
+// currentSessionGeneration returns the number of times the session of client was replaced.
+func (client *ProviderClient) currentSessionGeneration() int {
+	client.sessionMu.RLock()
+	defer client.sessionMu.RUnlock()
+	return client.sessionGeneration
+}
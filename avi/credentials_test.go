@@ -0,0 +1,117 @@
+// Copyright 2022 VMware, Inc.
+// SPDX-License-Identifier: Mozilla Public License 2.0
+
+package avi
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"os/exec"
+	"path/filepath"
+	"sync"
+	"testing"
+)
+
+func TestLoadCredentials(t *testing.T) {
+	dir := t.TempDir()
+	passwordFile := filepath.Join(dir, "password")
+	if err := ioutil.WriteFile(passwordFile, []byte("file-password\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	config := Credentials{Username: "admin", Password: "static", PasswordFile: passwordFile}
+	if err := loadCredentials(context.Background(), &config); err != nil {
+		t.Fatalf("loadCredentials() failed: %v", err)
+	}
+	if config.Password != "file-password" {
+		t.Errorf("got password %q from the password file, want file-password", config.Password)
+	}
+
+	if _, err := exec.LookPath("cat"); err != nil {
+		t.Skip("cat is not available to act as credential process")
+	}
+	credsFile := filepath.Join(dir, "creds.json")
+	if err := ioutil.WriteFile(credsFile, []byte(`{"username": "automation", "authtoken": "token"}`), 0600); err != nil {
+		t.Fatal(err)
+	}
+	config = Credentials{Username: "admin", Password: "static", CredentialProcess: "cat " + credsFile}
+	if err := loadCredentials(context.Background(), &config); err != nil {
+		t.Fatalf("loadCredentials() failed: %v", err)
+	}
+	if config.Username != "automation" || config.Password != "static" || config.AuthToken != "token" {
+		t.Errorf("got credentials %+v from the credential process", config)
+	}
+
+	for _, process := range []string{
+		"cat " + filepath.Join(dir, "missing.json"),
+		"cat " + passwordFile,
+		" ",
+	} {
+		config = Credentials{CredentialProcess: process}
+		if err := loadCredentials(context.Background(), &config); err == nil {
+			t.Errorf("credential process %q did not fail", process)
+		}
+	}
+}
+
+func TestAviSessionCallReauthenticate(t *testing.T) {
+	fc := newFakeController()
+	defer fc.Close()
+	client := newFakeControllerClient(t, fc)
+	ctx := context.Background()
+	getClouds := func() error {
+		var obj interface{}
+		return aviSessionCall(ctx, client, func() error {
+			return client.AviSession.Get("api/cloud", &obj)
+		})
+	}
+
+	passwordFile := filepath.Join(t.TempDir(), "password")
+	if err := ioutil.WriteFile(passwordFile, []byte("rotated-password\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	client.Credentials.PasswordFile = passwordFile
+	fc.SetPassword("rotated-password")
+	fc.ExpireSessions()
+	if err := getClouds(); err != nil {
+		t.Fatalf("request after the password rotation failed: %v", err)
+	}
+	if client.sessionGeneration != 1 || client.credentials().Password != "rotated-password" {
+		t.Errorf("session was not re-authenticated with the rotated password")
+	}
+
+	fc.SetPassword("unknown-password")
+	fc.ExpireSessions()
+	if err := getClouds(); aviErrorStatus(err) != http.StatusUnauthorized {
+		t.Errorf("got %v with wrong credentials, want HTTP 401", err)
+	}
+}
+
+func TestAviSessionReauthenticateConcurrent(t *testing.T) {
+	fc := newFakeController()
+	defer fc.Close()
+	client := newFakeControllerClient(t, fc)
+	ctx := context.Background()
+
+	// The session is replaced while other requests read the credentials, run with -race.
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 10; i++ {
+			if err := client.reauthenticate(ctx, i); err != nil {
+				t.Errorf("re-authentication failed: %v", err)
+			}
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 10; i++ {
+			if ok, err := validateUserAccountPassword(ctx, client, fakeControllerUsername,
+				fakeControllerPassword); err != nil || !ok {
+				t.Errorf("password of the provider user was not accepted: %v %v", ok, err)
+			}
+		}
+	}()
+	wg.Wait()
+}
@@ -0,0 +1,115 @@
+// Copyright 2022 VMware, Inc.
+// SPDX-License-Identifier: Mozilla Public License 2.0
+
+package avi
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// diffRules is the registry of the checks of combinations of attributes that the controller
+// rejects, by resource name. They run during plan, so that these combinations fail before any
+// object is changed.
+var diffRules = map[string][]schema.CustomizeDiffFunc{
+	"avi_pool":                 {checkPoolIgnoreServers},
+	"avi_sslkeyandcertificate": {checkCertificateBase64},
+	"avi_vsvip":                {checkVipAutoAllocateIP},
+}
+
+// addDiffRules adds the checks of the registry to the CustomizeDiff of the resources. All the
+// checks of a resource run, and their errors are reported together.
+func addDiffRules(resources map[string]*schema.Resource) {
+	for name, rules := range diffRules {
+		r, ok := resources[name]
+		if !ok {
+			continue
+		}
+		var funcs []schema.CustomizeDiffFunc
+		if r.CustomizeDiff != nil {
+			funcs = append(funcs, r.CustomizeDiff)
+		}
+		r.CustomizeDiff = customdiff.All(append(funcs, rules...)...)
+	}
+}
+
+// The checks read the configuration rather than the planned values, as the controller fills in
+// computed attributes, such as the allocated addresses of a vip, that are not configured.
+
+// checkPoolIgnoreServers rejects servers in a pool whose servers are ignored.
+func checkPoolIgnoreServers(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	config := d.GetRawConfig()
+	ignoreServers := configAttr(config, "ignore_servers")
+	if !configTrue(ignoreServers) || !configNotEmpty(configAttr(config, "servers")) {
+		return nil
+	}
+	return errors.New("servers cannot be set when ignore_servers is true, " +
+		"remove the servers or manage them with avi_server resources")
+}
+
+// checkVipAutoAllocateIP rejects addresses in a vip whose addresses are allocated by the controller.
+func checkVipAutoAllocateIP(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	var errs []string
+	vips := configAttr(d.GetRawConfig(), "vip")
+	for i := 0; ; i++ {
+		vip := configElem(vips, i)
+		if vip.IsNull() || !vip.IsKnown() {
+			break
+		}
+		if !configTrue(configAttr(vip, "auto_allocate_ip")) {
+			continue
+		}
+		for _, name := range []string{"ip_address", "ip6_address"} {
+			if configNotEmpty(configAttr(vip, name)) {
+				errs = append(errs, fmt.Sprintf("vip.%d.%s cannot be set when vip.%d.auto_allocate_ip is true, "+
+					"the controller allocates the address", i, name, i))
+			}
+		}
+	}
+	if len(errs) > 0 {
+		return errors.New(strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+// checkCertificateBase64 rejects a certificate that is not base64 encoded while certificate_base64
+// says it is, which the controller fails to decode.
+func checkCertificateBase64(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	config := d.GetRawConfig()
+	if !configTrue(configAttr(config, "certificate_base64")) {
+		return nil
+	}
+	certificate := configAttr(configElem(configAttr(config, "certificate"), 0), "certificate")
+	if certificate.IsNull() || !certificate.IsKnown() || !certificate.Type().Equals(cty.String) {
+		return nil
+	}
+	content := strings.Join(strings.Fields(certificate.AsString()), "")
+	if _, err := base64.StdEncoding.DecodeString(content); err != nil {
+		return errors.New("certificate.certificate is not base64 encoded while certificate_base64 is true, " +
+			"encode it with base64encode() or set certificate_base64 to false for PEM content")
+	}
+	return nil
+}
+
+// configTrue reports whether the bool config is known and true.
+func configTrue(config cty.Value) bool {
+	return !config.IsNull() && config.IsKnown() && config.Type().Equals(cty.Bool) && config.True()
+}
+
+// configNotEmpty reports whether config is known, not null and, for a list or set, not empty.
+func configNotEmpty(config cty.Value) bool {
+	if config.IsNull() || !config.IsKnown() {
+		return false
+	}
+	if config.Type().IsCollectionType() {
+		return config.LengthInt() > 0
+	}
+	return true
+}
@@ -0,0 +1,121 @@
+// Copyright 2022 VMware, Inc.
+// SPDX-License-Identifier: Mozilla Public License 2.0
+
+package avi
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	ctyjson "github.com/hashicorp/go-cty/cty/json"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+// planDiff plans the creation of resource name from config, as terraform does, and returns the
+// error of the plan.
+func planDiff(t *testing.T, name string, config map[string]interface{}) error {
+	r := Provider().ResourcesMap[name]
+	buf, err := json.Marshal(config)
+	if err != nil {
+		t.Fatalf("marshalling config failed: %v", err)
+	}
+	rawConfig, err := ctyjson.Unmarshal(buf, r.CoreConfigSchema().ImpliedType())
+	if err != nil {
+		t.Fatalf("converting config failed: %v", err)
+	}
+	state := &terraform.InstanceState{RawConfig: rawConfig}
+	_, err = r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(config), nil)
+	return err
+}
+
+func TestDiffRules(t *testing.T) {
+	pem := "-----BEGIN CERTIFICATE-----\nMIIBszCCAVmgAwIBAgIUYQ==\n-----END CERTIFICATE-----\n"
+	server := map[string]interface{}{
+		"ip":   []interface{}{map[string]interface{}{"addr": "10.0.0.1", "type": "V4"}},
+		"port": 80,
+	}
+	address := []interface{}{map[string]interface{}{"addr": "10.0.0.10", "type": "V4"}}
+	tests := []struct {
+		name     string
+		resource string
+		config   map[string]interface{}
+		err      string
+	}{
+		{
+			name:     "pool servers",
+			resource: "avi_pool",
+			config:   map[string]interface{}{"name": "pool-1", "servers": []interface{}{server}},
+		},
+		{
+			name:     "pool ignore_servers",
+			resource: "avi_pool",
+			config:   map[string]interface{}{"name": "pool-1", "ignore_servers": true},
+		},
+		{
+			name:     "pool servers and ignore_servers",
+			resource: "avi_pool",
+			config: map[string]interface{}{
+				"name": "pool-1", "ignore_servers": true, "servers": []interface{}{server},
+			},
+			err: "servers cannot be set when ignore_servers is true",
+		},
+		{
+			name:     "vsvip address",
+			resource: "avi_vsvip",
+			config: map[string]interface{}{"name": "vsvip-1", "vip": []interface{}{
+				map[string]interface{}{"vip_id": "0", "ip_address": address},
+			}},
+		},
+		{
+			name:     "vsvip auto_allocate_ip",
+			resource: "avi_vsvip",
+			config: map[string]interface{}{"name": "vsvip-1", "vip": []interface{}{
+				map[string]interface{}{"vip_id": "0", "auto_allocate_ip": true},
+			}},
+		},
+		{
+			name:     "vsvip auto_allocate_ip and address",
+			resource: "avi_vsvip",
+			config: map[string]interface{}{"name": "vsvip-1", "vip": []interface{}{
+				map[string]interface{}{"vip_id": "0", "ip_address": address},
+				map[string]interface{}{"vip_id": "1", "auto_allocate_ip": true, "ip_address": address},
+			}},
+			err: "vip.1.ip_address cannot be set when vip.1.auto_allocate_ip is true",
+		},
+		{
+			name:     "certificate pem",
+			resource: "avi_sslkeyandcertificate",
+			config: map[string]interface{}{"name": "cert-1", "certificate": []interface{}{
+				map[string]interface{}{"certificate": pem},
+			}},
+		},
+		{
+			name:     "certificate base64",
+			resource: "avi_sslkeyandcertificate",
+			config: map[string]interface{}{"name": "cert-1", "certificate_base64": true, "certificate": []interface{}{
+				map[string]interface{}{"certificate": "LS0tLS1CRUdJTiBDRVJUSUZJQ0FURS0tLS0t\nCg=="},
+			}},
+		},
+		{
+			name:     "certificate base64 and pem",
+			resource: "avi_sslkeyandcertificate",
+			config: map[string]interface{}{"name": "cert-1", "certificate_base64": true, "certificate": []interface{}{
+				map[string]interface{}{"certificate": pem},
+			}},
+			err: "certificate.certificate is not base64 encoded while certificate_base64 is true",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := planDiff(t, test.resource, test.config)
+			if test.err == "" && err != nil {
+				t.Errorf("plan failed: %v", err)
+			}
+			if test.err != "" && (err == nil || !strings.Contains(err.Error(), test.err)) {
+				t.Errorf("got plan error %v, want %q", err, test.err)
+			}
+		})
+	}
+}
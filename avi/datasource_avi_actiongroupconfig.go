@@ -7,14 +7,14 @@ import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 func dataSourceAviActionGroupConfig() *schema.Resource {
 	return &schema.Resource{
-		Read: ResourceAviActionGroupConfigRead,
+		ReadContext: ResourceAviActionGroupConfigRead,
 		Schema: map[string]*schema.Schema{
 			"action_script_config_ref": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
 			"autoscale_trigger_notification": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"configpb_attributes": {
@@ -31,7 +31,7 @@ func dataSourceAviActionGroupConfig() *schema.Resource {
 				Computed: true,
 			},
 			"external_only": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"level": {
@@ -7,7 +7,7 @@ import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 func dataSourceAviALBServicesConfig() *schema.Resource {
 	return &schema.Resource{
-		Read: ResourceAviALBServicesConfigRead,
+		ReadContext: ResourceAviALBServicesConfigRead,
 		Schema: map[string]*schema.Schema{
 			"app_signature_config": {
 				Type:     schema.TypeSet,
@@ -44,7 +44,7 @@ func dataSourceAviALBServicesConfig() *schema.Resource {
 				Computed: true,
 			},
 			"polling_interval": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeInt,
 				Computed: true,
 			},
 			"portal_url": {
@@ -62,11 +62,11 @@ func dataSourceAviALBServicesConfig() *schema.Resource {
 				Elem:     ResourceProxyConfigurationSchema(),
 			},
 			"use_split_proxy": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"use_tls": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"user_agent_db_config": {
@@ -7,7 +7,7 @@ import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 func dataSourceAviALBServicesFileUpload() *schema.Resource {
 	return &schema.Resource{
-		Read: ResourceAviALBServicesFileUploadRead,
+		ReadContext: ResourceAviALBServicesFileUploadRead,
 		Schema: map[string]*schema.Schema{
 			"case_id": {
 				Type:     schema.TypeString,
@@ -7,7 +7,7 @@ import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 func dataSourceAviALBServicesJob() *schema.Resource {
 	return &schema.Resource{
-		Read: ResourceAviALBServicesJobRead,
+		ReadContext: ResourceAviALBServicesJobRead,
 		Schema: map[string]*schema.Schema{
 			"command": {
 				Type:     schema.TypeString,
@@ -38,7 +38,7 @@ func dataSourceAviALBServicesJob() *schema.Resource {
 				Computed: true,
 			},
 			"pulse_sync_status": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"result": {
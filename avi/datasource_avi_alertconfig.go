@@ -7,7 +7,7 @@ import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 func dataSourceAviAlertConfig() *schema.Resource {
 	return &schema.Resource{
-		Read: ResourceAviAlertConfigRead,
+		ReadContext: ResourceAviAlertConfigRead,
 		Schema: map[string]*schema.Schema{
 			"action_group_ref": {
 				Type:     schema.TypeString,
@@ -19,7 +19,7 @@ func dataSourceAviAlertConfig() *schema.Resource {
 				Elem:     ResourceAlertRuleSchema(),
 			},
 			"autoscale_alert": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"category": {
@@ -36,11 +36,11 @@ func dataSourceAviAlertConfig() *schema.Resource {
 				Computed: true,
 			},
 			"enabled": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"expiry_time": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeInt,
 				Computed: true,
 			},
 			"name": {
@@ -61,7 +61,7 @@ func dataSourceAviAlertConfig() *schema.Resource {
 				Computed: true,
 			},
 			"rolling_window": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeInt,
 				Computed: true,
 			},
 			"source": {
@@ -78,11 +78,11 @@ func dataSourceAviAlertConfig() *schema.Resource {
 				Computed: true,
 			},
 			"threshold": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeInt,
 				Computed: true,
 			},
 			"throttle": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeInt,
 				Computed: true,
 			},
 			"uuid": {
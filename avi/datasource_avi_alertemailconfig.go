@@ -7,7 +7,7 @@ import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 func dataSourceAviAlertEmailConfig() *schema.Resource {
 	return &schema.Resource{
-		Read: ResourceAviAlertEmailConfigRead,
+		ReadContext: ResourceAviAlertEmailConfigRead,
 		Schema: map[string]*schema.Schema{
 			"cc_emails": {
 				Type:     schema.TypeString,
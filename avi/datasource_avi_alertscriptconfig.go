@@ -7,7 +7,7 @@ import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 func dataSourceAviAlertScriptConfig() *schema.Resource {
 	return &schema.Resource{
-		Read: ResourceAviAlertScriptConfigRead,
+		ReadContext: ResourceAviAlertScriptConfigRead,
 		Schema: map[string]*schema.Schema{
 			"action_script": {
 				Type:     schema.TypeString,
@@ -7,7 +7,7 @@ import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 func dataSourceAviAlertSyslogConfig() *schema.Resource {
 	return &schema.Resource{
-		Read: ResourceAviAlertSyslogConfigRead,
+		ReadContext: ResourceAviAlertSyslogConfigRead,
 		Schema: map[string]*schema.Schema{
 			"configpb_attributes": {
 				Type:     schema.TypeSet,
@@ -7,7 +7,7 @@ import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 func dataSourceAviApplicationPersistenceProfile() *schema.Resource {
 	return &schema.Resource{
-		Read: ResourceAviApplicationPersistenceProfileRead,
+		ReadContext: ResourceAviApplicationPersistenceProfileRead,
 		Schema: map[string]*schema.Schema{
 			"app_cookie_persistence_profile": {
 				Type:     schema.TypeSet,
@@ -39,7 +39,7 @@ func dataSourceAviApplicationPersistenceProfile() *schema.Resource {
 				Elem:     ResourceIPPersistenceProfileSchema(),
 			},
 			"is_federated": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"markers": {
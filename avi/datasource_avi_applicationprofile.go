@@ -7,7 +7,7 @@ import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 func dataSourceAviApplicationProfile() *schema.Resource {
 	return &schema.Resource{
-		Read: ResourceAviApplicationProfileRead,
+		ReadContext: ResourceAviApplicationProfileRead,
 		Schema: map[string]*schema.Schema{
 			"app_service_type": {
 				Type:     schema.TypeString,
@@ -61,15 +61,15 @@ func dataSourceAviApplicationProfile() *schema.Resource {
 				Computed: true,
 			},
 			"preserve_client_ip": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"preserve_client_port": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"preserve_dest_ip_port": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"sip_service_profile": {
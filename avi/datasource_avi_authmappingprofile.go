@@ -7,7 +7,7 @@ import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 func dataSourceAviAuthMappingProfile() *schema.Resource {
 	return &schema.Resource{
-		Read: ResourceAviAuthMappingProfileRead,
+		ReadContext: ResourceAviAuthMappingProfileRead,
 		Schema: map[string]*schema.Schema{
 			"configpb_attributes": {
 				Type:     schema.TypeSet,
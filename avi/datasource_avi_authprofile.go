@@ -7,7 +7,7 @@ import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 func dataSourceAviAuthProfile() *schema.Resource {
 	return &schema.Resource{
-		Read: ResourceAviAuthProfileRead,
+		ReadContext: ResourceAviAuthProfileRead,
 		Schema: map[string]*schema.Schema{
 			"configpb_attributes": {
 				Type:     schema.TypeSet,
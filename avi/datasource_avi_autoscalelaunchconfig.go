@@ -7,7 +7,7 @@ import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 func dataSourceAviAutoScaleLaunchConfig() *schema.Resource {
 	return &schema.Resource{
-		Read: ResourceAviAutoScaleLaunchConfigRead,
+		ReadContext: ResourceAviAutoScaleLaunchConfigRead,
 		Schema: map[string]*schema.Schema{
 			"configpb_attributes": {
 				Type:     schema.TypeSet,
@@ -48,7 +48,7 @@ func dataSourceAviAutoScaleLaunchConfig() *schema.Resource {
 				Computed: true,
 			},
 			"use_external_asg": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"uuid": {
@@ -7,7 +7,7 @@ import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 func dataSourceAviAvailabilityZone() *schema.Resource {
 	return &schema.Resource{
-		Read: ResourceAviAvailabilityZoneRead,
+		ReadContext: ResourceAviAvailabilityZoneRead,
 		Schema: map[string]*schema.Schema{
 			"cloud_ref": {
 				Type:     schema.TypeString,
@@ -7,7 +7,7 @@ import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 func dataSourceAviBackup() *schema.Resource {
 	return &schema.Resource{
-		Read: ResourceAviBackupRead,
+		ReadContext: ResourceAviBackupRead,
 		Schema: map[string]*schema.Schema{
 			"backup_config_ref": {
 				Type:     schema.TypeString,
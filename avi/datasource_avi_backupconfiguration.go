@@ -7,7 +7,7 @@ import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 func dataSourceAviBackupConfiguration() *schema.Resource {
 	return &schema.Resource{
-		Read: ResourceAviBackupConfigurationRead,
+		ReadContext: ResourceAviBackupConfigurationRead,
 		Schema: map[string]*schema.Schema{
 			"aws_access_key": {
 				Type:     schema.TypeString,
@@ -39,7 +39,7 @@ func dataSourceAviBackupConfiguration() *schema.Resource {
 				Elem:     ResourceConfigPbAttributesSchema(),
 			},
 			"maximum_backups_stored": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeInt,
 				Computed: true,
 			},
 			"name": {
@@ -60,7 +60,7 @@ func dataSourceAviBackupConfiguration() *schema.Resource {
 				Computed: true,
 			},
 			"save_local": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"ssh_user_ref": {
@@ -73,11 +73,11 @@ func dataSourceAviBackupConfiguration() *schema.Resource {
 				Computed: true,
 			},
 			"upload_to_remote_host": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"upload_to_s3": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"uuid": {
@@ -7,7 +7,7 @@ import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 func dataSourceAviBotConfigConsolidator() *schema.Resource {
 	return &schema.Resource{
-		Read: ResourceAviBotConfigConsolidatorRead,
+		ReadContext: ResourceAviBotConfigConsolidatorRead,
 		Schema: map[string]*schema.Schema{
 			"description": {
 				Type:     schema.TypeString,
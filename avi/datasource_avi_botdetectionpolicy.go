@@ -7,7 +7,7 @@ import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 func dataSourceAviBotDetectionPolicy() *schema.Resource {
 	return &schema.Resource{
-		Read: ResourceAviBotDetectionPolicyRead,
+		ReadContext: ResourceAviBotDetectionPolicyRead,
 		Schema: map[string]*schema.Schema{
 			"allow_list": {
 				Type:     schema.TypeSet,
@@ -7,7 +7,7 @@ import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 func dataSourceAviBotIPReputationTypeMapping() *schema.Resource {
 	return &schema.Resource{
-		Read: ResourceAviBotIPReputationTypeMappingRead,
+		ReadContext: ResourceAviBotIPReputationTypeMappingRead,
 		Schema: map[string]*schema.Schema{
 			"ip_reputation_mappings": {
 				Type:     schema.TypeList,
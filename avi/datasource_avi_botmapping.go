@@ -7,7 +7,7 @@ import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 func dataSourceAviBotMapping() *schema.Resource {
 	return &schema.Resource{
-		Read: ResourceAviBotMappingRead,
+		ReadContext: ResourceAviBotMappingRead,
 		Schema: map[string]*schema.Schema{
 			"mapping_rules": {
 				Type:     schema.TypeList,
@@ -7,7 +7,7 @@ import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 func dataSourceAviCertificateManagementProfile() *schema.Resource {
 	return &schema.Resource{
-		Read: ResourceAviCertificateManagementProfileRead,
+		ReadContext: ResourceAviCertificateManagementProfileRead,
 		Schema: map[string]*schema.Schema{
 			"configpb_attributes": {
 				Type:     schema.TypeSet,
@@ -7,10 +7,10 @@ import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 func dataSourceAviCloud() *schema.Resource {
 	return &schema.Resource{
-		Read: ResourceAviCloudRead,
+		ReadContext: ResourceAviCloudRead,
 		Schema: map[string]*schema.Schema{
 			"autoscale_polling_interval": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeInt,
 				Computed: true,
 			},
 			"aws_configuration": {
@@ -39,7 +39,7 @@ func dataSourceAviCloud() *schema.Resource {
 				Elem:     ResourceCustomTagSchema(),
 			},
 			"dhcp_enabled": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"dns_provider_ref": {
@@ -47,7 +47,7 @@ func dataSourceAviCloud() *schema.Resource {
 				Computed: true,
 			},
 			"dns_resolution_on_se": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"dns_resolvers": {
@@ -69,11 +69,11 @@ func dataSourceAviCloud() *schema.Resource {
 				Computed: true,
 			},
 			"enable_vip_on_all_interfaces": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"enable_vip_static_routes": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"gcp_configuration": {
@@ -82,7 +82,7 @@ func dataSourceAviCloud() *schema.Resource {
 				Elem:     ResourceGCPConfigurationSchema(),
 			},
 			"ip6_autocfg_enabled": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"ipam_provider_ref": {
@@ -103,7 +103,7 @@ func dataSourceAviCloud() *schema.Resource {
 				Elem:     ResourceLinuxServerConfigurationSchema(),
 			},
 			"maintenance_mode": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"markers": {
@@ -112,11 +112,11 @@ func dataSourceAviCloud() *schema.Resource {
 				Elem:     ResourceRoleFilterMatchLabelSchema(),
 			},
 			"metrics_polling_interval": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeInt,
 				Computed: true,
 			},
 			"mtu": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeInt,
 				Computed: true,
 			},
 			"name": {
@@ -144,7 +144,7 @@ func dataSourceAviCloud() *schema.Resource {
 				Elem:     ResourceOpenStackConfigurationSchema(),
 			},
 			"prefer_static_routes": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"proxy_configuration": {
@@ -162,7 +162,7 @@ func dataSourceAviCloud() *schema.Resource {
 				Computed: true,
 			},
 			"state_based_dns_registration": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"tenant_ref": {
@@ -186,7 +186,7 @@ func dataSourceAviCloud() *schema.Resource {
 				Elem:     ResourcevCenterConfigurationSchema(),
 			},
 			"vmc_deployment": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"vtype": {
@@ -7,7 +7,7 @@ import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 func dataSourceAviCloudConnectorUser() *schema.Resource {
 	return &schema.Resource{
-		Read: ResourceAviCloudConnectorUserRead,
+		ReadContext: ResourceAviCloudConnectorUserRead,
 		Schema: map[string]*schema.Schema{
 			"azure_serviceprincipal": {
 				Type:     schema.TypeSet,
@@ -7,7 +7,7 @@ import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 func dataSourceAviCloudProperties() *schema.Resource {
 	return &schema.Resource{
-		Read: ResourceAviCloudPropertiesRead,
+		ReadContext: ResourceAviCloudPropertiesRead,
 		Schema: map[string]*schema.Schema{
 			"cc_props": {
 				Type:     schema.TypeSet,
@@ -7,7 +7,7 @@ import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 func dataSourceAviCluster() *schema.Resource {
 	return &schema.Resource{
-		Read: ResourceAviClusterRead,
+		ReadContext: ResourceAviClusterRead,
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -20,7 +20,7 @@ func dataSourceAviCluster() *schema.Resource {
 				Elem:     ResourceClusterNodeSchema(),
 			},
 			"rejoin_nodes_automatically": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"tenant_ref": {
@@ -7,7 +7,7 @@ import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 func dataSourceAviClusterCloudDetails() *schema.Resource {
 	return &schema.Resource{
-		Read: ResourceAviClusterCloudDetailsRead,
+		ReadContext: ResourceAviClusterCloudDetailsRead,
 		Schema: map[string]*schema.Schema{
 			"azure_info": {
 				Type:     schema.TypeSet,
@@ -7,7 +7,7 @@ import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 func dataSourceAviControllerPortalRegistration() *schema.Resource {
 	return &schema.Resource{
-		Read: ResourceAviControllerPortalRegistrationRead,
+		ReadContext: ResourceAviControllerPortalRegistrationRead,
 		Schema: map[string]*schema.Schema{
 			"asset": {
 				Type:     schema.TypeSet,
@@ -7,7 +7,7 @@ import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 func dataSourceAviControllerSite() *schema.Resource {
 	return &schema.Resource{
-		Read: ResourceAviControllerSiteRead,
+		ReadContext: ResourceAviControllerSiteRead,
 		Schema: map[string]*schema.Schema{
 			"address": {
 				Type:     schema.TypeString,
@@ -24,7 +24,7 @@ func dataSourceAviControllerSite() *schema.Resource {
 				Computed: true,
 			},
 			"port": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeInt,
 				Computed: true,
 			},
 			"tenant_ref": {
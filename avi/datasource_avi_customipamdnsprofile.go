@@ -8,7 +8,7 @@ import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 //nolint
 func dataSourceAviCustomIpamDnsProfile() *schema.Resource {
 	return &schema.Resource{
-		Read: ResourceAviCustomIpamDnsProfileRead,
+		ReadContext: ResourceAviCustomIpamDnsProfileRead,
 		Schema: map[string]*schema.Schema{
 			"configpb_attributes": {
 				Type:     schema.TypeSet,
@@ -8,7 +8,7 @@ import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 //nolint
 func dataSourceAviDnsPolicy() *schema.Resource {
 	return &schema.Resource{
-		Read: ResourceAviDnsPolicyRead,
+		ReadContext: ResourceAviDnsPolicyRead,
 		Schema: map[string]*schema.Schema{
 			"configpb_attributes": {
 				Type:     schema.TypeSet,
@@ -24,7 +24,7 @@ func dataSourceAviDnsPolicy() *schema.Resource {
 				Computed: true,
 			},
 			"internal": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"markers": {
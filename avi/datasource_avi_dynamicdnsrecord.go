@@ -8,7 +8,7 @@ import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 //nolint
 func dataSourceAviDynamicDnsRecord() *schema.Resource {
 	return &schema.Resource{
-		Read: ResourceAviDynamicDnsRecordRead,
+		ReadContext: ResourceAviDynamicDnsRecordRead,
 		Schema: map[string]*schema.Schema{
 			"algorithm": {
 				Type:     schema.TypeString,
@@ -20,7 +20,7 @@ func dataSourceAviDynamicDnsRecord() *schema.Resource {
 				Elem:     ResourceDnsCnameRdataSchema(),
 			},
 			"delegated": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"description": {
@@ -65,7 +65,7 @@ func dataSourceAviDynamicDnsRecord() *schema.Resource {
 				Elem:     ResourceDnsNsRdataSchema(),
 			},
 			"num_records_in_response": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeInt,
 				Computed: true,
 			},
 			"service_locators": {
@@ -79,7 +79,7 @@ func dataSourceAviDynamicDnsRecord() *schema.Resource {
 				Computed: true,
 			},
 			"ttl": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeInt,
 				Computed: true,
 			},
 			"txt_records": {
@@ -97,7 +97,7 @@ func dataSourceAviDynamicDnsRecord() *schema.Resource {
 				Computed: true,
 			},
 			"wildcard_match": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 		},
@@ -7,7 +7,7 @@ import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 func dataSourceAviErrorPageBody() *schema.Resource {
 	return &schema.Resource{
-		Read: ResourceAviErrorPageBodyRead,
+		ReadContext: ResourceAviErrorPageBodyRead,
 		Schema: map[string]*schema.Schema{
 			"configpb_attributes": {
 				Type:     schema.TypeSet,
@@ -7,7 +7,7 @@ import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 func dataSourceAviErrorPageProfile() *schema.Resource {
 	return &schema.Resource{
-		Read: ResourceAviErrorPageProfileRead,
+		ReadContext: ResourceAviErrorPageProfileRead,
 		Schema: map[string]*schema.Schema{
 			"configpb_attributes": {
 				Type:     schema.TypeSet,
@@ -7,7 +7,7 @@ import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 func dataSourceAviFederationCheckpoint() *schema.Resource {
 	return &schema.Resource{
-		Read: ResourceAviFederationCheckpointRead,
+		ReadContext: ResourceAviFederationCheckpointRead,
 		Schema: map[string]*schema.Schema{
 			"configpb_attributes": {
 				Type:     schema.TypeSet,
@@ -23,7 +23,7 @@ func dataSourceAviFederationCheckpoint() *schema.Resource {
 				Computed: true,
 			},
 			"is_federated": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"name": {
@@ -7,14 +7,14 @@ import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 func dataSourceAviFileObject() *schema.Resource {
 	return &schema.Resource{
-		Read: ResourceAviFileObjectRead,
+		ReadContext: ResourceAviFileObjectRead,
 		Schema: map[string]*schema.Schema{
 			"checksum": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
 			"compressed": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"created": {
@@ -30,7 +30,7 @@ func dataSourceAviFileObject() *schema.Resource {
 				Computed: true,
 			},
 			"is_federated": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"name": {
@@ -43,15 +43,15 @@ func dataSourceAviFileObject() *schema.Resource {
 				Computed: true,
 			},
 			"read_only": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"restrict_download": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"size": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeInt,
 				Computed: true,
 			},
 			"tenant_ref": {
@@ -10,7 +10,7 @@ import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 func dataSourceAviFileService() *schema.Resource {
 	return &schema.Resource{
-		Read: ResourceAviFileServiceCreate,
+		ReadContext: ResourceAviFileServiceCreate,
 		Schema: map[string]*schema.Schema{
 			"uri": {
 				Type:     schema.TypeString,
@@ -7,7 +7,7 @@ import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 func dataSourceAviGeoDB() *schema.Resource {
 	return &schema.Resource{
-		Read: ResourceAviGeoDBRead,
+		ReadContext: ResourceAviGeoDBRead,
 		Schema: map[string]*schema.Schema{
 			"description": {
 				Type:     schema.TypeString,
@@ -19,7 +19,7 @@ func dataSourceAviGeoDB() *schema.Resource {
 				Elem:     ResourceGeoDBFileSchema(),
 			},
 			"is_federated": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"mappings": {
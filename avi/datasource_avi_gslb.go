@@ -7,14 +7,14 @@ import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 func dataSourceAviGslb() *schema.Resource {
 	return &schema.Resource{
-		Read: ResourceAviGslbRead,
+		ReadContext: ResourceAviGslbRead,
 		Schema: map[string]*schema.Schema{
 			"async_interval": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeInt,
 				Computed: true,
 			},
 			"clear_on_max_retries": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeInt,
 				Computed: true,
 			},
 			"client_ip_addr_group": {
@@ -37,15 +37,15 @@ func dataSourceAviGslb() *schema.Resource {
 				Elem:     ResourceDNSConfigSchema(),
 			},
 			"enable_config_by_members": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"error_resync_interval": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeInt,
 				Computed: true,
 			},
 			"is_federated": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"leader_cluster_uuid": {
@@ -53,7 +53,7 @@ func dataSourceAviGslb() *schema.Resource {
 				Computed: true,
 			},
 			"maintenance_mode": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"name": {
@@ -67,11 +67,11 @@ func dataSourceAviGslb() *schema.Resource {
 				Elem:     ResourceReplicationPolicySchema(),
 			},
 			"send_interval": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeInt,
 				Computed: true,
 			},
 			"send_interval_prior_to_maintenance_mode": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeInt,
 				Computed: true,
 			},
 			"sites": {
@@ -85,7 +85,7 @@ func dataSourceAviGslb() *schema.Resource {
 				Computed: true,
 			},
 			"tenant_scoped": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"third_party_sites": {
@@ -99,7 +99,7 @@ func dataSourceAviGslb() *schema.Resource {
 				Computed: true,
 			},
 			"view_id": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeInt,
 				Computed: true,
 			},
 		},
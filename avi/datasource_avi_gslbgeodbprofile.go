@@ -7,7 +7,7 @@ import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 func dataSourceAviGslbGeoDbProfile() *schema.Resource {
 	return &schema.Resource{
-		Read: ResourceAviGslbGeoDbProfileRead,
+		ReadContext: ResourceAviGslbGeoDbProfileRead,
 		Schema: map[string]*schema.Schema{
 			"configpb_attributes": {
 				Type:     schema.TypeSet,
@@ -24,7 +24,7 @@ func dataSourceAviGslbGeoDbProfile() *schema.Resource {
 				Elem:     ResourceGslbGeoDbEntrySchema(),
 			},
 			"is_federated": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"markers": {
@@ -7,7 +7,7 @@ import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 func dataSourceAviGslbService() *schema.Resource {
 	return &schema.Resource{
-		Read: ResourceAviGslbServiceRead,
+		ReadContext: ResourceAviGslbServiceRead,
 		Schema: map[string]*schema.Schema{
 			"application_persistence_profile_ref": {
 				Type:     schema.TypeString,
@@ -19,7 +19,7 @@ func dataSourceAviGslbService() *schema.Resource {
 				Elem:     ResourceConfigPbAttributesSchema(),
 			},
 			"controller_health_status_enabled": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"created_by": {
@@ -41,7 +41,7 @@ func dataSourceAviGslbService() *schema.Resource {
 				Elem:     ResourceGslbServiceDownResponseSchema(),
 			},
 			"enabled": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"groups": {
@@ -59,11 +59,11 @@ func dataSourceAviGslbService() *schema.Resource {
 				Computed: true,
 			},
 			"hm_off": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"is_federated": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"markers": {
@@ -72,7 +72,7 @@ func dataSourceAviGslbService() *schema.Resource {
 				Elem:     ResourceRoleFilterMatchLabelSchema(),
 			},
 			"min_members": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeInt,
 				Computed: true,
 			},
 			"name": {
@@ -81,7 +81,7 @@ func dataSourceAviGslbService() *schema.Resource {
 				Computed: true,
 			},
 			"num_dns_ip": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeInt,
 				Computed: true,
 			},
 			"pki_profile_ref": {
@@ -93,11 +93,11 @@ func dataSourceAviGslbService() *schema.Resource {
 				Computed: true,
 			},
 			"resolve_cname": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"site_persistence_enabled": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"tenant_ref": {
@@ -106,15 +106,15 @@ func dataSourceAviGslbService() *schema.Resource {
 				Computed: true,
 			},
 			"topology_policy_enabled": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"ttl": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeInt,
 				Computed: true,
 			},
 			"use_edns_client_subnet": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"uuid": {
@@ -123,7 +123,7 @@ func dataSourceAviGslbService() *schema.Resource {
 				Computed: true,
 			},
 			"wildcard_match": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 		},
@@ -7,7 +7,7 @@ import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 func dataSourceAviHardwareSecurityModuleGroup() *schema.Resource {
 	return &schema.Resource{
-		Read: ResourceAviHardwareSecurityModuleGroupRead,
+		ReadContext: ResourceAviHardwareSecurityModuleGroupRead,
 		Schema: map[string]*schema.Schema{
 			"configpb_attributes": {
 				Type:     schema.TypeSet,
@@ -7,10 +7,10 @@ import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 func dataSourceAviHealthMonitor() *schema.Resource {
 	return &schema.Resource{
-		Read: ResourceAviHealthMonitorRead,
+		ReadContext: ResourceAviHealthMonitorRead,
 		Schema: map[string]*schema.Schema{
 			"allow_duplicate_monitors": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"authentication": {
@@ -28,7 +28,7 @@ func dataSourceAviHealthMonitor() *schema.Resource {
 				Computed: true,
 			},
 			"disable_quickstart": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"dns_monitor": {
@@ -42,7 +42,7 @@ func dataSourceAviHealthMonitor() *schema.Resource {
 				Elem:     ResourceHealthMonitorExternalSchema(),
 			},
 			"failed_checks": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeInt,
 				Computed: true,
 			},
 			"ftp_monitor": {
@@ -76,7 +76,7 @@ func dataSourceAviHealthMonitor() *schema.Resource {
 				Elem:     ResourceHealthMonitorImapSchema(),
 			},
 			"is_federated": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"ldap_monitor": {
@@ -95,7 +95,7 @@ func dataSourceAviHealthMonitor() *schema.Resource {
 				Elem:     ResourceRoleFilterMatchLabelSchema(),
 			},
 			"monitor_port": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeInt,
 				Computed: true,
 			},
 			"name": {
@@ -119,7 +119,7 @@ func dataSourceAviHealthMonitor() *schema.Resource {
 				Elem:     ResourceHealthMonitorRadiusSchema(),
 			},
 			"receive_timeout": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeInt,
 				Computed: true,
 			},
 			"sctp_monitor": {
@@ -128,7 +128,7 @@ func dataSourceAviHealthMonitor() *schema.Resource {
 				Elem:     ResourceHealthMonitorSctpSchema(),
 			},
 			"send_interval": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeInt,
 				Computed: true,
 			},
 			"sip_monitor": {
@@ -147,7 +147,7 @@ func dataSourceAviHealthMonitor() *schema.Resource {
 				Elem:     ResourceHealthMonitorSmtpSchema(),
 			},
 			"successful_checks": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeInt,
 				Computed: true,
 			},
 			"tcp_monitor": {
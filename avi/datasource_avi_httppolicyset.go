@@ -7,7 +7,7 @@ import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 func dataSourceAviHTTPPolicySet() *schema.Resource {
 	return &schema.Resource{
-		Read: ResourceAviHTTPPolicySetRead,
+		ReadContext: ResourceAviHTTPPolicySetRead,
 		Schema: map[string]*schema.Schema{
 			"cloud_config_cksum": {
 				Type:     schema.TypeString,
@@ -50,7 +50,7 @@ func dataSourceAviHTTPPolicySet() *schema.Resource {
 				Computed: true,
 			},
 			"is_internal_policy": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"markers": {
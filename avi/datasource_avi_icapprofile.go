@@ -7,14 +7,14 @@ import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 func dataSourceAviIcapProfile() *schema.Resource {
 	return &schema.Resource{
-		Read: ResourceAviIcapProfileRead,
+		ReadContext: ResourceAviIcapProfileRead,
 		Schema: map[string]*schema.Schema{
 			"allow_204": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"buffer_size": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeInt,
 				Computed: true,
 			},
 			"buffer_size_exceed_action": {
@@ -36,7 +36,7 @@ func dataSourceAviIcapProfile() *schema.Resource {
 				Computed: true,
 			},
 			"enable_preview": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"fail_action": {
@@ -58,11 +58,11 @@ func dataSourceAviIcapProfile() *schema.Resource {
 				Computed: true,
 			},
 			"preview_size": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeInt,
 				Computed: true,
 			},
 			"response_timeout": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeInt,
 				Computed: true,
 			},
 			"service_uri": {
@@ -70,7 +70,7 @@ func dataSourceAviIcapProfile() *schema.Resource {
 				Computed: true,
 			},
 			"slow_response_warning_threshold": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeInt,
 				Computed: true,
 			},
 			"tenant_ref": {
@@ -7,7 +7,7 @@ import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 func dataSourceAviImage() *schema.Resource {
 	return &schema.Resource{
-		Read: ResourceAviImageRead,
+		ReadContext: ResourceAviImageRead,
 		Schema: map[string]*schema.Schema{
 			"cloud_info_values": {
 				Type:     schema.TypeList,
@@ -28,7 +28,7 @@ func dataSourceAviImage() *schema.Resource {
 				Computed: true,
 			},
 			"duration": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeInt,
 				Computed: true,
 			},
 			"end_time": {
@@ -56,7 +56,7 @@ func dataSourceAviImage() *schema.Resource {
 				Computed: true,
 			},
 			"progress": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeInt,
 				Computed: true,
 			},
 			"se_info": {
@@ -77,7 +77,7 @@ func dataSourceAviImage() *schema.Resource {
 				Computed: true,
 			},
 			"tasks_completed": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeInt,
 				Computed: true,
 			},
 			"tenant_ref": {
@@ -86,7 +86,7 @@ func dataSourceAviImage() *schema.Resource {
 				Computed: true,
 			},
 			"total_tasks": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeInt,
 				Computed: true,
 			},
 			"type": {
@@ -94,7 +94,7 @@ func dataSourceAviImage() *schema.Resource {
 				Computed: true,
 			},
 			"uber_bundle": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"uuid": {
@@ -7,7 +7,7 @@ import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 func dataSourceAviInventoryFaultConfig() *schema.Resource {
 	return &schema.Resource{
-		Read: ResourceAviInventoryFaultConfigRead,
+		ReadContext: ResourceAviInventoryFaultConfigRead,
 		Schema: map[string]*schema.Schema{
 			"configpb_attributes": {
 				Type:     schema.TypeSet,
@@ -8,7 +8,7 @@ import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 //nolint
 func dataSourceAviIpAddrGroup() *schema.Resource {
 	return &schema.Resource{
-		Read: ResourceAviIpAddrGroupRead,
+		ReadContext: ResourceAviIpAddrGroupRead,
 		Schema: map[string]*schema.Schema{
 			"addrs": {
 				Type:     schema.TypeList,
@@ -39,7 +39,7 @@ func dataSourceAviIpAddrGroup() *schema.Resource {
 				Computed: true,
 			},
 			"marathon_service_port": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeInt,
 				Computed: true,
 			},
 			"markers": {
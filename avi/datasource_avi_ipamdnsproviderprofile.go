@@ -8,10 +8,10 @@ import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 //nolint
 func dataSourceAviIpamDnsProviderProfile() *schema.Resource {
 	return &schema.Resource{
-		Read: ResourceAviIpamDnsProviderProfileRead,
+		ReadContext: ResourceAviIpamDnsProviderProfileRead,
 		Schema: map[string]*schema.Schema{
 			"allocate_ip_in_vrf": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"aws_profile": {
@@ -7,7 +7,7 @@ import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 func dataSourceAviIPReputationDB() *schema.Resource {
 	return &schema.Resource{
-		Read: ResourceAviIPReputationDBRead,
+		ReadContext: ResourceAviIPReputationDBRead,
 		Schema: map[string]*schema.Schema{
 			"base_file_refs": {
 				Type:     schema.TypeList,
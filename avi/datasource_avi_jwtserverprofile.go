@@ -7,7 +7,7 @@ import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 func dataSourceAviJWTServerProfile() *schema.Resource {
 	return &schema.Resource{
-		Read: ResourceAviJWTServerProfileRead,
+		ReadContext: ResourceAviJWTServerProfileRead,
 		Schema: map[string]*schema.Schema{
 			"configpb_attributes": {
 				Type:     schema.TypeSet,
@@ -20,7 +20,7 @@ func dataSourceAviJWTServerProfile() *schema.Resource {
 				Elem:     ResourceControllerInternalAuthSchema(),
 			},
 			"is_federated": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"issuer": {
@@ -7,7 +7,7 @@ import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 func dataSourceAviL4PolicySet() *schema.Resource {
 	return &schema.Resource{
-		Read: ResourceAviL4PolicySetRead,
+		ReadContext: ResourceAviL4PolicySetRead,
 		Schema: map[string]*schema.Schema{
 			"configpb_attributes": {
 				Type:     schema.TypeSet,
@@ -23,7 +23,7 @@ func dataSourceAviL4PolicySet() *schema.Resource {
 				Computed: true,
 			},
 			"is_internal_policy": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"l4_connection_policy": {
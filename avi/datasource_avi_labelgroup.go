@@ -7,7 +7,7 @@ import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 func dataSourceAviLabelGroup() *schema.Resource {
 	return &schema.Resource{
-		Read: ResourceAviLabelGroupRead,
+		ReadContext: ResourceAviLabelGroupRead,
 		Schema: map[string]*schema.Schema{
 			"configpb_attributes": {
 				Type:     schema.TypeSet,
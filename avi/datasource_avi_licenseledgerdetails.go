@@ -7,7 +7,7 @@ import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 func dataSourceAviLicenseLedgerDetails() *schema.Resource {
 	return &schema.Resource{
-		Read: ResourceAviLicenseLedgerDetailsRead,
+		ReadContext: ResourceAviLicenseLedgerDetailsRead,
 		Schema: map[string]*schema.Schema{
 			"escrow_infos": {
 				Type:     schema.TypeList,
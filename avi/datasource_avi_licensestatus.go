@@ -7,7 +7,7 @@ import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 func dataSourceAviLicenseStatus() *schema.Resource {
 	return &schema.Resource{
-		Read: ResourceAviLicenseStatusRead,
+		ReadContext: ResourceAviLicenseStatusRead,
 		Schema: map[string]*schema.Schema{
 			"configpb_attributes": {
 				Type:     schema.TypeSet,
@@ -7,7 +7,7 @@ import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 func dataSourceAviMemoryBalancerRequest() *schema.Resource {
 	return &schema.Resource{
-		Read: ResourceAviMemoryBalancerRequestRead,
+		ReadContext: ResourceAviMemoryBalancerRequestRead,
 		Schema: map[string]*schema.Schema{
 			"configpb_attributes": {
 				Type:     schema.TypeSet,
@@ -7,7 +7,7 @@ import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 func dataSourceAviMicroServiceGroup() *schema.Resource {
 	return &schema.Resource{
-		Read: ResourceAviMicroServiceGroupRead,
+		ReadContext: ResourceAviMicroServiceGroupRead,
 		Schema: map[string]*schema.Schema{
 			"configpb_attributes": {
 				Type:     schema.TypeSet,
@@ -7,7 +7,7 @@ import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 func dataSourceAviNatPolicy() *schema.Resource {
 	return &schema.Resource{
-		Read: ResourceAviNatPolicyRead,
+		ReadContext: ResourceAviNatPolicyRead,
 		Schema: map[string]*schema.Schema{
 			"configpb_attributes": {
 				Type:     schema.TypeSet,
@@ -7,7 +7,7 @@ import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 func dataSourceAviNetwork() *schema.Resource {
 	return &schema.Resource{
-		Read: ResourceAviNetworkRead,
+		ReadContext: ResourceAviNetworkRead,
 		Schema: map[string]*schema.Schema{
 			"attrs": {
 				Type:     schema.TypeList,
@@ -30,15 +30,15 @@ func dataSourceAviNetwork() *schema.Resource {
 				Elem:     ResourceSubnetSchema(),
 			},
 			"dhcp_enabled": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"exclude_discovered_subnets": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"ip6_autocfg_enabled": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"markers": {
@@ -52,7 +52,7 @@ func dataSourceAviNetwork() *schema.Resource {
 				Computed: true,
 			},
 			"synced_from_se": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"tenant_ref": {
@@ -66,7 +66,7 @@ func dataSourceAviNetwork() *schema.Resource {
 				Computed: true,
 			},
 			"vcenter_dvs": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"vimgrnw_ref": {
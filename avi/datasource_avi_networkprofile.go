@@ -7,7 +7,7 @@ import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 func dataSourceAviNetworkProfile() *schema.Resource {
 	return &schema.Resource{
-		Read: ResourceAviNetworkProfileRead,
+		ReadContext: ResourceAviNetworkProfileRead,
 		Schema: map[string]*schema.Schema{
 			"configpb_attributes": {
 				Type:     schema.TypeSet,
@@ -15,7 +15,7 @@ func dataSourceAviNetworkProfile() *schema.Resource {
 				Elem:     ResourceConfigPbAttributesSchema(),
 			},
 			"connection_mirror": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"description": {
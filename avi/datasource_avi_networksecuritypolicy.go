@@ -7,7 +7,7 @@ import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 func dataSourceAviNetworkSecurityPolicy() *schema.Resource {
 	return &schema.Resource{
-		Read: ResourceAviNetworkSecurityPolicyRead,
+		ReadContext: ResourceAviNetworkSecurityPolicyRead,
 		Schema: map[string]*schema.Schema{
 			"cloud_config_cksum": {
 				Type:     schema.TypeString,
@@ -31,7 +31,7 @@ func dataSourceAviNetworkSecurityPolicy() *schema.Resource {
 				Computed: true,
 			},
 			"internal": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"ip_reputation_db_ref": {
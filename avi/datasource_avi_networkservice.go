@@ -7,7 +7,7 @@ import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 func dataSourceAviNetworkService() *schema.Resource {
 	return &schema.Resource{
-		Read: ResourceAviNetworkServiceRead,
+		ReadContext: ResourceAviNetworkServiceRead,
 		Schema: map[string]*schema.Schema{
 			"cloud_ref": {
 				Type:     schema.TypeString,
@@ -7,7 +7,7 @@ import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 func dataSourceAviNsxtSegmentRuntime() *schema.Resource {
 	return &schema.Resource{
-		Read: ResourceAviNsxtSegmentRuntimeRead,
+		ReadContext: ResourceAviNsxtSegmentRuntimeRead,
 		Schema: map[string]*schema.Schema{
 			"cloud_ref": {
 				Type:     schema.TypeString,
@@ -20,7 +20,7 @@ func dataSourceAviNsxtSegmentRuntime() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
 			"dhcp_enabled": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"dhcp_ranges": {
@@ -50,7 +50,7 @@ func dataSourceAviNsxtSegmentRuntime() *schema.Resource {
 				Computed: true,
 			},
 			"security_only_nsxt": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"segment_gw": {
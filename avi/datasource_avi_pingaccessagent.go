@@ -7,7 +7,7 @@ import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 func dataSourceAviPingAccessAgent() *schema.Resource {
 	return &schema.Resource{
-		Read: ResourceAviPingAccessAgentRead,
+		ReadContext: ResourceAviPingAccessAgentRead,
 		Schema: map[string]*schema.Schema{
 			"configpb_attributes": {
 				Type:     schema.TypeSet,
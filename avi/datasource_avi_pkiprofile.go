@@ -7,7 +7,7 @@ import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 func dataSourceAviPKIProfile() *schema.Resource {
 	return &schema.Resource{
-		Read: ResourceAviPKIProfileRead,
+		ReadContext: ResourceAviPKIProfileRead,
 		Schema: map[string]*schema.Schema{
 			"ca_certs": {
 				Type:     schema.TypeList,
@@ -24,7 +24,7 @@ func dataSourceAviPKIProfile() *schema.Resource {
 				Computed: true,
 			},
 			"crl_check": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"crls": {
@@ -33,11 +33,11 @@ func dataSourceAviPKIProfile() *schema.Resource {
 				Elem:     ResourceCRLSchema(),
 			},
 			"ignore_peer_chain": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"is_federated": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"markers": {
@@ -61,7 +61,7 @@ func dataSourceAviPKIProfile() *schema.Resource {
 				Computed: true,
 			},
 			"validate_only_leaf_crl": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 		},
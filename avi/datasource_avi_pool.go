@@ -7,7 +7,7 @@ import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 func dataSourceAviPool() *schema.Resource {
 	return &schema.Resource{
-		Read: ResourceAviPoolRead,
+		ReadContext: ResourceAviPoolRead,
 		Schema: map[string]*schema.Schema{
 			"ignore_servers": {
 				Type:     schema.TypeBool,
@@ -45,11 +45,11 @@ func dataSourceAviPool() *schema.Resource {
 				Computed: true,
 			},
 			"capacity_estimation": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"capacity_estimation_ttfb_thresh": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeInt,
 				Computed: true,
 			},
 			"cloud_config_cksum": {
@@ -72,7 +72,7 @@ func dataSourceAviPool() *schema.Resource {
 				Elem:     ResourceConnPoolPropertiesSchema(),
 			},
 			"connection_ramp_duration": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeInt,
 				Computed: true,
 			},
 			"created_by": {
@@ -80,11 +80,11 @@ func dataSourceAviPool() *schema.Resource {
 				Computed: true,
 			},
 			"default_server_port": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeInt,
 				Computed: true,
 			},
 			"delete_server_on_dns_refresh": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"description": {
@@ -97,15 +97,15 @@ func dataSourceAviPool() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
 			"east_west": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"enable_http2": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"enabled": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"external_autoscale_groups": {
@@ -119,15 +119,15 @@ func dataSourceAviPool() *schema.Resource {
 				Elem:     ResourceFailActionSchema(),
 			},
 			"fewest_tasks_feedback_delay": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeInt,
 				Computed: true,
 			},
 			"graceful_disable_timeout": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeInt,
 				Computed: true,
 			},
 			"gslb_sp_enabled": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"health_monitor_refs": {
@@ -141,7 +141,7 @@ func dataSourceAviPool() *schema.Resource {
 				Elem:     ResourceHorizonProfileSchema(),
 			},
 			"host_check_enabled": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"http2_properties": {
@@ -150,11 +150,11 @@ func dataSourceAviPool() *schema.Resource {
 				Elem:     ResourceHTTP2PoolPropertiesSchema(),
 			},
 			"ignore_server_port": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"inline_health_monitor": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"ipaddrgroup_ref": {
@@ -162,7 +162,7 @@ func dataSourceAviPool() *schema.Resource {
 				Computed: true,
 			},
 			"lb_algo_rr_per_se": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"lb_algorithm": {
@@ -174,7 +174,7 @@ func dataSourceAviPool() *schema.Resource {
 				Computed: true,
 			},
 			"lb_algorithm_core_nonaffinity": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeInt,
 				Computed: true,
 			},
 			"lb_algorithm_hash": {
@@ -182,7 +182,7 @@ func dataSourceAviPool() *schema.Resource {
 				Computed: true,
 			},
 			"lookup_server_by_name": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"markers": {
@@ -191,7 +191,7 @@ func dataSourceAviPool() *schema.Resource {
 				Elem:     ResourceRoleFilterMatchLabelSchema(),
 			},
 			"max_concurrent_connections_per_server": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeInt,
 				Computed: true,
 			},
 			"max_conn_rate_per_server": {
@@ -200,11 +200,11 @@ func dataSourceAviPool() *schema.Resource {
 				Elem:     ResourceRateProfileSchema(),
 			},
 			"min_health_monitors_up": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeInt,
 				Computed: true,
 			},
 			"min_servers_up": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeInt,
 				Computed: true,
 			},
 			"name": {
@@ -236,27 +236,27 @@ func dataSourceAviPool() *schema.Resource {
 				Computed: true,
 			},
 			"request_queue_depth": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeInt,
 				Computed: true,
 			},
 			"request_queue_enabled": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"resolve_pool_by_dns": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"rewrite_host_header_to_server_name": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"rewrite_host_header_to_sni": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"routing_pool": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"server_disable_type": {
@@ -273,7 +273,7 @@ func dataSourceAviPool() *schema.Resource {
 				Elem:     ResourceHTTPServerReselectSchema(),
 			},
 			"server_timeout": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeInt,
 				Computed: true,
 			},
 			"servers": {
@@ -286,7 +286,7 @@ func dataSourceAviPool() *schema.Resource {
 				Computed: true,
 			},
 			"sni_enabled": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"ssl_key_and_certificate_ref": {
@@ -307,11 +307,11 @@ func dataSourceAviPool() *schema.Resource {
 				Computed: true,
 			},
 			"use_service_port": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"use_service_ssl_mode": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"uuid": {
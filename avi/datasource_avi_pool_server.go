@@ -7,7 +7,7 @@ import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 func dataSourceAviServer() *schema.Resource {
 	return &schema.Resource{
-		Read: ResourceAviServerRead,
+		ReadContext: ResourceAviServerRead,
 		Schema: map[string]*schema.Schema{
 			"pool_ref": {
 				Type:     schema.TypeString,
@@ -39,7 +39,7 @@ func dataSourceAviServer() *schema.Resource {
 				Elem:     ResourceDiscoveredNetworkSchema(),
 			},
 			"enabled": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"external_orchestration_id": {
@@ -68,11 +68,11 @@ func dataSourceAviServer() *schema.Resource {
 				Computed: true,
 			},
 			"port": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeInt,
 				Computed: true,
 			},
 			"preference_order": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeInt,
 				Computed: true,
 			},
 			"prst_hdr_val": {
@@ -80,15 +80,15 @@ func dataSourceAviServer() *schema.Resource {
 				Computed: true,
 			},
 			"ratio": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeInt,
 				Computed: true,
 			},
 			"resolve_server_by_dns": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"rewrite_host_header": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"server_node": {
@@ -96,11 +96,11 @@ func dataSourceAviServer() *schema.Resource {
 				Computed: true,
 			},
 			"static": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"verify_network": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"vm_ref": {
@@ -7,7 +7,7 @@ import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 func dataSourceAviPoolGroup() *schema.Resource {
 	return &schema.Resource{
-		Read: ResourceAviPoolGroupRead,
+		ReadContext: ResourceAviPoolGroupRead,
 		Schema: map[string]*schema.Schema{
 			"cloud_config_cksum": {
 				Type:     schema.TypeString,
@@ -28,7 +28,7 @@ func dataSourceAviPoolGroup() *schema.Resource {
 				Computed: true,
 			},
 			"deactivate_primary_pool_on_down": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"deployment_policy_ref": {
@@ -40,7 +40,7 @@ func dataSourceAviPoolGroup() *schema.Resource {
 				Computed: true,
 			},
 			"enable_http2": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"fail_action": {
@@ -49,7 +49,7 @@ func dataSourceAviPoolGroup() *schema.Resource {
 				Elem:     ResourceFailActionSchema(),
 			},
 			"implicit_priority_labels": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"markers": {
@@ -63,7 +63,7 @@ func dataSourceAviPoolGroup() *schema.Resource {
 				Elem:     ResourcePoolGroupMemberSchema(),
 			},
 			"min_servers": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeInt,
 				Computed: true,
 			},
 			"name": {
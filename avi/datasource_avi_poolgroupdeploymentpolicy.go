@@ -7,10 +7,10 @@ import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 func dataSourceAviPoolGroupDeploymentPolicy() *schema.Resource {
 	return &schema.Resource{
-		Read: ResourceAviPoolGroupDeploymentPolicyRead,
+		ReadContext: ResourceAviPoolGroupDeploymentPolicyRead,
 		Schema: map[string]*schema.Schema{
 			"auto_disable_old_prod_pools": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"configpb_attributes": {
@@ -23,7 +23,7 @@ func dataSourceAviPoolGroupDeploymentPolicy() *schema.Resource {
 				Computed: true,
 			},
 			"evaluation_duration": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeInt,
 				Computed: true,
 			},
 			"markers": {
@@ -46,7 +46,7 @@ func dataSourceAviPoolGroupDeploymentPolicy() *schema.Resource {
 				Computed: true,
 			},
 			"target_test_traffic_ratio": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeInt,
 				Computed: true,
 			},
 			"tenant_ref": {
@@ -55,7 +55,7 @@ func dataSourceAviPoolGroupDeploymentPolicy() *schema.Resource {
 				Computed: true,
 			},
 			"test_traffic_ratio_rampup": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeInt,
 				Computed: true,
 			},
 			"uuid": {
@@ -7,7 +7,7 @@ import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 func dataSourceAviPriorityLabels() *schema.Resource {
 	return &schema.Resource{
-		Read: ResourceAviPriorityLabelsRead,
+		ReadContext: ResourceAviPriorityLabelsRead,
 		Schema: map[string]*schema.Schema{
 			"cloud_ref": {
 				Type:     schema.TypeString,
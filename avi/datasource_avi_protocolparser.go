@@ -7,7 +7,7 @@ import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 func dataSourceAviProtocolParser() *schema.Resource {
 	return &schema.Resource{
-		Read: ResourceAviProtocolParserRead,
+		ReadContext: ResourceAviProtocolParserRead,
 		Schema: map[string]*schema.Schema{
 			"configpb_attributes": {
 				Type:     schema.TypeSet,
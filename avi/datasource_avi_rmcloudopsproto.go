@@ -7,10 +7,10 @@ import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 func dataSourceAviRmCloudOpsProto() *schema.Resource {
 	return &schema.Resource{
-		Read: ResourceAviRmCloudOpsProtoRead,
+		ReadContext: ResourceAviRmCloudOpsProtoRead,
 		Schema: map[string]*schema.Schema{
 			"last_queried_se_creation_limit": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeInt,
 				Computed: true,
 			},
 			"name": {
@@ -19,11 +19,11 @@ func dataSourceAviRmCloudOpsProto() *schema.Resource {
 				Computed: true,
 			},
 			"pending_se_creation_count": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeInt,
 				Computed: true,
 			},
 			"pending_vnic_op_count": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeInt,
 				Computed: true,
 			},
 			"uuid": {
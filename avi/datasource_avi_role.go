@@ -7,10 +7,10 @@ import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 func dataSourceAviRole() *schema.Resource {
 	return &schema.Resource{
-		Read: ResourceAviRoleRead,
+		ReadContext: ResourceAviRoleRead,
 		Schema: map[string]*schema.Schema{
 			"allow_unlabelled_access": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"configpb_attributes": {
@@ -7,7 +7,7 @@ import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 func dataSourceAviScheduler() *schema.Resource {
 	return &schema.Resource{
-		Read: ResourceAviSchedulerRead,
+		ReadContext: ResourceAviSchedulerRead,
 		Schema: map[string]*schema.Schema{
 			"backup_config_ref": {
 				Type:     schema.TypeString,
@@ -19,7 +19,7 @@ func dataSourceAviScheduler() *schema.Resource {
 				Elem:     ResourceConfigPbAttributesSchema(),
 			},
 			"enabled": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"end_date_time": {
@@ -27,7 +27,7 @@ func dataSourceAviScheduler() *schema.Resource {
 				Computed: true,
 			},
 			"frequency": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeInt,
 				Computed: true,
 			},
 			"frequency_unit": {
@@ -7,7 +7,7 @@ import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 func dataSourceAviSecurityManagerData() *schema.Resource {
 	return &schema.Resource{
-		Read: ResourceAviSecurityManagerDataRead,
+		ReadContext: ResourceAviSecurityManagerDataRead,
 		Schema: map[string]*schema.Schema{
 			"app_learning_info": {
 				Type:     schema.TypeList,
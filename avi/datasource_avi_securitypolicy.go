@@ -7,7 +7,7 @@ import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 func dataSourceAviSecurityPolicy() *schema.Resource {
 	return &schema.Resource{
-		Read: ResourceAviSecurityPolicyRead,
+		ReadContext: ResourceAviSecurityPolicyRead,
 		Schema: map[string]*schema.Schema{
 			"configpb_attributes": {
 				Type:     schema.TypeSet,
@@ -29,7 +29,7 @@ func dataSourceAviSecurityPolicy() *schema.Resource {
 				Elem:     ResourceDnsAttacksSchema(),
 			},
 			"dns_policy_index": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeInt,
 				Computed: true,
 			},
 			"markers": {
@@ -43,7 +43,7 @@ func dataSourceAviSecurityPolicy() *schema.Resource {
 				Computed: true,
 			},
 			"network_security_policy_index": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeInt,
 				Computed: true,
 			},
 			"oper_mode": {
@@ -7,7 +7,7 @@ import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 func dataSourceAviSeProperties() *schema.Resource {
 	return &schema.Resource{
-		Read: ResourceAviSePropertiesRead,
+		ReadContext: ResourceAviSePropertiesRead,
 		Schema: map[string]*schema.Schema{
 			"configpb_attributes": {
 				Type:     schema.TypeSet,
@@ -7,7 +7,7 @@ import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 func dataSourceAviServerAutoScalePolicy() *schema.Resource {
 	return &schema.Resource{
-		Read: ResourceAviServerAutoScalePolicyRead,
+		ReadContext: ResourceAviServerAutoScalePolicyRead,
 		Schema: map[string]*schema.Schema{
 			"configpb_attributes": {
 				Type:     schema.TypeSet,
@@ -15,7 +15,7 @@ func dataSourceAviServerAutoScalePolicy() *schema.Resource {
 				Elem:     ResourceConfigPbAttributesSchema(),
 			},
 			"delay_for_server_garbage_collection": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeInt,
 				Computed: true,
 			},
 			"description": {
@@ -23,15 +23,15 @@ func dataSourceAviServerAutoScalePolicy() *schema.Resource {
 				Computed: true,
 			},
 			"intelligent_autoscale": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"intelligent_scalein_margin": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeInt,
 				Computed: true,
 			},
 			"intelligent_scaleout_margin": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeInt,
 				Computed: true,
 			},
 			"markers": {
@@ -40,19 +40,19 @@ func dataSourceAviServerAutoScalePolicy() *schema.Resource {
 				Elem:     ResourceRoleFilterMatchLabelSchema(),
 			},
 			"max_scalein_adjustment_step": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeInt,
 				Computed: true,
 			},
 			"max_scaleout_adjustment_step": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeInt,
 				Computed: true,
 			},
 			"max_size": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeInt,
 				Computed: true,
 			},
 			"min_size": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeInt,
 				Computed: true,
 			},
 			"name": {
@@ -66,7 +66,7 @@ func dataSourceAviServerAutoScalePolicy() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
 			"scalein_cooldown": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeInt,
 				Computed: true,
 			},
 			"scaleout_alertconfig_refs": {
@@ -75,7 +75,7 @@ func dataSourceAviServerAutoScalePolicy() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
 			"scaleout_cooldown": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeInt,
 				Computed: true,
 			},
 			"scheduled_scalings": {
@@ -89,7 +89,7 @@ func dataSourceAviServerAutoScalePolicy() *schema.Resource {
 				Computed: true,
 			},
 			"use_predicted_load": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"uuid": {
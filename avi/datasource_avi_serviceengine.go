@@ -7,7 +7,7 @@ import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 func dataSourceAviServiceEngine() *schema.Resource {
 	return &schema.Resource{
-		Read: ResourceAviServiceEngineRead,
+		ReadContext: ResourceAviServiceEngineRead,
 		Schema: map[string]*schema.Schema{
 			"availability_zone": {
 				Type:     schema.TypeString,
@@ -19,7 +19,7 @@ func dataSourceAviServiceEngine() *schema.Resource {
 				Computed: true,
 			},
 			"container_mode": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"container_type": {
@@ -27,7 +27,7 @@ func dataSourceAviServiceEngine() *schema.Resource {
 				Computed: true,
 			},
 			"controller_created": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"controller_ip": {
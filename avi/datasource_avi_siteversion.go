@@ -7,7 +7,7 @@ import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 func dataSourceAviSiteVersion() *schema.Resource {
 	return &schema.Resource{
-		Read: ResourceAviSiteVersionRead,
+		ReadContext: ResourceAviSiteVersionRead,
 		Schema: map[string]*schema.Schema{
 			"datetime": {
 				Type:     schema.TypeString,
@@ -19,7 +19,7 @@ func dataSourceAviSiteVersion() *schema.Resource {
 				Computed: true,
 			},
 			"prev_target_version": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeInt,
 				Computed: true,
 			},
 			"replication_state": {
@@ -35,7 +35,7 @@ func dataSourceAviSiteVersion() *schema.Resource {
 				Computed: true,
 			},
 			"target_version": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeInt,
 				Computed: true,
 			},
 			"tenant_ref": {
@@ -53,7 +53,7 @@ func dataSourceAviSiteVersion() *schema.Resource {
 				Computed: true,
 			},
 			"version": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeInt,
 				Computed: true,
 			},
 			"version_type": {
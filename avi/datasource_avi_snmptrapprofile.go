@@ -7,7 +7,7 @@ import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 func dataSourceAviSnmpTrapProfile() *schema.Resource {
 	return &schema.Resource{
-		Read: ResourceAviSnmpTrapProfileRead,
+		ReadContext: ResourceAviSnmpTrapProfileRead,
 		Schema: map[string]*schema.Schema{
 			"configpb_attributes": {
 				Type:     schema.TypeSet,
@@ -7,7 +7,7 @@ import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 func dataSourceAviSSLKeyAndCertificate() *schema.Resource {
 	return &schema.Resource{
-		Read: ResourceAviSSLKeyAndCertificateRead,
+		ReadContext: ResourceAviSSLKeyAndCertificateRead,
 		Schema: map[string]*schema.Schema{
 			"ca_certs": {
 				Type:     schema.TypeList,
@@ -20,7 +20,7 @@ func dataSourceAviSSLKeyAndCertificate() *schema.Resource {
 				Elem:     ResourceSSLCertificateSchema(),
 			},
 			"certificate_base64": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"certificate_management_profile_ref": {
@@ -42,7 +42,7 @@ func dataSourceAviSSLKeyAndCertificate() *schema.Resource {
 				Elem:     ResourceCustomParamsSchema(),
 			},
 			"enable_ocsp_stapling": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"enckey_base64": {
@@ -62,11 +62,11 @@ func dataSourceAviSSLKeyAndCertificate() *schema.Resource {
 				Computed: true,
 			},
 			"import_key_to_hsm": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"is_federated": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"key": {
@@ -74,7 +74,7 @@ func dataSourceAviSSLKeyAndCertificate() *schema.Resource {
 				Computed: true,
 			},
 			"key_base64": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"key_params": {
@@ -7,7 +7,7 @@ import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 func dataSourceAviSSLProfile() *schema.Resource {
 	return &schema.Resource{
-		Read: ResourceAviSSLProfileRead,
+		ReadContext: ResourceAviSSLProfileRead,
 		Schema: map[string]*schema.Schema{
 			"accepted_ciphers": {
 				Type:     schema.TypeString,
@@ -45,15 +45,15 @@ func dataSourceAviSSLProfile() *schema.Resource {
 				Computed: true,
 			},
 			"enable_early_data": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"enable_ssl_session_reuse": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"is_federated": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"markers": {
@@ -67,11 +67,11 @@ func dataSourceAviSSLProfile() *schema.Resource {
 				Computed: true,
 			},
 			"prefer_client_cipher_ordering": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"send_close_notify": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"signature_algorithm": {
@@ -84,7 +84,7 @@ func dataSourceAviSSLProfile() *schema.Resource {
 				Elem:     ResourceSSLRatingSchema(),
 			},
 			"ssl_session_timeout": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeInt,
 				Computed: true,
 			},
 			"tags": {
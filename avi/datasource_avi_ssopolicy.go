@@ -7,7 +7,7 @@ import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 func dataSourceAviSSOPolicy() *schema.Resource {
 	return &schema.Resource{
-		Read: ResourceAviSSOPolicyRead,
+		ReadContext: ResourceAviSSOPolicyRead,
 		Schema: map[string]*schema.Schema{
 			"authentication_policy": {
 				Type:     schema.TypeSet,
@@ -7,7 +7,7 @@ import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 func dataSourceAviStatediffOperation() *schema.Resource {
 	return &schema.Resource{
-		Read: ResourceAviStatediffOperationRead,
+		ReadContext: ResourceAviStatediffOperationRead,
 		Schema: map[string]*schema.Schema{
 			"events": {
 				Type:     schema.TypeList,
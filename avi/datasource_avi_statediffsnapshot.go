@@ -7,7 +7,7 @@ import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 func dataSourceAviStatediffSnapshot() *schema.Resource {
 	return &schema.Resource{
-		Read: ResourceAviStatediffSnapshotRead,
+		ReadContext: ResourceAviStatediffSnapshotRead,
 		Schema: map[string]*schema.Schema{
 			"gslb_name": {
 				Type:     schema.TypeString,
@@ -7,7 +7,7 @@ import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 func dataSourceAviStringGroup() *schema.Resource {
 	return &schema.Resource{
-		Read: ResourceAviStringGroupRead,
+		ReadContext: ResourceAviStringGroupRead,
 		Schema: map[string]*schema.Schema{
 			"configpb_attributes": {
 				Type:     schema.TypeSet,
@@ -24,7 +24,7 @@ func dataSourceAviStringGroup() *schema.Resource {
 				Elem:     ResourceKeyValueSchema(),
 			},
 			"longest_match": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"markers": {
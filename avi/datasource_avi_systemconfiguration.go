@@ -7,7 +7,7 @@ import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 func dataSourceAviSystemConfiguration() *schema.Resource {
 	return &schema.Resource{
-		Read: ResourceAviSystemConfigurationRead,
+		ReadContext: ResourceAviSystemConfigurationRead,
 		Schema: map[string]*schema.Schema{
 			"admin_auth_configuration": {
 				Type:     schema.TypeSet,
@@ -15,7 +15,7 @@ func dataSourceAviSystemConfiguration() *schema.Resource {
 				Elem:     ResourceAdminAuthConfigurationSchema(),
 			},
 			"common_criteria_mode": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"configpb_attributes": {
@@ -43,7 +43,7 @@ func dataSourceAviSystemConfiguration() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
 			"docker_mode": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"email_configuration": {
@@ -52,11 +52,11 @@ func dataSourceAviSystemConfiguration() *schema.Resource {
 				Elem:     ResourceEmailConfigurationSchema(),
 			},
 			"enable_cors": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"fips_mode": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"global_tenant_config": {
@@ -115,7 +115,7 @@ func dataSourceAviSystemConfiguration() *schema.Resource {
 				Computed: true,
 			},
 			"welcome_workflow_complete": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 		},
@@ -7,7 +7,7 @@ import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 func dataSourceAviSystemLimits() *schema.Resource {
 	return &schema.Resource{
-		Read: ResourceAviSystemLimitsRead,
+		ReadContext: ResourceAviSystemLimitsRead,
 		Schema: map[string]*schema.Schema{
 			"configpb_attributes": {
 				Type:     schema.TypeSet,
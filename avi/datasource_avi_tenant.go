@@ -7,7 +7,7 @@ import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 func dataSourceAviTenant() *schema.Resource {
 	return &schema.Resource{
-		Read: ResourceAviTenantRead,
+		ReadContext: ResourceAviTenantRead,
 		Schema: map[string]*schema.Schema{
 			"config_settings": {
 				Type:     schema.TypeSet,
@@ -28,7 +28,7 @@ func dataSourceAviTenant() *schema.Resource {
 				Computed: true,
 			},
 			"enforce_label_group": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"label_group_refs": {
@@ -37,7 +37,7 @@ func dataSourceAviTenant() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
 			"local": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"name": {
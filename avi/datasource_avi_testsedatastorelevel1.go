@@ -7,7 +7,7 @@ import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 func dataSourceAviTestSeDatastoreLevel1() *schema.Resource {
 	return &schema.Resource{
-		Read: ResourceAviTestSeDatastoreLevel1Read,
+		ReadContext: ResourceAviTestSeDatastoreLevel1Read,
 		Schema: map[string]*schema.Schema{
 			"configpb_attributes": {
 				Type:     schema.TypeSet,
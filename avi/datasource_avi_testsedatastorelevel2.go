@@ -7,7 +7,7 @@ import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 func dataSourceAviTestSeDatastoreLevel2() *schema.Resource {
 	return &schema.Resource{
-		Read: ResourceAviTestSeDatastoreLevel2Read,
+		ReadContext: ResourceAviTestSeDatastoreLevel2Read,
 		Schema: map[string]*schema.Schema{
 			"configpb_attributes": {
 				Type:     schema.TypeSet,
@@ -7,7 +7,7 @@ import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 func dataSourceAviTestSeDatastoreLevel3() *schema.Resource {
 	return &schema.Resource{
-		Read: ResourceAviTestSeDatastoreLevel3Read,
+		ReadContext: ResourceAviTestSeDatastoreLevel3Read,
 		Schema: map[string]*schema.Schema{
 			"configpb_attributes": {
 				Type:     schema.TypeSet,
@@ -7,7 +7,7 @@ import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 func dataSourceAviTrafficCloneProfile() *schema.Resource {
 	return &schema.Resource{
-		Read: ResourceAviTrafficCloneProfileRead,
+		ReadContext: ResourceAviTrafficCloneProfileRead,
 		Schema: map[string]*schema.Schema{
 			"clone_servers": {
 				Type:     schema.TypeList,
@@ -35,7 +35,7 @@ func dataSourceAviTrafficCloneProfile() *schema.Resource {
 				Computed: true,
 			},
 			"preserve_client_ip": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"tenant_ref": {
@@ -7,7 +7,7 @@ import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 func dataSourceAviUpgradeStatusInfo() *schema.Resource {
 	return &schema.Resource{
-		Read: ResourceAviUpgradeStatusInfoRead,
+		ReadContext: ResourceAviUpgradeStatusInfoRead,
 		Schema: map[string]*schema.Schema{
 			"after_reboot_rollback_fnc": {
 				Type:     schema.TypeString,
@@ -18,19 +18,19 @@ func dataSourceAviUpgradeStatusInfo() *schema.Resource {
 				Computed: true,
 			},
 			"clean": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"duration": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeInt,
 				Computed: true,
 			},
 			"enable_patch_rollback": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"enable_rollback": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"end_time": {
@@ -42,7 +42,7 @@ func dataSourceAviUpgradeStatusInfo() *schema.Resource {
 				Computed: true,
 			},
 			"fips_mode": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"history": {
@@ -90,7 +90,7 @@ func dataSourceAviUpgradeStatusInfo() *schema.Resource {
 				Elem:     ResourcePatchDataSchema(),
 			},
 			"patch_reboot": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"patch_version": {
@@ -127,7 +127,7 @@ func dataSourceAviUpgradeStatusInfo() *schema.Resource {
 				Computed: true,
 			},
 			"progress": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeInt,
 				Computed: true,
 			},
 			"reason": {
@@ -171,11 +171,11 @@ func dataSourceAviUpgradeStatusInfo() *schema.Resource {
 				Computed: true,
 			},
 			"system": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"tasks_completed": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeInt,
 				Computed: true,
 			},
 			"tenant_ref": {
@@ -184,7 +184,7 @@ func dataSourceAviUpgradeStatusInfo() *schema.Resource {
 				Computed: true,
 			},
 			"total_tasks": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeInt,
 				Computed: true,
 			},
 			"upgrade_events": {
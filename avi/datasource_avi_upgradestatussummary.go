@@ -7,14 +7,14 @@ import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 func dataSourceAviUpgradeStatusSummary() *schema.Resource {
 	return &schema.Resource{
-		Read: ResourceAviUpgradeStatusSummaryRead,
+		ReadContext: ResourceAviUpgradeStatusSummaryRead,
 		Schema: map[string]*schema.Schema{
 			"enable_patch_rollback": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"enable_rollback": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"end_time": {
@@ -52,7 +52,7 @@ func dataSourceAviUpgradeStatusSummary() *schema.Resource {
 				Elem:     ResourceUpgradeOpsStateSchema(),
 			},
 			"tasks_completed": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeInt,
 				Computed: true,
 			},
 			"tenant_ref": {
@@ -61,7 +61,7 @@ func dataSourceAviUpgradeStatusSummary() *schema.Resource {
 				Computed: true,
 			},
 			"total_tasks": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeInt,
 				Computed: true,
 			},
 			"upgrade_ops": {
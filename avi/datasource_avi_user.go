@@ -7,7 +7,7 @@ import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 func dataSourceAviUser() *schema.Resource {
 	return &schema.Resource{
-		Read: ResourceAviUserRead,
+		ReadContext: ResourceAviUserRead,
 		Schema: map[string]*schema.Schema{
 			"access": {
 				Type:     schema.TypeList,
@@ -27,11 +27,11 @@ func dataSourceAviUser() *schema.Resource {
 				Computed: true,
 			},
 			"is_superuser": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"local": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"name": {
@@ -7,10 +7,10 @@ import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 func dataSourceAviUserAccountProfile() *schema.Resource {
 	return &schema.Resource{
-		Read: ResourceAviUserAccountProfileRead,
+		ReadContext: ResourceAviUserAccountProfileRead,
 		Schema: map[string]*schema.Schema{
 			"account_lock_timeout": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeInt,
 				Computed: true,
 			},
 			"configpb_attributes": {
@@ -19,23 +19,23 @@ func dataSourceAviUserAccountProfile() *schema.Resource {
 				Elem:     ResourceConfigPbAttributesSchema(),
 			},
 			"credentials_timeout_threshold": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeInt,
 				Computed: true,
 			},
 			"login_failure_count_expiry_window": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeInt,
 				Computed: true,
 			},
 			"max_concurrent_sessions": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeInt,
 				Computed: true,
 			},
 			"max_login_failure_count": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeInt,
 				Computed: true,
 			},
 			"max_password_history_count": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeInt,
 				Computed: true,
 			},
 			"name": {
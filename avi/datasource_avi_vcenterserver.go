@@ -7,7 +7,7 @@ import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 func dataSourceAviVCenterServer() *schema.Resource {
 	return &schema.Resource{
-		Read: ResourceAviVCenterServerRead,
+		ReadContext: ResourceAviVCenterServerRead,
 		Schema: map[string]*schema.Schema{
 			"cloud_ref": {
 				Type:     schema.TypeString,
@@ -7,18 +7,18 @@ import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 func dataSourceAviVirtualService() *schema.Resource {
 	return &schema.Resource{
-		Read: ResourceAviVirtualServiceRead,
+		ReadContext: ResourceAviVirtualServiceRead,
 		Schema: map[string]*schema.Schema{
 			"active_standby_se_tag": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
 			"advertise_down_vs": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"allow_invalid_client_cert": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"analytics_policy": {
@@ -48,11 +48,11 @@ func dataSourceAviVirtualService() *schema.Resource {
 				Computed: true,
 			},
 			"bulk_sync_kvcache": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"close_client_conn_on_config_update": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"cloud_config_cksum": {
@@ -88,7 +88,7 @@ func dataSourceAviVirtualService() *schema.Resource {
 				Computed: true,
 			},
 			"delay_fairness": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"description": {
@@ -106,23 +106,23 @@ func dataSourceAviVirtualService() *schema.Resource {
 				Elem:     ResourceDnsPoliciesSchema(),
 			},
 			"east_west_placement": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"enable_autogw": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"enable_rhi": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"enable_rhi_snat": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"enabled": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"error_page_profile_ref": {
@@ -156,7 +156,7 @@ func dataSourceAviVirtualService() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
 			"ign_pool_net_reach": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"jwt_config": {
@@ -175,7 +175,7 @@ func dataSourceAviVirtualService() *schema.Resource {
 				Elem:     ResourceLDAPVSConfigSchema(),
 			},
 			"limit_doser": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"markers": {
@@ -184,7 +184,7 @@ func dataSourceAviVirtualService() *schema.Resource {
 				Elem:     ResourceRoleFilterMatchLabelSchema(),
 			},
 			"max_cps_per_client": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeInt,
 				Computed: true,
 			},
 			"microservice_ref": {
@@ -192,7 +192,7 @@ func dataSourceAviVirtualService() *schema.Resource {
 				Computed: true,
 			},
 			"min_pools_up": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeInt,
 				Computed: true,
 			},
 			"name": {
@@ -232,7 +232,7 @@ func dataSourceAviVirtualService() *schema.Resource {
 				Computed: true,
 			},
 			"remove_listening_port_on_vs_down": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"requests_rate_limit": {
@@ -246,7 +246,7 @@ func dataSourceAviVirtualService() *schema.Resource {
 				Elem:     ResourceSAMLSPConfigSchema(),
 			},
 			"scaleout_ecmp": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"se_group_ref": {
@@ -305,7 +305,7 @@ func dataSourceAviVirtualService() *schema.Resource {
 				Elem:     ResourceSSLProfileSelectorSchema(),
 			},
 			"ssl_sess_cache_avg_size": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeInt,
 				Computed: true,
 			},
 			"sso_policy_ref": {
@@ -336,7 +336,7 @@ func dataSourceAviVirtualService() *schema.Resource {
 				Computed: true,
 			},
 			"traffic_enabled": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"type": {
@@ -344,11 +344,11 @@ func dataSourceAviVirtualService() *schema.Resource {
 				Computed: true,
 			},
 			"use_bridge_ip_as_vip": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"use_vip_as_snat": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"uuid": {
@@ -401,7 +401,7 @@ func dataSourceAviVirtualService() *schema.Resource {
 				Computed: true,
 			},
 			"weight": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeInt,
 				Computed: true,
 			},
 		},
@@ -7,7 +7,7 @@ import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 func dataSourceAviVrfContext() *schema.Resource {
 	return &schema.Resource{
-		Read: ResourceAviVrfContextRead,
+		ReadContext: ResourceAviVrfContextRead,
 		Schema: map[string]*schema.Schema{
 			"attrs": {
 				Type:     schema.TypeList,
@@ -54,7 +54,7 @@ func dataSourceAviVrfContext() *schema.Resource {
 				Elem:     ResourceInternalGatewayMonitorSchema(),
 			},
 			"lldp_enable": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"markers": {
@@ -73,7 +73,7 @@ func dataSourceAviVrfContext() *schema.Resource {
 				Elem:     ResourceStaticRouteSchema(),
 			},
 			"system_default": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"tenant_ref": {
@@ -7,7 +7,7 @@ import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 func dataSourceAviVSDataScriptSet() *schema.Resource {
 	return &schema.Resource{
-		Read: ResourceAviVSDataScriptSetRead,
+		ReadContext: ResourceAviVSDataScriptSetRead,
 		Schema: map[string]*schema.Schema{
 			"configpb_attributes": {
 				Type:     schema.TypeSet,
@@ -7,7 +7,7 @@ import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 func dataSourceAviVsGs() *schema.Resource {
 	return &schema.Resource{
-		Read: ResourceAviVsGsRead,
+		ReadContext: ResourceAviVsGsRead,
 		Schema: map[string]*schema.Schema{
 			"configpb_attributes": {
 				Type:     schema.TypeSet,
@@ -7,7 +7,7 @@ import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 func dataSourceAviVsVip() *schema.Resource {
 	return &schema.Resource{
-		Read: ResourceAviVsVipRead,
+		ReadContext: ResourceAviVsVipRead,
 		Schema: map[string]*schema.Schema{
 			"bgp_peer_labels": {
 				Type:     schema.TypeList,
@@ -30,7 +30,7 @@ func dataSourceAviVsVip() *schema.Resource {
 				Elem:     ResourceDnsInfoSchema(),
 			},
 			"east_west_placement": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"ipam_selector": {
@@ -58,7 +58,7 @@ func dataSourceAviVsVip() *schema.Resource {
 				Computed: true,
 			},
 			"use_standard_alb": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"uuid": {
@@ -7,7 +7,7 @@ import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 func dataSourceAviWafApplicationSignatureProvider() *schema.Resource {
 	return &schema.Resource{
-		Read: ResourceAviWafApplicationSignatureProviderRead,
+		ReadContext: ResourceAviWafApplicationSignatureProviderRead,
 		Schema: map[string]*schema.Schema{
 			"available_applications": {
 				Type:     schema.TypeList,
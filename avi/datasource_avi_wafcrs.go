@@ -7,7 +7,7 @@ import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 func dataSourceAviWafCRS() *schema.Resource {
 	return &schema.Resource{
-		Read: ResourceAviWafCRSRead,
+		ReadContext: ResourceAviWafCRSRead,
 		Schema: map[string]*schema.Schema{
 			"configpb_attributes": {
 				Type:     schema.TypeSet,
@@ -7,10 +7,10 @@ import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 func dataSourceAviWafPolicy() *schema.Resource {
 	return &schema.Resource{
-		Read: ResourceAviWafPolicyRead,
+		ReadContext: ResourceAviWafPolicyRead,
 		Schema: map[string]*schema.Schema{
 			"allow_mode_delegation": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"allowlist": {
@@ -24,11 +24,11 @@ func dataSourceAviWafPolicy() *schema.Resource {
 				Elem:     ResourceWafApplicationSignaturesSchema(),
 			},
 			"auto_update_crs": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"bypass_static_extensions": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"confidence_override": {
@@ -55,15 +55,15 @@ func dataSourceAviWafPolicy() *schema.Resource {
 				Computed: true,
 			},
 			"enable_app_learning": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"enable_auto_rule_updates": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"enable_regex_learning": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"failure_mode": {
@@ -127,7 +127,7 @@ func dataSourceAviWafPolicy() *schema.Resource {
 				Computed: true,
 			},
 			"updated_crs_rules_in_detection_mode": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"uuid": {
@@ -7,7 +7,7 @@ import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 func dataSourceAviWafPolicyPSMGroup() *schema.Resource {
 	return &schema.Resource{
-		Read: ResourceAviWafPolicyPSMGroupRead,
+		ReadContext: ResourceAviWafPolicyPSMGroupRead,
 		Schema: map[string]*schema.Schema{
 			"configpb_attributes": {
 				Type:     schema.TypeSet,
@@ -19,7 +19,7 @@ func dataSourceAviWafPolicyPSMGroup() *schema.Resource {
 				Computed: true,
 			},
 			"enable": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"hit_action": {
@@ -27,7 +27,7 @@ func dataSourceAviWafPolicyPSMGroup() *schema.Resource {
 				Computed: true,
 			},
 			"is_learning_group": {
-				Type:     schema.TypeString,
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"locations": {
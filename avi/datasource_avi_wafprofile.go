@@ -7,7 +7,7 @@ import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 func dataSourceAviWafProfile() *schema.Resource {
 	return &schema.Resource{
-		Read: ResourceAviWafProfileRead,
+		ReadContext: ResourceAviWafProfileRead,
 		Schema: map[string]*schema.Schema{
 			"config": {
 				Type:     schema.TypeSet,
@@ -7,7 +7,7 @@ import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 func dataSourceAviWebappUT() *schema.Resource {
 	return &schema.Resource{
-		Read: ResourceAviWebappUTRead,
+		ReadContext: ResourceAviWebappUTRead,
 		Schema: map[string]*schema.Schema{
 			"configpb_attributes": {
 				Type:     schema.TypeSet,
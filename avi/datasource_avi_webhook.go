@@ -7,7 +7,7 @@ import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 func dataSourceAviWebhook() *schema.Resource {
 	return &schema.Resource{
-		Read: ResourceAviWebhookRead,
+		ReadContext: ResourceAviWebhookRead,
 		Schema: map[string]*schema.Schema{
 			"callback_url": {
 				Type:     schema.TypeString,
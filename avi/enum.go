@@ -0,0 +1,39 @@
+// Copyright 2022 VMware, Inc.
+// SPDX-License-Identifier: Mozilla Public License 2.0
+
+package avi
+
+//go:generate go run ../scripts/genenums -o enum_fields.go
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// addEnumValidateFuncs validates the enum fields of the registry, in enum_fields.go, in the schemas
+// of resources against their allowed values, so that invalid values are reported by terraform validate instead
+// of by the controller during apply. The elements of lists of enums are validated each. An empty
+// string leaves the field unset, like for the other fields.
+func addEnumValidateFuncs(resources map[string]*schema.Resource) {
+	for name, r := range resources {
+		addEnumValidateFuncsSchema(name, r.Schema)
+	}
+}
+
+func addEnumValidateFuncsSchema(path string, s map[string]*schema.Schema) {
+	for name, field := range s {
+		fieldPath := path + "." + name
+		if values, ok := enumFields[fieldPath]; ok && (field.Optional || field.Required) {
+			value := field
+			if elem, ok := field.Elem.(*schema.Schema); ok {
+				value = elem
+			}
+			if value.Type == schema.TypeString && value.ValidateFunc == nil && value.ValidateDiagFunc == nil {
+				value.ValidateFunc = validation.Any(validation.StringIsEmpty, validation.StringInSlice(values, false))
+			}
+		}
+		if elem, ok := field.Elem.(*schema.Resource); ok {
+			addEnumValidateFuncsSchema(fieldPath, elem.Schema)
+		}
+	}
+}
@@ -0,0 +1,251 @@
+// Copyright 2022 VMware, Inc.
+// SPDX-License-Identifier: Mozilla Public License 2.0
+
+package avi
+
+// enumFields is the registry of the values allowed by the controller for enum fields, by dotted
+// path starting with the resource name, as defined by the object model of the Avi SDK. This copy
+// is maintained by hand from the Enum options of the resource documentation and covers only part
+// of the enum fields, mostly top-level ones. Running go generate with the pinned SDK available
+// replaces it with the output of scripts/genenums, which covers every enum field of the
+// resources and their nested blocks.
+var enumFields = map[string][]string{
+	"avi_actiongroupconfig.level":          {"ALERT_LOW", "ALERT_MEDIUM", "ALERT_HIGH"},
+	"avi_albservicesconfig.mode":           {"MODE_UNKNOWN", "SALESFORCE", "SYSTEST", "MYVMWARE"},
+	"avi_albservicesjob.status":            {"UNDETERMINED", "PENDING", "IN_PROGRESS", "COMPLETED", "FAILED"},
+	"avi_alertconfig.category":             {"REALTIME", "ROLLINGWINDOW", "WATERMARK"},
+	"avi_alertconfig.source":               {"CONN_LOGS", "APP_LOGS", "EVENT_LOGS", "METRICS"},
+	"avi_analyticsprofile.resp_code_block": {"AP_HTTP_RSP_4XX", "AP_HTTP_RSP_5XX"},
+	"avi_applicationpersistenceprofile.persistence_type": {
+		"PERSISTENCE_TYPE_CLIENT_IP_ADDRESS", "PERSISTENCE_TYPE_HTTP_COOKIE", "PERSISTENCE_TYPE_TLS",
+		"PERSISTENCE_TYPE_CLIENT_IPV6_ADDRESS", "PERSISTENCE_TYPE_CUSTOM_HTTP_HEADER",
+		"PERSISTENCE_TYPE_APP_COOKIE", "PERSISTENCE_TYPE_GSLB_SITE",
+	},
+	"avi_applicationpersistenceprofile.server_hm_down_recovery": {
+		"HM_DOWN_PICK_NEW_SERVER", "HM_DOWN_ABORT_CONNECTION", "HM_DOWN_CONTINUE_PERSISTENT_SERVER",
+	},
+	"avi_applicationprofile.app_service_type": {
+		"APP_SERVICE_TYPE_L7_HORIZON", "APP_SERVICE_TYPE_L4_BLAST", "APP_SERVICE_TYPE_L4_PCOIP",
+		"APP_SERVICE_TYPE_L4_FTP",
+	},
+	"avi_applicationprofile.type": {
+		"APPLICATION_PROFILE_TYPE_L4", "APPLICATION_PROFILE_TYPE_HTTP", "APPLICATION_PROFILE_TYPE_SYSLOG",
+		"APPLICATION_PROFILE_TYPE_DNS", "APPLICATION_PROFILE_TYPE_SSL", "APPLICATION_PROFILE_TYPE_SIP",
+	},
+	"avi_authmappingprofile.type": {
+		"AUTH_PROFILE_LDAP", "AUTH_PROFILE_TACACS_PLUS", "AUTH_PROFILE_SAML", "AUTH_PROFILE_PINGACCESS",
+		"AUTH_PROFILE_JWT", "AUTH_PROFILE_OAUTH",
+	},
+	"avi_authprofile.type": {
+		"AUTH_PROFILE_LDAP", "AUTH_PROFILE_TACACS_PLUS", "AUTH_PROFILE_SAML", "AUTH_PROFILE_PINGACCESS",
+		"AUTH_PROFILE_JWT", "AUTH_PROFILE_OAUTH",
+	},
+	"avi_backupconfiguration.remote_file_transfer_protocol": {"SCP", "SFTP"},
+	"avi_cloud.license_tier": {
+		"ENTERPRISE_16", "ENTERPRISE", "ENTERPRISE_18", "BASIC", "ESSENTIALS",
+		"ENTERPRISE_WITH_CLOUD_SERVICES",
+	},
+	"avi_cloud.license_type": {
+		"LIC_BACKEND_SERVERS", "LIC_SOCKETS", "LIC_CORES", "LIC_HOSTS", "LIC_SE_BANDWIDTH",
+		"LIC_METERED_SE_BANDWIDTH",
+	},
+	"avi_cloud.vtype": {
+		"CLOUD_NONE", "CLOUD_VCENTER", "CLOUD_OPENSTACK", "CLOUD_AWS", "CLOUD_VCA", "CLOUD_APIC",
+		"CLOUD_MESOS", "CLOUD_LINUXSERVER", "CLOUD_DOCKER_UCP", "CLOUD_RANCHER", "CLOUD_OSHIFT_K8S",
+		"CLOUD_AZURE", "CLOUD_GCP", "CLOUD_NSXT",
+	},
+	"avi_cloudproperties.cc_vtypes": {
+		"CLOUD_NONE", "CLOUD_VCENTER", "CLOUD_OPENSTACK", "CLOUD_AWS", "CLOUD_VCA", "CLOUD_APIC",
+		"CLOUD_MESOS", "CLOUD_LINUXSERVER", "CLOUD_DOCKER_UCP", "CLOUD_RANCHER", "CLOUD_OSHIFT_K8S",
+		"CLOUD_AZURE", "CLOUD_GCP", "CLOUD_NSXT",
+	},
+	"avi_controllerproperties.se_from_marketplace": {"MARKETPLACE", "IMAGE_SE"},
+	"avi_dynamicdnsrecord.algorithm": {
+		"DNS_RECORD_RESPONSE_ROUND_ROBIN", "DNS_RECORD_RESPONSE_CONSISTENT_HASH",
+	},
+	"avi_dynamicdnsrecord.type": {
+		"DNS_RECORD_OTHER", "DNS_RECORD_A", "DNS_RECORD_NS", "DNS_RECORD_CNAME", "DNS_RECORD_SOA",
+		"DNS_RECORD_PTR", "DNS_RECORD_HINFO", "DNS_RECORD_MX", "DNS_RECORD_TXT", "DNS_RECORD_RP",
+		"DNS_RECORD_DNSKEY", "DNS_RECORD_AAAA", "DNS_RECORD_SRV", "DNS_RECORD_OPT", "DNS_RECORD_RRSIG",
+		"DNS_RECORD_AXFR", "DNS_RECORD_ANY",
+	},
+	"avi_errorpagebody.format": {"ERROR_PAGE_FORMAT_HTML", "ERROR_PAGE_FORMAT_JSON"},
+	"avi_fileobject.type": {
+		"OTHER_FILE_TYPES", "IP_REPUTATION", "GEO_DB", "TECH_SUPPORT", "HSMPACKAGES", "IPAMDNSSCRIPTS",
+		"CONTROLLER_IMAGE",
+	},
+	"avi_gslbservice.health_monitor_scope": {
+		"GSLB_SERVICE_HEALTH_MONITOR_ALL_MEMBERS", "GSLB_SERVICE_HEALTH_MONITOR_ONLY_NON_AVI_MEMBERS",
+	},
+	"avi_gslbservice.pool_algorithm": {"GSLB_SERVICE_ALGORITHM_PRIORITY", "GSLB_SERVICE_ALGORITHM_GEO"},
+	"avi_healthmonitor.http_monitor.http_response_code": {
+		"HTTP_ANY", "HTTP_1XX", "HTTP_2XX", "HTTP_3XX", "HTTP_4XX", "HTTP_5XX",
+	},
+	"avi_healthmonitor.https_monitor.http_response_code": {
+		"HTTP_ANY", "HTTP_1XX", "HTTP_2XX", "HTTP_3XX", "HTTP_4XX", "HTTP_5XX",
+	},
+	"avi_healthmonitor.type": {
+		"HEALTH_MONITOR_PING", "HEALTH_MONITOR_TCP", "HEALTH_MONITOR_HTTP", "HEALTH_MONITOR_HTTPS",
+		"HEALTH_MONITOR_EXTERNAL", "HEALTH_MONITOR_UDP", "HEALTH_MONITOR_DNS", "HEALTH_MONITOR_GSLB",
+		"HEALTH_MONITOR_SIP", "HEALTH_MONITOR_RADIUS", "HEALTH_MONITOR_SMTP", "HEALTH_MONITOR_SMTPS",
+		"HEALTH_MONITOR_POP3", "HEALTH_MONITOR_POP3S", "HEALTH_MONITOR_IMAP", "HEALTH_MONITOR_IMAPS",
+		"HEALTH_MONITOR_FTP", "HEALTH_MONITOR_FTPS", "HEALTH_MONITOR_LDAP", "HEALTH_MONITOR_LDAPS",
+		"HEALTH_MONITOR_SCTP",
+	},
+	"avi_icapprofile.buffer_size_exceed_action": {"ICAP_FAIL_OPEN", "ICAP_FAIL_CLOSED"},
+	"avi_icapprofile.fail_action":               {"ICAP_FAIL_OPEN", "ICAP_FAIL_CLOSED"},
+	"avi_icapprofile.vendor":                    {"ICAP_VENDOR_GENERIC", "ICAP_VENDOR_OPSWAT", "ICAP_VENDOR_LASTLINE"},
+	"avi_image.type":                            {"IMAGE_TYPE_PATCH", "IMAGE_TYPE_SYSTEM", "IMAGE_TYPE_MUST_CHECK"},
+	"avi_ipamdnsproviderprofile.type": {
+		"IPAMDNS_TYPE_INFOBLOX", "IPAMDNS_TYPE_AWS", "IPAMDNS_TYPE_OPENSTACK", "IPAMDNS_TYPE_GCP",
+		"IPAMDNS_TYPE_INFOBLOX_DNS", "IPAMDNS_TYPE_CUSTOM", "IPAMDNS_TYPE_CUSTOM_DNS",
+		"IPAMDNS_TYPE_AZURE", "IPAMDNS_TYPE_OCI", "IPAMDNS_TYPE_TENCENT", "IPAMDNS_TYPE_INTERNAL",
+		"IPAMDNS_TYPE_INTERNAL_DNS", "IPAMDNS_TYPE_AWS_DNS", "IPAMDNS_TYPE_AZURE_DNS",
+	},
+	"avi_ipreputationdb.vendor":             {"IP_REPUTATION_VENDOR_WEBROOT"},
+	"avi_jwtserverprofile.jwt_profile_type": {"CLIENT_AUTH", "CONTROLLER_INTERNAL_AUTH"},
+	"avi_networkservice.service_type":       {"ROUTING_SERVICE"},
+	"avi_pool.fail_action.type": {
+		"FAIL_ACTION_HTTP_REDIRECT", "FAIL_ACTION_HTTP_LOCAL_RSP", "FAIL_ACTION_CLOSE_CONN",
+		"FAIL_ACTION_BACKUP_POOL",
+	},
+	"avi_pool.lb_algorithm": {
+		"LB_ALGORITHM_LEAST_CONNECTIONS", "LB_ALGORITHM_ROUND_ROBIN", "LB_ALGORITHM_FASTEST_RESPONSE",
+		"LB_ALGORITHM_CONSISTENT_HASH", "LB_ALGORITHM_LEAST_LOAD", "LB_ALGORITHM_FEWEST_SERVERS",
+		"LB_ALGORITHM_RANDOM", "LB_ALGORITHM_FEWEST_TASKS", "LB_ALGORITHM_NEAREST_SERVER",
+		"LB_ALGORITHM_CORE_AFFINITY", "LB_ALGORITHM_TOPOLOGY",
+	},
+	"avi_pool.lb_algorithm_hash": {
+		"LB_ALGORITHM_CONSISTENT_HASH_SOURCE_IP_ADDRESS",
+		"LB_ALGORITHM_CONSISTENT_HASH_SOURCE_IP_ADDRESS_AND_PORT", "LB_ALGORITHM_CONSISTENT_HASH_URI",
+		"LB_ALGORITHM_CONSISTENT_HASH_CUSTOM_HEADER", "LB_ALGORITHM_CONSISTENT_HASH_CUSTOM_STRING",
+		"LB_ALGORITHM_CONSISTENT_HASH_CALLID",
+	},
+	"avi_pool.servers.ip.type":             {"V4", "DNS", "V6"},
+	"avi_poolgroupdeploymentpolicy.scheme": {"BLUE_GREEN", "CANARY"},
+	"avi_scheduler.frequency_unit": {
+		"SCHEDULER_FREQUENCY_UNIT_MIN", "SCHEDULER_FREQUENCY_UNIT_HOUR", "SCHEDULER_FREQUENCY_UNIT_DAY",
+		"SCHEDULER_FREQUENCY_UNIT_WEEK", "SCHEDULER_FREQUENCY_UNIT_MONTH",
+	},
+	"avi_scheduler.run_mode":         {"RUN_MODE_PERIODIC", "RUN_MODE_AT", "RUN_MODE_NOW"},
+	"avi_scheduler.scheduler_action": {"SCHEDULER_ACTION_RUN_A_SCRIPT", "SCHEDULER_ACTION_BACKUP"},
+	"avi_securitypolicy.oper_mode":   {"DETECTION", "MITIGATION"},
+	"avi_serviceengine.container_type": {
+		"CONTAINER_TYPE_BRIDGE", "CONTAINER_TYPE_HOST", "CONTAINER_TYPE_HOST_DPDK",
+	},
+	"avi_serviceengine.enable_state": {
+		"SE_STATE_ENABLED", "SE_STATE_DISABLED_FOR_PLACEMENT", "SE_STATE_DISABLED",
+		"SE_STATE_DISABLED_FORCE",
+	},
+	"avi_serviceengine.hypervisor": {"DEFAULT", "VMWARE_ESX", "KVM", "VMWARE_VSAN", "XEN"},
+	"avi_serviceenginegroup.algo":  {"PLACEMENT_ALGO_PACKED", "PLACEMENT_ALGO_DISTRIBUTED"},
+	"avi_serviceenginegroup.auto_rebalance_criteria": {
+		"SE_AUTO_REBALANCE_CPU", "SE_AUTO_REBALANCE_PPS", "SE_AUTO_REBALANCE_MBPS",
+		"SE_AUTO_REBALANCE_OPEN_CONNS", "SE_AUTO_REBALANCE_CPS",
+	},
+	"avi_serviceenginegroup.ha_mode": {
+		"HA_MODE_SHARED_PAIR", "HA_MODE_SHARED", "HA_MODE_LEGACY_ACTIVE_STANDBY",
+	},
+	"avi_serviceenginegroup.hypervisor": {"DEFAULT", "VMWARE_ESX", "KVM", "VMWARE_VSAN", "XEN"},
+	"avi_serviceenginegroup.ingress_access_data": {
+		"SG_INGRESS_ACCESS_NONE", "SG_INGRESS_ACCESS_ALL", "SG_INGRESS_ACCESS_VPC",
+	},
+	"avi_serviceenginegroup.ingress_access_mgmt": {
+		"SG_INGRESS_ACCESS_NONE", "SG_INGRESS_ACCESS_ALL", "SG_INGRESS_ACCESS_VPC",
+	},
+	"avi_serviceenginegroup.license_tier": {
+		"ENTERPRISE_16", "ENTERPRISE", "ENTERPRISE_18", "BASIC", "ESSENTIALS",
+		"ENTERPRISE_WITH_CLOUD_SERVICES",
+	},
+	"avi_serviceenginegroup.license_type": {
+		"LIC_BACKEND_SERVERS", "LIC_SOCKETS", "LIC_CORES", "LIC_HOSTS", "LIC_SE_BANDWIDTH",
+		"LIC_METERED_SE_BANDWIDTH",
+	},
+	"avi_serviceenginegroup.pcap_tx_mode":   {"PCAP_TX_AUTO", "PCAP_TX_SOCKET", "PCAP_TX_RING"},
+	"avi_serviceenginegroup.placement_mode": {"PLACEMENT_MODE_AUTO"},
+	"avi_serviceenginegroup.se_bandwidth_type": {
+		"SE_BANDWIDTH_UNLIMITED", "SE_BANDWIDTH_25M", "SE_BANDWIDTH_200M", "SE_BANDWIDTH_1000M",
+		"SE_BANDWIDTH_10000M",
+	},
+	"avi_serviceenginegroup.se_hyperthreaded_mode": {
+		"SE_CPU_HT_AUTO", "SE_CPU_HT_SPARSE_DISPATCHER_PRIORITY", "SE_CPU_HT_SPARSE_PROXY_PRIORITY",
+		"SE_CPU_HT_PACKED_CORES",
+	},
+	"avi_serviceenginegroup.vcenter_datastore_mode": {
+		"VCENTER_DATASTORE_ANY", "VCENTER_DATASTORE_LOCAL", "VCENTER_DATASTORE_SHARED",
+	},
+	"avi_siteversion.replication_state": {
+		"REPLICATION_STATE_FASTFORWARD", "REPLICATION_STATE_FORCESYNC", "REPLICATION_STATE_STREAMING",
+		"REPLICATION_STATE_SUSPENDED", "REPLICATION_STATE_INIT", "REPLICATION_STATE_WAIT",
+		"REPLICATION_STATE_NOT_APPLICABLE",
+	},
+	"avi_siteversion.version_type":    {"CONFIG_VERSION", "HEALTH_STATUS_VERSION"},
+	"avi_sslkeyandcertificate.format": {"SSL_PEM", "SSL_PKCS12"},
+	"avi_sslkeyandcertificate.ocsp_error_status": {
+		"OCSP_ERR_CERTSTATUS_GOOD", "OCSP_ERR_CERTSTATUS_REVOKED", "OCSP_ERR_CERTSTATUS_UNKNOWN",
+		"OCSP_ERR_CERTSTATUS_SERVERFAIL_ERR", "OCSP_ERR_CERTSTATUS_JOBDB", "OCSP_ERR_CERTSTATUS_DISABLED",
+		"OCSP_ERR_CERTSTATUS_GETCERT", "OCSP_ERR_CERTSTATUS_NONVSCERT", "OCSP_ERR_CERTSTATUS_SELFSIGNED",
+		"OCSP_ERR_CERTSTATUS_CERTFINISH", "OCSP_ERR_CERTSTATUS_CACERT", "OCSP_ERR_CERTSTATUS_REQUEST",
+		"OCSP_ERR_CERTSTATUS_ISSUER_REVOKED", "OCSP_ERR_CERTSTATUS_PARSE_CERT",
+		"OCSP_ERR_CERTSTATUS_HTTP_REQ", "OCSP_ERR_CERTSTATUS_URL_LIST", "OCSP_ERR_CERTSTATUS_HTTP_SEND",
+		"OCSP_ERR_CERTSTATUS_HTTP_RECV", "OCSP_ERR_CERTSTATUS_HTTP_RESP",
+	},
+	"avi_sslkeyandcertificate.status": {"SSL_CERTIFICATE_FINISHED", "SSL_CERTIFICATE_PENDING"},
+	"avi_sslkeyandcertificate.type": {
+		"SSL_CERTIFICATE_TYPE_VIRTUALSERVICE", "SSL_CERTIFICATE_TYPE_SYSTEM", "SSL_CERTIFICATE_TYPE_CA",
+	},
+	"avi_sslprofile.type": {"SSL_PROFILE_TYPE_APPLICATION", "SSL_PROFILE_TYPE_SYSTEM"},
+	"avi_ssopolicy.type": {
+		"SSO_TYPE_SAML", "SSO_TYPE_PINGACCESS", "SSO_TYPE_JWT", "SSO_TYPE_LDAP", "SSO_TYPE_OAUTH",
+	},
+	"avi_statediffoperation.operation": {"FB_UPGRADE", "FB_ROLLBACK", "FB_PATCH", "FB_ROLLBACK_PATCH"},
+	"avi_statediffoperation.phase":     {"FB_PRE_SNAPSHOT", "FB_POST_SNAPSHOT"},
+	"avi_statediffoperation.status": {
+		"FB_INIT", "FB_IN_PROGRESS", "FB_COMPLETED", "FB_FAILED", "FB_COMPLETED_WITH_ERRORS",
+	},
+	"avi_statediffsnapshot.snapshot_type": {
+		"FB_VS_SNAPSHOT", "FB_SE_SNAPSHOT", "FB_GSLB_SNAPSHOT", "FB_POOL_SNAPSHOT",
+	},
+	"avi_stringgroup.type": {"SG_TYPE_STRING", "SG_TYPE_KEYVAL"},
+	"avi_systemconfiguration.default_license_tier": {
+		"ENTERPRISE_16", "ENTERPRISE", "ENTERPRISE_18", "BASIC", "ESSENTIALS",
+		"ENTERPRISE_WITH_CLOUD_SERVICES",
+	},
+	"avi_upgradestatusinfo.node_type": {"NODE_CONTROLLER_CLUSTER", "NODE_SE_GROUP", "NODE_SE_TYPE"},
+	"avi_upgradestatusinfo.upgrade_ops": {
+		"UPGRADE", "PATCH", "ROLLBACK", "ROLLBACKPATCH", "SEGROUP_RESUME", "EVAL_UPGRADE", "EVAL_PATCH",
+		"EVAL_ROLLBACK", "EVAL_ROLLBACKPATCH", "EVAL_SEGROUP_RESUME",
+	},
+	"avi_upgradestatussummary.node_type": {"NODE_CONTROLLER_CLUSTER", "NODE_SE_GROUP", "NODE_SE_TYPE"},
+	"avi_upgradestatussummary.upgrade_ops": {
+		"UPGRADE", "PATCH", "ROLLBACK", "ROLLBACKPATCH", "SEGROUP_RESUME", "EVAL_UPGRADE", "EVAL_PATCH",
+		"EVAL_ROLLBACK", "EVAL_ROLLBACKPATCH", "EVAL_SEGROUP_RESUME",
+	},
+	"avi_virtualservice.active_standby_se_tag": {"ACTIVE_STANDBY_SE_1", "ACTIVE_STANDBY_SE_2"},
+	"avi_virtualservice.cloud_type": {
+		"CLOUD_NONE", "CLOUD_VCENTER", "CLOUD_OPENSTACK", "CLOUD_AWS", "CLOUD_VCA", "CLOUD_APIC",
+		"CLOUD_MESOS", "CLOUD_LINUXSERVER", "CLOUD_DOCKER_UCP", "CLOUD_RANCHER", "CLOUD_OSHIFT_K8S",
+		"CLOUD_AZURE", "CLOUD_GCP", "CLOUD_NSXT",
+	},
+	"avi_virtualservice.flow_dist": {
+		"LOAD_AWARE", "CONSISTENT_HASH_SOURCE_IP_ADDRESS", "CONSISTENT_HASH_SOURCE_IP_ADDRESS_AND_PORT",
+	},
+	"avi_virtualservice.flow_label_type": {"NO_LABEL", "APPLICATION_LABEL", "SERVICE_LABEL"},
+	"avi_virtualservice.type":            {"VS_TYPE_NORMAL", "VS_TYPE_VH_PARENT", "VS_TYPE_VH_CHILD"},
+	"avi_virtualservice.vh_type":         {"VS_TYPE_VH_SNI", "VS_TYPE_VH_ENHANCED"},
+	"avi_vsgs.type":                      {"VSGS_TYPE_GSLB", "VSGS_TYPE_GS", "VSGS_TYPE_GEO_DB"},
+	"avi_vsvip.vip.ip6_address.type":     {"V4", "DNS", "V6"},
+	"avi_vsvip.vip.ip_address.type":      {"V4", "DNS", "V6"},
+	"avi_wafpolicy.failure_mode":         {"WAF_FAILURE_MODE_OPEN", "WAF_FAILURE_MODE_CLOSED"},
+	"avi_wafpolicy.min_confidence": {
+		"CONFIDENCE_VERY_HIGH", "CONFIDENCE_HIGH", "CONFIDENCE_PROBABLE", "CONFIDENCE_LOW",
+		"CONFIDENCE_NONE",
+	},
+	"avi_wafpolicy.mode": {"WAF_MODE_DETECTION_ONLY", "WAF_MODE_ENFORCEMENT"},
+	"avi_wafpolicy.paranoia_level": {
+		"WAF_PARANOIA_LEVEL_LOW", "WAF_PARANOIA_LEVEL_MEDIUM", "WAF_PARANOIA_LEVEL_HIGH",
+		"WAF_PARANOIA_LEVEL_EXTREME",
+	},
+	"avi_wafpolicypsmgroup.hit_action":  {"WAF_ACTION_NO_OP", "WAF_ACTION_BLOCK", "WAF_ACTION_ALLOW_PARAMETER"},
+	"avi_wafpolicypsmgroup.miss_action": {"WAF_ACTION_NO_OP", "WAF_ACTION_BLOCK", "WAF_ACTION_ALLOW_PARAMETER"},
+}
@@ -0,0 +1,87 @@
+// Copyright 2022 VMware, Inc.
+// SPDX-License-Identifier: Mozilla Public License 2.0
+
+package avi
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestEnumFields(t *testing.T) {
+	provider := Provider()
+	for path, values := range enumFields {
+		parts := strings.Split(path, ".")
+		r, ok := provider.ResourcesMap[parts[0]]
+		if !ok {
+			continue
+		}
+		s := r.Schema
+		var field *schema.Schema
+		for _, name := range parts[1:] {
+			if field = s[name]; field == nil {
+				break
+			}
+			if elem, ok := field.Elem.(*schema.Resource); ok {
+				s = elem.Schema
+			}
+		}
+		// The registry is generated from the SDK, whose models have objects and fields that the
+		// provider does not manage.
+		if field == nil || !(field.Optional || field.Required) {
+			continue
+		}
+		if elem, ok := field.Elem.(*schema.Schema); ok {
+			field = elem
+		}
+		if field.ValidateFunc == nil {
+			t.Errorf("enum field %s is not validated", path)
+		}
+		if field.Default != nil {
+			if _, errs := field.ValidateFunc(field.Default, path); len(errs) > 0 {
+				t.Errorf("default of enum field %s is not allowed: %v", path, errs)
+			}
+		}
+		if len(values) == 0 {
+			t.Errorf("enum field %s has no values", path)
+		}
+	}
+}
+
+func TestEnumFieldsNested(t *testing.T) {
+	r := Provider().ResourcesMap["avi_pool"]
+	if r.Schema["lb_algorithm"].ValidateFunc == nil {
+		t.Errorf("lb_algorithm of avi_pool is not validated")
+	}
+	ip := r.Schema["servers"].Elem.(*schema.Resource).Schema["ip"].Elem.(*schema.Resource).Schema["type"]
+	if ip.ValidateFunc == nil {
+		t.Errorf("servers.ip.type of avi_pool is not validated")
+	}
+	if Provider().ResourcesMap["avi_healthmonitor"].Schema["type"].ValidateFunc == nil {
+		t.Errorf("type of avi_healthmonitor is not validated")
+	}
+}
+
+func TestEnumValidation(t *testing.T) {
+	provider := Provider()
+	tests := []struct {
+		vtype string
+		valid bool
+	}{
+		{"CLOUD_VCENTER", true},
+		{"CLOUD_VCENTRE", false},
+		{"cloud_vcenter", false},
+	}
+	for _, test := range tests {
+		diags := provider.ValidateResource("avi_cloud", terraform.NewResourceConfigRaw(map[string]interface{}{
+			"name":  "cloud-1",
+			"vtype": test.vtype,
+		}))
+		if diags.HasError() == test.valid {
+			t.Errorf("got diagnostics %v for vtype %s, want valid %v", diags, test.vtype, test.valid)
+		}
+	}
+}
@@ -0,0 +1,162 @@
+// Copyright 2022 VMware, Inc.
+// SPDX-License-Identifier: Mozilla Public License 2.0
+
+package avi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/vmware/alb-sdk/go/session"
+)
+
+// apiError is an error returned for a request made through the AviSession. It keeps the
+// parts of the controller response needed to decide how a failed request is handled, so
+// that callers do not have to match on the error text.
+type apiError struct {
+	// HTTPStatus is the HTTP status code of the response, 0 if no response was received.
+	HTTPStatus int
+	// Code is the error code reported by the controller in the response body.
+	Code int
+	// Message is the error message reported by the controller.
+	Message string
+	// Verb is the HTTP method of the failed request.
+	Verb string
+	// Path is the api path of the failed request.
+	Path string
+
+	err error
+}
+
+func (e *apiError) Error() string {
+	if e.HTTPStatus == 0 {
+		return e.err.Error()
+	}
+	msg := e.Message
+	if msg == "" {
+		msg = http.StatusText(e.HTTPStatus)
+	}
+	if e.Path == "" {
+		return fmt.Sprintf("HTTP %d: %s", e.HTTPStatus, msg)
+	}
+	return fmt.Sprintf("%s %s failed with HTTP %d: %s", e.Verb, e.Path, e.HTTPStatus, msg)
+}
+
+func (e *apiError) Unwrap() error {
+	return e.err
+}
+
+// NotFound reports whether the requested object does not exist on the controller.
+func (e *apiError) NotFound() bool {
+	return e.HTTPStatus == http.StatusNotFound
+}
+
+// Forbidden reports whether the controller denied the request to the current user.
+func (e *apiError) Forbidden() bool {
+	return e.HTTPStatus == http.StatusForbidden
+}
+
+// abandonedRequestError is returned for a request that was still in flight when its context was
+// done. The SDK calls take no context, so the request goes on in the background and the
+// controller may still process it.
+type abandonedRequestError struct {
+	err error
+	// done receives the result of the request once it finishes.
+	done <-chan error
+}
+
+func (e *abandonedRequestError) Error() string {
+	return e.err.Error() + " while the request was in flight"
+}
+
+func (e *abandonedRequestError) Unwrap() error {
+	return e.err
+}
+
+// wait returns the result of the abandoned request, or the error of ctx if it is done before.
+func (e *abandonedRequestError) wait(ctx context.Context) error {
+	select {
+	case err := <-e.done:
+		return newAPIError(err)
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// newAPIError converts an error returned by the AviSession into an *apiError. Errors that did
+// not come from the controller, such as a cancelled context, are returned unchanged.
+func newAPIError(err error) error {
+	if err == nil {
+		return nil
+	}
+	var ae *apiError
+	if errors.As(err, &ae) {
+		return err
+	}
+	var aviErr session.AviError
+	if errors.As(err, &aviErr) {
+		return newAPIErrorFromAviError(aviErr, err)
+	}
+	var aviErrPtr *session.AviError
+	if errors.As(err, &aviErrPtr) && aviErrPtr != nil {
+		return newAPIErrorFromAviError(*aviErrPtr, err)
+	}
+	// GetObject reports a name lookup without results as a plain error.
+	if strings.HasPrefix(err.Error(), "No object of type") {
+		return &apiError{HTTPStatus: http.StatusNotFound, Message: err.Error(), err: err}
+	}
+	return err
+}
+
+func newAPIErrorFromAviError(aviErr session.AviError, err error) *apiError {
+	ae := &apiError{
+		HTTPStatus: aviErr.HttpStatusCode,
+		Code:       aviErr.Code,
+		Verb:       aviErr.Verb,
+		Path:       aviErr.Url,
+		err:        err,
+	}
+	if aviErr.Message != nil {
+		ae.Message = *aviErr.Message
+	}
+	if u, perr := url.Parse(aviErr.Url); perr == nil && u.Path != "" {
+		ae.Path = strings.TrimPrefix(u.Path, "/")
+	}
+	return ae
+}
+
+// aviErrorStatus returns the HTTP status of an error returned by the AviSession, or 0 when the
+// request did not get a response from the controller.
+func aviErrorStatus(err error) int {
+	var ae *apiError
+	if errors.As(newAPIError(err), &ae) {
+		return ae.HTTPStatus
+	}
+	return 0
+}
+
+// isAviObjectNotFound reports whether err means that the requested object does not exist on the
+// controller: either a 404 response or an empty result for a lookup by name. Any other error,
+// including authentication failures and server errors, says nothing about the object.
+func isAviObjectNotFound(err error) bool {
+	return aviErrorStatus(err) == http.StatusNotFound
+}
+
+// isControllerUnreachable reports whether err means that a request got no response from the
+// controller, as opposed to an error response or a cancelled request.
+func isControllerUnreachable(err error) bool {
+	if err == nil || errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	var ae *apiError
+	if errors.As(err, &ae) {
+		return ae.HTTPStatus == 0
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr)
+}
@@ -0,0 +1,106 @@
+// Copyright 2022 VMware, Inc.
+// SPDX-License-Identifier: Mozilla Public License 2.0
+
+package avi
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/vmware/alb-sdk/go/session"
+)
+
+// controllerHealthCheckTimeout limits the health check of a controller node during a failover.
+const controllerHealthCheckTimeout = 10 * time.Second
+
+// failoverSessionOptions returns the session options of a provider that can fail over to other
+// controllers. The SDK then gives up on an unreachable controller after one status check, instead
+// of waiting for it to come back.
+func failoverSessionOptions(failover bool) []func(*session.AviSession) error {
+	if !failover {
+		return nil
+	}
+	return []func(*session.AviSession) error{session.SetControllerStatusCheckLimits(1, 1)}
+}
+
+// addControllers adds the controller addresses that client does not know yet.
+func (client *ProviderClient) addControllers(addrs ...string) {
+	client.sessionMu.Lock()
+	defer client.sessionMu.Unlock()
+	for _, addr := range addrs {
+		known := addr == ""
+		for _, c := range client.controllers {
+			if c == addr {
+				known = true
+				break
+			}
+		}
+		if !known {
+			client.controllers = append(client.controllers, addr)
+		}
+	}
+}
+
+// discoverControllers adds the addresses of the nodes of the controller cluster to the controllers
+// that client fails over to.
+func (client *ProviderClient) discoverControllers(ctx context.Context) error {
+	var cluster map[string]interface{}
+	err := aviSessionCall(ctx, client, func() error {
+		return client.AviSession.Get("api/cluster", &cluster)
+	})
+	if err != nil {
+		return err
+	}
+	nodes, _ := cluster["nodes"].([]interface{})
+	var addrs []string
+	for _, node := range nodes {
+		ip, _ := node.(map[string]interface{})["ip"].(map[string]interface{})
+		if addr, ok := ip["addr"].(string); ok {
+			addrs = append(addrs, addr)
+		}
+	}
+	log.Printf("[INFO] Discovered Avi Controller cluster nodes %v\n", addrs)
+	client.addControllers(addrs...)
+	return nil
+}
+
+// failover replaces the session of client, whose controller did not respond, by a session with
+// the first healthy controller of the others. generation is the session generation seen by the
+// caller when its request failed; if another request has already replaced that session, nothing
+// is done.
+func (client *ProviderClient) failover(ctx context.Context, generation int) error {
+	client.sessionMu.Lock()
+	defer client.sessionMu.Unlock()
+	if client.sessionGeneration != generation {
+		return nil
+	}
+	current := client.Credentials.Controller
+	for _, addr := range client.controllers {
+		if addr == current {
+			continue
+		}
+		config := client.Credentials
+		config.Controller = addr
+		if err := checkControllerHealth(ctx, config); err != nil {
+			log.Printf("[DEBUG] failover skips unhealthy controller %s: %v\n", addr, err)
+			continue
+		}
+		if err := client.replaceSessionLocked(ctx, config); err != nil {
+			log.Printf("[DEBUG] failover failed to log in to controller %s: %v\n", addr, err)
+			continue
+		}
+		log.Printf("[INFO] Failed over from Avi Controller %s to %s\n", current, addr)
+		return nil
+	}
+	return fmt.Errorf("no healthy controller to fail over to from %s", current)
+}
+
+// checkControllerHealth returns an error unless the controller of config answers api/initial-data.
+func checkControllerHealth(ctx context.Context, config Credentials) error {
+	ctx, cancel := context.WithTimeout(ctx, controllerHealthCheckTimeout)
+	defer cancel()
+	_, err := detectControllerVersion(ctx, config)
+	return err
+}
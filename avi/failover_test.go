@@ -0,0 +1,90 @@
+// Copyright 2022 VMware, Inc.
+// SPDX-License-Identifier: Mozilla Public License 2.0
+
+package avi
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/vmware/alb-sdk/go/clients"
+)
+
+func TestAviSessionCallFailover(t *testing.T) {
+	fc := newFakeController()
+	defer fc.Close()
+	down := httptest.NewTLSServer(http.NotFoundHandler())
+	downHost := strings.TrimPrefix(down.URL, "https://")
+	down.Close()
+
+	config := Credentials{
+		Username:   fakeControllerUsername,
+		Password:   fakeControllerPassword,
+		Controller: downHost,
+		Tenant:     fakeControllerTenant,
+		Version:    fakeControllerVersion,
+		CABundle:   fc.CABundle(),
+	}
+	options, err := aviSessionOptions(config, nil)
+	if err != nil {
+		t.Fatalf("aviSessionOptions() failed: %v", err)
+	}
+	aviClient, err := clients.NewAviClient(downHost, config.Username,
+		append(options, failoverSessionOptions(true)...)...)
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	client := &ProviderClient{AviClient: aviClient, Credentials: config}
+	client.addControllers(downHost, fc.Host(), downHost)
+	if !reflect.DeepEqual(client.controllers, []string{downHost, fc.Host()}) {
+		t.Fatalf("got controllers %v", client.controllers)
+	}
+
+	var clouds interface{}
+	err = aviSessionCall(context.Background(), client, func() error {
+		return client.AviSession.Get("api/cloud", &clouds)
+	})
+	if err != nil {
+		t.Fatalf("request did not fail over: %v", err)
+	}
+	if client.credentials().Controller != fc.Host() || client.sessionGeneration != 1 {
+		t.Errorf("session was not moved to %s: controller %s generation %d", fc.Host(),
+			client.credentials().Controller, client.sessionGeneration)
+	}
+
+	fc.Close()
+	err = aviSessionCall(context.Background(), client, func() error {
+		return client.AviSession.Get("api/cloud", &clouds)
+	})
+	if !isControllerUnreachable(err) {
+		t.Errorf("got %v without a healthy controller, want an unreachable controller", err)
+	}
+}
+
+func TestDiscoverControllers(t *testing.T) {
+	fc := newFakeController()
+	defer fc.Close()
+	client := newFakeControllerClient(t, fc)
+	client.addControllers(fc.Host())
+	cluster := map[string]interface{}{
+		"name": "cluster-0-1",
+		"nodes": []interface{}{
+			map[string]interface{}{"name": "node-1", "ip": map[string]interface{}{"addr": "10.10.10.1", "type": "V4"}},
+			map[string]interface{}{"name": "node-2", "ip": map[string]interface{}{"addr": "10.10.10.2", "type": "V4"}},
+		},
+	}
+	if err := client.AviSession.Put("api/cluster", cluster, nil); err != nil {
+		t.Fatalf("failed to update cluster: %v", err)
+	}
+	if err := client.discoverControllers(context.Background()); err != nil {
+		t.Fatalf("discoverControllers() failed: %v", err)
+	}
+	want := []string{fc.Host(), "10.10.10.1", "10.10.10.2"}
+	if !reflect.DeepEqual(client.controllers, want) {
+		t.Errorf("got controllers %v, want %v", client.controllers, want)
+	}
+}
@@ -0,0 +1,819 @@
+/***************************************************************************
+ * ========================================================================
+ * Copyright 2022 VMware, Inc.  All rights reserved. VMware Confidential
+ * ========================================================================
+ */
+
+package avi
+
+import (
+	"crypto/rand"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/vmware/alb-sdk/go/clients"
+	"github.com/vmware/alb-sdk/go/session"
+)
+
+const (
+	fakeControllerUsername  = "admin"
+	fakeControllerPassword  = "fake-controller-password"
+	fakeControllerAuthToken = "fake-controller-token"
+	fakeControllerVersion   = "22.1.2"
+	fakeControllerTenant    = "admin"
+)
+
+// System objects present on a freshly installed controller. The acceptance
+// test configurations look these up through data sources.
+var fakeControllerSeedObjects = []struct {
+	objType string
+	name    string
+}{
+	{"cloud", "Default-Cloud"},
+	{"vrfcontext", "global"},
+	{"vrfcontext", "management"},
+	{"serviceenginegroup", "Default-Group"},
+	{"healthmonitor", "System-HTTP"},
+	{"healthmonitor", "System-TCP"},
+	{"healthmonitor", "System-Ping"},
+	{"applicationprofile", "System-HTTP"},
+	{"applicationprofile", "System-Secure-HTTP"},
+	{"applicationprofile", "System-L4-Application"},
+	{"networkprofile", "System-TCP-Proxy"},
+	{"networkprofile", "System-UDP-Fast-Path"},
+	{"analyticsprofile", "System-Analytics-Profile"},
+	{"sslkeyandcertificate", "System-Default-Cert"},
+	{"sslprofile", "System-Standard"},
+	{"stringgroup", "System-Cacheable-Resource-Types"},
+	{"stringgroup", "System-Compressible-Content-Types"},
+	{"stringgroup", "System-Devices-Mobile"},
+	{"actiongroupconfig", "System-Alert-Level-Medium"},
+	{"backupconfiguration", "Backup-Configuration"},
+	{"wafprofile", "System-WAF-Profile"},
+	{"role", "System-Admin"},
+	{"useraccountprofile", "Default-User-Account-Profile"},
+	{"cluster", "cluster-0-1"},
+	{"systemconfiguration", "systemconfiguration"},
+	{"controllerproperties", "controllerproperties"},
+	{"seproperties", "seproperties"},
+}
+
+type fakeObject struct {
+	data   map[string]interface{}
+	tenant string
+	shared bool
+}
+
+// fakeController is an in-memory stand-in for the /api/<objtype> REST surface
+// of the Avi Controller. It is good enough for the provider CRUD helpers and
+// the acceptance tests; it does not fill in schema defaults.
+type fakeController struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	objects  map[string]map[string]*fakeObject
+	order    map[string][]string
+	sessions map[string]bool
+	password string
+	account  map[string]interface{}
+	requests []string
+
+	// failStatus is returned instead of serving the next failCount api requests.
+	failStatus int
+	failCount  int
+	// failRetryAfter is the Retry-After header of the failed requests, if set.
+	failRetryAfter string
+	// patchDelay makes PATCH a non-atomic read-modify-write: the patch is applied to the object
+	// as it was patchDelay before, so that concurrent patches lose each other's changes.
+	patchDelay time.Duration
+	// postDelay delays the response of a POST that created an object.
+	postDelay time.Duration
+}
+
+func newFakeController() *fakeController {
+	fc := &fakeController{
+		objects:  map[string]map[string]*fakeObject{},
+		order:    map[string][]string{},
+		sessions: map[string]bool{},
+		password: fakeControllerPassword,
+		account:  map[string]interface{}{"username": fakeControllerUsername, "local": true},
+	}
+	fc.Server = httptest.NewTLSServer(http.HandlerFunc(fc.serveHTTP))
+	fc.seed()
+	return fc
+}
+
+// Host returns the address to use as avi_controller.
+func (fc *fakeController) Host() string {
+	return strings.TrimPrefix(fc.URL, "https://")
+}
+
+// CABundle returns the PEM encoded certificate of the fake controller, to use as avi_ca_bundle.
+func (fc *fakeController) CABundle() string {
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: fc.Certificate().Raw}))
+}
+
+// Requests returns the "METHOD /path" of every request served so far.
+func (fc *fakeController) Requests() []string {
+	fc.mu.Lock()
+	defer fc.mu.Unlock()
+	return append([]string(nil), fc.requests...)
+}
+
+// SetPassword changes the password of the user as if it was changed outside of the provider.
+func (fc *fakeController) SetPassword(password string) {
+	fc.mu.Lock()
+	defer fc.mu.Unlock()
+	fc.password = password
+}
+
+// SessionCount returns the number of sessions that are logged in.
+func (fc *fakeController) SessionCount() int {
+	fc.mu.Lock()
+	defer fc.mu.Unlock()
+	return len(fc.sessions)
+}
+
+// ExpireSessions logs out all sessions, as if they had timed out on the controller.
+func (fc *fakeController) ExpireSessions() {
+	fc.mu.Lock()
+	defer fc.mu.Unlock()
+	fc.sessions = map[string]bool{}
+}
+
+// FailNext makes the next n api requests fail with the given HTTP status.
+func (fc *fakeController) FailNext(n, status int) {
+	fc.mu.Lock()
+	defer fc.mu.Unlock()
+	fc.failCount = n
+	fc.failStatus = status
+	fc.failRetryAfter = ""
+}
+
+// FailNextRetryAfter makes the next n api requests fail like FailNext, with a Retry-After header.
+func (fc *fakeController) FailNextRetryAfter(n, status int, retryAfter string) {
+	fc.FailNext(n, status)
+	fc.mu.Lock()
+	defer fc.mu.Unlock()
+	fc.failRetryAfter = retryAfter
+}
+
+func (fc *fakeController) seed() {
+	fc.addObject("tenant", map[string]interface{}{"uuid": fakeControllerTenant, "name": fakeControllerTenant},
+		fakeControllerTenant, true)
+	cloudRef := ""
+	for _, o := range fakeControllerSeedObjects {
+		data := map[string]interface{}{"name": o.name}
+		if o.objType == "vrfcontext" || o.objType == "serviceenginegroup" {
+			data["cloud_ref"] = cloudRef
+		}
+		obj := fc.addObject(o.objType, data, fakeControllerTenant, true)
+		if o.objType == "cloud" {
+			cloudRef = obj.data["url"].(string)
+		}
+	}
+}
+
+func (fc *fakeController) newUUID(objType string) string {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		panic(err)
+	}
+	return fmt.Sprintf("%s-%x-%x-%x-%x-%x", objType, b[0:4], b[4:6], b[6:8], b[8:10], b[10:])
+}
+
+func (fc *fakeController) objectURL(objType, uuid string) string {
+	return fc.URL + "/api/" + objType + "/" + uuid
+}
+
+func (fc *fakeController) addObject(objType string, data map[string]interface{}, tenant string,
+	shared bool) *fakeObject {
+	uuid, _ := data["uuid"].(string)
+	if uuid == "" {
+		uuid = fc.newUUID(objType)
+	}
+	data["uuid"] = uuid
+	data["url"] = fc.objectURL(objType, uuid)
+	data["tenant_ref"] = fc.objectURL("tenant", tenant)
+	obj := &fakeObject{data: data, tenant: tenant, shared: shared}
+	if fc.objects[objType] == nil {
+		fc.objects[objType] = map[string]*fakeObject{}
+	}
+	fc.objects[objType][uuid] = obj
+	fc.order[objType] = append(fc.order[objType], uuid)
+	return obj
+}
+
+func (fc *fakeController) removeObject(objType, uuid string) {
+	delete(fc.objects[objType], uuid)
+	order := fc.order[objType][:0]
+	for _, u := range fc.order[objType] {
+		if u != uuid {
+			order = append(order, u)
+		}
+	}
+	fc.order[objType] = order
+}
+
+func (fc *fakeController) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	fc.mu.Lock()
+	defer fc.mu.Unlock()
+	fc.requests = append(fc.requests, r.Method+" "+r.URL.Path)
+
+	path := strings.Trim(r.URL.Path, "/")
+	switch path {
+	case "":
+		http.SetCookie(w, &http.Cookie{Name: "csrftoken", Value: fc.newUUID("csrf")})
+		fc.writeJSON(w, http.StatusOK, map[string]interface{}{})
+		return
+	case "login":
+		fc.login(w, r)
+		return
+	case "logout":
+		for _, name := range []string{"sessionid", "avi-sessionid"} {
+			if c, err := r.Cookie(name); err == nil {
+				delete(fc.sessions, c.Value)
+			}
+		}
+		fc.writeJSON(w, http.StatusOK, map[string]interface{}{})
+		return
+	case "api/initial-data":
+		fc.writeJSON(w, http.StatusOK, map[string]interface{}{
+			"version": map[string]interface{}{"Version": fakeControllerVersion},
+		})
+		return
+	}
+	if !fc.authenticated(r) {
+		fc.writeError(w, http.StatusUnauthorized, "Authentication credentials were not provided.")
+		return
+	}
+	if !strings.HasPrefix(path, "api/") {
+		fc.writeError(w, http.StatusNotFound, "Not found.")
+		return
+	}
+	if fc.failCount > 0 {
+		fc.failCount--
+		if fc.failRetryAfter != "" {
+			w.Header().Set("Retry-After", fc.failRetryAfter)
+		}
+		fc.writeError(w, fc.failStatus, http.StatusText(fc.failStatus))
+		return
+	}
+	tenant, err := fc.requestTenant(r)
+	if err != nil {
+		fc.writeError(w, http.StatusNotFound, err.Error())
+		return
+	}
+	parts := strings.Split(strings.TrimPrefix(path, "api/"), "/")
+	switch {
+	case len(parts) == 2 && parts[0] == "cluster" && parts[1] == "runtime":
+		fc.writeJSON(w, http.StatusOK, map[string]interface{}{
+			"cluster_state": map[string]interface{}{"state": "CLUSTER_UP_HA_ACTIVE", "progress": 100},
+		})
+	case parts[0] == "cloud-inventory":
+		fc.cloudInventory(w, r, tenant)
+	case parts[0] == "useraccount":
+		fc.userAccount(w, r)
+	case len(parts) == 1:
+		fc.collection(w, r, parts[0], tenant)
+	case len(parts) == 2:
+		fc.object(w, r, parts[0], parts[1], tenant)
+	default:
+		fc.writeError(w, http.StatusNotFound, "Not found.")
+	}
+}
+
+func (fc *fakeController) login(w http.ResponseWriter, r *http.Request) {
+	var cred map[string]string
+	if err := json.NewDecoder(r.Body).Decode(&cred); err != nil {
+		fc.writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if cred["username"] != fakeControllerUsername ||
+		(cred["password"] != fc.password && cred["token"] != fakeControllerAuthToken) {
+		fc.writeError(w, http.StatusUnauthorized, "Invalid credentials")
+		return
+	}
+	sessionID := fc.newUUID("session")
+	fc.sessions[sessionID] = true
+	for _, name := range []string{"sessionid", "avi-sessionid"} {
+		http.SetCookie(w, &http.Cookie{Name: name, Value: sessionID})
+	}
+	http.SetCookie(w, &http.Cookie{Name: "csrftoken", Value: fc.newUUID("csrf")})
+	fc.writeJSON(w, http.StatusOK, map[string]interface{}{
+		"user":    map[string]interface{}{"username": fakeControllerUsername},
+		"version": map[string]interface{}{"Version": fakeControllerVersion},
+	})
+}
+
+func (fc *fakeController) authenticated(r *http.Request) bool {
+	for _, name := range []string{"sessionid", "avi-sessionid"} {
+		if c, err := r.Cookie(name); err == nil && fc.sessions[c.Value] {
+			return true
+		}
+	}
+	return false
+}
+
+// requestTenant resolves the X-Avi-Tenant (or X-Avi-Tenant-UUID) header to a
+// tenant uuid. "*" selects all tenants.
+func (fc *fakeController) requestTenant(r *http.Request) (string, error) {
+	if uuid := r.Header.Get("X-Avi-Tenant-UUID"); uuid != "" {
+		if _, ok := fc.objects["tenant"][uuid]; !ok {
+			return "", fmt.Errorf("Tenant %s not found", uuid)
+		}
+		return uuid, nil
+	}
+	name := r.Header.Get("X-Avi-Tenant")
+	if name == "" {
+		name = fakeControllerTenant
+	}
+	if name == "*" {
+		return name, nil
+	}
+	for uuid, t := range fc.objects["tenant"] {
+		if t.data["name"] == name {
+			return uuid, nil
+		}
+	}
+	return "", fmt.Errorf("Tenant %s not found", name)
+}
+
+func (fc *fakeController) visible(obj *fakeObject, tenant string) bool {
+	return tenant == "*" || obj.shared || obj.tenant == tenant
+}
+
+func (fc *fakeController) collection(w http.ResponseWriter, r *http.Request, objType, tenant string) {
+	query := r.URL.Query()
+	switch r.Method {
+	case http.MethodGet:
+		if IsPostNotAllowed(objType) && query.Get("name") == "" {
+			if obj := fc.singleton(objType); obj != nil {
+				fc.writeJSON(w, http.StatusOK, obj.data)
+			} else {
+				fc.writeError(w, http.StatusNotFound, "Object not found!")
+			}
+			return
+		}
+		results := []interface{}{}
+		for _, uuid := range fc.order[objType] {
+			obj := fc.objects[objType][uuid]
+			if fc.visible(obj, tenant) && fc.matches(obj, query) {
+				results = append(results, obj.data)
+			}
+		}
+		fc.writeJSON(w, http.StatusOK, map[string]interface{}{"count": len(results), "results": results})
+	case http.MethodPost:
+		if IsPostNotAllowed(objType) {
+			fc.writeError(w, http.StatusMethodNotAllowed, "Method \"POST\" not allowed.")
+			return
+		}
+		data, ok := fc.readPayload(w, r)
+		if !ok {
+			return
+		}
+		delete(data, "uuid")
+		delete(data, "url")
+		objTenant := fc.payloadTenant(data, tenant)
+		if fc.nameConflict(objType, "", objTenant, data) {
+			fc.writeError(w, http.StatusConflict, objType+" object with this Name already exists.")
+			return
+		}
+		obj := fc.addObject(objType, data, objTenant, false)
+		if fc.postDelay > 0 {
+			fc.mu.Unlock()
+			time.Sleep(fc.postDelay)
+			fc.mu.Lock()
+		}
+		fc.writeJSON(w, http.StatusCreated, obj.data)
+	case http.MethodPut:
+		obj := fc.singleton(objType)
+		if !IsPostNotAllowed(objType) || obj == nil {
+			fc.writeError(w, http.StatusMethodNotAllowed, "Method \"PUT\" not allowed.")
+			return
+		}
+		fc.replace(w, r, obj)
+	default:
+		fc.writeError(w, http.StatusMethodNotAllowed, "Method \""+r.Method+"\" not allowed.")
+	}
+}
+
+func (fc *fakeController) object(w http.ResponseWriter, r *http.Request, objType, uuid, tenant string) {
+	obj, ok := fc.objects[objType][uuid]
+	if !ok || !fc.visible(obj, tenant) {
+		fc.writeError(w, http.StatusNotFound, "Object not found!")
+		return
+	}
+	switch r.Method {
+	case http.MethodGet:
+		fc.writeJSON(w, http.StatusOK, obj.data)
+	case http.MethodPut:
+		fc.replace(w, r, obj)
+	case http.MethodPatch:
+		var patch map[string]map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&patch); err != nil {
+			fc.writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		target := obj.data
+		if fc.patchDelay > 0 {
+			target = fakeCopyObject(obj.data)
+			fc.mu.Unlock()
+			time.Sleep(fc.patchDelay)
+			fc.mu.Lock()
+		}
+		for op, data := range patch {
+			fc.resolveRefs(data)
+			switch op {
+			case "add":
+				fc.patchAdd(target, data)
+			case "replace":
+				for k, v := range data {
+					target[k] = v
+				}
+			case "delete":
+				fc.patchDelete(target, data)
+			default:
+				fc.writeError(w, http.StatusBadRequest, "Unknown patch operation "+op)
+				return
+			}
+		}
+		obj.data = target
+		fc.writeJSON(w, http.StatusOK, obj.data)
+	case http.MethodDelete:
+		fc.removeObject(objType, uuid)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		fc.writeError(w, http.StatusMethodNotAllowed, "Method \""+r.Method+"\" not allowed.")
+	}
+}
+
+func (fc *fakeController) replace(w http.ResponseWriter, r *http.Request, obj *fakeObject) {
+	data, ok := fc.readPayload(w, r)
+	if !ok {
+		return
+	}
+	objType := strings.Split(strings.TrimPrefix(obj.data["url"].(string), fc.URL+"/api/"), "/")[0]
+	uuid := obj.data["uuid"].(string)
+	objTenant := fc.payloadTenant(data, obj.tenant)
+	if fc.nameConflict(objType, uuid, objTenant, data) {
+		fc.writeError(w, http.StatusConflict, objType+" object with this Name already exists.")
+		return
+	}
+	data["uuid"] = uuid
+	data["url"] = obj.data["url"]
+	obj.data = data
+	obj.tenant = objTenant
+	fc.writeJSON(w, http.StatusOK, obj.data)
+}
+
+func (fc *fakeController) singleton(objType string) *fakeObject {
+	if order := fc.order[objType]; len(order) > 0 {
+		return fc.objects[objType][order[0]]
+	}
+	return nil
+}
+
+func (fc *fakeController) matches(obj *fakeObject, query url.Values) bool {
+	if name := query.Get("name"); name != "" && obj.data["name"] != name {
+		return false
+	}
+	if uuid := query.Get("uuid"); uuid != "" && obj.data["uuid"] != uuid {
+		return false
+	}
+	for key := range query {
+		if !strings.HasSuffix(key, "_ref.uuid") {
+			continue
+		}
+		ref, _ := obj.data[strings.TrimSuffix(key, ".uuid")].(string)
+		if UUIDFromID(ref) != query.Get(key) {
+			return false
+		}
+	}
+	return true
+}
+
+// nameConflict reports whether another object of objType already uses the
+// payload name in the same tenant and cloud.
+func (fc *fakeController) nameConflict(objType, uuid, tenant string, data map[string]interface{}) bool {
+	name, ok := data["name"]
+	if !ok {
+		return false
+	}
+	for u, obj := range fc.objects[objType] {
+		if u == uuid || obj.tenant != tenant || obj.data["name"] != name {
+			continue
+		}
+		if data["cloud_ref"] == nil || obj.data["cloud_ref"] == nil ||
+			UUIDFromID(data["cloud_ref"].(string)) == UUIDFromID(obj.data["cloud_ref"].(string)) {
+			return true
+		}
+	}
+	return false
+}
+
+// payloadTenant returns the tenant uuid an object written with data belongs
+// to and normalises its tenant_ref.
+func (fc *fakeController) payloadTenant(data map[string]interface{}, tenant string) string {
+	if ref, ok := data["tenant_ref"].(string); ok && ref != "" {
+		if uuid := UUIDFromID(ref); fc.objects["tenant"][uuid] != nil {
+			tenant = uuid
+		}
+	}
+	if tenant == "*" {
+		tenant = fakeControllerTenant
+	}
+	data["tenant_ref"] = fc.objectURL("tenant", tenant)
+	return tenant
+}
+
+func (fc *fakeController) readPayload(w http.ResponseWriter, r *http.Request) (map[string]interface{}, bool) {
+	var data map[string]interface{}
+	if err := json.NewDecoder(r.Body).Decode(&data); err != nil {
+		fc.writeError(w, http.StatusBadRequest, err.Error())
+		return nil, false
+	}
+	if data == nil {
+		data = map[string]interface{}{}
+	}
+	fc.resolveRefs(data)
+	return data, true
+}
+
+// resolveRefs rewrites "/api/<objtype>?name=<name>" references into object
+// URLs, the way the controller does on write.
+func (fc *fakeController) resolveRefs(v interface{}) {
+	switch val := v.(type) {
+	case map[string]interface{}:
+		for k, item := range val {
+			switch ref := item.(type) {
+			case string:
+				if strings.HasSuffix(k, "_ref") {
+					val[k] = fc.resolveRef(ref)
+				}
+			case []interface{}:
+				for i, elem := range ref {
+					if s, ok := elem.(string); ok && strings.HasSuffix(k, "_refs") {
+						ref[i] = fc.resolveRef(s)
+					} else {
+						fc.resolveRefs(elem)
+					}
+				}
+			default:
+				fc.resolveRefs(item)
+			}
+		}
+	case []interface{}:
+		for _, item := range val {
+			fc.resolveRefs(item)
+		}
+	}
+}
+
+func (fc *fakeController) resolveRef(ref string) string {
+	idx := strings.Index(ref, "api/")
+	if idx < 0 || !strings.Contains(ref, "?name=") {
+		return ref
+	}
+	u, err := url.Parse(ref[idx:])
+	if err != nil {
+		return ref
+	}
+	objType := strings.Trim(strings.TrimPrefix(u.Path, "api/"), "/")
+	name := u.Query().Get("name")
+	for _, uuid := range fc.order[objType] {
+		if obj := fc.objects[objType][uuid]; obj.data["name"] == name {
+			return obj.data["url"].(string)
+		}
+	}
+	return ref
+}
+
+// fakeCopyObject returns a copy of data whose lists can be changed without changing data.
+func fakeCopyObject(data map[string]interface{}) map[string]interface{} {
+	c := make(map[string]interface{}, len(data))
+	for k, v := range data {
+		if list, ok := v.([]interface{}); ok {
+			v = append([]interface{}(nil), list...)
+		}
+		c[k] = v
+	}
+	return c
+}
+
+// patchAdd appends list elements and sets scalars, as PATCH "add" does.
+func (fc *fakeController) patchAdd(dst, src map[string]interface{}) {
+	for k, v := range src {
+		if list, ok := v.([]interface{}); ok {
+			existing, _ := dst[k].([]interface{})
+			dst[k] = append(existing, list...)
+		} else {
+			dst[k] = v
+		}
+	}
+}
+
+// patchDelete removes the matching list elements, as PATCH "delete" does.
+func (fc *fakeController) patchDelete(dst, src map[string]interface{}) {
+	for k, v := range src {
+		list, ok := v.([]interface{})
+		existing, isList := dst[k].([]interface{})
+		if !ok || !isList {
+			delete(dst, k)
+			continue
+		}
+		kept := []interface{}{}
+		for _, elem := range existing {
+			matched := false
+			for _, del := range list {
+				if fakeElementMatches(elem, del) {
+					matched = true
+					break
+				}
+			}
+			if !matched {
+				kept = append(kept, elem)
+			}
+		}
+		dst[k] = kept
+	}
+}
+
+// fakeElementMatches compares two list elements on the keys they share, so
+// a delete payload carrying extra defaulted fields still finds its element.
+func fakeElementMatches(elem, del interface{}) bool {
+	em, ok1 := elem.(map[string]interface{})
+	dm, ok2 := del.(map[string]interface{})
+	if !ok1 || !ok2 {
+		return reflect.DeepEqual(elem, del)
+	}
+	for k, v := range dm {
+		if ev, ok := em[k]; ok && !fakeElementMatches(ev, v) {
+			return false
+		}
+	}
+	return true
+}
+
+func (fc *fakeController) cloudInventory(w http.ResponseWriter, r *http.Request, tenant string) {
+	results := []interface{}{}
+	uuid := r.URL.Query().Get("uuid")
+	if obj, ok := fc.objects["cloud"][uuid]; ok && fc.visible(obj, tenant) {
+		results = append(results, map[string]interface{}{
+			"config": obj.data,
+			"status": map[string]interface{}{"state": "CLOUD_STATE_PLACEMENT_READY"},
+		})
+	}
+	fc.writeJSON(w, http.StatusOK, map[string]interface{}{"count": len(results), "results": results})
+}
+
+func (fc *fakeController) userAccount(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		fc.writeJSON(w, http.StatusOK, fc.account)
+	case http.MethodPut:
+		data, ok := fc.readPayload(w, r)
+		if !ok {
+			return
+		}
+		if password, ok := data["password"].(string); ok && password != "" {
+			if data["old_password"] != fc.password {
+				fc.writeError(w, http.StatusBadRequest, "Old password is incorrect")
+				return
+			}
+			fc.password = password
+		}
+		for _, k := range []string{"name", "full_name", "email", "local"} {
+			if v, ok := data[k]; ok {
+				fc.account[k] = v
+			}
+		}
+		fc.writeJSON(w, http.StatusOK, fc.account)
+	default:
+		fc.writeError(w, http.StatusMethodNotAllowed, "Method \""+r.Method+"\" not allowed.")
+	}
+}
+
+func (fc *fakeController) writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func (fc *fakeController) writeError(w http.ResponseWriter, status int, msg string) {
+	fc.writeJSON(w, status, map[string]interface{}{"error": msg})
+}
+
+func newFakeControllerClient(t *testing.T, fc *fakeController) *ProviderClient {
+	config := Credentials{
+		Username:   fakeControllerUsername,
+		Password:   fakeControllerPassword,
+		Controller: fc.Host(),
+		Tenant:     fakeControllerTenant,
+		Version:    fakeControllerVersion,
+		CABundle:   fc.CABundle(),
+	}
+	throttle := &retryThrottle{}
+	options, err := aviSessionOptions(config, throttle)
+	if err != nil {
+		t.Fatalf("failed to create session options for fake controller: %v", err)
+	}
+	client, err := clients.NewAviClient(fc.Host(), fakeControllerUsername,
+		append(options, session.SetLazyAuthentication(false))...)
+	if err != nil {
+		t.Fatalf("failed to create client for fake controller: %v", err)
+	}
+	return &ProviderClient{
+		AviClient:    client,
+		Credentials:  config,
+		AdoptionMode: adoptionModeAdopt,
+		throttle:     throttle,
+	}
+}
+
+func TestFakeController(t *testing.T) {
+	fc := newFakeController()
+	defer fc.Close()
+	client := newFakeControllerClient(t, fc)
+
+	var pool map[string]interface{}
+	payload := map[string]interface{}{
+		"name":       "fake-pool",
+		"tenant_ref": "/api/tenant/?name=admin",
+		"servers":    []interface{}{map[string]interface{}{"ip": map[string]interface{}{"addr": "10.0.0.1", "type": "V4"}, "port": 80}},
+	}
+	if err := client.AviSession.Post("api/pool", payload, &pool); err != nil {
+		t.Fatalf("POST failed: %v", err)
+	}
+	uuid := pool["uuid"].(string)
+	if pool["tenant_ref"] != fc.objectURL("tenant", fakeControllerTenant) {
+		t.Errorf("tenant_ref was not resolved: %v", pool["tenant_ref"])
+	}
+	if err := client.AviSession.Post("api/pool", payload, nil); err == nil || !strings.Contains(err.Error(), "409") {
+		t.Errorf("expected 409 for duplicate name, got %v", err)
+	}
+
+	var byName interface{}
+	if err := client.AviSession.GetObject("pool", session.SetName("fake-pool"), session.SetResult(&byName),
+		session.SetSkipDefault(true)); err != nil {
+		t.Fatalf("GET by name failed: %v", err)
+	}
+	if byName.(map[string]interface{})["uuid"] != uuid {
+		t.Errorf("GET by name returned %v", byName)
+	}
+
+	server := map[string]interface{}{"ip": map[string]interface{}{"addr": "10.0.0.2", "type": "V4"}, "port": 80}
+	patch := map[string]interface{}{"servers": []interface{}{server}}
+	if err := client.AviSession.Patch("api/pool/"+uuid, patch, "add", nil); err != nil {
+		t.Fatalf("PATCH add failed: %v", err)
+	}
+	var obj map[string]interface{}
+	if err := client.AviSession.Get("api/pool/"+uuid+"?skip_default=true", &obj); err != nil {
+		t.Fatalf("GET failed: %v", err)
+	}
+	if n := len(obj["servers"].([]interface{})); n != 2 {
+		t.Errorf("expected 2 servers after PATCH add, got %d", n)
+	}
+	if err := client.AviSession.Patch("api/pool/"+uuid, patch, "delete", nil); err != nil {
+		t.Fatalf("PATCH delete failed: %v", err)
+	}
+	if err := client.AviSession.Patch("api/pool/"+uuid, map[string]interface{}{"description": "patched"},
+		"replace", &obj); err != nil {
+		t.Fatalf("PATCH replace failed: %v", err)
+	}
+	if n := len(obj["servers"].([]interface{})); n != 1 || obj["description"] != "patched" {
+		t.Errorf("unexpected pool after PATCH delete/replace: %v", obj)
+	}
+
+	payload["name"] = "fake-pool-renamed"
+	if err := client.AviSession.Put("api/pool/"+uuid, payload, &obj); err != nil {
+		t.Fatalf("PUT failed: %v", err)
+	}
+	if obj["name"] != "fake-pool-renamed" || obj["uuid"] != uuid {
+		t.Errorf("unexpected pool after PUT: %v", obj)
+	}
+
+	var cluster map[string]interface{}
+	if err := client.AviSession.Put("api/cluster", map[string]interface{}{"name": "cluster-0-2"}, &cluster); err != nil {
+		t.Fatalf("PUT on singleton failed: %v", err)
+	}
+	if cluster["name"] != "cluster-0-2" {
+		t.Errorf("unexpected cluster after PUT: %v", cluster)
+	}
+
+	if err := client.AviSession.Delete("api/pool/" + uuid); err != nil {
+		t.Fatalf("DELETE failed: %v", err)
+	}
+	if err := client.AviSession.Get("api/pool/"+uuid, &obj); err == nil || !strings.Contains(err.Error(), "404") {
+		t.Errorf("expected 404 after DELETE, got %v", err)
+	}
+}
@@ -0,0 +1,1353 @@
+// Copyright 2022 VMware, Inc.
+// SPDX-License-Identifier: Mozilla Public License 2.0
+
+package avi
+
+// aviFieldVersions maps an object type to the controller version that introduced each of its
+// top level fields, as listed in the resource documentation.
+var aviFieldVersions = map[string]map[string]string{
+	"actiongroupconfig": {
+		"configpb_attributes": "21.1.1",
+	},
+	"albservicesconfig": {
+		"app_signature_config":      "20.1.4",
+		"asset_contact":             "20.1.1",
+		"case_config":               "21.1.1",
+		"configpb_attributes":       "21.1.1",
+		"feature_opt_in_status":     "20.1.1",
+		"ip_reputation_config":      "20.1.1",
+		"mode":                      "20.1.2",
+		"polling_interval":          "18.2.6",
+		"portal_url":                "18.2.6",
+		"saas_licensing_config":     "21.1.3",
+		"split_proxy_configuration": "20.1.1",
+		"use_split_proxy":           "20.1.1",
+		"use_tls":                   "20.1.3",
+		"user_agent_db_config":      "21.1.1",
+		"uuid":                      "18.2.6",
+		"waf_config":                "21.1.1",
+	},
+	"albservicesfileupload": {
+		"case_id":      "18.2.6",
+		"error":        "18.2.6",
+		"file_path":    "18.2.6",
+		"name":         "18.2.6",
+		"s3_directory": "18.2.6",
+		"status":       "18.2.6",
+		"tenant_ref":   "18.2.6",
+	},
+	"albservicesjob": {
+		"command":             "21.1.3",
+		"configpb_attributes": "21.1.3",
+		"end_time":            "21.1.3",
+		"name":                "21.1.3",
+		"params":              "22.1.1",
+		"pulse_job_id":        "21.1.3",
+		"pulse_sync_status":   "22.1.1",
+		"result":              "22.1.1",
+		"start_time":          "21.1.3",
+		"status":              "21.1.3",
+		"tenant_ref":          "21.1.3",
+		"token":               "22.1.1",
+		"uuid":                "21.1.3",
+	},
+	"alertconfig": {
+		"configpb_attributes": "21.1.1",
+	},
+	"alertemailconfig": {
+		"configpb_attributes": "21.1.1",
+	},
+	"alertscriptconfig": {
+		"configpb_attributes": "21.1.1",
+	},
+	"alertsyslogconfig": {
+		"configpb_attributes": "21.1.1",
+	},
+	"analyticsprofile": {
+		"client_log_streaming_config":                    "17.1.1",
+		"configpb_attributes":                            "21.1.1",
+		"enable_adaptive_config":                         "20.1.1",
+		"enable_advanced_analytics":                      "17.2.13",
+		"enable_ondemand_metrics":                        "20.1.3",
+		"enable_se_analytics":                            "20.1.3",
+		"enable_server_analytics":                        "20.1.3",
+		"enable_vs_analytics":                            "20.1.3",
+		"exclude_dns_policy_drop_as_significant":         "17.2.2",
+		"exclude_issuer_revoked_ocsp_responses_as_error": "20.1.1",
+		"exclude_revoked_ocsp_responses_as_error":        "20.1.1",
+		"exclude_sip_error_codes":                        "17.2.13",
+		"exclude_stale_ocsp_responses_as_error":          "20.1.1",
+		"exclude_unavailable_ocsp_responses_as_error":    "20.1.1",
+		"healthscore_max_server_limit":                   "17.2.13",
+		"hs_security_ocsp_revoked_score":                 "20.1.1",
+		"hs_security_tls13_score":                        "18.2.6",
+		"latency_audit_props":                            "21.1.1",
+		"markers":                                        "20.1.5",
+		"ondemand_metrics_idle_timeout":                  "18.1.1",
+		"sensitive_log_profile":                          "17.2.10",
+		"sip_log_depth":                                  "17.2.13",
+		"time_tracker_props":                             "22.1.1",
+	},
+	"applicationpersistenceprofile": {
+		"configpb_attributes": "21.1.1",
+		"is_federated":        "17.1.3",
+		"markers":             "20.1.5",
+	},
+	"applicationprofile": {
+		"app_service_type":      "21.1.3",
+		"cloud_config_cksum":    "17.2.14",
+		"configpb_attributes":   "21.1.1",
+		"created_by":            "17.2.14",
+		"l4_ssl_profile":        "22.1.2",
+		"markers":               "20.1.5",
+		"preserve_client_port":  "17.2.7",
+		"preserve_dest_ip_port": "20.1.1",
+		"sip_service_profile":   "17.2.8",
+	},
+	"authmappingprofile": {
+		"configpb_attributes": "22.1.1",
+		"description":         "22.1.1",
+		"mapping_rules":       "22.1.1",
+		"name":                "22.1.1",
+		"tenant_ref":          "22.1.1",
+		"type":                "22.1.1",
+		"uuid":                "22.1.1",
+	},
+	"authprofile": {
+		"configpb_attributes": "21.1.1",
+		"jwt_profile_ref":     "20.1.3",
+		"markers":             "20.1.6",
+		"oauth_profile":       "21.1.3",
+		"pa_agent_ref":        "18.2.3",
+		"saml":                "17.2.3",
+	},
+	"autoscalelaunchconfig": {
+		"configpb_attributes": "21.1.1",
+		"markers":             "20.1.5",
+		"use_external_asg":    "17.2.3",
+	},
+	"availabilityzone": {
+		"cloud_ref":           "20.1.1",
+		"configpb_attributes": "21.1.1",
+		"name":                "20.1.1",
+		"tenant_ref":          "20.1.1",
+		"uuid":                "20.1.1",
+		"vcenter_refs":        "20.1.1",
+	},
+	"backupconfiguration": {
+		"aws_access_key":                "18.2.3",
+		"aws_bucket_id":                 "18.2.3",
+		"aws_bucket_region":             "21.1.5",
+		"aws_secret_access":             "18.2.3",
+		"backup_file_prefix":            "17.1.1",
+		"configpb_attributes":           "21.1.1",
+		"remote_file_transfer_protocol": "22.1.1",
+		"upload_to_s3":                  "18.2.3",
+	},
+	"botconfigconsolidator": {
+		"description": "21.1.1",
+		"name":        "21.1.1",
+		"script":      "21.1.1",
+		"tenant_ref":  "21.1.1",
+		"uuid":        "21.1.1",
+	},
+	"botdetectionpolicy": {
+		"allow_list":              "21.1.1",
+		"description":             "21.1.1",
+		"ip_location_detector":    "21.1.1",
+		"ip_reputation_detector":  "21.1.1",
+		"name":                    "21.1.1",
+		"system_bot_mapping_ref":  "21.1.1",
+		"system_consolidator_ref": "21.1.1",
+		"tenant_ref":              "21.1.1",
+		"user_agent_detector":     "21.1.1",
+		"user_bot_mapping_ref":    "21.1.1",
+		"user_consolidator_ref":   "21.1.1",
+		"uuid":                    "21.1.1",
+	},
+	"botipreputationtypemapping": {
+		"ip_reputation_mappings": "21.1.1",
+		"name":                   "21.1.1",
+		"tenant_ref":             "21.1.1",
+		"uuid":                   "21.1.1",
+	},
+	"botmapping": {
+		"mapping_rules": "21.1.1",
+		"name":          "21.1.1",
+		"tenant_ref":    "21.1.1",
+		"uuid":          "21.1.1",
+	},
+	"certificatemanagementprofile": {
+		"configpb_attributes": "21.1.1",
+		"markers":             "20.1.6",
+		"run_script_ref":      "20.1.3",
+	},
+	"cloud": {
+		"autoscale_polling_interval":   "18.2.2",
+		"azure_configuration":          "17.2.1",
+		"configpb_attributes":          "21.1.1",
+		"custom_tags":                  "17.1.5",
+		"dns_resolution_on_se":         "18.2.6",
+		"dns_resolvers":                "20.1.5",
+		"enable_vip_on_all_interfaces": "18.2.9",
+		"gcp_configuration":            "18.2.1",
+		"ip6_autocfg_enabled":          "18.1.1",
+		"license_tier":                 "17.2.5",
+		"maintenance_mode":             "20.1.7",
+		"markers":                      "22.1.1",
+		"metrics_polling_interval":     "22.1.1",
+		"nsxt_configuration":           "20.1.1",
+		"ntp_configuration":            "22.1.1",
+		"se_group_template_ref":        "18.2.5",
+		"state_based_dns_registration": "17.1.12",
+		"vmc_deployment":               "20.1.5",
+	},
+	"cloudconnectoruser": {
+		"azure_serviceprincipal": "17.2.1",
+		"azure_userpass":         "17.2.1",
+		"configpb_attributes":    "21.1.1",
+		"gcp_credentials":        "18.2.1",
+		"nsxt_credentials":       "20.1.1",
+		"oci_credentials":        "18.2.1",
+		"tencent_credentials":    "18.2.3",
+		"vcenter_credentials":    "20.1.1",
+	},
+	"cloudproperties": {
+		"configpb_attributes": "21.1.1",
+	},
+	"clusterclouddetails": {
+		"azure_info":          "17.2.5",
+		"configpb_attributes": "21.1.1",
+		"name":                "17.2.5",
+		"tenant_ref":          "17.2.5",
+		"uuid":                "17.2.5",
+	},
+	"controllerportalregistration": {
+		"asset":       "18.2.6",
+		"name":        "18.2.6",
+		"portal_auth": "18.2.6",
+		"tenant_ref":  "18.2.6",
+		"uuid":        "18.2.6",
+	},
+	"controllerproperties": {
+		"allow_admin_network_updates":                "18.2.7",
+		"allow_ip_forwarding":                        "17.1.1",
+		"api_perf_logging_threshold":                 "18.1.4",
+		"appviewx_compat_mode":                       "17.1.1",
+		"async_patch_merge_period":                   "18.2.11",
+		"async_patch_request_cleanup_duration":       "18.2.11",
+		"bm_use_ansible":                             "17.2.2",
+		"check_vsvip_fqdn_syntax":                    "20.1.6",
+		"cleanup_expired_authtoken_timeout_period":   "18.1.1",
+		"cleanup_sessions_timeout_period":            "18.1.1",
+		"cloud_reconcile":                            "17.2.14",
+		"configpb_attributes":                        "21.1.1",
+		"consistency_check_timeout_period":           "18.1.1",
+		"controller_resource_info_collection_period": "20.1.3",
+		"default_minimum_api_timeout":                "18.2.6",
+		"del_offline_se_after_reboot_delay":          "20.1.5",
+		"detach_ip_retry_interval":                   "21.1.3",
+		"detach_ip_retry_limit":                      "21.1.3",
+		"detach_ip_timeout":                          "21.1.3",
+		"edit_system_limits":                         "20.1.1",
+		"enable_api_sharding":                        "18.1.5",
+		"enable_memory_balancer":                     "17.2.8",
+		"enable_per_process_stop":                    "21.1.1",
+		"enable_resmgr_log_cache_print":              "20.1.6",
+		"false_positive_learning_config":             "22.1.1",
+		"federated_datastore_cleanup_duration":       "20.1.1",
+		"file_object_cleanup_period":                 "20.1.1",
+		"max_se_spawn_interval_delay":                "20.1.1",
+		"max_seq_attach_ip_failures":                 "17.2.2",
+		"max_threads_cc_vip_bg_worker":               "20.1.3",
+		"permission_scoped_shared_admin_networks":    "18.2.7",
+		"portal_request_burst_limit":                 "20.1.1",
+		"portal_request_rate_limit":                  "20.1.1",
+		"portal_token":                               "16.4.6",
+		"process_locked_useraccounts_timeout_period": "18.1.1",
+		"process_pki_profile_timeout_period":         "18.1.1",
+		"resmgr_log_caching_period":                  "20.1.5",
+		"restrict_cloud_read_access":                 "22.1.1",
+		"safenet_hsm_version":                        "16.5.2",
+		"se_from_marketplace":                        "18.1.4",
+		"se_spawn_retry_interval":                    "20.1.1",
+		"se_upgrade_flow_cleanup_timeout":            "22.1.1",
+		"se_vnic_gc_wait_time":                       "20.1.4",
+		"seupgrade_copy_pool_size":                   "18.2.6",
+		"shared_ssl_certificates":                    "18.2.5",
+		"update_dns_entry_retry_limit":               "21.1.4",
+		"update_dns_entry_timeout":                   "21.1.4",
+		"upgrade_dns_ttl":                            "17.1.1",
+		"upgrade_fat_se_lease_time":                  "18.2.10",
+		"upgrade_se_per_vs_scale_ops_txn_time":       "18.2.10",
+		"user_agent_cache_config":                    "21.1.1",
+		"vs_scaleout_ready_check_interval":           "18.2.2",
+		"vs_se_attach_ip_fail":                       "17.2.2",
+		"vsphere_ha_detection_timeout":               "20.1.7",
+		"vsphere_ha_recovery_timeout":                "20.1.7",
+		"vsphere_ha_timer_interval":                  "20.1.7",
+		"warmstart_vs_resync_wait_time":              "18.1.4",
+	},
+	"controllersite": {
+		"address":             "18.2.5",
+		"configpb_attributes": "21.1.1",
+		"name":                "18.2.5",
+		"port":                "18.2.5",
+		"tenant_ref":          "18.2.5",
+		"uuid":                "18.2.5",
+	},
+	"customipamdnsprofile": {
+		"configpb_attributes": "21.1.1",
+		"name":                "17.1.1",
+		"script_params":       "17.1.1",
+		"script_uri":          "17.1.1",
+		"tenant_ref":          "17.1.1",
+		"uuid":                "17.1.1",
+	},
+	"dnspolicy": {
+		"configpb_attributes": "21.1.1",
+		"created_by":          "17.1.1",
+		"description":         "17.1.1",
+		"internal":            "21.1.1",
+		"markers":             "20.1.5",
+		"name":                "17.1.1",
+		"rule":                "17.1.1",
+		"tenant_ref":          "17.1.1",
+		"uuid":                "17.1.1",
+	},
+	"dynamicdnsrecord": {
+		"algorithm":               "20.1.3",
+		"cname":                   "20.1.3",
+		"delegated":               "20.1.3",
+		"description":             "20.1.3",
+		"dns_vs_uuid":             "20.1.3",
+		"fqdn":                    "20.1.3",
+		"ip6_address":             "20.1.3",
+		"ip_address":              "20.1.3",
+		"metadata":                "20.1.3",
+		"mx_records":              "20.1.3",
+		"name":                    "20.1.3",
+		"ns":                      "20.1.3",
+		"num_records_in_response": "20.1.3",
+		"service_locators":        "20.1.3",
+		"tenant_ref":              "20.1.3",
+		"ttl":                     "20.1.3",
+		"txt_records":             "20.1.3",
+		"type":                    "20.1.3",
+		"uuid":                    "20.1.3",
+		"wildcard_match":          "20.1.3",
+	},
+	"errorpagebody": {
+		"configpb_attributes": "21.1.1",
+		"error_page_body":     "17.2.4",
+		"format":              "18.2.3",
+		"markers":             "20.1.5",
+		"name":                "17.2.4",
+		"tenant_ref":          "17.2.4",
+		"uuid":                "17.2.4",
+	},
+	"errorpageprofile": {
+		"configpb_attributes": "21.1.1",
+		"error_pages":         "17.2.4",
+		"markers":             "20.1.5",
+		"name":                "17.2.4",
+		"tenant_ref":          "17.2.4",
+		"uuid":                "17.2.4",
+	},
+	"federationcheckpoint": {
+		"configpb_attributes": "21.1.1",
+		"date":                "20.1.1",
+		"description":         "20.1.1",
+		"is_federated":        "20.1.1",
+		"name":                "20.1.1",
+		"tenant_ref":          "20.1.1",
+		"uuid":                "20.1.1",
+	},
+	"fileobject": {
+		"checksum":          "20.1.1",
+		"compressed":        "20.1.1",
+		"created":           "20.1.1",
+		"description":       "20.1.1",
+		"expires_at":        "20.1.1",
+		"is_federated":      "20.1.1",
+		"name":              "20.1.1",
+		"path":              "20.1.1",
+		"read_only":         "20.1.1",
+		"restrict_download": "20.1.1",
+		"size":              "20.1.1",
+		"tenant_ref":        "20.1.1",
+		"type":              "20.1.1",
+		"uuid":              "20.1.1",
+		"version":           "20.1.1",
+	},
+	"geodb": {
+		"description":  "21.1.1",
+		"files":        "21.1.1",
+		"is_federated": "21.1.1",
+		"mappings":     "21.1.1",
+		"name":         "21.1.1",
+		"tenant_ref":   "21.1.1",
+		"uuid":         "21.1.1",
+	},
+	"gslb": {
+		"async_interval":                          "18.2.3",
+		"client_ip_addr_group":                    "17.1.2",
+		"configpb_attributes":                     "21.1.1",
+		"enable_config_by_members":                "20.1.5",
+		"error_resync_interval":                   "18.2.3",
+		"is_federated":                            "17.1.3",
+		"maintenance_mode":                        "17.2.1",
+		"replication_policy":                      "20.1.1",
+		"send_interval_prior_to_maintenance_mode": "18.2.3",
+		"tenant_scoped":                           "18.2.12",
+		"third_party_sites":                       "17.1.1",
+	},
+	"gslbgeodbprofile": {
+		"configpb_attributes": "21.1.1",
+		"description":         "17.1.1",
+		"entries":             "17.1.1",
+		"is_federated":        "17.1.3",
+		"markers":             "20.1.5",
+		"name":                "17.1.1",
+		"tenant_ref":          "17.1.1",
+		"uuid":                "17.1.1",
+	},
+	"gslbservice": {
+		"application_persistence_profile_ref": "17.2.1",
+		"configpb_attributes":                 "21.1.1",
+		"created_by":                          "17.1.2",
+		"hm_off":                              "18.2.2",
+		"is_federated":                        "17.1.3",
+		"markers":                             "20.1.5",
+		"min_members":                         "17.2.4",
+		"pki_profile_ref":                     "22.1.3",
+		"pool_algorithm":                      "17.2.3",
+		"resolve_cname":                       "18.2.5",
+		"site_persistence_enabled":            "17.2.1",
+		"topology_policy_enabled":             "22.1.1",
+		"use_edns_client_subnet":              "17.1.1",
+		"wildcard_match":                      "17.1.1",
+	},
+	"hardwaresecuritymodulegroup": {
+		"configpb_attributes": "21.1.1",
+		"markers":             "20.1.5",
+	},
+	"healthmonitor": {
+		"allow_duplicate_monitors": "18.2.8",
+		"authentication":           "20.1.1",
+		"configpb_attributes":      "21.1.1",
+		"disable_quickstart":       "18.2.7",
+		"ftp_monitor":              "21.1.3",
+		"ftps_monitor":             "21.1.3",
+		"imap_monitor":             "21.1.1",
+		"imaps_monitor":            "21.1.1",
+		"is_federated":             "17.1.3",
+		"ldap_monitor":             "21.1.3",
+		"ldaps_monitor":            "21.1.3",
+		"markers":                  "20.1.5",
+		"pop3_monitor":             "21.1.1",
+		"pop3s_monitor":            "21.1.1",
+		"radius_monitor":           "18.2.3",
+		"sctp_monitor":             "22.1.3",
+		"sip_monitor":              "17.2.8",
+		"smtp_monitor":             "21.1.1",
+		"smtps_monitor":            "21.1.1",
+	},
+	"httppolicyset": {
+		"configpb_attributes":  "21.1.1",
+		"geo_db_ref":           "21.1.1",
+		"ip_reputation_db_ref": "20.1.3",
+		"markers":              "20.1.5",
+	},
+	"icapprofile": {
+		"allow_204":                       "20.1.3",
+		"buffer_size":                     "20.1.1",
+		"buffer_size_exceed_action":       "20.1.1",
+		"cloud_ref":                       "20.1.1",
+		"configpb_attributes":             "21.1.1",
+		"description":                     "20.1.1",
+		"enable_preview":                  "20.1.1",
+		"fail_action":                     "20.1.1",
+		"name":                            "20.1.1",
+		"nsx_defender_config":             "21.1.1",
+		"pool_group_ref":                  "20.1.1",
+		"preview_size":                    "20.1.1",
+		"response_timeout":                "20.1.1",
+		"service_uri":                     "20.1.1",
+		"slow_response_warning_threshold": "20.1.1",
+		"tenant_ref":                      "20.1.1",
+		"uuid":                            "20.1.1",
+		"vendor":                          "20.1.1",
+	},
+	"image": {
+		"cloud_info_values":     "20.1.1",
+		"controller_info":       "18.2.6",
+		"controller_patch_name": "18.2.10",
+		"controller_patch_ref":  "18.2.8",
+		"duration":              "21.1.3",
+		"end_time":              "21.1.3",
+		"events":                "21.1.3",
+		"img_state":             "21.1.3",
+		"migrations":            "18.2.6",
+		"name":                  "18.2.6",
+		"progress":              "21.1.3",
+		"se_info":               "18.2.6",
+		"se_patch_name":         "18.2.10",
+		"se_patch_ref":          "18.2.8",
+		"start_time":            "21.1.3",
+		"tasks_completed":       "21.1.3",
+		"tenant_ref":            "18.2.6",
+		"total_tasks":           "21.1.3",
+		"type":                  "18.2.6",
+		"uber_bundle":           "18.2.8",
+		"uuid":                  "18.2.6",
+	},
+	"inventoryfaultconfig": {
+		"configpb_attributes":   "21.1.1",
+		"controller_faults":     "20.1.6",
+		"name":                  "20.1.6",
+		"serviceengine_faults":  "20.1.6",
+		"tenant_ref":            "20.1.6",
+		"uuid":                  "20.1.6",
+		"virtualservice_faults": "20.1.6",
+	},
+	"ipaddrgroup": {
+		"configpb_attributes": "21.1.1",
+		"markers":             "20.1.5",
+	},
+	"ipamdnsproviderprofile": {
+		"allocate_ip_in_vrf":  "17.2.4",
+		"azure_profile":       "17.2.1",
+		"configpb_attributes": "21.1.1",
+		"custom_profile":      "17.1.1",
+		"markers":             "20.1.5",
+		"oci_profile":         "18.2.1",
+		"proxy_configuration": "17.1.1",
+		"tencent_profile":     "18.2.3",
+	},
+	"ipreputationdb": {
+		"base_file_refs":        "20.1.1",
+		"configpb_attributes":   "21.1.1",
+		"description":           "20.1.1",
+		"incremental_file_refs": "20.1.1",
+		"markers":               "20.1.5",
+		"name":                  "20.1.1",
+		"service_status":        "20.1.1",
+		"tenant_ref":            "20.1.1",
+		"uuid":                  "20.1.1",
+		"vendor":                "20.1.1",
+		"version":               "20.1.1",
+	},
+	"jwtserverprofile": {
+		"configpb_attributes":      "21.1.1",
+		"controller_internal_auth": "20.1.6",
+		"is_federated":             "20.1.6",
+		"issuer":                   "20.1.3",
+		"jwks_keys":                "20.1.3",
+		"jwt_profile_type":         "20.1.6",
+		"name":                     "20.1.3",
+		"tenant_ref":               "20.1.3",
+		"uuid":                     "20.1.3",
+	},
+	"l4policyset": {
+		"configpb_attributes":  "21.1.1",
+		"created_by":           "17.2.7",
+		"description":          "17.2.7",
+		"is_internal_policy":   "17.2.7",
+		"l4_connection_policy": "17.2.7",
+		"markers":              "20.1.5",
+		"name":                 "17.2.7",
+		"tenant_ref":           "17.2.7",
+		"uuid":                 "17.2.7",
+	},
+	"labelgroup": {
+		"configpb_attributes": "21.1.1",
+		"labels":              "20.1.5",
+		"name":                "20.1.5",
+		"uuid":                "20.1.5",
+	},
+	"licenseledgerdetails": {
+		"escrow_infos": "20.1.1",
+		"se_infos":     "20.1.1",
+		"tier_usages":  "20.1.1",
+		"uuid":         "20.1.1",
+	},
+	"licensestatus": {
+		"configpb_attributes": "21.1.3",
+		"saas_status":         "21.1.3",
+		"service_update":      "21.1.4",
+		"uuid":                "21.1.3",
+	},
+	"memorybalancerrequest": {
+		"configpb_attributes": "21.1.1",
+		"controller_info":     "21.1.1",
+		"name":                "21.1.1",
+		"node_uuid":           "21.1.1",
+		"process_info":        "21.1.1",
+		"process_instance":    "21.1.1",
+		"tenant_ref":          "21.1.1",
+		"timestamp":           "21.1.1",
+		"uuid":                "21.1.1",
+	},
+	"microservicegroup": {
+		"configpb_attributes": "21.1.1",
+	},
+	"natpolicy": {
+		"configpb_attributes": "21.1.1",
+		"created_by":          "18.2.3",
+		"description":         "18.2.3",
+		"markers":             "20.1.5",
+		"name":                "18.2.3",
+		"rules":               "18.2.3",
+		"tenant_ref":          "18.2.3",
+		"uuid":                "18.2.3",
+	},
+	"network": {
+		"attrs":               "20.1.1",
+		"configpb_attributes": "21.1.1",
+		"ip6_autocfg_enabled": "18.1.1",
+		"markers":             "20.1.5",
+	},
+	"networkprofile": {
+		"configpb_attributes": "21.1.1",
+		"connection_mirror":   "18.1.3",
+		"markers":             "20.1.5",
+	},
+	"networksecuritypolicy": {
+		"configpb_attributes":  "21.1.1",
+		"geo_db_ref":           "21.1.1",
+		"internal":             "21.1.1",
+		"ip_reputation_db_ref": "20.1.1",
+		"markers":              "20.1.5",
+	},
+	"networkservice": {
+		"cloud_ref":           "18.2.5",
+		"configpb_attributes": "21.1.1",
+		"markers":             "20.1.5",
+		"name":                "18.2.5",
+		"routing_service":     "18.2.5",
+		"se_group_ref":        "18.2.5",
+		"service_type":        "18.2.5",
+		"tenant_ref":          "18.2.5",
+		"uuid":                "18.2.5",
+		"vrf_ref":             "18.2.5",
+	},
+	"nsxtsegmentruntime": {
+		"cloud_ref":          "20.1.1",
+		"dhcp6_ranges":       "20.1.1",
+		"dhcp_enabled":       "20.1.1",
+		"dhcp_ranges":        "20.1.1",
+		"name":               "20.1.1",
+		"nw_name":            "20.1.1",
+		"nw_ref":             "20.1.1",
+		"opaque_network_id":  "20.1.1",
+		"origin_id":          "22.1.2",
+		"security_only_nsxt": "22.1.2",
+		"segment_gw":         "20.1.1",
+		"segment_gw6":        "20.1.1",
+		"segment_id":         "20.1.1",
+		"segname":            "20.1.1",
+		"subnet":             "20.1.1",
+		"subnet6":            "20.1.1",
+		"tenant_ref":         "20.1.1",
+		"tier1_id":           "20.1.1",
+		"uuid":               "20.1.1",
+		"vlan_ids":           "20.1.5",
+		"vrf_context_ref":    "20.1.1",
+	},
+	"pingaccessagent": {
+		"configpb_attributes":  "21.1.1",
+		"description":          "18.2.3",
+		"markers":              "20.1.5",
+		"name":                 "18.2.3",
+		"pingaccess_pool_ref":  "18.2.3",
+		"primary_server":       "18.2.3",
+		"properties_file_data": "18.2.3",
+		"tenant_ref":           "18.2.3",
+		"uuid":                 "18.2.3",
+	},
+	"pkiprofile": {
+		"configpb_attributes": "21.1.1",
+		"is_federated":        "17.1.3",
+		"markers":             "20.1.5",
+	},
+	"poolgroup": {
+		"configpb_attributes":             "21.1.1",
+		"deactivate_primary_pool_on_down": "20.1.7",
+		"enable_http2":                    "20.1.1",
+		"implicit_priority_labels":        "17.1.9",
+		"markers":                         "20.1.5",
+		"service_metadata":                "17.2.14",
+	},
+	"poolgroupdeploymentpolicy": {
+		"configpb_attributes": "21.1.1",
+		"markers":             "20.1.5",
+		"webhook_ref":         "17.1.1",
+	},
+	"prioritylabels": {
+		"configpb_attributes": "21.1.1",
+		"markers":             "20.1.5",
+	},
+	"protocolparser": {
+		"configpb_attributes": "21.1.1",
+		"description":         "18.2.3",
+		"markers":             "20.1.5",
+		"name":                "18.2.3",
+		"parser_code":         "18.2.3",
+		"tenant_ref":          "18.2.3",
+		"uuid":                "18.2.3",
+	},
+	"rmcloudopsproto": {
+		"last_queried_se_creation_limit": "20.1.1",
+		"name":                           "20.1.1",
+		"pending_se_creation_count":      "20.1.1",
+		"pending_vnic_op_count":          "20.1.1",
+		"uuid":                           "20.1.1",
+	},
+	"role": {
+		"allow_unlabelled_access": "20.1.5",
+		"configpb_attributes":     "21.1.1",
+		"filters":                 "20.1.3",
+	},
+	"scheduler": {
+		"configpb_attributes": "21.1.1",
+	},
+	"securitymanagerdata": {
+		"app_learning_info": "20.1.1",
+		"name":              "20.1.1",
+		"tenant_ref":        "20.1.1",
+		"uuid":              "20.1.1",
+	},
+	"securitypolicy": {
+		"configpb_attributes":           "21.1.1",
+		"description":                   "18.2.1",
+		"dns_amplification_denyports":   "21.1.1",
+		"dns_attacks":                   "18.2.1",
+		"dns_policy_index":              "18.2.1",
+		"markers":                       "20.1.5",
+		"name":                          "18.2.1",
+		"network_security_policy_index": "18.2.1",
+		"oper_mode":                     "18.2.1",
+		"tcp_attacks":                   "18.2.1",
+		"tenant_ref":                    "18.2.1",
+		"udp_attacks":                   "18.2.1",
+		"uuid":                          "18.2.1",
+	},
+	"seproperties": {
+		"configpb_attributes": "21.1.1",
+	},
+	"serverautoscalepolicy": {
+		"configpb_attributes":                 "21.1.1",
+		"delay_for_server_garbage_collection": "20.1.3",
+		"markers":                             "20.1.5",
+		"scheduled_scalings":                  "21.1.1",
+	},
+	"serviceenginegroup": {
+		"accelerated_networking":                  "17.2.14",
+		"allow_burst":                             "17.2.5",
+		"app_cache_percent":                       "18.2.3",
+		"app_cache_threshold":                     "20.1.1",
+		"app_learning_memory_percent":             "18.2.3",
+		"archive_shm_limit":                       "17.1.3",
+		"auto_rebalance_capacity_per_se":          "17.2.4",
+		"auto_rebalance_criteria":                 "17.2.3",
+		"availability_zone_refs":                  "20.1.1",
+		"baremetal_dispatcher_handles_flows":      "21.1.3",
+		"bgp_peer_monitor_failover_enabled":       "21.1.3",
+		"bgp_state_update_interval":               "17.2.14",
+		"compress_ip_rules_for_each_ns_subnet":    "18.2.9",
+		"config_debugs_on_all_cores":              "17.2.13",
+		"configpb_attributes":                     "21.1.1",
+		"core_shm_app_cache":                      "18.2.8",
+		"core_shm_app_learning":                   "18.2.8",
+		"custom_securitygroups_data":              "17.1.3",
+		"custom_securitygroups_mgmt":              "17.1.3",
+		"data_network_id":                         "18.2.3",
+		"datascript_timeout":                      "18.2.3",
+		"deactivate_ipv6_discovery":               "21.1.1",
+		"deactivate_kni_filtering_at_dispatcher":  "21.1.3",
+		"disable_avi_securitygroups":              "17.2.13",
+		"disable_csum_offloads":                   "17.1.14",
+		"disable_flow_probes":                     "20.1.3",
+		"disable_gro":                             "17.2.5",
+		"disable_se_memory_check":                 "18.1.2",
+		"disable_tso":                             "17.2.5",
+		"distribute_queues":                       "17.2.8",
+		"distribute_vnics":                        "18.2.5",
+		"downstream_send_timeout":                 "21.1.1",
+		"dp_aggressive_deq_interval_msec":         "21.1.1",
+		"dp_aggressive_enq_interval_msec":         "21.1.1",
+		"dp_aggressive_hb_frequency":              "20.1.3",
+		"dp_aggressive_hb_timeout_count":          "20.1.3",
+		"dp_deq_interval_msec":                    "21.1.1",
+		"dp_enq_interval_msec":                    "21.1.1",
+		"dp_hb_frequency":                         "20.1.3",
+		"dp_hb_timeout_count":                     "20.1.3",
+		"dpdk_gro_timeout_interval":               "22.1.1",
+		"enable_gratarp_permanent":                "18.2.3",
+		"enable_hsm_log":                          "21.1.1",
+		"enable_hsm_priming":                      "17.2.7",
+		"enable_multi_lb":                         "17.2.10",
+		"enable_pcap_tx_ring":                     "18.2.5",
+		"ephemeral_portrange_end":                 "17.2.13",
+		"ephemeral_portrange_start":               "17.2.13",
+		"extra_shared_config_memory":              "17.1.1",
+		"flow_table_new_syn_max_entries":          "17.2.5",
+		"free_list_size":                          "17.2.10",
+		"gcp_config":                              "20.1.3",
+		"gratarp_permanent_periodicity":           "18.2.3",
+		"grpc_channel_connect_timeout":            "22.1.1",
+		"handle_per_pkt_attack":                   "20.1.3",
+		"heap_minimum_config_memory":              "18.1.2",
+		"host_gateway_monitor":                    "17.2.4",
+		"http_rum_console_log":                    "21.1.1",
+		"http_rum_min_content_length":             "21.1.1",
+		"hybrid_rss_mode":                         "21.1.3",
+		"ignore_docker_mac_change":                "21.1.3",
+		"ignore_rtt_threshold":                    "17.1.6",
+		"ingress_access_data":                     "17.1.5",
+		"ingress_access_mgmt":                     "17.1.5",
+		"instance_flavor_info":                    "20.1.1",
+		"kni_allowed_server_ports":                "21.1.3",
+		"l7_conns_per_core":                       "21.1.1",
+		"l7_resvd_listen_conns_per_core":          "21.1.1",
+		"labels":                                  "20.1.1",
+		"lbaction_num_requests_to_dispatch":       "21.1.1",
+		"lbaction_rq_per_request_max_retries":     "21.1.1",
+		"license_tier":                            "17.2.5",
+		"license_type":                            "17.2.5",
+		"log_agent_compress_logs":                 "21.1.1",
+		"log_agent_debug_enabled":                 "21.1.1",
+		"log_agent_file_sz_appl":                  "21.1.1",
+		"log_agent_file_sz_conn":                  "21.1.1",
+		"log_agent_file_sz_debug":                 "21.1.1",
+		"log_agent_file_sz_event":                 "21.1.1",
+		"log_agent_log_storage_min_sz":            "21.1.1",
+		"log_agent_max_concurrent_rsync":          "21.1.1",
+		"log_agent_max_storage_excess_percent":    "21.1.1",
+		"log_agent_max_storage_ignore_percent":    "21.1.1",
+		"log_agent_min_storage_per_vs":            "21.1.1",
+		"log_agent_sleep_interval":                "21.1.1",
+		"log_agent_trace_enabled":                 "21.1.1",
+		"log_agent_unknown_vs_timer":              "21.1.1",
+		"log_malloc_failure":                      "20.1.2",
+		"log_message_max_file_list_size":          "21.1.1",
+		"markers":                                 "20.1.7",
+		"max_concurrent_external_hm":              "18.2.7",
+		"max_memory_per_mempool":                  "18.1.5",
+		"max_num_se_dps":                          "20.1.1",
+		"max_public_ips_per_lb":                   "17.2.12",
+		"max_queues_per_vnic":                     "18.2.7",
+		"max_rules_per_lb":                        "17.2.12",
+		"max_skb_frags":                           "21.1.3",
+		"memory_for_config_update":                "18.1.2",
+		"min_se":                                  "17.2.13",
+		"minimum_connection_memory":               "18.1.2",
+		"n_log_streaming_threads":                 "17.2.12",
+		"netlink_poller_threads":                  "21.1.1",
+		"netlink_sock_buf_size":                   "21.1.1",
+		"ngx_free_connection_stack":               "21.1.1",
+		"non_significant_log_throttle":            "17.1.3",
+		"ns_helper_deq_interval_msec":             "21.1.1",
+		"ntp_sync_fail_event":                     "22.1.2",
+		"ntp_sync_status_interval":                "22.1.2",
+		"num_dispatcher_cores":                    "17.2.12",
+		"num_dispatcher_queues":                   "21.1.3",
+		"objsync_config":                          "20.1.3",
+		"objsync_port":                            "20.1.3",
+		"openstack_availability_zones":            "17.1.1",
+		"pcap_tx_mode":                            "18.2.8",
+		"pcap_tx_ring_rd_balancing_factor":        "20.1.3",
+		"per_vs_admission_control":                "20.1.3",
+		"reboot_on_panic":                         "18.2.5",
+		"resync_time_interval":                    "20.1.1",
+		"sdb_flush_interval":                      "21.1.1",
+		"sdb_pipeline_size":                       "21.1.1",
+		"sdb_scan_count":                          "21.1.1",
+		"se_bandwidth_type":                       "17.2.5",
+		"se_delayed_flow_delete":                  "20.1.2",
+		"se_dp_hm_drops":                          "20.1.3",
+		"se_dp_if_state_poll_interval":            "21.1.3",
+		"se_dp_isolation":                         "20.1.4",
+		"se_dp_isolation_num_non_dp_cpus":         "20.1.4",
+		"se_dp_log_nf_enqueue_percent":            "21.1.1",
+		"se_dp_log_udf_enqueue_percent":           "21.1.1",
+		"se_dp_max_hb_version":                    "20.1.1",
+		"se_dp_vnic_queue_stall_event_sleep":      "18.2.5",
+		"se_dp_vnic_queue_stall_threshold":        "18.2.5",
+		"se_dp_vnic_queue_stall_timeout":          "18.2.5",
+		"se_dp_vnic_restart_on_queue_stall_count": "18.2.5",
+		"se_dp_vnic_stall_se_restart_window":      "18.2.5",
+		"se_dpdk_pmd":                             "18.1.3",
+		"se_dump_core_on_assert":                  "21.1.3",
+		"se_emulated_cores":                       "21.1.3",
+		"se_flow_probe_retries":                   "18.1.4",
+		"se_flow_probe_retry_timer":               "18.2.5",
+		"se_group_analytics_policy":               "20.1.3",
+		"se_hyperthreaded_mode":                   "20.1.1",
+		"se_ip_encap_ipc":                         "20.1.3",
+		"se_kni_burst_factor":                     "18.2.6",
+		"se_l3_encap_ipc":                         "20.1.3",
+		"se_log_buffer_app_blocking_dequeue":      "21.1.1",
+		"se_log_buffer_conn_blocking_dequeue":     "21.1.1",
+		"se_log_buffer_events_blocking_dequeue":   "21.1.1",
+		"se_lro":                                  "18.2.5",
+		"se_mp_ring_retry_count":                  "20.1.3",
+		"se_mtu":                                  "18.2.8",
+		"se_packet_buffer_max":                    "21.1.3",
+		"se_pcap_lookahead":                       "18.2.3",
+		"se_pcap_pkt_count":                       "18.2.5",
+		"se_pcap_pkt_sz":                          "18.2.5",
+		"se_pcap_qdisc_bypass":                    "18.2.6",
+		"se_pcap_reinit_frequency":                "17.2.13",
+		"se_pcap_reinit_threshold":                "17.2.13",
+		"se_probe_port":                           "17.2.2",
+		"se_rl_prop":                              "20.1.1",
+		"se_rum_sampling_nav_interval":            "18.2.6",
+		"se_rum_sampling_nav_percent":             "18.2.6",
+		"se_rum_sampling_res_interval":            "18.2.6",
+		"se_rum_sampling_res_percent":             "18.2.6",
+		"se_sb_dedicated_core":                    "16.5.2",
+		"se_sb_threads":                           "16.5.2",
+		"se_time_tracker_props":                   "22.1.1",
+		"se_tracert_port_range":                   "17.2.8",
+		"se_tunnel_mode":                          "17.1.1",
+		"se_tunnel_udp_port":                      "17.1.3",
+		"se_tx_batch_size":                        "18.2.5",
+		"se_txq_threshold":                        "20.1.2",
+		"se_udp_encap_ipc":                        "17.1.2",
+		"se_use_dpdk":                             "18.1.3",
+		"se_vnic_tx_sw_queue_flush_frequency":     "20.1.1",
+		"se_vnic_tx_sw_queue_size":                "20.1.1",
+		"se_vs_hb_max_pkts_in_batch":              "17.1.1",
+		"se_vs_hb_max_vs_in_pkt":                  "17.1.1",
+		"self_se_election":                        "18.1.2",
+		"send_se_ready_timeout":                   "21.1.1",
+		"service_ip6_subnets":                     "18.1.1",
+		"service_ip_subnets":                      "17.1.1",
+		"shm_minimum_config_memory":               "18.1.2",
+		"significant_log_throttle":                "17.1.3",
+		"ssl_preprocess_sni_hostname":             "17.2.12",
+		"ssl_sess_cache_per_vs":                   "21.1.1",
+		"transient_shared_memory_max":             "20.1.1",
+		"udf_log_throttle":                        "17.1.3",
+		"upstream_connect_timeout":                "21.1.1",
+		"upstream_connpool_enable":                "21.1.1",
+		"upstream_read_timeout":                   "21.1.1",
+		"upstream_send_timeout":                   "21.1.1",
+		"use_hyperthreaded_cores":                 "20.1.1",
+		"use_legacy_netlink":                      "21.1.1",
+		"use_objsync":                             "20.1.3",
+		"use_standard_alb":                        "18.2.3",
+		"user_agent_cache_config":                 "21.1.1",
+		"user_defined_metric_age":                 "21.1.1",
+		"vcenter_parking_vnic_pg":                 "22.1.1",
+		"vcenters":                                "20.1.1",
+		"vip_asg":                                 "17.2.12",
+		"vnic_dhcp_ip_check_interval":             "21.1.1",
+		"vnic_dhcp_ip_max_retries":                "21.1.1",
+		"vnic_ip_delete_interval":                 "21.1.1",
+		"vnic_probe_interval":                     "21.1.1",
+		"vnic_rpc_retry_interval":                 "21.1.1",
+		"vnicdb_cmd_history_size":                 "21.1.1",
+		"vs_se_scaleout_additional_wait_time":     "18.1.5",
+		"vs_se_scaleout_ready_timeout":            "18.1.5",
+		"vs_switchover_timeout":                   "17.2.13",
+		"vss_placement":                           "17.2.5",
+		"vss_placement_enabled":                   "18.1.1",
+		"waf_mempool":                             "17.2.3",
+		"waf_mempool_size":                        "17.2.3",
+	},
+	"siteversion": {
+		"datetime":            "20.1.1",
+		"name":                "20.1.1",
+		"prev_target_version": "20.1.1",
+		"replication_state":   "20.1.1",
+		"site_id":             "20.1.1",
+		"target_timeline":     "20.1.1",
+		"target_version":      "20.1.1",
+		"tenant_ref":          "20.1.1",
+		"timeline":            "20.1.1",
+		"uuid":                "20.1.1",
+		"version":             "20.1.1",
+		"version_type":        "20.1.1",
+	},
+	"snmptrapprofile": {
+		"configpb_attributes": "21.1.1",
+	},
+	"sslkeyandcertificate": {
+		"configpb_attributes":                "21.1.1",
+		"enable_ocsp_stapling":               "20.1.1",
+		"import_key_to_hsm":                  "22.1.1",
+		"is_federated":                       "22.1.3",
+		"markers":                            "20.1.5",
+		"ocsp_config":                        "20.1.1",
+		"ocsp_error_status":                  "20.1.1",
+		"ocsp_responder_url_list_from_certs": "20.1.1",
+		"ocsp_response_info":                 "20.1.1",
+	},
+	"sslprofile": {
+		"ciphersuites":        "18.2.6",
+		"configpb_attributes": "21.1.1",
+		"ec_named_curve":      "21.1.1",
+		"enable_early_data":   "18.2.6",
+		"is_federated":        "22.1.3",
+		"markers":             "20.1.5",
+		"signature_algorithm": "21.1.1",
+		"type":                "17.2.8",
+	},
+	"ssopolicy": {
+		"authentication_policy": "18.2.1",
+		"authorization_policy":  "18.2.5",
+		"configpb_attributes":   "21.1.1",
+		"markers":               "20.1.5",
+		"name":                  "18.2.3",
+		"tenant_ref":            "18.2.3",
+		"type":                  "18.2.5",
+		"uuid":                  "18.2.3",
+	},
+	"statediffoperation": {
+		"events":     "21.1.3",
+		"name":       "21.1.3",
+		"node_uuid":  "21.1.3",
+		"operation":  "21.1.3",
+		"phase":      "21.1.3",
+		"status":     "21.1.3",
+		"tenant_ref": "21.1.3",
+		"uuid":       "21.1.3",
+	},
+	"statediffsnapshot": {
+		"gslb_name":               "21.1.3",
+		"gslb_uuid":               "21.1.3",
+		"name":                    "21.1.3",
+		"pool_name":               "21.1.3",
+		"pool_uuid":               "21.1.3",
+		"post_snapshot":           "21.1.3",
+		"pre_snapshot":            "21.1.3",
+		"se_group_name":           "21.1.3",
+		"se_group_uuid":           "21.1.3",
+		"se_name":                 "21.1.3",
+		"se_uuid":                 "21.1.3",
+		"snapshot_type":           "21.1.3",
+		"statediff_operation_ref": "21.1.3",
+		"tenant_ref":              "21.1.3",
+		"uuid":                    "21.1.3",
+		"vs_name":                 "21.1.3",
+		"vs_uuid":                 "21.1.3",
+	},
+	"stringgroup": {
+		"configpb_attributes": "21.1.1",
+		"longest_match":       "18.2.8",
+		"markers":             "20.1.5",
+	},
+	"systemconfiguration": {
+		"common_criteria_mode":         "20.1.3",
+		"configpb_attributes":          "21.1.1",
+		"controller_analytics_policy":  "21.1.3",
+		"default_license_tier":         "17.2.5",
+		"enable_cors":                  "20.1.3",
+		"fips_mode":                    "20.1.1",
+		"secure_channel_configuration": "18.1.4",
+		"welcome_workflow_complete":    "18.2.3",
+	},
+	"systemlimits": {
+		"configpb_attributes":  "21.1.1",
+		"controller_limits":    "20.1.1",
+		"controller_sizes":     "20.1.1",
+		"serviceengine_limits": "20.1.1",
+		"uuid":                 "20.1.1",
+	},
+	"tenant": {
+		"configpb_attributes": "21.1.1",
+		"enforce_label_group": "20.1.5",
+		"label_group_refs":    "20.1.5",
+	},
+	"testsedatastorelevel1": {
+		"configpb_attributes":           "21.1.1",
+		"tenant_ref":                    "18.2.6",
+		"test_se_datastore_level_2_ref": "18.2.6",
+	},
+	"testsedatastorelevel2": {
+		"configpb_attributes":            "21.1.1",
+		"tenant_ref":                     "18.2.6",
+		"test_se_datastore_level_3_refs": "18.2.6",
+	},
+	"testsedatastorelevel3": {
+		"configpb_attributes": "21.1.1",
+		"tenant_ref":          "18.2.6",
+	},
+	"trafficcloneprofile": {
+		"clone_servers":       "17.1.1",
+		"cloud_ref":           "17.1.1",
+		"configpb_attributes": "21.1.1",
+		"markers":             "20.1.5",
+		"name":                "17.1.1",
+		"preserve_client_ip":  "17.1.1",
+		"tenant_ref":          "17.1.1",
+		"uuid":                "17.1.1",
+	},
+	"upgradestatusinfo": {
+		"after_reboot_rollback_fnc": "18.2.10",
+		"after_reboot_task_name":    "18.2.10",
+		"clean":                     "18.2.10",
+		"duration":                  "18.2.6",
+		"enable_patch_rollback":     "18.2.6",
+		"enable_rollback":           "18.2.6",
+		"end_time":                  "18.2.6",
+		"enqueue_time":              "18.2.6",
+		"fips_mode":                 "20.1.5",
+		"history":                   "20.1.4",
+		"image_path":                "18.2.10",
+		"image_ref":                 "18.2.6",
+		"name":                      "18.2.6",
+		"node_type":                 "18.2.6",
+		"obj_cloud_ref":             "18.2.6",
+		"params":                    "18.2.6",
+		"patch_image_path":          "18.2.10",
+		"patch_image_ref":           "18.2.6",
+		"patch_list":                "18.2.8",
+		"patch_reboot":              "18.2.10",
+		"patch_version":             "18.2.6",
+		"prev_image_path":           "18.2.10",
+		"prev_patch_image_path":     "18.2.10",
+		"previous_image_ref":        "18.2.6",
+		"previous_patch_image_ref":  "18.2.6",
+		"previous_patch_list":       "18.2.8",
+		"previous_patch_version":    "18.2.6",
+		"previous_version":          "18.2.6",
+		"progress":                  "18.2.8",
+		"reason":                    "22.1.3",
+		"se_patch_image_path":       "18.2.10",
+		"se_patch_image_ref":        "18.2.10",
+		"se_upgrade_events":         "18.2.6",
+		"seg_params":                "18.2.10",
+		"seg_status":                "18.2.6",
+		"start_time":                "18.2.6",
+		"state":                     "18.2.6",
+		"statediff_ref":             "21.1.3",
+		"system":                    "18.2.6",
+		"tasks_completed":           "18.2.6",
+		"tenant_ref":                "18.2.6",
+		"total_tasks":               "18.2.6",
+		"upgrade_events":            "18.2.6",
+		"upgrade_ops":               "18.2.6",
+		"upgrade_readiness":         "22.1.3",
+		"uuid":                      "18.2.6",
+		"version":                   "18.2.6",
+	},
+	"upgradestatussummary": {
+		"enable_patch_rollback": "18.2.6",
+		"enable_rollback":       "18.2.6",
+		"end_time":              "18.2.6",
+		"image_ref":             "18.2.6",
+		"name":                  "18.2.6",
+		"node_type":             "18.2.6",
+		"obj_cloud_ref":         "18.2.6",
+		"patch_image_ref":       "18.2.6",
+		"start_time":            "18.2.6",
+		"state":                 "18.2.6",
+		"tasks_completed":       "18.2.6",
+		"tenant_ref":            "18.2.6",
+		"total_tasks":           "18.2.6",
+		"upgrade_ops":           "18.2.6",
+		"uuid":                  "18.2.6",
+		"version":               "18.2.6",
+	},
+	"useraccountprofile": {
+		"configpb_attributes":               "21.1.1",
+		"login_failure_count_expiry_window": "22.1.1",
+	},
+	"vcenterserver": {
+		"cloud_ref":               "20.1.1",
+		"configpb_attributes":     "21.1.1",
+		"content_lib":             "20.1.1",
+		"name":                    "20.1.1",
+		"tenant_ref":              "20.1.1",
+		"uuid":                    "20.1.1",
+		"vcenter_credentials_ref": "20.1.1",
+		"vcenter_url":             "20.1.1",
+	},
+	"virtualservice": {
+		"advertise_down_vs":                  "20.1.1",
+		"allow_invalid_client_cert":          "18.2.3",
+		"azure_availability_set":             "17.2.12",
+		"bgp_peer_labels":                    "20.1.5",
+		"bot_policy_ref":                     "21.1.1",
+		"bulk_sync_kvcache":                  "17.2.7",
+		"close_client_conn_on_config_update": "17.2.4",
+		"configpb_attributes":                "21.1.1",
+		"dns_policies":                       "17.1.1",
+		"error_page_profile_ref":             "17.2.4",
+		"icap_request_profile_refs":          "20.1.1",
+		"jwt_config":                         "20.1.3",
+		"l4_policies":                        "17.2.7",
+		"ldap_vs_config":                     "21.1.1",
+		"markers":                            "20.1.5",
+		"min_pools_up":                       "18.2.1",
+		"nsx_securitygroup":                  "17.1.1",
+		"oauth_vs_config":                    "21.1.3",
+		"saml_sp_config":                     "18.2.3",
+		"security_policy_ref":                "18.2.1",
+		"sp_pool_refs":                       "17.2.2",
+		"ssl_profile_selectors":              "18.2.3",
+		"sso_policy_ref":                     "18.2.3",
+		"test_se_datastore_level_1_ref":      "18.2.6",
+		"topology_policies":                  "18.2.3",
+		"traffic_clone_profile_ref":          "17.1.1",
+		"traffic_enabled":                    "17.2.8",
+		"use_vip_as_snat":                    "17.1.9",
+		"vh_matches":                         "20.1.3",
+		"vh_type":                            "20.1.3",
+		"vip":                                "17.1.1",
+		"vsvip_cloud_config_cksum":           "17.2.9",
+		"vsvip_ref":                          "17.1.1",
+		"waf_policy_ref":                     "17.2.1",
+	},
+	"vrfcontext": {
+		"attrs":                    "20.1.2",
+		"bfd_profile":              "20.1.1",
+		"configpb_attributes":      "21.1.1",
+		"debugvrfcontext":          "17.1.1",
+		"internal_gateway_monitor": "17.1.1",
+		"lldp_enable":              "18.2.10",
+		"markers":                  "20.1.5",
+	},
+	"vsdatascriptset": {
+		"configpb_attributes":      "21.1.1",
+		"created_by":               "17.1.11",
+		"geo_db_ref":               "21.1.1",
+		"ip_reputation_db_ref":     "20.1.3",
+		"markers":                  "20.1.5",
+		"pki_profile_refs":         "21.1.1",
+		"protocol_parser_refs":     "18.2.3",
+		"rate_limiters":            "18.2.9",
+		"ssl_key_certificate_refs": "21.1.1",
+		"ssl_profile_refs":         "21.1.1",
+	},
+	"vsgs": {
+		"configpb_attributes": "21.1.3",
+		"geodb_uuid":          "21.1.3",
+		"gs_uuid":             "21.1.3",
+		"gslb_uuid":           "21.1.3",
+		"name":                "21.1.3",
+		"tenant_ref":          "21.1.3",
+		"type":                "21.1.3",
+		"uuid":                "21.1.3",
+		"vs_uuid":             "21.1.3",
+	},
+	"vsvip": {
+		"bgp_peer_labels":          "20.1.5",
+		"cloud_ref":                "17.1.1",
+		"configpb_attributes":      "21.1.1",
+		"dns_info":                 "17.1.1",
+		"east_west_placement":      "17.1.1",
+		"ipam_selector":            "20.1.3",
+		"markers":                  "20.1.5",
+		"name":                     "17.1.1",
+		"tenant_ref":               "17.1.1",
+		"tier1_lr":                 "20.1.1",
+		"use_standard_alb":         "18.2.3",
+		"uuid":                     "17.1.1",
+		"vip":                      "17.1.1",
+		"vrf_context_ref":          "17.1.1",
+		"vsvip_cloud_config_cksum": "17.2.9",
+	},
+	"wafapplicationsignatureprovider": {
+		"available_applications": "20.1.1",
+		"configpb_attributes":    "21.1.1",
+		"name":                   "20.1.1",
+		"ruleset_version":        "20.1.1",
+		"service_status":         "20.1.3",
+		"signatures":             "20.1.1",
+		"tenant_ref":             "20.1.1",
+		"uuid":                   "20.1.1",
+	},
+	"wafcrs": {
+		"configpb_attributes": "21.1.1",
+		"description":         "18.1.1",
+		"groups":              "18.1.1",
+		"integrity":           "18.2.1",
+		"markers":             "20.1.6",
+		"name":                "18.2.1",
+		"release_date":        "18.1.1",
+		"tenant_ref":          "18.2.1",
+		"uuid":                "18.1.1",
+		"version":             "18.1.1",
+	},
+	"wafpolicy": {
+		"allow_mode_delegation":               "18.1.5",
+		"allowlist":                           "20.1.3",
+		"application_signatures":              "20.1.1",
+		"auto_update_crs":                     "22.1.3",
+		"bypass_static_extensions":            "22.1.1",
+		"confidence_override":                 "20.1.1",
+		"configpb_attributes":                 "21.1.1",
+		"created_by":                          "17.2.4",
+		"crs_overrides":                       "20.1.6",
+		"description":                         "17.2.1",
+		"enable_app_learning":                 "18.2.3",
+		"enable_auto_rule_updates":            "20.1.1",
+		"enable_regex_learning":               "20.1.1",
+		"failure_mode":                        "18.1.2",
+		"geo_db_ref":                          "21.1.1",
+		"learning_params":                     "20.1.1",
+		"markers":                             "20.1.5",
+		"min_confidence":                      "20.1.1",
+		"mode":                                "17.2.1",
+		"name":                                "17.2.1",
+		"paranoia_level":                      "17.2.1",
+		"positive_security_model":             "18.2.3",
+		"post_crs_groups":                     "17.2.1",
+		"pre_crs_groups":                      "17.2.1",
+		"required_data_files":                 "22.1.3",
+		"tenant_ref":                          "17.2.1",
+		"updated_crs_rules_in_detection_mode": "22.1.3",
+		"uuid":                                "17.2.1",
+		"waf_crs_ref":                         "18.1.1",
+		"waf_profile_ref":                     "17.2.1",
+	},
+	"wafpolicypsmgroup": {
+		"configpb_attributes": "21.1.1",
+		"description":         "18.2.3",
+		"enable":              "18.2.3",
+		"hit_action":          "18.2.3",
+		"is_learning_group":   "18.2.3",
+		"locations":           "18.2.3",
+		"markers":             "20.1.5",
+		"miss_action":         "18.2.3",
+		"name":                "18.2.3",
+		"tenant_ref":          "18.2.3",
+		"uuid":                "18.2.3",
+	},
+	"wafprofile": {
+		"config":              "17.2.1",
+		"configpb_attributes": "21.1.1",
+		"description":         "17.2.1",
+		"files":               "17.2.1",
+		"markers":             "20.1.5",
+		"name":                "17.2.1",
+		"tenant_ref":          "17.2.1",
+		"uuid":                "17.2.1",
+	},
+	"webapput": {
+		"configpb_attributes": "21.1.5",
+		"mandatory_test":      "21.1.5",
+		"mandatory_tests":     "21.1.5",
+		"name":                "21.1.5",
+		"string_length_test":  "21.1.5",
+		"string_length_tests": "21.1.5",
+		"tenant_ref":          "21.1.5",
+		"test_string":         "21.1.5",
+		"uuid":                "21.1.5",
+	},
+	"webhook": {
+		"callback_url":        "17.1.1",
+		"configpb_attributes": "21.1.1",
+		"description":         "17.1.1",
+		"markers":             "20.1.6",
+		"name":                "17.1.1",
+		"tenant_ref":          "17.1.1",
+		"uuid":                "17.1.1",
+		"verification_token":  "17.1.1",
+	},
+}
@@ -0,0 +1,74 @@
+// Copyright 2022 VMware, Inc.
+// SPDX-License-Identifier: Mozilla Public License 2.0
+
+package avi
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// requestLimiter caps the number of requests in flight to the controller and the rate at which
+// they are started, for all resources and data sources of a provider. A nil *requestLimiter does
+// not limit anything.
+type requestLimiter struct {
+	inFlight chan struct{}
+	interval time.Duration
+
+	mu   sync.Mutex
+	next time.Time
+}
+
+// newRequestLimiter returns a limiter for at most maxInFlight concurrent requests and
+// requestsPerSecond started requests per second. Zero disables either limit, and nil is
+// returned when both are disabled.
+func newRequestLimiter(maxInFlight int, requestsPerSecond float64) *requestLimiter {
+	if maxInFlight <= 0 && requestsPerSecond <= 0 {
+		return nil
+	}
+	l := &requestLimiter{}
+	if maxInFlight > 0 {
+		l.inFlight = make(chan struct{}, maxInFlight)
+	}
+	if requestsPerSecond > 0 {
+		l.interval = time.Duration(float64(time.Second) / requestsPerSecond)
+	}
+	return l
+}
+
+// acquire waits until a request may be started and returns the function to call once it has
+// finished. It returns the error of ctx if ctx is done first.
+func (l *requestLimiter) acquire(ctx context.Context) (func(), error) {
+	if l == nil {
+		return func() {}, nil
+	}
+	if l.interval > 0 {
+		l.mu.Lock()
+		now := time.Now()
+		if l.next.Before(now) {
+			l.next = now
+		}
+		wait := l.next.Sub(now)
+		l.next = l.next.Add(l.interval)
+		l.mu.Unlock()
+		if wait > 0 {
+			timer := time.NewTimer(wait)
+			select {
+			case <-timer.C:
+			case <-ctx.Done():
+				timer.Stop()
+				return nil, ctx.Err()
+			}
+		}
+	}
+	if l.inFlight == nil {
+		return func() {}, nil
+	}
+	select {
+	case l.inFlight <- struct{}{}:
+		return func() { <-l.inFlight }, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
@@ -0,0 +1,106 @@
+// Copyright 2022 VMware, Inc.
+// SPDX-License-Identifier: Mozilla Public License 2.0
+
+package avi
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestRequestLimiterInFlight(t *testing.T) {
+	l := newRequestLimiter(2, 0)
+	var inFlight, maxInFlight int32
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			release, err := l.acquire(context.Background())
+			if err != nil {
+				t.Errorf("acquire failed: %v", err)
+				return
+			}
+			defer release()
+			n := atomic.AddInt32(&inFlight, 1)
+			for {
+				max := atomic.LoadInt32(&maxInFlight)
+				if n <= max || atomic.CompareAndSwapInt32(&maxInFlight, max, n) {
+					break
+				}
+			}
+			time.Sleep(5 * time.Millisecond)
+			atomic.AddInt32(&inFlight, -1)
+		}()
+	}
+	wg.Wait()
+	if maxInFlight != 2 {
+		t.Errorf("got %d requests in flight, want 2", maxInFlight)
+	}
+
+	release, _ := l.acquire(context.Background())
+	defer release()
+	release2, _ := l.acquire(context.Background())
+	defer release2()
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if _, err := l.acquire(ctx); err != context.DeadlineExceeded {
+		t.Errorf("acquire of a full limiter returned %v, want %v", err, context.DeadlineExceeded)
+	}
+}
+
+func TestRequestLimiterRate(t *testing.T) {
+	l := newRequestLimiter(0, 100)
+	start := time.Now()
+	for i := 0; i < 6; i++ {
+		release, err := l.acquire(context.Background())
+		if err != nil {
+			t.Fatalf("acquire failed: %v", err)
+		}
+		release()
+	}
+	if elapsed := time.Since(start); elapsed < 50*time.Millisecond {
+		t.Errorf("6 requests at 100 per second took %v, want at least 50ms", elapsed)
+	}
+}
+
+func TestRequestLimiterDisabled(t *testing.T) {
+	l := newRequestLimiter(0, 0)
+	if l != nil {
+		t.Fatalf("got a limiter without limits")
+	}
+	release, err := l.acquire(context.Background())
+	if err != nil {
+		t.Fatalf("acquire of a nil limiter failed: %v", err)
+	}
+	release()
+}
+
+func TestAviSessionCallLimit(t *testing.T) {
+	fc := newFakeController()
+	defer fc.Close()
+	client := newFakeControllerClient(t, fc)
+	client.limiter = newRequestLimiter(1, 0)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			var obj interface{}
+			err := aviSessionCall(context.Background(), client, func() error {
+				return client.AviSession.Get("api/cloud", &obj)
+			})
+			if err != nil {
+				t.Errorf("limited call failed: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+	if len(client.limiter.inFlight) != 0 {
+		t.Errorf("%d requests still hold the limiter", len(client.limiter.inFlight))
+	}
+}
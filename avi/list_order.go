@@ -0,0 +1,249 @@
+// Copyright 2022 VMware, Inc.
+// SPDX-License-Identifier: Mozilla Public License 2.0
+
+package avi
+
+import (
+	"fmt"
+	"log"
+	"reflect"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// listOrderPolicy tells how the elements of a list attribute, that the controller may return in
+// another order than they were configured in, are matched.
+type listOrderPolicy struct {
+	// Key lists the fields that identify an element of a list of blocks, with fields of nested
+	// blocks in dotted notation. The elements of a list without key, a list of refs or other
+	// values, are identified by their value.
+	Key []string
+}
+
+// listOrderPolicies is the registry of list attributes whose order does not matter, by dotted path
+// starting with the resource name. The other lists are ordered and merged by position.
+var listOrderPolicies = map[string]listOrderPolicy{
+	"avi_gslbservice.health_monitor_refs": {},
+	"avi_pool.health_monitor_refs":        {},
+	"avi_vsdatascriptset.ipgroup_refs":    {},
+
+	"avi_pool.servers":            {Key: []string{"ip.addr", "port"}},
+	"avi_virtualservice.services": {Key: []string{"port", "port_range_end"}},
+
+	"avi_httppolicyset.http_request_policy.rules":  {Key: []string{"index"}},
+	"avi_httppolicyset.http_response_policy.rules": {Key: []string{"index"}},
+	"avi_httppolicyset.http_security_policy.rules": {Key: []string{"index"}},
+	"avi_l4policyset.l4_connection_policy.rules":   {Key: []string{"index"}},
+	"avi_natpolicy.rules":                          {Key: []string{"index"}},
+	"avi_networksecuritypolicy.rules":              {Key: []string{"index"}},
+}
+
+// elemKey returns the key identifying elem, an element of the list in Avi JSON or in the
+// terraform data.
+func (p listOrderPolicy) elemKey(elem interface{}) string {
+	if len(p.Key) == 0 {
+		return listValueKey(elem)
+	}
+	parts := make([]string, len(p.Key))
+	for i, field := range p.Key {
+		value := elem
+		for _, name := range strings.Split(field, ".") {
+			m, _ := singleElem(value).(map[string]interface{})
+			value = m[name]
+		}
+		parts[i] = listValueKey(value)
+	}
+	return strings.Join(parts, "|")
+}
+
+// orderLike returns the elements of list in the order of the elements of like with the same
+// key, followed by the elements without match in their order. matches holds the element of like
+// matched by each returned element, or nil.
+func (p listOrderPolicy) orderLike(list, like []interface{}) (ordered, matches []interface{}) {
+	remaining := append([]interface{}(nil), list...)
+	for _, l := range like {
+		key := p.elemKey(l)
+		for i, e := range remaining {
+			if p.elemKey(e) == key {
+				ordered = append(ordered, e)
+				matches = append(matches, l)
+				remaining = append(remaining[:i], remaining[i+1:]...)
+				break
+			}
+		}
+	}
+	for _, e := range remaining {
+		ordered = append(ordered, e)
+		matches = append(matches, nil)
+	}
+	return ordered, matches
+}
+
+// setDefaults sets the defaults of the matching elements of localList in the elements of
+// apiList, which is returned in the order of localList.
+func (p listOrderPolicy) setDefaults(apiList, localList []interface{}, s *schema.Schema, path string) []interface{} {
+	ordered, matches := p.orderLike(apiList, localList)
+	elem, ok := s.Elem.(*schema.Resource)
+	if !ok {
+		return ordered
+	}
+	for i, local := range matches {
+		if local == nil {
+			continue
+		}
+		obj, err := SetDefaultsInAPIRes(ordered[i], local, elem.Schema, path)
+		if err != nil {
+			log.Printf("[ERROR] SetDefaultsInAPIRes err %v in %v", err, path)
+			continue
+		}
+		ordered[i] = obj
+	}
+	return ordered
+}
+
+// sameElements reports whether the lists old and new of the terraform data have the same
+// elements, in any order. Elements with the same key are compared on all their fields.
+func (p listOrderPolicy) sameElements(old, new interface{}) bool {
+	oldList, _ := old.([]interface{})
+	newList, _ := new.([]interface{})
+	if len(oldList) != len(newList) {
+		return false
+	}
+	ordered, matches := p.orderLike(oldList, newList)
+	for i, match := range matches {
+		if match == nil || !sameFields(ordered[i], match) {
+			return false
+		}
+	}
+	return true
+}
+
+// addListOrderDiffSuppress suppresses the diffs of the lists of the registry in the schemas of
+// resources that only reorder their elements.
+func addListOrderDiffSuppress(resources map[string]*schema.Resource) {
+	for name, r := range resources {
+		addListOrderDiffSuppressSchema(name, r.Schema)
+	}
+}
+
+func addListOrderDiffSuppressSchema(path string, s map[string]*schema.Schema) {
+	for name, field := range s {
+		fieldPath := path + "." + name
+		if policy, ok := listOrderPolicies[fieldPath]; ok && field.Type == schema.TypeList &&
+			field.DiffSuppressFunc == nil && (field.Optional || field.Required) {
+			field.DiffSuppressFunc = suppressListReorder(name, policy)
+		}
+		if elem, ok := field.Elem.(*schema.Resource); ok {
+			addListOrderDiffSuppressSchema(fieldPath, elem.Schema)
+		}
+	}
+}
+
+// suppressListReorder returns the diff suppress function of the list attribute name. It is
+// called for every attribute of the list that differs, and suppresses them all when the list
+// in the configuration has the same elements as the state.
+func suppressListReorder(name string, policy listOrderPolicy) schema.SchemaDiffSuppressFunc {
+	return func(k, old, new string, d *schema.ResourceData) bool {
+		parts := strings.Split(k, ".")
+		for i, part := range parts {
+			if part == name {
+				o, n := d.GetChange(strings.Join(parts[:i+1], "."))
+				return policy.sameElements(o, n)
+			}
+		}
+		return false
+	}
+}
+
+// singleElem returns the element of a nested block, which is a set or list in the terraform
+// data and an object in Avi JSON.
+func singleElem(v interface{}) interface{} {
+	switch value := v.(type) {
+	case *schema.Set:
+		return singleElem(value.List())
+	case []interface{}:
+		if len(value) > 0 {
+			return value[0]
+		}
+		return nil
+	}
+	return v
+}
+
+// listValueKey returns the key of a scalar value. Refs are identified by the uuid they refer to,
+// as the controller returns them as urls with the name of the object.
+func listValueKey(v interface{}) string {
+	if ref, ok := v.(string); ok && strings.Contains(ref, "/api/") {
+		return UUIDFromID(ref)
+	}
+	if v == nil {
+		return ""
+	}
+	return fmt.Sprint(v)
+}
+
+// sameFields reports whether a and b, elements matched by key, have equal values for all their
+// fields. A field that is empty on both sides is equal, whether it is absent, nil or zero.
+func sameFields(a, b interface{}) bool {
+	if isEmptyValue(a) && isEmptyValue(b) {
+		return true
+	}
+	switch av := a.(type) {
+	case map[string]interface{}:
+		bv, ok := b.(map[string]interface{})
+		if !ok {
+			return false
+		}
+		for k, x := range av {
+			if !sameFields(x, bv[k]) {
+				return false
+			}
+		}
+		for k, y := range bv {
+			if _, ok := av[k]; !ok && !isEmptyValue(y) {
+				return false
+			}
+		}
+		return true
+	case *schema.Set:
+		return sameFields(av.List(), singleList(b))
+	case []interface{}:
+		bl := singleList(b)
+		if len(av) != len(bl) {
+			return false
+		}
+		for i := range av {
+			if !sameFields(av[i], bl[i]) {
+				return false
+			}
+		}
+		return true
+	}
+	if isEmptyValue(a) != isEmptyValue(b) {
+		return false
+	}
+	return listValueKey(a) == listValueKey(b)
+}
+
+func singleList(v interface{}) []interface{} {
+	if set, ok := v.(*schema.Set); ok {
+		return set.List()
+	}
+	list, _ := v.([]interface{})
+	return list
+}
+
+func isEmptyValue(v interface{}) bool {
+	switch value := v.(type) {
+	case nil:
+		return true
+	case *schema.Set:
+		return value.Len() == 0
+	case []interface{}:
+		return len(value) == 0
+	case map[string]interface{}:
+		return len(value) == 0
+	}
+	return reflect.ValueOf(v).IsZero()
+}
@@ -0,0 +1,180 @@
+// Copyright 2022 VMware, Inc.
+// SPDX-License-Identifier: Mozilla Public License 2.0
+
+package avi
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestListOrderPolicies(t *testing.T) {
+	provider := Provider()
+	for path, policy := range listOrderPolicies {
+		parts := strings.Split(path, ".")
+		r, ok := provider.ResourcesMap[parts[0]]
+		if !ok {
+			t.Errorf("resource of list %s does not exist", path)
+			continue
+		}
+		s := r.Schema
+		var field *schema.Schema
+		for _, name := range parts[1:] {
+			if s == nil {
+				field = nil
+				break
+			}
+			field = s[name]
+			s = nil
+			if field == nil {
+				break
+			}
+			if elem, ok := field.Elem.(*schema.Resource); ok {
+				s = elem.Schema
+			}
+		}
+		if field == nil || field.Type != schema.TypeList {
+			t.Errorf("list %s does not exist", path)
+			continue
+		}
+		if field.DiffSuppressFunc == nil {
+			t.Errorf("list %s does not suppress reorder diffs", path)
+		}
+		for _, key := range policy.Key {
+			elem := s
+			for _, name := range strings.Split(key, ".") {
+				keyField, ok := elem[name]
+				if !ok {
+					t.Errorf("key field %s does not exist in the elements of list %s", key, path)
+					break
+				}
+				elem = nil
+				if r, ok := keyField.Elem.(*schema.Resource); ok {
+					elem = r.Schema
+				}
+			}
+		}
+	}
+}
+
+func TestSetDefaultsInAPIResListOrder(t *testing.T) {
+	server := func(addr string, port int64, ratio interface{}) map[string]interface{} {
+		s := map[string]interface{}{
+			"ip":   map[string]interface{}{"addr": addr, "type": "V4"},
+			"port": port,
+		}
+		if ratio != nil {
+			s["ratio"] = ratio
+		}
+		return s
+	}
+	// The controller returns the servers and monitors in another order than configured.
+	apiRes := map[string]interface{}{
+		"name": "pool-1",
+		"servers": []interface{}{
+			server("10.0.0.2", 80, float64(3)),
+			server("10.0.0.3", 80, float64(1)),
+			server("10.0.0.1", 8080, nil),
+		},
+		"health_monitor_refs": []interface{}{
+			"https://controller/api/healthmonitor/healthmonitor-2#hm-2",
+			"https://controller/api/healthmonitor/healthmonitor-1#hm-1",
+		},
+	}
+	localData := map[string]interface{}{
+		"name": "pool-1",
+		"servers": []interface{}{
+			server("10.0.0.1", 8080, 1),
+			server("10.0.0.2", 80, 1),
+		},
+		"health_monitor_refs": []interface{}{
+			"/api/healthmonitor/healthmonitor-1",
+			"/api/healthmonitor/healthmonitor-2",
+		},
+	}
+	res, err := SetDefaultsInAPIRes(apiRes, localData, ResourcePoolSchema(), "avi_pool")
+	if err != nil {
+		t.Fatalf("SetDefaultsInAPIRes failed: %v", err)
+	}
+	servers := res.(map[string]interface{})["servers"].([]interface{})
+	var got []interface{}
+	for _, s := range servers {
+		got = append(got, []interface{}{
+			s.(map[string]interface{})["ip"].(map[string]interface{})["addr"],
+			s.(map[string]interface{})["ratio"],
+		})
+	}
+	// The configured servers come first and get the default ratio, the server only on the
+	// controller is kept last as returned.
+	want := []interface{}{
+		[]interface{}{"10.0.0.1", 1},
+		[]interface{}{"10.0.0.2", float64(3)},
+		[]interface{}{"10.0.0.3", float64(1)},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got servers %v, want %v", got, want)
+	}
+	refs := res.(map[string]interface{})["health_monitor_refs"].([]interface{})
+	if UUIDFromID(refs[0].(string)) != "healthmonitor-1" {
+		t.Errorf("got health_monitor_refs %v, want healthmonitor-1 first", refs)
+	}
+}
+
+func TestSuppressListReorder(t *testing.T) {
+	r := Provider().ResourcesMap["avi_pool"]
+	server := func(addr string, port int) map[string]interface{} {
+		return map[string]interface{}{
+			"ip":   []interface{}{map[string]interface{}{"addr": addr, "type": "V4"}},
+			"port": port,
+		}
+	}
+	disabled := func(s map[string]interface{}) map[string]interface{} {
+		s["enabled"] = false
+		return s
+	}
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"name":    "pool-1",
+		"servers": []interface{}{server("10.0.0.1", 80), server("10.0.0.2", 80)},
+	})
+	d.SetId("https://controller/api/pool/pool-1")
+	state := d.State()
+
+	tests := []struct {
+		name    string
+		servers []interface{}
+		diff    bool
+	}{
+		{"reordered", []interface{}{server("10.0.0.2", 80), server("10.0.0.1", 80)}, false},
+		{"changed port", []interface{}{server("10.0.0.2", 80), server("10.0.0.1", 8080)}, true},
+		{"added", []interface{}{server("10.0.0.2", 80), server("10.0.0.1", 80), server("10.0.0.3", 80)}, true},
+		{"reordered and disabled", []interface{}{server("10.0.0.2", 80), disabled(server("10.0.0.1", 80))}, true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			config := terraform.NewResourceConfigRaw(map[string]interface{}{
+				"name":    "pool-1",
+				"servers": test.servers,
+			})
+			diff, err := r.Diff(context.Background(), state, config, nil)
+			if err != nil {
+				t.Fatalf("diff failed: %v", err)
+			}
+			var changed []string
+			if diff != nil {
+				for k := range diff.Attributes {
+					if strings.HasPrefix(k, "servers.") {
+						changed = append(changed, k)
+					}
+				}
+			}
+			if (len(changed) > 0) != test.diff {
+				t.Errorf("got changed servers attributes %v, want diff %v", changed, test.diff)
+			}
+		})
+	}
+}
@@ -4,16 +4,24 @@
 package avi
 
 import (
+	"context"
+	"fmt"
 	"log"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/vmware/alb-sdk/go/clients"
 	"github.com/vmware/alb-sdk/go/session"
 )
 
 func Provider() *schema.Provider {
-	return &schema.Provider{
+	provider := &schema.Provider{
 		Schema: map[string]*schema.Schema{
 			"avi_username": {
 				Type:        schema.TypeString,
@@ -27,12 +35,38 @@ func Provider() *schema.Provider {
 				DefaultFunc: schema.EnvDefaultFunc("AVI_CONTROLLER", nil),
 				Description: "Avi Controller hostname or IP address.",
 			},
+			"avi_controllers": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Description: "Other Avi Controller cluster nodes to fail over to when avi_controller does not respond. " +
+					"Defaults to AVI_CONTROLLERS, a comma separated list.",
+			},
+			"avi_cluster_discovery": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("AVI_CLUSTER_DISCOVERY", false),
+				Description: "Add the nodes of the Avi Controller cluster, read from api/cluster, to the controllers to fail over to.",
+			},
 			"avi_password": {
 				Type:        schema.TypeString,
 				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc("AVI_PASSWORD", nil),
 				Description: "Password for Avi Controller.",
 			},
+			"avi_password_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("AVI_PASSWORD_FILE", nil),
+				Description: "Path of a file holding the password for Avi Controller. It takes precedence over avi_password.",
+			},
+			"avi_credential_process": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("AVI_CREDENTIAL_PROCESS", nil),
+				Description: "Command that prints the credentials for Avi Controller as JSON with the keys username, " +
+					"password and authtoken. It is run again when the session has to be re-authenticated.",
+			},
 			"avi_tenant": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -43,7 +77,7 @@ func Provider() *schema.Provider {
 				Type:        schema.TypeString,
 				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc("AVI_VERSION", nil),
-				Description: "Avi version for Avi Controller.",
+				Description: "Avi version for Avi Controller, or auto to use the version of the controller.",
 			},
 			"avi_authtoken": {
 				Type:        schema.TypeString,
@@ -57,6 +91,121 @@ func Provider() *schema.Provider {
 				DefaultFunc: schema.EnvDefaultFunc("AVI_API_TIMEOUT", nil),
 				Description: "Session timeout for Avi Controller.",
 			},
+			"avi_adoption_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("AVI_ADOPTION_MODE", adoptionModeAdopt),
+				ValidateFunc: validation.StringInSlice(adoptionModes, false),
+				Description: "What to do when a resource without uuid finds an existing object with the same name: " +
+					"adopt it, fail, or adopt only objects created by this provider (adopt_marked).",
+			},
+			"avi_owner_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("AVI_OWNER_ID", defaultProviderOwner),
+				Description: "Owner written in the created_by marker of the objects created in adopt_marked mode. " +
+					"adopt_marked only adopts the objects with the same owner.",
+			},
+			"avi_insecure": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("AVI_INSECURE", false),
+				Description: "Skip the verification of the Avi Controller certificate.",
+			},
+			"avi_ca_bundle": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("AVI_CA_BUNDLE", nil),
+				Description: "PEM encoded CA certificates, or the path of a file holding them, trusted for the Avi Controller " +
+					"certificate in addition to the system roots.",
+			},
+			"avi_client_cert": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("AVI_CLIENT_CERT", nil),
+				Description: "PEM encoded client certificate, or the path of a file holding it, for mutual TLS.",
+			},
+			"avi_client_key": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				DefaultFunc: schema.EnvDefaultFunc("AVI_CLIENT_KEY", nil),
+				Description: "PEM encoded private key of avi_client_cert, or the path of a file holding it.",
+			},
+			"avi_port": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("AVI_PORT", nil),
+				ValidateFunc: validation.IsPortNumber,
+				Description:  "Port of the Avi Controller, when avi_controller does not include one.",
+			},
+			"avi_proxy_url": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("AVI_PROXY_URL", nil),
+				Description: "URL of the HTTP(S) or SOCKS5 proxy used to reach the Avi Controller. " +
+					"Defaults to the HTTPS_PROXY environment variable. NO_PROXY is honored in both cases.",
+			},
+			"avi_connection_pool_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("AVI_CONNECTION_POOL_SIZE", 10),
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "Maximum number of idle connections kept open to the Avi Controller.",
+			},
+			"avi_keep_alive": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("AVI_KEEP_ALIVE", 30),
+				ValidateFunc: validation.IntAtLeast(-1),
+				Description: "TCP keep-alive interval in seconds of the connections to the Avi Controller. " +
+					"0 uses the system default and -1 disables keep-alive and connection reuse.",
+			},
+			"avi_retry_max_attempts": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("AVI_RETRY_MAX_ATTEMPTS", 3),
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "Number of attempts of a request to the Avi Controller that fails with a retryable status.",
+			},
+			"avi_retry_min_backoff": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("AVI_RETRY_MIN_BACKOFF", 1),
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "Delay in seconds before the first retry of a request, doubled for every further retry.",
+			},
+			"avi_retry_max_backoff": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("AVI_RETRY_MAX_BACKOFF", 30),
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "Maximum delay in seconds between two attempts of a request.",
+			},
+			"avi_retry_status_codes": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeInt,
+					ValidateFunc: validation.IntBetween(400, 599),
+				},
+				Description: "HTTP statuses of the Avi Controller responses that are retried. " +
+					"Defaults to AVI_RETRY_STATUS_CODES, or 412, 429, 502, 503 and 504.",
+			},
+			"avi_max_concurrent_requests": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("AVI_MAX_CONCURRENT_REQUESTS", 0),
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "Maximum number of requests in flight to the Avi Controller, 0 for no limit.",
+			},
+			"avi_requests_per_second": {
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("AVI_REQUESTS_PER_SECOND", 0),
+				ValidateFunc: validation.FloatAtLeast(0),
+				Description:  "Maximum number of requests started per second to the Avi Controller, 0 for no limit.",
+			},
 		},
 		DataSourcesMap: map[string]*schema.Resource{
 			"avi_rmcloudopsproto":                 dataSourceAviRmCloudOpsProto(),
@@ -289,11 +438,21 @@ func Provider() *schema.Provider {
 			"avi_fileservice":                     resourceAviFileService(),
 			"avi_server":                          resourceAviServer(),
 		},
-		ConfigureFunc: providerConfigure,
+		ConfigureContextFunc: providerConfigure,
 	}
+	addAdoptionModeSchema(provider.ResourcesMap)
+	addTenantSchema(provider.ResourcesMap, true)
+	addTenantSchema(provider.DataSourcesMap, false)
+	markSensitiveFields(provider)
+	addNativeTypesStateUpgraders(provider.ResourcesMap)
+	addSetHashFuncs(provider.ResourcesMap)
+	addListOrderDiffSuppress(provider.ResourcesMap)
+	addEnumValidateFuncs(provider.ResourcesMap)
+	addDiffRules(provider.ResourcesMap)
+	return provider
 }
 
-func providerConfigure(d *schema.ResourceData) (interface{}, error) {
+func providerConfigure(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
 	config := Credentials{
 		Username:   "admin",
 		Password:   d.Get("avi_password").(string),
@@ -302,6 +461,20 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 		Version:    "18.2.8",
 		AuthToken:  d.Get("avi_authtoken").(string),
 		Timeout:    time.Duration(d.Get("avi_api_timeout").(int)) * time.Second,
+		Insecure:   d.Get("avi_insecure").(bool),
+		CABundle:   d.Get("avi_ca_bundle").(string),
+		ClientCert: d.Get("avi_client_cert").(string),
+		ClientKey:  d.Get("avi_client_key").(string),
+		ProxyURL:   d.Get("avi_proxy_url").(string),
+		PoolSize:   d.Get("avi_connection_pool_size").(int),
+		KeepAlive:  time.Duration(d.Get("avi_keep_alive").(int)) * time.Second,
+
+		PasswordFile:      d.Get("avi_password_file").(string),
+		CredentialProcess: d.Get("avi_credential_process").(string),
+		AuditLog:          os.Getenv(auditLogEnv),
+	}
+	if port, ok := d.GetOk("avi_port"); ok {
+		config.Port = strconv.Itoa(port.(int))
 	}
 	if username, ok := d.GetOk("avi_username"); ok {
 		config.Username = username.(string)
@@ -318,18 +491,133 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 		config.Timeout = time.Duration(timeout.(int)) * time.Second
 	}
 
-	aviClient, err := clients.NewAviClient(
-		config.Controller, config.Username,
+	if err := loadCredentials(ctx, &config); err != nil {
+		return nil, diag.FromErr(err)
+	}
+
+	retry, err := providerRetryPolicy(d)
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+
+	limiter := newRequestLimiter(d.Get("avi_max_concurrent_requests").(int),
+		d.Get("avi_requests_per_second").(float64))
+
+	controllers := append([]string{config.Controller}, providerControllers(d)...)
+
+	var detectedVersion string
+	if config.Version == aviVersionAuto {
+		// Any node of the cluster can tell the version, also when avi_controller is down.
+		var version string
+		for _, addr := range controllers {
+			nodeConfig := config
+			nodeConfig.Controller = addr
+			if version, err = detectControllerVersion(ctx, nodeConfig); err == nil {
+				break
+			}
+		}
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+		log.Printf("[INFO] Detected Avi Controller version %s\n", version)
+		config.Version = version
+		detectedVersion = version
+	}
+
+	throttle := &retryThrottle{}
+	options, err := aviSessionOptions(config, throttle)
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	discovery := d.Get("avi_cluster_discovery").(bool)
+	options = append(options, failoverSessionOptions(len(controllers) > 1 || discovery)...)
+	aviClient, err := clients.NewAviClient(aviControllerAddress(config), config.Username, options...)
+
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	log.Printf("Avi Client created for user %s tenant %s version %s\n",
+		config.Username, config.Tenant, config.Version)
+	client := &ProviderClient{
+		AviClient:       aviClient,
+		Credentials:     config,
+		AdoptionMode:    d.Get("avi_adoption_mode").(string),
+		OwnerID:         d.Get("avi_owner_id").(string),
+		DetectedVersion: detectedVersion,
+		retry:           retry,
+		limiter:         limiter,
+		throttle:        throttle,
+	}
+	client.addControllers(controllers...)
+	if discovery {
+		if err := client.discoverControllers(ctx); err != nil {
+			log.Printf("[WARN] Failed to discover the Avi Controller cluster nodes: %v\n", err)
+		}
+	}
+	return client, nil
+}
+
+// providerControllers returns the controllers to fail over to of the provider configuration d.
+func providerControllers(d *schema.ResourceData) []string {
+	var controllers []string
+	if list, ok := d.GetOk("avi_controllers"); ok {
+		for _, c := range list.([]interface{}) {
+			controllers = append(controllers, c.(string))
+		}
+	} else if env := os.Getenv("AVI_CONTROLLERS"); env != "" {
+		for _, c := range strings.Split(env, ",") {
+			controllers = append(controllers, strings.TrimSpace(c))
+		}
+	}
+	return controllers
+}
+
+// providerRetryPolicy returns the retry policy of the provider configuration d.
+func providerRetryPolicy(d *schema.ResourceData) (retryPolicy, error) {
+	policy := retryPolicy{
+		MaxAttempts: d.Get("avi_retry_max_attempts").(int),
+		MinBackoff:  time.Duration(d.Get("avi_retry_min_backoff").(int)) * time.Second,
+		MaxBackoff:  time.Duration(d.Get("avi_retry_max_backoff").(int)) * time.Second,
+		StatusCodes: defaultRetryStatusCodes,
+	}
+	if codes, ok := d.GetOk("avi_retry_status_codes"); ok {
+		policy.StatusCodes = nil
+		for _, code := range codes.([]interface{}) {
+			policy.StatusCodes = append(policy.StatusCodes, code.(int))
+		}
+	} else if env := os.Getenv("AVI_RETRY_STATUS_CODES"); env != "" {
+		policy.StatusCodes = nil
+		for _, code := range strings.Split(env, ",") {
+			status, err := strconv.Atoi(strings.TrimSpace(code))
+			if err != nil {
+				return policy, fmt.Errorf("invalid status code %q in AVI_RETRY_STATUS_CODES", code)
+			}
+			policy.StatusCodes = append(policy.StatusCodes, status)
+		}
+	}
+	return policy, nil
+}
+
+// aviSessionOptions returns the options of an AviSession for config. The Retry-After header of
+// the throttled responses is recorded in throttle, unless it is nil.
+func aviSessionOptions(config Credentials, throttle *retryThrottle) ([]func(*session.AviSession) error, error) {
+	transport, err := newAviTransport(config, throttle)
+	if err != nil {
+		return nil, err
+	}
+	options := []func(*session.AviSession) error{
 		session.SetPassword(config.Password),
 		session.SetTenant(config.Tenant),
 		session.SetVersion(config.Version),
 		session.SetAuthToken(config.AuthToken),
-		session.SetInsecure, session.SetTimeout(config.Timeout),
-		session.SetLazyAuthentication(true))
-
-	log.Printf("Avi Client created for user %s tenant %s version %s\n",
-		config.Username, config.Tenant, config.Version)
-	return aviClient, err
+		session.SetTransport(transport),
+		session.SetTimeout(config.Timeout),
+		session.SetLazyAuthentication(true),
+	}
+	if config.Insecure {
+		options = append(options, session.SetInsecure)
+	}
+	return options, nil
 }
 
 type Credentials struct {
@@ -341,4 +629,46 @@ type Credentials struct {
 	Version    string
 	AuthToken  string
 	Timeout    time.Duration
+	Insecure   bool
+	CABundle   string
+	ClientCert string
+	ClientKey  string
+	ProxyURL   string
+	// PoolSize is the number of idle connections kept open to the controller.
+	PoolSize int
+	// KeepAlive is the TCP keep-alive interval, a negative value disables keep-alive.
+	KeepAlive time.Duration
+	// PasswordFile and CredentialProcess are read by loadCredentials.
+	PasswordFile      string
+	CredentialProcess string
+	// AuditLog is the file that every request to the controller is logged to, if set.
+	AuditLog string
+}
+
+// ProviderClient is the meta value passed to every resource and data source. It carries the
+// Avi client together with the provider level settings.
+type ProviderClient struct {
+	*clients.AviClient
+	// Credentials are replaced together with the session, read them with credentials().
+	Credentials  Credentials
+	AdoptionMode string
+	// OwnerID is the value of the provider marker written in adopt_marked mode.
+	OwnerID string
+	// DetectedVersion is the version of the controller when avi_version is auto, empty otherwise.
+	DetectedVersion string
+
+	// tenantNames caches the names of the tenants referenced by uuid.
+	tenantNames sync.Map
+	// retry is the retry policy of the requests to the controller.
+	retry retryPolicy
+	// limiter limits the requests to the controller, nil if they are not limited.
+	limiter *requestLimiter
+	// sessionMu guards the replacement of the session by reauthenticate. Requests hold it
+	// for reading while they use the session.
+	sessionMu         sync.RWMutex
+	sessionGeneration int
+	// controllers are the addresses of the controller cluster nodes, starting with avi_controller.
+	controllers []string
+	// throttle is shared by the sessions of the client to honour Retry-After.
+	throttle *retryThrottle
 }
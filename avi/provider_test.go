@@ -12,8 +12,10 @@
 package avi
 
 import (
+	"context"
 	"os"
 	"strconv"
+	"sync"
 	"testing"
 	"time"
 
@@ -26,6 +28,9 @@ import (
 var testAccProviders map[string]*schema.Provider
 var testAccProvider *schema.Provider
 
+var testAccFakeController *fakeController
+var testAccFakeControllerOnce sync.Once
+
 func init() {
 	testAccProvider = Provider()
 	testAccProviders = map[string]*schema.Provider{
@@ -89,7 +94,34 @@ func TestProvider(t *testing.T) {
 	}
 }
 
+// testAccUseFakeController points the acceptance tests at an in-process fake
+// controller when AVI_FAKE_CONTROLLER is set, so they run without network access.
+func testAccUseFakeController(t *testing.T) {
+	if useFake, _ := strconv.ParseBool(os.Getenv("AVI_FAKE_CONTROLLER")); !useFake {
+		return
+	}
+	testAccFakeControllerOnce.Do(func() {
+		testAccFakeController = newFakeController()
+	})
+	env := map[string]string{
+		"AVI_CONTROLLER": testAccFakeController.Host(),
+		"AVI_USERNAME":   fakeControllerUsername,
+		"AVI_PASSWORD":   fakeControllerPassword,
+		"AVI_TENANT":     fakeControllerTenant,
+		"AVI_VERSION":    fakeControllerVersion,
+		"AVI_AUTHTOKEN":  "",
+		"AVI_CA_BUNDLE":  testAccFakeController.CABundle(),
+	}
+	for k, v := range env {
+		if err := os.Setenv(k, v); err != nil {
+			t.Fatalf("Unable to set env variable %s. Error: %s", k, err)
+		}
+	}
+}
+
 func testAccPreCheck(t *testing.T) {
+	testAccUseFakeController(t)
+
 	var timeout time.Duration
 	if tm, err := strconv.Atoi(os.Getenv("AVI_API_TIMEOUT")); err == nil {
 		timeout = time.Duration(tm) * time.Second
@@ -103,12 +135,24 @@ func testAccPreCheck(t *testing.T) {
 		Version:    os.Getenv("AVI_VERSION"),
 		AuthToken:  os.Getenv("AVI_AUTHTOKEN"),
 		Timeout:    timeout,
+		CABundle:   os.Getenv("AVI_CA_BUNDLE"),
+		ClientCert: os.Getenv("AVI_CLIENT_CERT"),
+		ClientKey:  os.Getenv("AVI_CLIENT_KEY"),
+		Port:       os.Getenv("AVI_PORT"),
+		ProxyURL:   os.Getenv("AVI_PROXY_URL"),
+
+		PasswordFile:      os.Getenv("AVI_PASSWORD_FILE"),
+		CredentialProcess: os.Getenv("AVI_CREDENTIAL_PROCESS"),
 	}
+	config.Insecure, _ = strconv.ParseBool(os.Getenv("AVI_INSECURE"))
 
 	if config.Controller == "" {
 		t.Fatalf("AVI_CONTROLLER must be set for acceptance test")
 	}
 
+	if err := loadCredentials(context.Background(), &config); err != nil {
+		t.Fatalf("%+v", err)
+	}
 	if config.Password == "" && config.AuthToken == "" {
 		t.Fatalf("AVI_PASSWORD or AVI_AUTHTOKEN must be set for acceptance test")
 	}
@@ -128,13 +172,12 @@ func testAccPreCheck(t *testing.T) {
 		t.Fatalf("Unable to set env variable AVI_SUPPRESS_SENSITIVE_FIELDS_DIFF. Error: %s", errs)
 	}
 
-	_, err := clients.NewAviClient(
-		config.Controller, config.Username,
-		session.SetPassword(config.Password),
-		session.SetTenant(config.Tenant),
-		session.SetVersion(config.Version),
-		session.SetAuthToken(config.AuthToken),
-		session.SetInsecure, session.SetTimeout(config.Timeout))
+	options, err := aviSessionOptions(config, nil)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	_, err = clients.NewAviClient(aviControllerAddress(config), config.Username,
+		append(options, session.SetLazyAuthentication(false))...)
 
 	if err != nil {
 		t.Fatalf("%+v", err)
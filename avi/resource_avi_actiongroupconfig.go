@@ -4,11 +4,11 @@
 package avi
 
 import (
+	"context"
 	"log"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/vmware/alb-sdk/go/clients"
 )
 
 func ResourceActionGroupConfigSchema() map[string]*schema.Schema {
@@ -19,10 +19,9 @@ func ResourceActionGroupConfigSchema() map[string]*schema.Schema {
 			Computed: true,
 		},
 		"autoscale_trigger_notification": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "false",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
 		},
 		"configpb_attributes": {
 			Type:     schema.TypeSet,
@@ -41,9 +40,8 @@ func ResourceActionGroupConfigSchema() map[string]*schema.Schema {
 			Computed: true,
 		},
 		"external_only": {
-			Type:         schema.TypeString,
-			Required:     true,
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Required: true,
 		},
 		"level": {
 			Type:     schema.TypeString,
@@ -78,65 +76,50 @@ func ResourceActionGroupConfigSchema() map[string]*schema.Schema {
 
 func resourceAviActionGroupConfig() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviActionGroupConfigCreate,
-		Read:   ResourceAviActionGroupConfigRead,
-		Update: resourceAviActionGroupConfigUpdate,
-		Delete: resourceAviActionGroupConfigDelete,
-		Schema: ResourceActionGroupConfigSchema(),
+		CreateContext: resourceAviActionGroupConfigCreate,
+		ReadContext:   ResourceAviActionGroupConfigRead,
+		UpdateContext: resourceAviActionGroupConfigUpdate,
+		DeleteContext: resourceAviActionGroupConfigDelete,
+		Schema:        ResourceActionGroupConfigSchema(),
 		Importer: &schema.ResourceImporter{
-			State: ResourceActionGroupConfigImporter,
+			StateContext: ResourceActionGroupConfigImporter,
 		},
 	}
 }
 
-func ResourceActionGroupConfigImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func ResourceActionGroupConfigImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	s := ResourceActionGroupConfigSchema()
-	return ResourceImporter(d, m, "actiongroupconfig", s)
+	return ResourceImporter(ctx, d, m, "actiongroupconfig", s)
 }
 
-func ResourceAviActionGroupConfigRead(d *schema.ResourceData, meta interface{}) error {
+func ResourceAviActionGroupConfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceActionGroupConfigSchema()
-	err := APIRead(d, meta, "actiongroupconfig", s)
-	if err != nil {
-		log.Printf("[ERROR] in reading object %v\n", err)
+	diags := APIRead(ctx, d, meta, "actiongroupconfig", s)
+	if diags.HasError() {
+		log.Printf("[ERROR] in reading object %v\n", diags)
 	}
-	return err
+	return diags
 }
 
-func resourceAviActionGroupConfigCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviActionGroupConfigCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceActionGroupConfigSchema()
-	err := APICreateOrUpdate(d, meta, "actiongroupconfig", s)
-	if err == nil {
-		err = ResourceAviActionGroupConfigRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "actiongroupconfig", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviActionGroupConfigRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviActionGroupConfigUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviActionGroupConfigUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceActionGroupConfigSchema()
-	var err error
-	err = APICreateOrUpdate(d, meta, "actiongroupconfig", s)
-	if err == nil {
-		err = ResourceAviActionGroupConfigRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "actiongroupconfig", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviActionGroupConfigRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviActionGroupConfigDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAviActionGroupConfigDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	objType := "actiongroupconfig"
-	client := meta.(*clients.AviClient)
-	if APIDeleteSystemDefaultCheck(d) {
-		return nil
-	}
-	uuid := d.Get("uuid").(string)
-	if uuid != "" {
-		path := "api/" + objType + "/" + uuid
-		err := client.AviSession.Delete(path)
-		if err != nil && !(strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "204") || strings.Contains(err.Error(), "403")) {
-			log.Println("[INFO] resourceAviActionGroupConfigDelete not found")
-			return err
-		}
-		d.SetId("")
-	}
-	return nil
+	return APIDelete(ctx, d, meta, objType)
 }
@@ -7,7 +7,6 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
-	"github.com/vmware/alb-sdk/go/clients"
 )
 
 func TestAVIActionGroupConfigBasic(t *testing.T) {
@@ -53,7 +52,7 @@ func TestAVIActionGroupConfigBasic(t *testing.T) {
 
 func testAccCheckAVIActionGroupConfigExists(resourcename string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*clients.AviClient).AviSession
+		conn := testAccProvider.Meta().(*ProviderClient).AviSession
 		var obj interface{}
 		rs, ok := s.RootModule().Resources[resourcename]
 		if !ok {
@@ -75,7 +74,7 @@ func testAccCheckAVIActionGroupConfigExists(resourcename string) resource.TestCh
 }
 
 func testAccCheckAVIActionGroupConfigDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*clients.AviClient).AviSession
+	conn := testAccProvider.Meta().(*ProviderClient).AviSession
 	var obj interface{}
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "avi_actiongroupconfig" {
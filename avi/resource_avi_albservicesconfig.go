@@ -4,11 +4,11 @@
 package avi
 
 import (
+	"context"
 	"log"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/vmware/alb-sdk/go/clients"
 )
 
 func ResourceALBServicesConfigSchema() map[string]*schema.Schema {
@@ -51,10 +51,9 @@ func ResourceALBServicesConfigSchema() map[string]*schema.Schema {
 			Default:  "MYVMWARE",
 		},
 		"polling_interval": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "10",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  10,
 		},
 		"portal_url": {
 			Type:     schema.TypeString,
@@ -72,16 +71,14 @@ func ResourceALBServicesConfigSchema() map[string]*schema.Schema {
 			Elem:     ResourceProxyConfigurationSchema(),
 		},
 		"use_split_proxy": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "false",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
 		},
 		"use_tls": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "true",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  true,
 		},
 		"user_agent_db_config": {
 			Type:     schema.TypeSet,
@@ -103,65 +100,50 @@ func ResourceALBServicesConfigSchema() map[string]*schema.Schema {
 
 func resourceAviALBServicesConfig() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviALBServicesConfigCreate,
-		Read:   ResourceAviALBServicesConfigRead,
-		Update: resourceAviALBServicesConfigUpdate,
-		Delete: resourceAviALBServicesConfigDelete,
-		Schema: ResourceALBServicesConfigSchema(),
+		CreateContext: resourceAviALBServicesConfigCreate,
+		ReadContext:   ResourceAviALBServicesConfigRead,
+		UpdateContext: resourceAviALBServicesConfigUpdate,
+		DeleteContext: resourceAviALBServicesConfigDelete,
+		Schema:        ResourceALBServicesConfigSchema(),
 		Importer: &schema.ResourceImporter{
-			State: ResourceALBServicesConfigImporter,
+			StateContext: ResourceALBServicesConfigImporter,
 		},
 	}
 }
 
-func ResourceALBServicesConfigImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func ResourceALBServicesConfigImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	s := ResourceALBServicesConfigSchema()
-	return ResourceImporter(d, m, "albservicesconfig", s)
+	return ResourceImporter(ctx, d, m, "albservicesconfig", s)
 }
 
-func ResourceAviALBServicesConfigRead(d *schema.ResourceData, meta interface{}) error {
+func ResourceAviALBServicesConfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceALBServicesConfigSchema()
-	err := APIRead(d, meta, "albservicesconfig", s)
-	if err != nil {
-		log.Printf("[ERROR] in reading object %v\n", err)
+	diags := APIRead(ctx, d, meta, "albservicesconfig", s)
+	if diags.HasError() {
+		log.Printf("[ERROR] in reading object %v\n", diags)
 	}
-	return err
+	return diags
 }
 
-func resourceAviALBServicesConfigCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviALBServicesConfigCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceALBServicesConfigSchema()
-	err := APICreateOrUpdate(d, meta, "albservicesconfig", s)
-	if err == nil {
-		err = ResourceAviALBServicesConfigRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "albservicesconfig", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviALBServicesConfigRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviALBServicesConfigUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviALBServicesConfigUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceALBServicesConfigSchema()
-	var err error
-	err = APICreateOrUpdate(d, meta, "albservicesconfig", s)
-	if err == nil {
-		err = ResourceAviALBServicesConfigRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "albservicesconfig", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviALBServicesConfigRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviALBServicesConfigDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAviALBServicesConfigDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	objType := "albservicesconfig"
-	client := meta.(*clients.AviClient)
-	if APIDeleteSystemDefaultCheck(d) {
-		return nil
-	}
-	uuid := d.Get("uuid").(string)
-	if uuid != "" {
-		path := "api/" + objType + "/" + uuid
-		err := client.AviSession.Delete(path)
-		if err != nil && !(strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "204") || strings.Contains(err.Error(), "403")) {
-			log.Println("[INFO] resourceAviALBServicesConfigDelete not found")
-			return err
-		}
-		d.SetId("")
-	}
-	return nil
+	return APIDelete(ctx, d, meta, objType)
 }
@@ -4,11 +4,11 @@
 package avi
 
 import (
+	"context"
 	"log"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/vmware/alb-sdk/go/clients"
 )
 
 func ResourceALBServicesFileUploadSchema() map[string]*schema.Schema {
@@ -56,65 +56,50 @@ func ResourceALBServicesFileUploadSchema() map[string]*schema.Schema {
 
 func resourceAviALBServicesFileUpload() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviALBServicesFileUploadCreate,
-		Read:   ResourceAviALBServicesFileUploadRead,
-		Update: resourceAviALBServicesFileUploadUpdate,
-		Delete: resourceAviALBServicesFileUploadDelete,
-		Schema: ResourceALBServicesFileUploadSchema(),
+		CreateContext: resourceAviALBServicesFileUploadCreate,
+		ReadContext:   ResourceAviALBServicesFileUploadRead,
+		UpdateContext: resourceAviALBServicesFileUploadUpdate,
+		DeleteContext: resourceAviALBServicesFileUploadDelete,
+		Schema:        ResourceALBServicesFileUploadSchema(),
 		Importer: &schema.ResourceImporter{
-			State: ResourceALBServicesFileUploadImporter,
+			StateContext: ResourceALBServicesFileUploadImporter,
 		},
 	}
 }
 
-func ResourceALBServicesFileUploadImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func ResourceALBServicesFileUploadImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	s := ResourceALBServicesFileUploadSchema()
-	return ResourceImporter(d, m, "albservicesfileupload", s)
+	return ResourceImporter(ctx, d, m, "albservicesfileupload", s)
 }
 
-func ResourceAviALBServicesFileUploadRead(d *schema.ResourceData, meta interface{}) error {
+func ResourceAviALBServicesFileUploadRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceALBServicesFileUploadSchema()
-	err := APIRead(d, meta, "albservicesfileupload", s)
-	if err != nil {
-		log.Printf("[ERROR] in reading object %v\n", err)
+	diags := APIRead(ctx, d, meta, "albservicesfileupload", s)
+	if diags.HasError() {
+		log.Printf("[ERROR] in reading object %v\n", diags)
 	}
-	return err
+	return diags
 }
 
-func resourceAviALBServicesFileUploadCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviALBServicesFileUploadCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceALBServicesFileUploadSchema()
-	err := APICreateOrUpdate(d, meta, "albservicesfileupload", s)
-	if err == nil {
-		err = ResourceAviALBServicesFileUploadRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "albservicesfileupload", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviALBServicesFileUploadRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviALBServicesFileUploadUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviALBServicesFileUploadUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceALBServicesFileUploadSchema()
-	var err error
-	err = APICreateOrUpdate(d, meta, "albservicesfileupload", s)
-	if err == nil {
-		err = ResourceAviALBServicesFileUploadRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "albservicesfileupload", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviALBServicesFileUploadRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviALBServicesFileUploadDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAviALBServicesFileUploadDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	objType := "albservicesfileupload"
-	client := meta.(*clients.AviClient)
-	if APIDeleteSystemDefaultCheck(d) {
-		return nil
-	}
-	uuid := d.Get("uuid").(string)
-	if uuid != "" {
-		path := "api/" + objType + "/" + uuid
-		err := client.AviSession.Delete(path)
-		if err != nil && !(strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "204") || strings.Contains(err.Error(), "403")) {
-			log.Println("[INFO] resourceAviALBServicesFileUploadDelete not found")
-			return err
-		}
-		d.SetId("")
-	}
-	return nil
+	return APIDelete(ctx, d, meta, objType)
 }
@@ -4,11 +4,11 @@
 package avi
 
 import (
+	"context"
 	"log"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/vmware/alb-sdk/go/clients"
 )
 
 func ResourceALBServicesJobSchema() map[string]*schema.Schema {
@@ -44,10 +44,9 @@ func ResourceALBServicesJobSchema() map[string]*schema.Schema {
 			Computed: true,
 		},
 		"pulse_sync_status": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Computed:     true,
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Computed: true,
 		},
 		"result": {
 			Type:     schema.TypeString,
@@ -85,65 +84,50 @@ func ResourceALBServicesJobSchema() map[string]*schema.Schema {
 
 func resourceAviALBServicesJob() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviALBServicesJobCreate,
-		Read:   ResourceAviALBServicesJobRead,
-		Update: resourceAviALBServicesJobUpdate,
-		Delete: resourceAviALBServicesJobDelete,
-		Schema: ResourceALBServicesJobSchema(),
+		CreateContext: resourceAviALBServicesJobCreate,
+		ReadContext:   ResourceAviALBServicesJobRead,
+		UpdateContext: resourceAviALBServicesJobUpdate,
+		DeleteContext: resourceAviALBServicesJobDelete,
+		Schema:        ResourceALBServicesJobSchema(),
 		Importer: &schema.ResourceImporter{
-			State: ResourceALBServicesJobImporter,
+			StateContext: ResourceALBServicesJobImporter,
 		},
 	}
 }
 
-func ResourceALBServicesJobImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func ResourceALBServicesJobImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	s := ResourceALBServicesJobSchema()
-	return ResourceImporter(d, m, "albservicesjob", s)
+	return ResourceImporter(ctx, d, m, "albservicesjob", s)
 }
 
-func ResourceAviALBServicesJobRead(d *schema.ResourceData, meta interface{}) error {
+func ResourceAviALBServicesJobRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceALBServicesJobSchema()
-	err := APIRead(d, meta, "albservicesjob", s)
-	if err != nil {
-		log.Printf("[ERROR] in reading object %v\n", err)
+	diags := APIRead(ctx, d, meta, "albservicesjob", s)
+	if diags.HasError() {
+		log.Printf("[ERROR] in reading object %v\n", diags)
 	}
-	return err
+	return diags
 }
 
-func resourceAviALBServicesJobCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviALBServicesJobCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceALBServicesJobSchema()
-	err := APICreateOrUpdate(d, meta, "albservicesjob", s)
-	if err == nil {
-		err = ResourceAviALBServicesJobRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "albservicesjob", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviALBServicesJobRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviALBServicesJobUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviALBServicesJobUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceALBServicesJobSchema()
-	var err error
-	err = APICreateOrUpdate(d, meta, "albservicesjob", s)
-	if err == nil {
-		err = ResourceAviALBServicesJobRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "albservicesjob", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviALBServicesJobRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviALBServicesJobDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAviALBServicesJobDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	objType := "albservicesjob"
-	client := meta.(*clients.AviClient)
-	if APIDeleteSystemDefaultCheck(d) {
-		return nil
-	}
-	uuid := d.Get("uuid").(string)
-	if uuid != "" {
-		path := "api/" + objType + "/" + uuid
-		err := client.AviSession.Delete(path)
-		if err != nil && !(strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "204") || strings.Contains(err.Error(), "403")) {
-			log.Println("[INFO] resourceAviALBServicesJobDelete not found")
-			return err
-		}
-		d.SetId("")
-	}
-	return nil
+	return APIDelete(ctx, d, meta, objType)
 }
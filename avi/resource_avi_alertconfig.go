@@ -4,11 +4,11 @@
 package avi
 
 import (
+	"context"
 	"log"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/vmware/alb-sdk/go/clients"
 )
 
 func ResourceAlertConfigSchema() map[string]*schema.Schema {
@@ -24,10 +24,9 @@ func ResourceAlertConfigSchema() map[string]*schema.Schema {
 			Elem:     ResourceAlertRuleSchema(),
 		},
 		"autoscale_alert": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Computed:     true,
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Computed: true,
 		},
 		"category": {
 			Type:     schema.TypeString,
@@ -45,16 +44,14 @@ func ResourceAlertConfigSchema() map[string]*schema.Schema {
 			Computed: true,
 		},
 		"enabled": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "true",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  true,
 		},
 		"expiry_time": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "86400",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  86400,
 		},
 		"name": {
 			Type:     schema.TypeString,
@@ -76,10 +73,9 @@ func ResourceAlertConfigSchema() map[string]*schema.Schema {
 			Computed: true,
 		},
 		"rolling_window": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "300",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  300,
 		},
 		"source": {
 			Type:     schema.TypeString,
@@ -96,16 +92,14 @@ func ResourceAlertConfigSchema() map[string]*schema.Schema {
 			Computed: true,
 		},
 		"threshold": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "1",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  1,
 		},
 		"throttle": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "600",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  600,
 		},
 		"uuid": {
 			Type:     schema.TypeString,
@@ -117,65 +111,50 @@ func ResourceAlertConfigSchema() map[string]*schema.Schema {
 
 func resourceAviAlertConfig() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviAlertConfigCreate,
-		Read:   ResourceAviAlertConfigRead,
-		Update: resourceAviAlertConfigUpdate,
-		Delete: resourceAviAlertConfigDelete,
-		Schema: ResourceAlertConfigSchema(),
+		CreateContext: resourceAviAlertConfigCreate,
+		ReadContext:   ResourceAviAlertConfigRead,
+		UpdateContext: resourceAviAlertConfigUpdate,
+		DeleteContext: resourceAviAlertConfigDelete,
+		Schema:        ResourceAlertConfigSchema(),
 		Importer: &schema.ResourceImporter{
-			State: ResourceAlertConfigImporter,
+			StateContext: ResourceAlertConfigImporter,
 		},
 	}
 }
 
-func ResourceAlertConfigImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func ResourceAlertConfigImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	s := ResourceAlertConfigSchema()
-	return ResourceImporter(d, m, "alertconfig", s)
+	return ResourceImporter(ctx, d, m, "alertconfig", s)
 }
 
-func ResourceAviAlertConfigRead(d *schema.ResourceData, meta interface{}) error {
+func ResourceAviAlertConfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceAlertConfigSchema()
-	err := APIRead(d, meta, "alertconfig", s)
-	if err != nil {
-		log.Printf("[ERROR] in reading object %v\n", err)
+	diags := APIRead(ctx, d, meta, "alertconfig", s)
+	if diags.HasError() {
+		log.Printf("[ERROR] in reading object %v\n", diags)
 	}
-	return err
+	return diags
 }
 
-func resourceAviAlertConfigCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviAlertConfigCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceAlertConfigSchema()
-	err := APICreateOrUpdate(d, meta, "alertconfig", s)
-	if err == nil {
-		err = ResourceAviAlertConfigRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "alertconfig", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviAlertConfigRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviAlertConfigUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviAlertConfigUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceAlertConfigSchema()
-	var err error
-	err = APICreateOrUpdate(d, meta, "alertconfig", s)
-	if err == nil {
-		err = ResourceAviAlertConfigRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "alertconfig", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviAlertConfigRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviAlertConfigDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAviAlertConfigDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	objType := "alertconfig"
-	client := meta.(*clients.AviClient)
-	if APIDeleteSystemDefaultCheck(d) {
-		return nil
-	}
-	uuid := d.Get("uuid").(string)
-	if uuid != "" {
-		path := "api/" + objType + "/" + uuid
-		err := client.AviSession.Delete(path)
-		if err != nil && !(strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "204") || strings.Contains(err.Error(), "403")) {
-			log.Println("[INFO] resourceAviAlertConfigDelete not found")
-			return err
-		}
-		d.SetId("")
-	}
-	return nil
+	return APIDelete(ctx, d, meta, objType)
 }
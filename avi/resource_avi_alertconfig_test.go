@@ -7,7 +7,6 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
-	"github.com/vmware/alb-sdk/go/clients"
 )
 
 func TestAVIAlertConfigBasic(t *testing.T) {
@@ -65,7 +64,7 @@ func TestAVIAlertConfigBasic(t *testing.T) {
 
 func testAccCheckAVIAlertConfigExists(resourcename string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*clients.AviClient).AviSession
+		conn := testAccProvider.Meta().(*ProviderClient).AviSession
 		var obj interface{}
 		rs, ok := s.RootModule().Resources[resourcename]
 		if !ok {
@@ -87,7 +86,7 @@ func testAccCheckAVIAlertConfigExists(resourcename string) resource.TestCheckFun
 }
 
 func testAccCheckAVIAlertConfigDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*clients.AviClient).AviSession
+	conn := testAccProvider.Meta().(*ProviderClient).AviSession
 	var obj interface{}
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "avi_alertconfig" {
@@ -4,11 +4,11 @@
 package avi
 
 import (
+	"context"
 	"log"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/vmware/alb-sdk/go/clients"
 )
 
 func ResourceAlertEmailConfigSchema() map[string]*schema.Schema {
@@ -52,65 +52,50 @@ func ResourceAlertEmailConfigSchema() map[string]*schema.Schema {
 
 func resourceAviAlertEmailConfig() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviAlertEmailConfigCreate,
-		Read:   ResourceAviAlertEmailConfigRead,
-		Update: resourceAviAlertEmailConfigUpdate,
-		Delete: resourceAviAlertEmailConfigDelete,
-		Schema: ResourceAlertEmailConfigSchema(),
+		CreateContext: resourceAviAlertEmailConfigCreate,
+		ReadContext:   ResourceAviAlertEmailConfigRead,
+		UpdateContext: resourceAviAlertEmailConfigUpdate,
+		DeleteContext: resourceAviAlertEmailConfigDelete,
+		Schema:        ResourceAlertEmailConfigSchema(),
 		Importer: &schema.ResourceImporter{
-			State: ResourceAlertEmailConfigImporter,
+			StateContext: ResourceAlertEmailConfigImporter,
 		},
 	}
 }
 
-func ResourceAlertEmailConfigImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func ResourceAlertEmailConfigImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	s := ResourceAlertEmailConfigSchema()
-	return ResourceImporter(d, m, "alertemailconfig", s)
+	return ResourceImporter(ctx, d, m, "alertemailconfig", s)
 }
 
-func ResourceAviAlertEmailConfigRead(d *schema.ResourceData, meta interface{}) error {
+func ResourceAviAlertEmailConfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceAlertEmailConfigSchema()
-	err := APIRead(d, meta, "alertemailconfig", s)
-	if err != nil {
-		log.Printf("[ERROR] in reading object %v\n", err)
+	diags := APIRead(ctx, d, meta, "alertemailconfig", s)
+	if diags.HasError() {
+		log.Printf("[ERROR] in reading object %v\n", diags)
 	}
-	return err
+	return diags
 }
 
-func resourceAviAlertEmailConfigCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviAlertEmailConfigCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceAlertEmailConfigSchema()
-	err := APICreateOrUpdate(d, meta, "alertemailconfig", s)
-	if err == nil {
-		err = ResourceAviAlertEmailConfigRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "alertemailconfig", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviAlertEmailConfigRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviAlertEmailConfigUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviAlertEmailConfigUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceAlertEmailConfigSchema()
-	var err error
-	err = APICreateOrUpdate(d, meta, "alertemailconfig", s)
-	if err == nil {
-		err = ResourceAviAlertEmailConfigRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "alertemailconfig", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviAlertEmailConfigRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviAlertEmailConfigDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAviAlertEmailConfigDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	objType := "alertemailconfig"
-	client := meta.(*clients.AviClient)
-	if APIDeleteSystemDefaultCheck(d) {
-		return nil
-	}
-	uuid := d.Get("uuid").(string)
-	if uuid != "" {
-		path := "api/" + objType + "/" + uuid
-		err := client.AviSession.Delete(path)
-		if err != nil && !(strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "204") || strings.Contains(err.Error(), "403")) {
-			log.Println("[INFO] resourceAviAlertEmailConfigDelete not found")
-			return err
-		}
-		d.SetId("")
-	}
-	return nil
+	return APIDelete(ctx, d, meta, objType)
 }
@@ -7,7 +7,6 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
-	"github.com/vmware/alb-sdk/go/clients"
 )
 
 func TestAVIAlertEmailConfigBasic(t *testing.T) {
@@ -45,7 +44,7 @@ func TestAVIAlertEmailConfigBasic(t *testing.T) {
 
 func testAccCheckAVIAlertEmailConfigExists(resourcename string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*clients.AviClient).AviSession
+		conn := testAccProvider.Meta().(*ProviderClient).AviSession
 		var obj interface{}
 		rs, ok := s.RootModule().Resources[resourcename]
 		if !ok {
@@ -67,7 +66,7 @@ func testAccCheckAVIAlertEmailConfigExists(resourcename string) resource.TestChe
 }
 
 func testAccCheckAVIAlertEmailConfigDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*clients.AviClient).AviSession
+	conn := testAccProvider.Meta().(*ProviderClient).AviSession
 	var obj interface{}
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "avi_alertemailconfig" {
@@ -4,11 +4,11 @@
 package avi
 
 import (
+	"context"
 	"log"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/vmware/alb-sdk/go/clients"
 )
 
 func ResourceAlertScriptConfigSchema() map[string]*schema.Schema {
@@ -43,65 +43,50 @@ func ResourceAlertScriptConfigSchema() map[string]*schema.Schema {
 
 func resourceAviAlertScriptConfig() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviAlertScriptConfigCreate,
-		Read:   ResourceAviAlertScriptConfigRead,
-		Update: resourceAviAlertScriptConfigUpdate,
-		Delete: resourceAviAlertScriptConfigDelete,
-		Schema: ResourceAlertScriptConfigSchema(),
+		CreateContext: resourceAviAlertScriptConfigCreate,
+		ReadContext:   ResourceAviAlertScriptConfigRead,
+		UpdateContext: resourceAviAlertScriptConfigUpdate,
+		DeleteContext: resourceAviAlertScriptConfigDelete,
+		Schema:        ResourceAlertScriptConfigSchema(),
 		Importer: &schema.ResourceImporter{
-			State: ResourceAlertScriptConfigImporter,
+			StateContext: ResourceAlertScriptConfigImporter,
 		},
 	}
 }
 
-func ResourceAlertScriptConfigImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func ResourceAlertScriptConfigImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	s := ResourceAlertScriptConfigSchema()
-	return ResourceImporter(d, m, "alertscriptconfig", s)
+	return ResourceImporter(ctx, d, m, "alertscriptconfig", s)
 }
 
-func ResourceAviAlertScriptConfigRead(d *schema.ResourceData, meta interface{}) error {
+func ResourceAviAlertScriptConfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceAlertScriptConfigSchema()
-	err := APIRead(d, meta, "alertscriptconfig", s)
-	if err != nil {
-		log.Printf("[ERROR] in reading object %v\n", err)
+	diags := APIRead(ctx, d, meta, "alertscriptconfig", s)
+	if diags.HasError() {
+		log.Printf("[ERROR] in reading object %v\n", diags)
 	}
-	return err
+	return diags
 }
 
-func resourceAviAlertScriptConfigCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviAlertScriptConfigCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceAlertScriptConfigSchema()
-	err := APICreateOrUpdate(d, meta, "alertscriptconfig", s)
-	if err == nil {
-		err = ResourceAviAlertScriptConfigRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "alertscriptconfig", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviAlertScriptConfigRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviAlertScriptConfigUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviAlertScriptConfigUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceAlertScriptConfigSchema()
-	var err error
-	err = APICreateOrUpdate(d, meta, "alertscriptconfig", s)
-	if err == nil {
-		err = ResourceAviAlertScriptConfigRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "alertscriptconfig", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviAlertScriptConfigRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviAlertScriptConfigDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAviAlertScriptConfigDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	objType := "alertscriptconfig"
-	client := meta.(*clients.AviClient)
-	if APIDeleteSystemDefaultCheck(d) {
-		return nil
-	}
-	uuid := d.Get("uuid").(string)
-	if uuid != "" {
-		path := "api/" + objType + "/" + uuid
-		err := client.AviSession.Delete(path)
-		if err != nil && !(strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "204") || strings.Contains(err.Error(), "403")) {
-			log.Println("[INFO] resourceAviAlertScriptConfigDelete not found")
-			return err
-		}
-		d.SetId("")
-	}
-	return nil
+	return APIDelete(ctx, d, meta, objType)
 }
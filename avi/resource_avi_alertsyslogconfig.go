@@ -4,11 +4,11 @@
 package avi
 
 import (
+	"context"
 	"log"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/vmware/alb-sdk/go/clients"
 )
 
 func ResourceAlertSyslogConfigSchema() map[string]*schema.Schema {
@@ -48,65 +48,50 @@ func ResourceAlertSyslogConfigSchema() map[string]*schema.Schema {
 
 func resourceAviAlertSyslogConfig() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviAlertSyslogConfigCreate,
-		Read:   ResourceAviAlertSyslogConfigRead,
-		Update: resourceAviAlertSyslogConfigUpdate,
-		Delete: resourceAviAlertSyslogConfigDelete,
-		Schema: ResourceAlertSyslogConfigSchema(),
+		CreateContext: resourceAviAlertSyslogConfigCreate,
+		ReadContext:   ResourceAviAlertSyslogConfigRead,
+		UpdateContext: resourceAviAlertSyslogConfigUpdate,
+		DeleteContext: resourceAviAlertSyslogConfigDelete,
+		Schema:        ResourceAlertSyslogConfigSchema(),
 		Importer: &schema.ResourceImporter{
-			State: ResourceAlertSyslogConfigImporter,
+			StateContext: ResourceAlertSyslogConfigImporter,
 		},
 	}
 }
 
-func ResourceAlertSyslogConfigImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func ResourceAlertSyslogConfigImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	s := ResourceAlertSyslogConfigSchema()
-	return ResourceImporter(d, m, "alertsyslogconfig", s)
+	return ResourceImporter(ctx, d, m, "alertsyslogconfig", s)
 }
 
-func ResourceAviAlertSyslogConfigRead(d *schema.ResourceData, meta interface{}) error {
+func ResourceAviAlertSyslogConfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceAlertSyslogConfigSchema()
-	err := APIRead(d, meta, "alertsyslogconfig", s)
-	if err != nil {
-		log.Printf("[ERROR] in reading object %v\n", err)
+	diags := APIRead(ctx, d, meta, "alertsyslogconfig", s)
+	if diags.HasError() {
+		log.Printf("[ERROR] in reading object %v\n", diags)
 	}
-	return err
+	return diags
 }
 
-func resourceAviAlertSyslogConfigCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviAlertSyslogConfigCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceAlertSyslogConfigSchema()
-	err := APICreateOrUpdate(d, meta, "alertsyslogconfig", s)
-	if err == nil {
-		err = ResourceAviAlertSyslogConfigRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "alertsyslogconfig", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviAlertSyslogConfigRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviAlertSyslogConfigUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviAlertSyslogConfigUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceAlertSyslogConfigSchema()
-	var err error
-	err = APICreateOrUpdate(d, meta, "alertsyslogconfig", s)
-	if err == nil {
-		err = ResourceAviAlertSyslogConfigRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "alertsyslogconfig", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviAlertSyslogConfigRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviAlertSyslogConfigDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAviAlertSyslogConfigDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	objType := "alertsyslogconfig"
-	client := meta.(*clients.AviClient)
-	if APIDeleteSystemDefaultCheck(d) {
-		return nil
-	}
-	uuid := d.Get("uuid").(string)
-	if uuid != "" {
-		path := "api/" + objType + "/" + uuid
-		err := client.AviSession.Delete(path)
-		if err != nil && !(strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "204") || strings.Contains(err.Error(), "403")) {
-			log.Println("[INFO] resourceAviAlertSyslogConfigDelete not found")
-			return err
-		}
-		d.SetId("")
-	}
-	return nil
+	return APIDelete(ctx, d, meta, objType)
 }
@@ -13,7 +13,6 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
-	"github.com/vmware/alb-sdk/go/clients"
 )
 
 func TestAVIAlertSyslogConfigBasic(t *testing.T) {
@@ -44,7 +43,7 @@ func TestAVIAlertSyslogConfigBasic(t *testing.T) {
 
 func testAccCheckAVIAlertSyslogConfigExists(resourcename string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*clients.AviClient).AviSession
+		conn := testAccProvider.Meta().(*ProviderClient).AviSession
 		var obj interface{}
 		rs, ok := s.RootModule().Resources[resourcename]
 		if !ok {
@@ -66,7 +65,7 @@ func testAccCheckAVIAlertSyslogConfigExists(resourcename string) resource.TestCh
 }
 
 func testAccCheckAVIAlertSyslogConfigDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*clients.AviClient).AviSession
+	conn := testAccProvider.Meta().(*ProviderClient).AviSession
 	var obj interface{}
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "avi_alertsyslogconfig" {
@@ -7,7 +7,6 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
-	"github.com/vmware/alb-sdk/go/clients"
 )
 
 func TestAVIAnalyticsProfileBasic(t *testing.T) {
@@ -281,7 +280,7 @@ func TestAVIAnalyticsProfileBasic(t *testing.T) {
 
 func testAccCheckAVIAnalyticsProfileExists(resourcename string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*clients.AviClient).AviSession
+		conn := testAccProvider.Meta().(*ProviderClient).AviSession
 		var obj interface{}
 		rs, ok := s.RootModule().Resources[resourcename]
 		if !ok {
@@ -303,7 +302,7 @@ func testAccCheckAVIAnalyticsProfileExists(resourcename string) resource.TestChe
 }
 
 func testAccCheckAVIAnalyticsProfileDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*clients.AviClient).AviSession
+	conn := testAccProvider.Meta().(*ProviderClient).AviSession
 	var obj interface{}
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "avi_analyticsprofile" {
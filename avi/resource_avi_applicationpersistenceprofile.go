@@ -4,11 +4,11 @@
 package avi
 
 import (
+	"context"
 	"log"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/vmware/alb-sdk/go/clients"
 )
 
 func ResourceApplicationPersistenceProfileSchema() map[string]*schema.Schema {
@@ -49,10 +49,9 @@ func ResourceApplicationPersistenceProfileSchema() map[string]*schema.Schema {
 			Elem:     ResourceIPPersistenceProfileSchema(),
 		},
 		"is_federated": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "false",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
 		},
 		"markers": {
 			Type:     schema.TypeList,
@@ -87,65 +86,50 @@ func ResourceApplicationPersistenceProfileSchema() map[string]*schema.Schema {
 
 func resourceAviApplicationPersistenceProfile() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviApplicationPersistenceProfileCreate,
-		Read:   ResourceAviApplicationPersistenceProfileRead,
-		Update: resourceAviApplicationPersistenceProfileUpdate,
-		Delete: resourceAviApplicationPersistenceProfileDelete,
-		Schema: ResourceApplicationPersistenceProfileSchema(),
+		CreateContext: resourceAviApplicationPersistenceProfileCreate,
+		ReadContext:   ResourceAviApplicationPersistenceProfileRead,
+		UpdateContext: resourceAviApplicationPersistenceProfileUpdate,
+		DeleteContext: resourceAviApplicationPersistenceProfileDelete,
+		Schema:        ResourceApplicationPersistenceProfileSchema(),
 		Importer: &schema.ResourceImporter{
-			State: ResourceApplicationPersistenceProfileImporter,
+			StateContext: ResourceApplicationPersistenceProfileImporter,
 		},
 	}
 }
 
-func ResourceApplicationPersistenceProfileImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func ResourceApplicationPersistenceProfileImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	s := ResourceApplicationPersistenceProfileSchema()
-	return ResourceImporter(d, m, "applicationpersistenceprofile", s)
+	return ResourceImporter(ctx, d, m, "applicationpersistenceprofile", s)
 }
 
-func ResourceAviApplicationPersistenceProfileRead(d *schema.ResourceData, meta interface{}) error {
+func ResourceAviApplicationPersistenceProfileRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceApplicationPersistenceProfileSchema()
-	err := APIRead(d, meta, "applicationpersistenceprofile", s)
-	if err != nil {
-		log.Printf("[ERROR] in reading object %v\n", err)
+	diags := APIRead(ctx, d, meta, "applicationpersistenceprofile", s)
+	if diags.HasError() {
+		log.Printf("[ERROR] in reading object %v\n", diags)
 	}
-	return err
+	return diags
 }
 
-func resourceAviApplicationPersistenceProfileCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviApplicationPersistenceProfileCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceApplicationPersistenceProfileSchema()
-	err := APICreateOrUpdate(d, meta, "applicationpersistenceprofile", s)
-	if err == nil {
-		err = ResourceAviApplicationPersistenceProfileRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "applicationpersistenceprofile", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviApplicationPersistenceProfileRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviApplicationPersistenceProfileUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviApplicationPersistenceProfileUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceApplicationPersistenceProfileSchema()
-	var err error
-	err = APICreateOrUpdate(d, meta, "applicationpersistenceprofile", s)
-	if err == nil {
-		err = ResourceAviApplicationPersistenceProfileRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "applicationpersistenceprofile", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviApplicationPersistenceProfileRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviApplicationPersistenceProfileDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAviApplicationPersistenceProfileDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	objType := "applicationpersistenceprofile"
-	client := meta.(*clients.AviClient)
-	if APIDeleteSystemDefaultCheck(d) {
-		return nil
-	}
-	uuid := d.Get("uuid").(string)
-	if uuid != "" {
-		path := "api/" + objType + "/" + uuid
-		err := client.AviSession.Delete(path)
-		if err != nil && !(strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "204") || strings.Contains(err.Error(), "403")) {
-			log.Println("[INFO] resourceAviApplicationPersistenceProfileDelete not found")
-			return err
-		}
-		d.SetId("")
-	}
-	return nil
+	return APIDelete(ctx, d, meta, objType)
 }
@@ -4,11 +4,11 @@
 package avi
 
 import (
+	"context"
 	"log"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/vmware/alb-sdk/go/clients"
 )
 
 func ResourceApplicationProfileSchema() map[string]*schema.Schema {
@@ -73,22 +73,19 @@ func ResourceApplicationProfileSchema() map[string]*schema.Schema {
 			Required: true,
 		},
 		"preserve_client_ip": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "false",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
 		},
 		"preserve_client_port": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "false",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
 		},
 		"preserve_dest_ip_port": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "false",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
 		},
 		"sip_service_profile": {
 			Type:     schema.TypeSet,
@@ -121,65 +118,50 @@ func ResourceApplicationProfileSchema() map[string]*schema.Schema {
 
 func resourceAviApplicationProfile() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviApplicationProfileCreate,
-		Read:   ResourceAviApplicationProfileRead,
-		Update: resourceAviApplicationProfileUpdate,
-		Delete: resourceAviApplicationProfileDelete,
-		Schema: ResourceApplicationProfileSchema(),
+		CreateContext: resourceAviApplicationProfileCreate,
+		ReadContext:   ResourceAviApplicationProfileRead,
+		UpdateContext: resourceAviApplicationProfileUpdate,
+		DeleteContext: resourceAviApplicationProfileDelete,
+		Schema:        ResourceApplicationProfileSchema(),
 		Importer: &schema.ResourceImporter{
-			State: ResourceApplicationProfileImporter,
+			StateContext: ResourceApplicationProfileImporter,
 		},
 	}
 }
 
-func ResourceApplicationProfileImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func ResourceApplicationProfileImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	s := ResourceApplicationProfileSchema()
-	return ResourceImporter(d, m, "applicationprofile", s)
+	return ResourceImporter(ctx, d, m, "applicationprofile", s)
 }
 
-func ResourceAviApplicationProfileRead(d *schema.ResourceData, meta interface{}) error {
+func ResourceAviApplicationProfileRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceApplicationProfileSchema()
-	err := APIRead(d, meta, "applicationprofile", s)
-	if err != nil {
-		log.Printf("[ERROR] in reading object %v\n", err)
+	diags := APIRead(ctx, d, meta, "applicationprofile", s)
+	if diags.HasError() {
+		log.Printf("[ERROR] in reading object %v\n", diags)
 	}
-	return err
+	return diags
 }
 
-func resourceAviApplicationProfileCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviApplicationProfileCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceApplicationProfileSchema()
-	err := APICreateOrUpdate(d, meta, "applicationprofile", s)
-	if err == nil {
-		err = ResourceAviApplicationProfileRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "applicationprofile", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviApplicationProfileRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviApplicationProfileUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviApplicationProfileUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceApplicationProfileSchema()
-	var err error
-	err = APICreateOrUpdate(d, meta, "applicationprofile", s)
-	if err == nil {
-		err = ResourceAviApplicationProfileRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "applicationprofile", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviApplicationProfileRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviApplicationProfileDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAviApplicationProfileDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	objType := "applicationprofile"
-	client := meta.(*clients.AviClient)
-	if APIDeleteSystemDefaultCheck(d) {
-		return nil
-	}
-	uuid := d.Get("uuid").(string)
-	if uuid != "" {
-		path := "api/" + objType + "/" + uuid
-		err := client.AviSession.Delete(path)
-		if err != nil && !(strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "204") || strings.Contains(err.Error(), "403")) {
-			log.Println("[INFO] resourceAviApplicationProfileDelete not found")
-			return err
-		}
-		d.SetId("")
-	}
-	return nil
+	return APIDelete(ctx, d, meta, objType)
 }
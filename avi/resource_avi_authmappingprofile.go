@@ -4,11 +4,11 @@
 package avi
 
 import (
+	"context"
 	"log"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/vmware/alb-sdk/go/clients"
 )
 
 func ResourceAuthMappingProfileSchema() map[string]*schema.Schema {
@@ -52,65 +52,50 @@ func ResourceAuthMappingProfileSchema() map[string]*schema.Schema {
 
 func resourceAviAuthMappingProfile() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviAuthMappingProfileCreate,
-		Read:   ResourceAviAuthMappingProfileRead,
-		Update: resourceAviAuthMappingProfileUpdate,
-		Delete: resourceAviAuthMappingProfileDelete,
-		Schema: ResourceAuthMappingProfileSchema(),
+		CreateContext: resourceAviAuthMappingProfileCreate,
+		ReadContext:   ResourceAviAuthMappingProfileRead,
+		UpdateContext: resourceAviAuthMappingProfileUpdate,
+		DeleteContext: resourceAviAuthMappingProfileDelete,
+		Schema:        ResourceAuthMappingProfileSchema(),
 		Importer: &schema.ResourceImporter{
-			State: ResourceAuthMappingProfileImporter,
+			StateContext: ResourceAuthMappingProfileImporter,
 		},
 	}
 }
 
-func ResourceAuthMappingProfileImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func ResourceAuthMappingProfileImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	s := ResourceAuthMappingProfileSchema()
-	return ResourceImporter(d, m, "authmappingprofile", s)
+	return ResourceImporter(ctx, d, m, "authmappingprofile", s)
 }
 
-func ResourceAviAuthMappingProfileRead(d *schema.ResourceData, meta interface{}) error {
+func ResourceAviAuthMappingProfileRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceAuthMappingProfileSchema()
-	err := APIRead(d, meta, "authmappingprofile", s)
-	if err != nil {
-		log.Printf("[ERROR] in reading object %v\n", err)
+	diags := APIRead(ctx, d, meta, "authmappingprofile", s)
+	if diags.HasError() {
+		log.Printf("[ERROR] in reading object %v\n", diags)
 	}
-	return err
+	return diags
 }
 
-func resourceAviAuthMappingProfileCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviAuthMappingProfileCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceAuthMappingProfileSchema()
-	err := APICreateOrUpdate(d, meta, "authmappingprofile", s)
-	if err == nil {
-		err = ResourceAviAuthMappingProfileRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "authmappingprofile", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviAuthMappingProfileRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviAuthMappingProfileUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviAuthMappingProfileUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceAuthMappingProfileSchema()
-	var err error
-	err = APICreateOrUpdate(d, meta, "authmappingprofile", s)
-	if err == nil {
-		err = ResourceAviAuthMappingProfileRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "authmappingprofile", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviAuthMappingProfileRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviAuthMappingProfileDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAviAuthMappingProfileDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	objType := "authmappingprofile"
-	client := meta.(*clients.AviClient)
-	if APIDeleteSystemDefaultCheck(d) {
-		return nil
-	}
-	uuid := d.Get("uuid").(string)
-	if uuid != "" {
-		path := "api/" + objType + "/" + uuid
-		err := client.AviSession.Delete(path)
-		if err != nil && !(strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "204") || strings.Contains(err.Error(), "403")) {
-			log.Println("[INFO] resourceAviAuthMappingProfileDelete not found")
-			return err
-		}
-		d.SetId("")
-	}
-	return nil
+	return APIDelete(ctx, d, meta, objType)
 }
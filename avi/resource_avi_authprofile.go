@@ -4,11 +4,11 @@
 package avi
 
 import (
+	"context"
 	"log"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/vmware/alb-sdk/go/clients"
 )
 
 func ResourceAuthProfileSchema() map[string]*schema.Schema {
@@ -92,65 +92,50 @@ func ResourceAuthProfileSchema() map[string]*schema.Schema {
 
 func resourceAviAuthProfile() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviAuthProfileCreate,
-		Read:   ResourceAviAuthProfileRead,
-		Update: resourceAviAuthProfileUpdate,
-		Delete: resourceAviAuthProfileDelete,
-		Schema: ResourceAuthProfileSchema(),
+		CreateContext: resourceAviAuthProfileCreate,
+		ReadContext:   ResourceAviAuthProfileRead,
+		UpdateContext: resourceAviAuthProfileUpdate,
+		DeleteContext: resourceAviAuthProfileDelete,
+		Schema:        ResourceAuthProfileSchema(),
 		Importer: &schema.ResourceImporter{
-			State: ResourceAuthProfileImporter,
+			StateContext: ResourceAuthProfileImporter,
 		},
 	}
 }
 
-func ResourceAuthProfileImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func ResourceAuthProfileImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	s := ResourceAuthProfileSchema()
-	return ResourceImporter(d, m, "authprofile", s)
+	return ResourceImporter(ctx, d, m, "authprofile", s)
 }
 
-func ResourceAviAuthProfileRead(d *schema.ResourceData, meta interface{}) error {
+func ResourceAviAuthProfileRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceAuthProfileSchema()
-	err := APIRead(d, meta, "authprofile", s)
-	if err != nil {
-		log.Printf("[ERROR] in reading object %v\n", err)
+	diags := APIRead(ctx, d, meta, "authprofile", s)
+	if diags.HasError() {
+		log.Printf("[ERROR] in reading object %v\n", diags)
 	}
-	return err
+	return diags
 }
 
-func resourceAviAuthProfileCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviAuthProfileCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceAuthProfileSchema()
-	err := APICreateOrUpdate(d, meta, "authprofile", s)
-	if err == nil {
-		err = ResourceAviAuthProfileRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "authprofile", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviAuthProfileRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviAuthProfileUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviAuthProfileUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceAuthProfileSchema()
-	var err error
-	err = APICreateOrUpdate(d, meta, "authprofile", s)
-	if err == nil {
-		err = ResourceAviAuthProfileRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "authprofile", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviAuthProfileRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviAuthProfileDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAviAuthProfileDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	objType := "authprofile"
-	client := meta.(*clients.AviClient)
-	if APIDeleteSystemDefaultCheck(d) {
-		return nil
-	}
-	uuid := d.Get("uuid").(string)
-	if uuid != "" {
-		path := "api/" + objType + "/" + uuid
-		err := client.AviSession.Delete(path)
-		if err != nil && !(strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "204") || strings.Contains(err.Error(), "403")) {
-			log.Println("[INFO] resourceAviAuthProfileDelete not found")
-			return err
-		}
-		d.SetId("")
-	}
-	return nil
+	return APIDelete(ctx, d, meta, objType)
 }
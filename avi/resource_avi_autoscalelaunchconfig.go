@@ -4,11 +4,11 @@
 package avi
 
 import (
+	"context"
 	"log"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/vmware/alb-sdk/go/clients"
 )
 
 func ResourceAutoScaleLaunchConfigSchema() map[string]*schema.Schema {
@@ -56,10 +56,9 @@ func ResourceAutoScaleLaunchConfigSchema() map[string]*schema.Schema {
 			Computed: true,
 		},
 		"use_external_asg": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "true",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  true,
 		},
 		"uuid": {
 			Type:     schema.TypeString,
@@ -71,65 +70,50 @@ func ResourceAutoScaleLaunchConfigSchema() map[string]*schema.Schema {
 
 func resourceAviAutoScaleLaunchConfig() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviAutoScaleLaunchConfigCreate,
-		Read:   ResourceAviAutoScaleLaunchConfigRead,
-		Update: resourceAviAutoScaleLaunchConfigUpdate,
-		Delete: resourceAviAutoScaleLaunchConfigDelete,
-		Schema: ResourceAutoScaleLaunchConfigSchema(),
+		CreateContext: resourceAviAutoScaleLaunchConfigCreate,
+		ReadContext:   ResourceAviAutoScaleLaunchConfigRead,
+		UpdateContext: resourceAviAutoScaleLaunchConfigUpdate,
+		DeleteContext: resourceAviAutoScaleLaunchConfigDelete,
+		Schema:        ResourceAutoScaleLaunchConfigSchema(),
 		Importer: &schema.ResourceImporter{
-			State: ResourceAutoScaleLaunchConfigImporter,
+			StateContext: ResourceAutoScaleLaunchConfigImporter,
 		},
 	}
 }
 
-func ResourceAutoScaleLaunchConfigImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func ResourceAutoScaleLaunchConfigImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	s := ResourceAutoScaleLaunchConfigSchema()
-	return ResourceImporter(d, m, "autoscalelaunchconfig", s)
+	return ResourceImporter(ctx, d, m, "autoscalelaunchconfig", s)
 }
 
-func ResourceAviAutoScaleLaunchConfigRead(d *schema.ResourceData, meta interface{}) error {
+func ResourceAviAutoScaleLaunchConfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceAutoScaleLaunchConfigSchema()
-	err := APIRead(d, meta, "autoscalelaunchconfig", s)
-	if err != nil {
-		log.Printf("[ERROR] in reading object %v\n", err)
+	diags := APIRead(ctx, d, meta, "autoscalelaunchconfig", s)
+	if diags.HasError() {
+		log.Printf("[ERROR] in reading object %v\n", diags)
 	}
-	return err
+	return diags
 }
 
-func resourceAviAutoScaleLaunchConfigCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviAutoScaleLaunchConfigCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceAutoScaleLaunchConfigSchema()
-	err := APICreateOrUpdate(d, meta, "autoscalelaunchconfig", s)
-	if err == nil {
-		err = ResourceAviAutoScaleLaunchConfigRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "autoscalelaunchconfig", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviAutoScaleLaunchConfigRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviAutoScaleLaunchConfigUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviAutoScaleLaunchConfigUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceAutoScaleLaunchConfigSchema()
-	var err error
-	err = APICreateOrUpdate(d, meta, "autoscalelaunchconfig", s)
-	if err == nil {
-		err = ResourceAviAutoScaleLaunchConfigRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "autoscalelaunchconfig", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviAutoScaleLaunchConfigRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviAutoScaleLaunchConfigDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAviAutoScaleLaunchConfigDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	objType := "autoscalelaunchconfig"
-	client := meta.(*clients.AviClient)
-	if APIDeleteSystemDefaultCheck(d) {
-		return nil
-	}
-	uuid := d.Get("uuid").(string)
-	if uuid != "" {
-		path := "api/" + objType + "/" + uuid
-		err := client.AviSession.Delete(path)
-		if err != nil && !(strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "204") || strings.Contains(err.Error(), "403")) {
-			log.Println("[INFO] resourceAviAutoScaleLaunchConfigDelete not found")
-			return err
-		}
-		d.SetId("")
-	}
-	return nil
+	return APIDelete(ctx, d, meta, objType)
 }
@@ -7,7 +7,6 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
-	"github.com/vmware/alb-sdk/go/clients"
 )
 
 func TestAVIAutoScaleLaunchConfigBasic(t *testing.T) {
@@ -49,7 +48,7 @@ func TestAVIAutoScaleLaunchConfigBasic(t *testing.T) {
 
 func testAccCheckAVIAutoScaleLaunchConfigExists(resourcename string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*clients.AviClient).AviSession
+		conn := testAccProvider.Meta().(*ProviderClient).AviSession
 		var obj interface{}
 		rs, ok := s.RootModule().Resources[resourcename]
 		if !ok {
@@ -71,7 +70,7 @@ func testAccCheckAVIAutoScaleLaunchConfigExists(resourcename string) resource.Te
 }
 
 func testAccCheckAVIAutoScaleLaunchConfigDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*clients.AviClient).AviSession
+	conn := testAccProvider.Meta().(*ProviderClient).AviSession
 	var obj interface{}
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "avi_autoscalelaunchconfig" {
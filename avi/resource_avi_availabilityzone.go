@@ -4,11 +4,11 @@
 package avi
 
 import (
+	"context"
 	"log"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/vmware/alb-sdk/go/clients"
 )
 
 func ResourceAvailabilityZoneSchema() map[string]*schema.Schema {
@@ -48,65 +48,50 @@ func ResourceAvailabilityZoneSchema() map[string]*schema.Schema {
 
 func resourceAviAvailabilityZone() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviAvailabilityZoneCreate,
-		Read:   ResourceAviAvailabilityZoneRead,
-		Update: resourceAviAvailabilityZoneUpdate,
-		Delete: resourceAviAvailabilityZoneDelete,
-		Schema: ResourceAvailabilityZoneSchema(),
+		CreateContext: resourceAviAvailabilityZoneCreate,
+		ReadContext:   ResourceAviAvailabilityZoneRead,
+		UpdateContext: resourceAviAvailabilityZoneUpdate,
+		DeleteContext: resourceAviAvailabilityZoneDelete,
+		Schema:        ResourceAvailabilityZoneSchema(),
 		Importer: &schema.ResourceImporter{
-			State: ResourceAvailabilityZoneImporter,
+			StateContext: ResourceAvailabilityZoneImporter,
 		},
 	}
 }
 
-func ResourceAvailabilityZoneImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func ResourceAvailabilityZoneImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	s := ResourceAvailabilityZoneSchema()
-	return ResourceImporter(d, m, "availabilityzone", s)
+	return ResourceImporter(ctx, d, m, "availabilityzone", s)
 }
 
-func ResourceAviAvailabilityZoneRead(d *schema.ResourceData, meta interface{}) error {
+func ResourceAviAvailabilityZoneRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceAvailabilityZoneSchema()
-	err := APIRead(d, meta, "availabilityzone", s)
-	if err != nil {
-		log.Printf("[ERROR] in reading object %v\n", err)
+	diags := APIRead(ctx, d, meta, "availabilityzone", s)
+	if diags.HasError() {
+		log.Printf("[ERROR] in reading object %v\n", diags)
 	}
-	return err
+	return diags
 }
 
-func resourceAviAvailabilityZoneCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviAvailabilityZoneCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceAvailabilityZoneSchema()
-	err := APICreateOrUpdate(d, meta, "availabilityzone", s)
-	if err == nil {
-		err = ResourceAviAvailabilityZoneRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "availabilityzone", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviAvailabilityZoneRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviAvailabilityZoneUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviAvailabilityZoneUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceAvailabilityZoneSchema()
-	var err error
-	err = APICreateOrUpdate(d, meta, "availabilityzone", s)
-	if err == nil {
-		err = ResourceAviAvailabilityZoneRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "availabilityzone", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviAvailabilityZoneRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviAvailabilityZoneDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAviAvailabilityZoneDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	objType := "availabilityzone"
-	client := meta.(*clients.AviClient)
-	if APIDeleteSystemDefaultCheck(d) {
-		return nil
-	}
-	uuid := d.Get("uuid").(string)
-	if uuid != "" {
-		path := "api/" + objType + "/" + uuid
-		err := client.AviSession.Delete(path)
-		if err != nil && !(strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "204") || strings.Contains(err.Error(), "403")) {
-			log.Println("[INFO] resourceAviAvailabilityZoneDelete not found")
-			return err
-		}
-		d.SetId("")
-	}
-	return nil
+	return APIDelete(ctx, d, meta, objType)
 }
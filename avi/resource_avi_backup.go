@@ -4,11 +4,11 @@
 package avi
 
 import (
+	"context"
 	"log"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/vmware/alb-sdk/go/clients"
 )
 
 func ResourceBackupSchema() map[string]*schema.Schema {
@@ -57,65 +57,50 @@ func ResourceBackupSchema() map[string]*schema.Schema {
 
 func resourceAviBackup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviBackupCreate,
-		Read:   ResourceAviBackupRead,
-		Update: resourceAviBackupUpdate,
-		Delete: resourceAviBackupDelete,
-		Schema: ResourceBackupSchema(),
+		CreateContext: resourceAviBackupCreate,
+		ReadContext:   ResourceAviBackupRead,
+		UpdateContext: resourceAviBackupUpdate,
+		DeleteContext: resourceAviBackupDelete,
+		Schema:        ResourceBackupSchema(),
 		Importer: &schema.ResourceImporter{
-			State: ResourceBackupImporter,
+			StateContext: ResourceBackupImporter,
 		},
 	}
 }
 
-func ResourceBackupImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func ResourceBackupImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	s := ResourceBackupSchema()
-	return ResourceImporter(d, m, "backup", s)
+	return ResourceImporter(ctx, d, m, "backup", s)
 }
 
-func ResourceAviBackupRead(d *schema.ResourceData, meta interface{}) error {
+func ResourceAviBackupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceBackupSchema()
-	err := APIRead(d, meta, "backup", s)
-	if err != nil {
-		log.Printf("[ERROR] in reading object %v\n", err)
+	diags := APIRead(ctx, d, meta, "backup", s)
+	if diags.HasError() {
+		log.Printf("[ERROR] in reading object %v\n", diags)
 	}
-	return err
+	return diags
 }
 
-func resourceAviBackupCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviBackupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceBackupSchema()
-	err := APICreateOrUpdate(d, meta, "backup", s)
-	if err == nil {
-		err = ResourceAviBackupRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "backup", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviBackupRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviBackupUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviBackupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceBackupSchema()
-	var err error
-	err = APICreateOrUpdate(d, meta, "backup", s)
-	if err == nil {
-		err = ResourceAviBackupRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "backup", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviBackupRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviBackupDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAviBackupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	objType := "backup"
-	client := meta.(*clients.AviClient)
-	if APIDeleteSystemDefaultCheck(d) {
-		return nil
-	}
-	uuid := d.Get("uuid").(string)
-	if uuid != "" {
-		path := "api/" + objType + "/" + uuid
-		err := client.AviSession.Delete(path)
-		if err != nil && !(strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "204") || strings.Contains(err.Error(), "403")) {
-			log.Println("[INFO] resourceAviBackupDelete not found")
-			return err
-		}
-		d.SetId("")
-	}
-	return nil
+	return APIDelete(ctx, d, meta, objType)
 }
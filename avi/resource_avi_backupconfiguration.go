@@ -4,11 +4,11 @@
 package avi
 
 import (
+	"context"
 	"log"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/vmware/alb-sdk/go/clients"
 )
 
 func ResourceBackupConfigurationSchema() map[string]*schema.Schema {
@@ -56,10 +56,9 @@ func ResourceBackupConfigurationSchema() map[string]*schema.Schema {
 			Elem:     ResourceConfigPbAttributesSchema(),
 		},
 		"maximum_backups_stored": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "4",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  4,
 		},
 		"name": {
 			Type:     schema.TypeString,
@@ -81,10 +80,9 @@ func ResourceBackupConfigurationSchema() map[string]*schema.Schema {
 			Computed: true,
 		},
 		"save_local": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Computed:     true,
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Computed: true,
 		},
 		"ssh_user_ref": {
 			Type:     schema.TypeString,
@@ -97,16 +95,14 @@ func ResourceBackupConfigurationSchema() map[string]*schema.Schema {
 			Computed: true,
 		},
 		"upload_to_remote_host": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Computed:     true,
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Computed: true,
 		},
 		"upload_to_s3": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Computed:     true,
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Computed: true,
 		},
 		"uuid": {
 			Type:     schema.TypeString,
@@ -118,65 +114,50 @@ func ResourceBackupConfigurationSchema() map[string]*schema.Schema {
 
 func resourceAviBackupConfiguration() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviBackupConfigurationCreate,
-		Read:   ResourceAviBackupConfigurationRead,
-		Update: resourceAviBackupConfigurationUpdate,
-		Delete: resourceAviBackupConfigurationDelete,
-		Schema: ResourceBackupConfigurationSchema(),
+		CreateContext: resourceAviBackupConfigurationCreate,
+		ReadContext:   ResourceAviBackupConfigurationRead,
+		UpdateContext: resourceAviBackupConfigurationUpdate,
+		DeleteContext: resourceAviBackupConfigurationDelete,
+		Schema:        ResourceBackupConfigurationSchema(),
 		Importer: &schema.ResourceImporter{
-			State: ResourceBackupConfigurationImporter,
+			StateContext: ResourceBackupConfigurationImporter,
 		},
 	}
 }
 
-func ResourceBackupConfigurationImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func ResourceBackupConfigurationImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	s := ResourceBackupConfigurationSchema()
-	return ResourceImporter(d, m, "backupconfiguration", s)
+	return ResourceImporter(ctx, d, m, "backupconfiguration", s)
 }
 
-func ResourceAviBackupConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+func ResourceAviBackupConfigurationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceBackupConfigurationSchema()
-	err := APIRead(d, meta, "backupconfiguration", s)
-	if err != nil {
-		log.Printf("[ERROR] in reading object %v\n", err)
+	diags := APIRead(ctx, d, meta, "backupconfiguration", s)
+	if diags.HasError() {
+		log.Printf("[ERROR] in reading object %v\n", diags)
 	}
-	return err
+	return diags
 }
 
-func resourceAviBackupConfigurationCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviBackupConfigurationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceBackupConfigurationSchema()
-	err := APICreateOrUpdate(d, meta, "backupconfiguration", s)
-	if err == nil {
-		err = ResourceAviBackupConfigurationRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "backupconfiguration", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviBackupConfigurationRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviBackupConfigurationUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviBackupConfigurationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceBackupConfigurationSchema()
-	var err error
-	err = APICreateOrUpdate(d, meta, "backupconfiguration", s)
-	if err == nil {
-		err = ResourceAviBackupConfigurationRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "backupconfiguration", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviBackupConfigurationRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviBackupConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAviBackupConfigurationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	objType := "backupconfiguration"
-	client := meta.(*clients.AviClient)
-	if APIDeleteSystemDefaultCheck(d) {
-		return nil
-	}
-	uuid := d.Get("uuid").(string)
-	if uuid != "" {
-		path := "api/" + objType + "/" + uuid
-		err := client.AviSession.Delete(path)
-		if err != nil && !(strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "204") || strings.Contains(err.Error(), "403")) {
-			log.Println("[INFO] resourceAviBackupConfigurationDelete not found")
-			return err
-		}
-		d.SetId("")
-	}
-	return nil
+	return APIDelete(ctx, d, meta, objType)
 }
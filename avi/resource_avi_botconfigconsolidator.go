@@ -4,11 +4,11 @@
 package avi
 
 import (
+	"context"
 	"log"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/vmware/alb-sdk/go/clients"
 )
 
 func ResourceBotConfigConsolidatorSchema() map[string]*schema.Schema {
@@ -42,65 +42,50 @@ func ResourceBotConfigConsolidatorSchema() map[string]*schema.Schema {
 
 func resourceAviBotConfigConsolidator() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviBotConfigConsolidatorCreate,
-		Read:   ResourceAviBotConfigConsolidatorRead,
-		Update: resourceAviBotConfigConsolidatorUpdate,
-		Delete: resourceAviBotConfigConsolidatorDelete,
-		Schema: ResourceBotConfigConsolidatorSchema(),
+		CreateContext: resourceAviBotConfigConsolidatorCreate,
+		ReadContext:   ResourceAviBotConfigConsolidatorRead,
+		UpdateContext: resourceAviBotConfigConsolidatorUpdate,
+		DeleteContext: resourceAviBotConfigConsolidatorDelete,
+		Schema:        ResourceBotConfigConsolidatorSchema(),
 		Importer: &schema.ResourceImporter{
-			State: ResourceBotConfigConsolidatorImporter,
+			StateContext: ResourceBotConfigConsolidatorImporter,
 		},
 	}
 }
 
-func ResourceBotConfigConsolidatorImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func ResourceBotConfigConsolidatorImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	s := ResourceBotConfigConsolidatorSchema()
-	return ResourceImporter(d, m, "botconfigconsolidator", s)
+	return ResourceImporter(ctx, d, m, "botconfigconsolidator", s)
 }
 
-func ResourceAviBotConfigConsolidatorRead(d *schema.ResourceData, meta interface{}) error {
+func ResourceAviBotConfigConsolidatorRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceBotConfigConsolidatorSchema()
-	err := APIRead(d, meta, "botconfigconsolidator", s)
-	if err != nil {
-		log.Printf("[ERROR] in reading object %v\n", err)
+	diags := APIRead(ctx, d, meta, "botconfigconsolidator", s)
+	if diags.HasError() {
+		log.Printf("[ERROR] in reading object %v\n", diags)
 	}
-	return err
+	return diags
 }
 
-func resourceAviBotConfigConsolidatorCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviBotConfigConsolidatorCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceBotConfigConsolidatorSchema()
-	err := APICreateOrUpdate(d, meta, "botconfigconsolidator", s)
-	if err == nil {
-		err = ResourceAviBotConfigConsolidatorRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "botconfigconsolidator", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviBotConfigConsolidatorRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviBotConfigConsolidatorUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviBotConfigConsolidatorUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceBotConfigConsolidatorSchema()
-	var err error
-	err = APICreateOrUpdate(d, meta, "botconfigconsolidator", s)
-	if err == nil {
-		err = ResourceAviBotConfigConsolidatorRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "botconfigconsolidator", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviBotConfigConsolidatorRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviBotConfigConsolidatorDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAviBotConfigConsolidatorDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	objType := "botconfigconsolidator"
-	client := meta.(*clients.AviClient)
-	if APIDeleteSystemDefaultCheck(d) {
-		return nil
-	}
-	uuid := d.Get("uuid").(string)
-	if uuid != "" {
-		path := "api/" + objType + "/" + uuid
-		err := client.AviSession.Delete(path)
-		if err != nil && !(strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "204") || strings.Contains(err.Error(), "403")) {
-			log.Println("[INFO] resourceAviBotConfigConsolidatorDelete not found")
-			return err
-		}
-		d.SetId("")
-	}
-	return nil
+	return APIDelete(ctx, d, meta, objType)
 }
@@ -4,11 +4,11 @@
 package avi
 
 import (
+	"context"
 	"log"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/vmware/alb-sdk/go/clients"
 )
 
 func ResourceBotDetectionPolicySchema() map[string]*schema.Schema {
@@ -78,65 +78,50 @@ func ResourceBotDetectionPolicySchema() map[string]*schema.Schema {
 
 func resourceAviBotDetectionPolicy() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviBotDetectionPolicyCreate,
-		Read:   ResourceAviBotDetectionPolicyRead,
-		Update: resourceAviBotDetectionPolicyUpdate,
-		Delete: resourceAviBotDetectionPolicyDelete,
-		Schema: ResourceBotDetectionPolicySchema(),
+		CreateContext: resourceAviBotDetectionPolicyCreate,
+		ReadContext:   ResourceAviBotDetectionPolicyRead,
+		UpdateContext: resourceAviBotDetectionPolicyUpdate,
+		DeleteContext: resourceAviBotDetectionPolicyDelete,
+		Schema:        ResourceBotDetectionPolicySchema(),
 		Importer: &schema.ResourceImporter{
-			State: ResourceBotDetectionPolicyImporter,
+			StateContext: ResourceBotDetectionPolicyImporter,
 		},
 	}
 }
 
-func ResourceBotDetectionPolicyImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func ResourceBotDetectionPolicyImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	s := ResourceBotDetectionPolicySchema()
-	return ResourceImporter(d, m, "botdetectionpolicy", s)
+	return ResourceImporter(ctx, d, m, "botdetectionpolicy", s)
 }
 
-func ResourceAviBotDetectionPolicyRead(d *schema.ResourceData, meta interface{}) error {
+func ResourceAviBotDetectionPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceBotDetectionPolicySchema()
-	err := APIRead(d, meta, "botdetectionpolicy", s)
-	if err != nil {
-		log.Printf("[ERROR] in reading object %v\n", err)
+	diags := APIRead(ctx, d, meta, "botdetectionpolicy", s)
+	if diags.HasError() {
+		log.Printf("[ERROR] in reading object %v\n", diags)
 	}
-	return err
+	return diags
 }
 
-func resourceAviBotDetectionPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviBotDetectionPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceBotDetectionPolicySchema()
-	err := APICreateOrUpdate(d, meta, "botdetectionpolicy", s)
-	if err == nil {
-		err = ResourceAviBotDetectionPolicyRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "botdetectionpolicy", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviBotDetectionPolicyRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviBotDetectionPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviBotDetectionPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceBotDetectionPolicySchema()
-	var err error
-	err = APICreateOrUpdate(d, meta, "botdetectionpolicy", s)
-	if err == nil {
-		err = ResourceAviBotDetectionPolicyRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "botdetectionpolicy", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviBotDetectionPolicyRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviBotDetectionPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAviBotDetectionPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	objType := "botdetectionpolicy"
-	client := meta.(*clients.AviClient)
-	if APIDeleteSystemDefaultCheck(d) {
-		return nil
-	}
-	uuid := d.Get("uuid").(string)
-	if uuid != "" {
-		path := "api/" + objType + "/" + uuid
-		err := client.AviSession.Delete(path)
-		if err != nil && !(strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "204") || strings.Contains(err.Error(), "403")) {
-			log.Println("[INFO] resourceAviBotDetectionPolicyDelete not found")
-			return err
-		}
-		d.SetId("")
-	}
-	return nil
+	return APIDelete(ctx, d, meta, objType)
 }
@@ -4,11 +4,11 @@
 package avi
 
 import (
+	"context"
 	"log"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/vmware/alb-sdk/go/clients"
 )
 
 func ResourceBotIPReputationTypeMappingSchema() map[string]*schema.Schema {
@@ -37,65 +37,50 @@ func ResourceBotIPReputationTypeMappingSchema() map[string]*schema.Schema {
 
 func resourceAviBotIPReputationTypeMapping() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviBotIPReputationTypeMappingCreate,
-		Read:   ResourceAviBotIPReputationTypeMappingRead,
-		Update: resourceAviBotIPReputationTypeMappingUpdate,
-		Delete: resourceAviBotIPReputationTypeMappingDelete,
-		Schema: ResourceBotIPReputationTypeMappingSchema(),
+		CreateContext: resourceAviBotIPReputationTypeMappingCreate,
+		ReadContext:   ResourceAviBotIPReputationTypeMappingRead,
+		UpdateContext: resourceAviBotIPReputationTypeMappingUpdate,
+		DeleteContext: resourceAviBotIPReputationTypeMappingDelete,
+		Schema:        ResourceBotIPReputationTypeMappingSchema(),
 		Importer: &schema.ResourceImporter{
-			State: ResourceBotIPReputationTypeMappingImporter,
+			StateContext: ResourceBotIPReputationTypeMappingImporter,
 		},
 	}
 }
 
-func ResourceBotIPReputationTypeMappingImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func ResourceBotIPReputationTypeMappingImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	s := ResourceBotIPReputationTypeMappingSchema()
-	return ResourceImporter(d, m, "botipreputationtypemapping", s)
+	return ResourceImporter(ctx, d, m, "botipreputationtypemapping", s)
 }
 
-func ResourceAviBotIPReputationTypeMappingRead(d *schema.ResourceData, meta interface{}) error {
+func ResourceAviBotIPReputationTypeMappingRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceBotIPReputationTypeMappingSchema()
-	err := APIRead(d, meta, "botipreputationtypemapping", s)
-	if err != nil {
-		log.Printf("[ERROR] in reading object %v\n", err)
+	diags := APIRead(ctx, d, meta, "botipreputationtypemapping", s)
+	if diags.HasError() {
+		log.Printf("[ERROR] in reading object %v\n", diags)
 	}
-	return err
+	return diags
 }
 
-func resourceAviBotIPReputationTypeMappingCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviBotIPReputationTypeMappingCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceBotIPReputationTypeMappingSchema()
-	err := APICreateOrUpdate(d, meta, "botipreputationtypemapping", s)
-	if err == nil {
-		err = ResourceAviBotIPReputationTypeMappingRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "botipreputationtypemapping", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviBotIPReputationTypeMappingRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviBotIPReputationTypeMappingUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviBotIPReputationTypeMappingUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceBotIPReputationTypeMappingSchema()
-	var err error
-	err = APICreateOrUpdate(d, meta, "botipreputationtypemapping", s)
-	if err == nil {
-		err = ResourceAviBotIPReputationTypeMappingRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "botipreputationtypemapping", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviBotIPReputationTypeMappingRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviBotIPReputationTypeMappingDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAviBotIPReputationTypeMappingDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	objType := "botipreputationtypemapping"
-	client := meta.(*clients.AviClient)
-	if APIDeleteSystemDefaultCheck(d) {
-		return nil
-	}
-	uuid := d.Get("uuid").(string)
-	if uuid != "" {
-		path := "api/" + objType + "/" + uuid
-		err := client.AviSession.Delete(path)
-		if err != nil && !(strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "204") || strings.Contains(err.Error(), "403")) {
-			log.Println("[INFO] resourceAviBotIPReputationTypeMappingDelete not found")
-			return err
-		}
-		d.SetId("")
-	}
-	return nil
+	return APIDelete(ctx, d, meta, objType)
 }
@@ -4,11 +4,11 @@
 package avi
 
 import (
+	"context"
 	"log"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/vmware/alb-sdk/go/clients"
 )
 
 func ResourceBotMappingSchema() map[string]*schema.Schema {
@@ -37,65 +37,50 @@ func ResourceBotMappingSchema() map[string]*schema.Schema {
 
 func resourceAviBotMapping() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviBotMappingCreate,
-		Read:   ResourceAviBotMappingRead,
-		Update: resourceAviBotMappingUpdate,
-		Delete: resourceAviBotMappingDelete,
-		Schema: ResourceBotMappingSchema(),
+		CreateContext: resourceAviBotMappingCreate,
+		ReadContext:   ResourceAviBotMappingRead,
+		UpdateContext: resourceAviBotMappingUpdate,
+		DeleteContext: resourceAviBotMappingDelete,
+		Schema:        ResourceBotMappingSchema(),
 		Importer: &schema.ResourceImporter{
-			State: ResourceBotMappingImporter,
+			StateContext: ResourceBotMappingImporter,
 		},
 	}
 }
 
-func ResourceBotMappingImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func ResourceBotMappingImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	s := ResourceBotMappingSchema()
-	return ResourceImporter(d, m, "botmapping", s)
+	return ResourceImporter(ctx, d, m, "botmapping", s)
 }
 
-func ResourceAviBotMappingRead(d *schema.ResourceData, meta interface{}) error {
+func ResourceAviBotMappingRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceBotMappingSchema()
-	err := APIRead(d, meta, "botmapping", s)
-	if err != nil {
-		log.Printf("[ERROR] in reading object %v\n", err)
+	diags := APIRead(ctx, d, meta, "botmapping", s)
+	if diags.HasError() {
+		log.Printf("[ERROR] in reading object %v\n", diags)
 	}
-	return err
+	return diags
 }
 
-func resourceAviBotMappingCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviBotMappingCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceBotMappingSchema()
-	err := APICreateOrUpdate(d, meta, "botmapping", s)
-	if err == nil {
-		err = ResourceAviBotMappingRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "botmapping", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviBotMappingRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviBotMappingUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviBotMappingUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceBotMappingSchema()
-	var err error
-	err = APICreateOrUpdate(d, meta, "botmapping", s)
-	if err == nil {
-		err = ResourceAviBotMappingRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "botmapping", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviBotMappingRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviBotMappingDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAviBotMappingDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	objType := "botmapping"
-	client := meta.(*clients.AviClient)
-	if APIDeleteSystemDefaultCheck(d) {
-		return nil
-	}
-	uuid := d.Get("uuid").(string)
-	if uuid != "" {
-		path := "api/" + objType + "/" + uuid
-		err := client.AviSession.Delete(path)
-		if err != nil && !(strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "204") || strings.Contains(err.Error(), "403")) {
-			log.Println("[INFO] resourceAviBotMappingDelete not found")
-			return err
-		}
-		d.SetId("")
-	}
-	return nil
+	return APIDelete(ctx, d, meta, objType)
 }
@@ -4,11 +4,11 @@
 package avi
 
 import (
+	"context"
 	"log"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/vmware/alb-sdk/go/clients"
 )
 
 func ResourceCertificateManagementProfileSchema() map[string]*schema.Schema {
@@ -52,65 +52,50 @@ func ResourceCertificateManagementProfileSchema() map[string]*schema.Schema {
 
 func resourceAviCertificateManagementProfile() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviCertificateManagementProfileCreate,
-		Read:   ResourceAviCertificateManagementProfileRead,
-		Update: resourceAviCertificateManagementProfileUpdate,
-		Delete: resourceAviCertificateManagementProfileDelete,
-		Schema: ResourceCertificateManagementProfileSchema(),
+		CreateContext: resourceAviCertificateManagementProfileCreate,
+		ReadContext:   ResourceAviCertificateManagementProfileRead,
+		UpdateContext: resourceAviCertificateManagementProfileUpdate,
+		DeleteContext: resourceAviCertificateManagementProfileDelete,
+		Schema:        ResourceCertificateManagementProfileSchema(),
 		Importer: &schema.ResourceImporter{
-			State: ResourceCertificateManagementProfileImporter,
+			StateContext: ResourceCertificateManagementProfileImporter,
 		},
 	}
 }
 
-func ResourceCertificateManagementProfileImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func ResourceCertificateManagementProfileImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	s := ResourceCertificateManagementProfileSchema()
-	return ResourceImporter(d, m, "certificatemanagementprofile", s)
+	return ResourceImporter(ctx, d, m, "certificatemanagementprofile", s)
 }
 
-func ResourceAviCertificateManagementProfileRead(d *schema.ResourceData, meta interface{}) error {
+func ResourceAviCertificateManagementProfileRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceCertificateManagementProfileSchema()
-	err := APIRead(d, meta, "certificatemanagementprofile", s)
-	if err != nil {
-		log.Printf("[ERROR] in reading object %v\n", err)
+	diags := APIRead(ctx, d, meta, "certificatemanagementprofile", s)
+	if diags.HasError() {
+		log.Printf("[ERROR] in reading object %v\n", diags)
 	}
-	return err
+	return diags
 }
 
-func resourceAviCertificateManagementProfileCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviCertificateManagementProfileCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceCertificateManagementProfileSchema()
-	err := APICreateOrUpdate(d, meta, "certificatemanagementprofile", s)
-	if err == nil {
-		err = ResourceAviCertificateManagementProfileRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "certificatemanagementprofile", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviCertificateManagementProfileRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviCertificateManagementProfileUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviCertificateManagementProfileUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceCertificateManagementProfileSchema()
-	var err error
-	err = APICreateOrUpdate(d, meta, "certificatemanagementprofile", s)
-	if err == nil {
-		err = ResourceAviCertificateManagementProfileRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "certificatemanagementprofile", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviCertificateManagementProfileRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviCertificateManagementProfileDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAviCertificateManagementProfileDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	objType := "certificatemanagementprofile"
-	client := meta.(*clients.AviClient)
-	if APIDeleteSystemDefaultCheck(d) {
-		return nil
-	}
-	uuid := d.Get("uuid").(string)
-	if uuid != "" {
-		path := "api/" + objType + "/" + uuid
-		err := client.AviSession.Delete(path)
-		if err != nil && !(strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "204") || strings.Contains(err.Error(), "403")) {
-			log.Println("[INFO] resourceAviCertificateManagementProfileDelete not found")
-			return err
-		}
-		d.SetId("")
-	}
-	return nil
+	return APIDelete(ctx, d, meta, objType)
 }
@@ -13,7 +13,6 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
-	"github.com/vmware/alb-sdk/go/clients"
 )
 
 func TestAVICertificateManagementProfileBasic(t *testing.T) {
@@ -51,7 +50,7 @@ func TestAVICertificateManagementProfileBasic(t *testing.T) {
 
 func testAccCheckAVICertificateManagementProfileExists(resourcename string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*clients.AviClient).AviSession
+		conn := testAccProvider.Meta().(*ProviderClient).AviSession
 		var obj interface{}
 		rs, ok := s.RootModule().Resources[resourcename]
 		if !ok {
@@ -73,7 +72,7 @@ func testAccCheckAVICertificateManagementProfileExists(resourcename string) reso
 }
 
 func testAccCheckAVICertificateManagementProfileDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*clients.AviClient).AviSession
+	conn := testAccProvider.Meta().(*ProviderClient).AviSession
 	var obj interface{}
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "avi_certificatemanagementprofile" {
@@ -4,27 +4,25 @@
 package avi
 
 import (
+	"context"
 	"encoding/json"
-	"errors"
+	"fmt"
 	"log"
-	"os"
-	"strconv"
-	"strings"
 
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/vmware/alb-sdk/go/clients"
 	"github.com/vmware/alb-sdk/go/models"
 )
 
 func ResourceCloudSchema() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
 		"autoscale_polling_interval": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "60",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  60,
 		},
 		"aws_configuration": {
 			Type:     schema.TypeSet,
@@ -56,10 +54,9 @@ func ResourceCloudSchema() map[string]*schema.Schema {
 			Elem:     ResourceCustomTagSchema(),
 		},
 		"dhcp_enabled": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "false",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
 		},
 		"dns_provider_ref": {
 			Type:     schema.TypeString,
@@ -67,10 +64,9 @@ func ResourceCloudSchema() map[string]*schema.Schema {
 			Computed: true,
 		},
 		"dns_resolution_on_se": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "false",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
 		},
 		"dns_resolvers": {
 			Type:     schema.TypeList,
@@ -94,16 +90,14 @@ func ResourceCloudSchema() map[string]*schema.Schema {
 			Computed: true,
 		},
 		"enable_vip_on_all_interfaces": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "false",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
 		},
 		"enable_vip_static_routes": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "false",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
 		},
 		"gcp_configuration": {
 			Type:     schema.TypeSet,
@@ -112,10 +106,9 @@ func ResourceCloudSchema() map[string]*schema.Schema {
 			Elem:     ResourceGCPConfigurationSchema(),
 		},
 		"ip6_autocfg_enabled": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "false",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
 		},
 		"ipam_provider_ref": {
 			Type:     schema.TypeString,
@@ -139,10 +132,9 @@ func ResourceCloudSchema() map[string]*schema.Schema {
 			Elem:     ResourceLinuxServerConfigurationSchema(),
 		},
 		"maintenance_mode": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "false",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
 		},
 		"markers": {
 			Type:     schema.TypeList,
@@ -150,16 +142,14 @@ func ResourceCloudSchema() map[string]*schema.Schema {
 			Elem:     ResourceRoleFilterMatchLabelSchema(),
 		},
 		"metrics_polling_interval": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "300",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  300,
 		},
 		"mtu": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "1500",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  1500,
 		},
 		"name": {
 			Type:     schema.TypeString,
@@ -189,10 +179,9 @@ func ResourceCloudSchema() map[string]*schema.Schema {
 			Elem:     ResourceOpenStackConfigurationSchema(),
 		},
 		"prefer_static_routes": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "false",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
 		},
 		"proxy_configuration": {
 			Type:     schema.TypeSet,
@@ -212,10 +201,9 @@ func ResourceCloudSchema() map[string]*schema.Schema {
 			Computed: true,
 		},
 		"state_based_dns_registration": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "true",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  true,
 		},
 		"tenant_ref": {
 			Type:     schema.TypeString,
@@ -240,10 +228,9 @@ func ResourceCloudSchema() map[string]*schema.Schema {
 			Elem:     ResourcevCenterConfigurationSchema(),
 		},
 		"vmc_deployment": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "false",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
 		},
 		"vtype": {
 			Type:     schema.TypeString,
@@ -254,153 +241,171 @@ func ResourceCloudSchema() map[string]*schema.Schema {
 
 func resourceAviCloud() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviCloudCreate,
-		Read:   ResourceAviCloudRead,
-		Update: resourceAviCloudUpdate,
-		Delete: resourceAviCloudDelete,
-		Schema: ResourceCloudSchema(),
+		CreateContext: resourceAviCloudCreate,
+		ReadContext:   ResourceAviCloudRead,
+		UpdateContext: resourceAviCloudUpdate,
+		DeleteContext: resourceAviCloudDelete,
+		Schema:        ResourceCloudSchema(),
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: ResourceCloudImporter,
+			StateContext: ResourceCloudImporter,
 		},
 	}
 }
 
-func ResourceCloudImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func ResourceCloudImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	s := ResourceCloudSchema()
-	return ResourceImporter(d, m, "cloud", s)
+	return ResourceImporter(ctx, d, m, "cloud", s)
 }
 
-func ResourceAviCloudRead(d *schema.ResourceData, meta interface{}) error {
+func ResourceAviCloudRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceCloudSchema()
-	err := APIRead(d, meta, "cloud", s)
-	if err != nil {
-		log.Printf("[ERROR] in reading object %v\n", err)
+	diags := APIRead(ctx, d, meta, "cloud", s)
+	if diags.HasError() {
+		log.Printf("[ERROR] in reading object %v\n", diags)
 	}
-	return err
+	return diags
 }
 
-// Wait until cloud is ready for the placement
-func waitForCloudState(cloudUUID string, expectedCloudState string, client *clients.AviClient, maxRetry int) error {
+// cloudStates lists every state reported by the cloud-inventory api. Any of them other than the
+// expected one keeps waitForCloudState polling.
+var cloudStates = []string{
+	"CLOUD_STATE_UNKNOWN",
+	"CLOUD_STATE_IN_PROGRESS",
+	"CLOUD_STATE_FAILED",
+	"CLOUD_STATE_PLACEMENT_READY",
+	"CLOUD_STATE_DELETING",
+	"CLOUD_STATE_NOT_CONNECTED",
+}
 
-	var robj interface{}
-	var err error
-	var cloudState string
-	path := "api/cloud-inventory?uuid=" + cloudUUID
-	i := 0
-	for ; i < maxRetry; i++ {
-		if err = client.AviSession.Get(path, &robj); err == nil {
-			if objCount := robj.(map[string]interface{})["count"].(float64); objCount == float64(1) {
-				var resp *models.CloudInventory
-				jsonString, marshalErr := json.Marshal(robj.(map[string]interface{})["results"].([]interface{})[0])
-				if marshalErr != nil {
-					log.Printf("[Error] Got error while marshaling the response from the cloud-inventory api. %s", marshalErr.Error())
-				} else {
-					unmarshalErr := json.Unmarshal(jsonString, &resp)
-					if unmarshalErr != nil {
-						log.Printf("[Error] Got error while unmarshaling the response from the cloud-inventory api. %s", unmarshalErr.Error())
-					} else {
-						if cloudState = *((*resp).Status.State); cloudState == expectedCloudState {
-							log.Printf("Got expected cloud state %s", cloudState)
-							break
-						} else {
-							log.Printf("Didn't get expected cloud state. Current cloud state is %s", cloudState)
-						}
-					}
-				}
-			} else {
-				log.Printf("Didn't get inventory for cloud")
+// cloudStatePollInterval is the interval between two reads of the state of a cloud.
+var cloudStatePollInterval = 10 * time.Second
+
+// cloudStateRefreshFunc returns the current state of the cloud of tenant from the cloud-inventory api.
+func cloudStateRefreshFunc(ctx context.Context, cloudUUID, tenant string, client *ProviderClient) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		var robj interface{}
+		path := "api/cloud-inventory?uuid=" + cloudUUID
+		if err := aviSessionCall(ctx, client, func() error {
+			return client.AviSession.Get(path, &robj, aviTenantOptions(tenant)...)
+		}); err != nil {
+			if ctx.Err() != nil {
+				return nil, "", err
 			}
-		} else {
 			log.Printf("[Error] Got error while retrieving cloud-inventory %s", err.Error())
+			return robj, "", nil
+		}
+		if objCount := robj.(map[string]interface{})["count"].(float64); objCount != float64(1) {
+			log.Printf("Didn't get inventory for cloud")
+			return robj, "", nil
+		}
+		var resp *models.CloudInventory
+		jsonString, err := json.Marshal(robj.(map[string]interface{})["results"].([]interface{})[0])
+		if err != nil {
+			log.Printf("[Error] Got error while marshaling the response from the cloud-inventory api. %s", err.Error())
+			return robj, "", nil
 		}
-		time.Sleep(10 * time.Second)
+		if err := json.Unmarshal(jsonString, &resp); err != nil {
+			log.Printf("[Error] Got error while unmarshaling the response from the cloud-inventory api. %s", err.Error())
+			return robj, "", nil
+		}
+		if resp.Status == nil || resp.Status.State == nil {
+			return robj, "", nil
+		}
+		log.Printf("Current cloud state is %s", *resp.Status.State)
+		return robj, *resp.Status.State, nil
 	}
-	if i == maxRetry && err == nil {
-		err = errors.New("didn't get expected state CLOUD_STATE_PLACEMENT_READY in cloud-inventory. Current State: " + cloudState)
+}
+
+// Wait until cloud reaches the expected state or the given timeout expires
+func waitForCloudState(ctx context.Context, cloudUUID, tenant string, expectedCloudState string,
+	client *ProviderClient, timeout time.Duration) error {
+	pending := []string{""}
+	for _, state := range cloudStates {
+		if state != expectedCloudState {
+			pending = append(pending, state)
+		}
 	}
-	return err
+	stateConf := &resource.StateChangeConf{
+		Pending:      pending,
+		Target:       []string{expectedCloudState},
+		Refresh:      cloudStateRefreshFunc(ctx, cloudUUID, tenant, client),
+		Timeout:      timeout,
+		PollInterval: cloudStatePollInterval,
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("didn't get expected state %s in cloud-inventory: %s", expectedCloudState, err)
+	}
+	log.Printf("Got expected cloud state %s", expectedCloudState)
+	return nil
 }
 
-func setupVcenterMgmtNetwork(d *schema.ResourceData, meta interface{}) error {
+func setupVcenterMgmtNetwork(ctx context.Context, d *schema.ResourceData, meta interface{},
+	timeout time.Duration) diag.Diagnostics {
 	//Setup management network for vcenter cloud
 	s := ResourceCloudSchema()
-	var maxRetry int
-	if retryCount, isRetry := os.LookupEnv("cloud_state_max_retry"); !isRetry {
-		maxRetry = 50
-	} else {
-		intCount, err := strconv.Atoi(retryCount)
-		if err != nil {
-			maxRetry = 50
-		} else {
-			maxRetry = intCount
-		}
-	}
-	client := meta.(*clients.AviClient)
+	deadline := time.Now().Add(timeout)
+	client := meta.(*ProviderClient)
 	vcenterConfig, _ := d.GetOk("vcenter_configuration")
 	mgmtNetwork := vcenterConfig.(*schema.Set).List()[0].(map[string]interface{})["management_network"].(string)
 	mgmtNetwork = "vimgrruntime?name=" + mgmtNetwork
-	if err := APICreateOrUpdate(d, meta, "cloud", s); err != nil {
-		log.Printf("[Error] Got error for cloud create/update. Error: %s", err.Error())
-		return err
+	if diags := APICreateOrUpdate(ctx, d, meta, "cloud", s); diags.HasError() {
+		log.Printf("[Error] Got error for cloud create/update. Error: %v", diags)
+		return diags
 	}
 	uuid := d.Get("uuid").(string)
-	if err := waitForCloudState(uuid, "CLOUD_STATE_FAILED", client, maxRetry); err != nil {
-		return err
+	tenant, err := resourceTenant(ctx, d, client)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := waitForCloudState(ctx, uuid, tenant, "CLOUD_STATE_FAILED", client, time.Until(deadline)); err != nil {
+		return diag.FromErr(err)
 	}
 	vcenterConfig.(*schema.Set).List()[0].(map[string]interface{})["management_network"] = mgmtNetwork
 	if err := d.Set("vcenter_configuration", vcenterConfig); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
-	if err := APICreateOrUpdate(d, meta, "cloud", s); err != nil {
-		return err
+	if diags := APICreateOrUpdate(ctx, d, meta, "cloud", s); diags.HasError() {
+		return diags
 	}
-	if err := waitForCloudState(uuid, "CLOUD_STATE_PLACEMENT_READY", client, maxRetry); err != nil {
-		return err
+	if err := waitForCloudState(ctx, uuid, tenant, "CLOUD_STATE_PLACEMENT_READY", client,
+		time.Until(deadline)); err != nil {
+		return diag.FromErr(err)
 	}
 	return nil
 }
 
-func resourceAviCloudCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviCloudCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceCloudSchema()
-	var err error
+	var diags diag.Diagnostics
 	cloudType := d.Get("vtype")
 	_, isVcenterConfig := d.GetOk("vcenter_configuration")
 	if cloudType == "CLOUD_VCENTER" && isVcenterConfig {
-		err = setupVcenterMgmtNetwork(d, meta)
-	} else if err = APICreateOrUpdate(d, meta, "cloud", s); err == nil {
-		err = ResourceAviCloudRead(d, meta)
+		diags = setupVcenterMgmtNetwork(ctx, d, meta, d.Timeout(schema.TimeoutCreate))
+	} else if diags = APICreateOrUpdate(ctx, d, meta, "cloud", s); !diags.HasError() {
+		diags = append(diags, ResourceAviCloudRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviCloudUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviCloudUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceCloudSchema()
-	var err error
+	var diags diag.Diagnostics
 	cloudType := d.Get("vtype")
 	_, isVcenterConfig := d.GetOk("vcenter_configuration")
 	if cloudType == "CLOUD_VCENTER" && isVcenterConfig {
-		err = setupVcenterMgmtNetwork(d, meta)
-	} else if err = APICreateOrUpdate(d, meta, "cloud", s); err == nil {
-		err = ResourceAviCloudRead(d, meta)
+		diags = setupVcenterMgmtNetwork(ctx, d, meta, d.Timeout(schema.TimeoutUpdate))
+	} else if diags = APICreateOrUpdate(ctx, d, meta, "cloud", s); !diags.HasError() {
+		diags = append(diags, ResourceAviCloudRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviCloudDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAviCloudDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	objType := "cloud"
-	client := meta.(*clients.AviClient)
-	if APIDeleteSystemDefaultCheck(d) {
-		return nil
-	}
-	uuid := d.Get("uuid").(string)
-	if uuid != "" {
-		path := "api/" + objType + "/" + uuid
-		err := client.AviSession.Delete(path)
-		if err != nil && !(strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "204") || strings.Contains(err.Error(), "403")) {
-			log.Println("[INFO] resourceAviCloudDelete not found")
-			return err
-		}
-		d.SetId("")
-	}
-	return nil
+	return APIDelete(ctx, d, meta, objType)
 }
@@ -1,13 +1,14 @@
 package avi
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
-	"github.com/vmware/alb-sdk/go/clients"
 )
 
 func TestAVICloudBasic(t *testing.T) {
@@ -65,7 +66,7 @@ func TestAVICloudBasic(t *testing.T) {
 
 func testAccCheckAVICloudExists(resourcename string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*clients.AviClient).AviSession
+		conn := testAccProvider.Meta().(*ProviderClient).AviSession
 		var obj interface{}
 		rs, ok := s.RootModule().Resources[resourcename]
 		if !ok {
@@ -87,7 +88,7 @@ func testAccCheckAVICloudExists(resourcename string) resource.TestCheckFunc {
 }
 
 func testAccCheckAVICloudDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*clients.AviClient).AviSession
+	conn := testAccProvider.Meta().(*ProviderClient).AviSession
 	var obj interface{}
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "avi_cloud" {
@@ -145,3 +146,29 @@ resource "avi_cloud" "testCloud" {
 	mtu = "1500"
 }
 `
+
+func TestWaitForCloudState(t *testing.T) {
+	fc := newFakeController()
+	defer fc.Close()
+	client := newFakeControllerClient(t, fc)
+	ctx := context.Background()
+	defer func(interval time.Duration) { cloudStatePollInterval = interval }(cloudStatePollInterval)
+	cloudStatePollInterval = 10 * time.Millisecond
+
+	if err := client.AviSession.Post("api/tenant", map[string]interface{}{"name": "tenant-a"}, nil); err != nil {
+		t.Fatalf("failed to create tenant: %v", err)
+	}
+	var cloud map[string]interface{}
+	if err := client.AviSession.Post("api/cloud", map[string]interface{}{"name": "cloud-a"}, &cloud,
+		aviTenantOptions("tenant-a")...); err != nil {
+		t.Fatalf("failed to create cloud: %v", err)
+	}
+	uuid := cloud["uuid"].(string)
+
+	if err := waitForCloudState(ctx, uuid, "tenant-a", "CLOUD_STATE_PLACEMENT_READY", client, 5*time.Second); err != nil {
+		t.Errorf("wait for the state of the cloud of tenant-a failed: %v", err)
+	}
+	if err := waitForCloudState(ctx, uuid, "tenant-a", "CLOUD_STATE_FAILED", client, 100*time.Millisecond); err == nil {
+		t.Errorf("wait for a state that the cloud does not reach did not time out")
+	}
+}
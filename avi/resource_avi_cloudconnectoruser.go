@@ -4,11 +4,11 @@
 package avi
 
 import (
+	"context"
 	"log"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/vmware/alb-sdk/go/clients"
 )
 
 func ResourceCloudConnectorUserSchema() map[string]*schema.Schema {
@@ -99,65 +99,50 @@ func ResourceCloudConnectorUserSchema() map[string]*schema.Schema {
 
 func resourceAviCloudConnectorUser() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviCloudConnectorUserCreate,
-		Read:   ResourceAviCloudConnectorUserRead,
-		Update: resourceAviCloudConnectorUserUpdate,
-		Delete: resourceAviCloudConnectorUserDelete,
-		Schema: ResourceCloudConnectorUserSchema(),
+		CreateContext: resourceAviCloudConnectorUserCreate,
+		ReadContext:   ResourceAviCloudConnectorUserRead,
+		UpdateContext: resourceAviCloudConnectorUserUpdate,
+		DeleteContext: resourceAviCloudConnectorUserDelete,
+		Schema:        ResourceCloudConnectorUserSchema(),
 		Importer: &schema.ResourceImporter{
-			State: ResourceCloudConnectorUserImporter,
+			StateContext: ResourceCloudConnectorUserImporter,
 		},
 	}
 }
 
-func ResourceCloudConnectorUserImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func ResourceCloudConnectorUserImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	s := ResourceCloudConnectorUserSchema()
-	return ResourceImporter(d, m, "cloudconnectoruser", s)
+	return ResourceImporter(ctx, d, m, "cloudconnectoruser", s)
 }
 
-func ResourceAviCloudConnectorUserRead(d *schema.ResourceData, meta interface{}) error {
+func ResourceAviCloudConnectorUserRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceCloudConnectorUserSchema()
-	err := APIRead(d, meta, "cloudconnectoruser", s)
-	if err != nil {
-		log.Printf("[ERROR] in reading object %v\n", err)
+	diags := APIRead(ctx, d, meta, "cloudconnectoruser", s)
+	if diags.HasError() {
+		log.Printf("[ERROR] in reading object %v\n", diags)
 	}
-	return err
+	return diags
 }
 
-func resourceAviCloudConnectorUserCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviCloudConnectorUserCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceCloudConnectorUserSchema()
-	err := APICreateOrUpdate(d, meta, "cloudconnectoruser", s)
-	if err == nil {
-		err = ResourceAviCloudConnectorUserRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "cloudconnectoruser", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviCloudConnectorUserRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviCloudConnectorUserUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviCloudConnectorUserUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceCloudConnectorUserSchema()
-	var err error
-	err = APICreateOrUpdate(d, meta, "cloudconnectoruser", s)
-	if err == nil {
-		err = ResourceAviCloudConnectorUserRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "cloudconnectoruser", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviCloudConnectorUserRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviCloudConnectorUserDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAviCloudConnectorUserDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	objType := "cloudconnectoruser"
-	client := meta.(*clients.AviClient)
-	if APIDeleteSystemDefaultCheck(d) {
-		return nil
-	}
-	uuid := d.Get("uuid").(string)
-	if uuid != "" {
-		path := "api/" + objType + "/" + uuid
-		err := client.AviSession.Delete(path)
-		if err != nil && !(strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "204") || strings.Contains(err.Error(), "403")) {
-			log.Println("[INFO] resourceAviCloudConnectorUserDelete not found")
-			return err
-		}
-		d.SetId("")
-	}
-	return nil
+	return APIDelete(ctx, d, meta, objType)
 }
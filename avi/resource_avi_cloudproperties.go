@@ -4,11 +4,11 @@
 package avi
 
 import (
+	"context"
 	"log"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/vmware/alb-sdk/go/clients"
 )
 
 func ResourceCloudPropertiesSchema() map[string]*schema.Schema {
@@ -50,65 +50,50 @@ func ResourceCloudPropertiesSchema() map[string]*schema.Schema {
 
 func resourceAviCloudProperties() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviCloudPropertiesCreate,
-		Read:   ResourceAviCloudPropertiesRead,
-		Update: resourceAviCloudPropertiesUpdate,
-		Delete: resourceAviCloudPropertiesDelete,
-		Schema: ResourceCloudPropertiesSchema(),
+		CreateContext: resourceAviCloudPropertiesCreate,
+		ReadContext:   ResourceAviCloudPropertiesRead,
+		UpdateContext: resourceAviCloudPropertiesUpdate,
+		DeleteContext: resourceAviCloudPropertiesDelete,
+		Schema:        ResourceCloudPropertiesSchema(),
 		Importer: &schema.ResourceImporter{
-			State: ResourceCloudPropertiesImporter,
+			StateContext: ResourceCloudPropertiesImporter,
 		},
 	}
 }
 
-func ResourceCloudPropertiesImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func ResourceCloudPropertiesImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	s := ResourceCloudPropertiesSchema()
-	return ResourceImporter(d, m, "cloudproperties", s)
+	return ResourceImporter(ctx, d, m, "cloudproperties", s)
 }
 
-func ResourceAviCloudPropertiesRead(d *schema.ResourceData, meta interface{}) error {
+func ResourceAviCloudPropertiesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceCloudPropertiesSchema()
-	err := APIRead(d, meta, "cloudproperties", s)
-	if err != nil {
-		log.Printf("[ERROR] in reading object %v\n", err)
+	diags := APIRead(ctx, d, meta, "cloudproperties", s)
+	if diags.HasError() {
+		log.Printf("[ERROR] in reading object %v\n", diags)
 	}
-	return err
+	return diags
 }
 
-func resourceAviCloudPropertiesCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviCloudPropertiesCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceCloudPropertiesSchema()
-	err := APICreateOrUpdate(d, meta, "cloudproperties", s)
-	if err == nil {
-		err = ResourceAviCloudPropertiesRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "cloudproperties", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviCloudPropertiesRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviCloudPropertiesUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviCloudPropertiesUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceCloudPropertiesSchema()
-	var err error
-	err = APICreateOrUpdate(d, meta, "cloudproperties", s)
-	if err == nil {
-		err = ResourceAviCloudPropertiesRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "cloudproperties", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviCloudPropertiesRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviCloudPropertiesDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAviCloudPropertiesDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	objType := "cloudproperties"
-	client := meta.(*clients.AviClient)
-	if APIDeleteSystemDefaultCheck(d) {
-		return nil
-	}
-	uuid := d.Get("uuid").(string)
-	if uuid != "" {
-		path := "api/" + objType + "/" + uuid
-		err := client.AviSession.Delete(path)
-		if err != nil && !(strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "204") || strings.Contains(err.Error(), "403")) {
-			log.Println("[INFO] resourceAviCloudPropertiesDelete not found")
-			return err
-		}
-		d.SetId("")
-	}
-	return nil
+	return APIDelete(ctx, d, meta, objType)
 }
@@ -4,12 +4,15 @@
 package avi
 
 import (
+	"context"
+	"fmt"
 	"log"
 
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/vmware/alb-sdk/go/clients"
 )
 
 func ResourceClusterSchema() map[string]*schema.Schema {
@@ -24,10 +27,9 @@ func ResourceClusterSchema() map[string]*schema.Schema {
 			Elem:     ResourceClusterNodeSchema(),
 		},
 		"rejoin_nodes_automatically": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "true",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  true,
 		},
 		"tenant_ref": {
 			Type:     schema.TypeString,
@@ -57,10 +59,9 @@ func ResourceClusterSchema() map[string]*schema.Schema {
 						Computed: true,
 					},
 					"progress": {
-						Type:         schema.TypeString,
-						Optional:     true,
-						Computed:     true,
-						ValidateFunc: validateInteger,
+						Type:     schema.TypeInt,
+						Optional: true,
+						Computed: true,
 					},
 					"state": {
 						Type:     schema.TypeString,
@@ -75,75 +76,88 @@ func ResourceClusterSchema() map[string]*schema.Schema {
 
 func resourceAviCluster() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviClusterCreate,
-		Read:   ResourceAviClusterRead,
-		Update: resourceAviClusterUpdate,
-		Delete: resourceAviClusterDelete,
-		Schema: ResourceClusterSchema(),
+		CreateContext: resourceAviClusterCreate,
+		ReadContext:   ResourceAviClusterRead,
+		UpdateContext: resourceAviClusterUpdate,
+		DeleteContext: resourceAviClusterDelete,
+		Schema:        ResourceClusterSchema(),
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: ResourceClusterImporter,
+			StateContext: ResourceClusterImporter,
 		},
 	}
 }
 
-func ResourceClusterImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func ResourceClusterImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	s := ResourceClusterSchema()
-	return ResourceImporter(d, m, "cluster", s)
+	return ResourceImporter(ctx, d, m, "cluster", s)
 }
 
-func ResourceAviClusterRead(d *schema.ResourceData, meta interface{}) error {
+func ResourceAviClusterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceClusterSchema()
-	err := readClusterState(d, meta, s)
+	err := readClusterState(ctx, d, meta, s)
 	if err == nil {
-		err := APIRead(d, meta, "cluster", s)
-		if err != nil {
-			log.Printf("[ERROR] in reading object %v\n", err)
-			return err
+		if d.Id() == "" {
+			return nil
+		}
+		diags := APIRead(ctx, d, meta, "cluster", s)
+		if diags.HasError() {
+			log.Printf("[ERROR] in reading object %v\n", diags)
 		}
-	} else {
-		log.Printf("[ERROR] in Updateing cluster state object %v\n", err)
+		return diags
 	}
-	return err
+	log.Printf("[ERROR] in Updateing cluster state object %v\n", err)
+	return diag.FromErr(err)
 }
 
-func resourceAviClusterCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviClusterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceClusterSchema()
-	err := APICreateOrUpdate(d, meta, "cluster", s)
-	// Added wait for cluster initialization process as cluster initialization starts after few seconds.
-	// This is necessary to store correct state of initialized cluster.
-	time.Sleep(90 * time.Second)
-	if err == nil {
-		err = ResourceAviClusterRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "cluster", s)
+	if diags.HasError() {
+		return diags
 	}
-	return err
+	if err := waitForClusterUp(ctx, meta, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	return append(diags, ResourceAviClusterRead(ctx, d, meta)...)
 }
 
-func resourceAviClusterUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviClusterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceClusterSchema()
-	var err error
-	err = APICreateOrUpdate(d, meta, "cluster", s)
-	// Added wait for cluster initialization process as cluster initialization starts after few seconds.
-	// This is necessary to store correct state of initialized cluster.
-	time.Sleep(90 * time.Second)
-	if err == nil {
-		err = ResourceAviClusterRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "cluster", s)
+	if diags.HasError() {
+		return diags
 	}
-	return err
+	if err := waitForClusterUp(ctx, meta, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	return append(diags, ResourceAviClusterRead(ctx, d, meta)...)
 }
 
-func resourceAviClusterDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAviClusterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	log.Printf("[WARNING] WE can not delete cluster.")
 	return nil
 }
 
 // Function to get the cluster state and update the exact cluster state into d
-func readClusterState(d *schema.ResourceData, meta interface{}, s map[string]*schema.Schema) error {
-	client := meta.(*clients.AviClient)
+func readClusterState(ctx context.Context, d *schema.ResourceData, meta interface{},
+	s map[string]*schema.Schema) error {
+	client := meta.(*ProviderClient)
 	var err error
 	var robj interface{}
-	if err = client.AviSession.Get("api/cluster/runtime", &robj); err == nil {
+	if err = aviSessionCall(ctx, client, func() error {
+		return client.AviSession.Get("api/cluster/runtime", &robj)
+	}); isAviObjectNotFound(err) {
+		log.Printf("[ERROR] cluster runtime not found %v\n", err)
+		d.SetId("")
+		return nil
+	} else if err == nil {
 		if localData, err := SchemaToAviData(d, s); err == nil {
-			if modAPIRes, err := SetDefaultsInAPIRes(robj, localData, s); err == nil {
+			if modAPIRes, err := SetDefaultsInAPIRes(robj, localData, s, "avi_cluster"); err == nil {
 				if modAPIRes, err = PreprocessAPIRes(modAPIRes, s); err == nil {
 					if _, err := APIDataToSchema(modAPIRes, d, s); err != nil {
 						log.Printf("[ERROR] Converting APIDataToSchema object %v\n", err)
@@ -163,3 +177,56 @@ func readClusterState(d *schema.ResourceData, meta interface{}, s map[string]*sc
 	}
 	return err
 }
+
+// clusterStateUnreachable is reported while the cluster runtime api cannot be reached.
+const clusterStateUnreachable = "UNREACHABLE"
+
+// clusterStateRefreshFunc returns the cluster state reported by the cluster runtime api. The controller
+// api is unavailable for a while during cluster formation, so request errors are reported as the
+// clusterStateUnreachable state rather than failing the wait.
+func clusterStateRefreshFunc(ctx context.Context, client *ProviderClient) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		var robj map[string]interface{}
+		if err := aviSessionCall(ctx, client, func() error {
+			return client.AviSession.Get("api/cluster/runtime", &robj)
+		}); err != nil {
+			if ctx.Err() != nil {
+				return nil, "", err
+			}
+			log.Printf("[DEBUG] cluster runtime is not reachable yet: %v", err)
+			return robj, clusterStateUnreachable, nil
+		}
+		clusterState, _ := robj["cluster_state"].(map[string]interface{})
+		state, _ := clusterState["state"].(string)
+		log.Printf("[DEBUG] current cluster state is %s", state)
+		return robj, state, nil
+	}
+}
+
+// Wait for the cluster to come up after a cluster configuration change. Cluster initialization starts a
+// few seconds after the configuration is accepted, so the first check is delayed and the up state
+// has to be seen on consecutive polls.
+func waitForClusterUp(ctx context.Context, meta interface{}, timeout time.Duration) error {
+	client := meta.(*ProviderClient)
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			clusterStateUnreachable,
+			"",
+			"CLUSTER_STARTING",
+			"CLUSTER_ACTIVE",
+			"CLUSTER_UP_HA_NOT_READY",
+			"CLUSTER_UP_HA_COMPROMISED",
+			"CLUSTER_DOWN",
+		},
+		Target:                    []string{"CLUSTER_UP_HA_ACTIVE", "CLUSTER_UP_NO_HA"},
+		Refresh:                   clusterStateRefreshFunc(ctx, client),
+		Timeout:                   timeout,
+		Delay:                     10 * time.Second,
+		PollInterval:              10 * time.Second,
+		ContinuousTargetOccurence: 3,
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("error waiting for cluster to come up: %s", err)
+	}
+	return nil
+}
@@ -4,11 +4,11 @@
 package avi
 
 import (
+	"context"
 	"log"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/vmware/alb-sdk/go/clients"
 )
 
 func ResourceClusterCloudDetailsSchema() map[string]*schema.Schema {
@@ -44,65 +44,50 @@ func ResourceClusterCloudDetailsSchema() map[string]*schema.Schema {
 
 func resourceAviClusterCloudDetails() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviClusterCloudDetailsCreate,
-		Read:   ResourceAviClusterCloudDetailsRead,
-		Update: resourceAviClusterCloudDetailsUpdate,
-		Delete: resourceAviClusterCloudDetailsDelete,
-		Schema: ResourceClusterCloudDetailsSchema(),
+		CreateContext: resourceAviClusterCloudDetailsCreate,
+		ReadContext:   ResourceAviClusterCloudDetailsRead,
+		UpdateContext: resourceAviClusterCloudDetailsUpdate,
+		DeleteContext: resourceAviClusterCloudDetailsDelete,
+		Schema:        ResourceClusterCloudDetailsSchema(),
 		Importer: &schema.ResourceImporter{
-			State: ResourceClusterCloudDetailsImporter,
+			StateContext: ResourceClusterCloudDetailsImporter,
 		},
 	}
 }
 
-func ResourceClusterCloudDetailsImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func ResourceClusterCloudDetailsImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	s := ResourceClusterCloudDetailsSchema()
-	return ResourceImporter(d, m, "clusterclouddetails", s)
+	return ResourceImporter(ctx, d, m, "clusterclouddetails", s)
 }
 
-func ResourceAviClusterCloudDetailsRead(d *schema.ResourceData, meta interface{}) error {
+func ResourceAviClusterCloudDetailsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceClusterCloudDetailsSchema()
-	err := APIRead(d, meta, "clusterclouddetails", s)
-	if err != nil {
-		log.Printf("[ERROR] in reading object %v\n", err)
+	diags := APIRead(ctx, d, meta, "clusterclouddetails", s)
+	if diags.HasError() {
+		log.Printf("[ERROR] in reading object %v\n", diags)
 	}
-	return err
+	return diags
 }
 
-func resourceAviClusterCloudDetailsCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviClusterCloudDetailsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceClusterCloudDetailsSchema()
-	err := APICreateOrUpdate(d, meta, "clusterclouddetails", s)
-	if err == nil {
-		err = ResourceAviClusterCloudDetailsRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "clusterclouddetails", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviClusterCloudDetailsRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviClusterCloudDetailsUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviClusterCloudDetailsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceClusterCloudDetailsSchema()
-	var err error
-	err = APICreateOrUpdate(d, meta, "clusterclouddetails", s)
-	if err == nil {
-		err = ResourceAviClusterCloudDetailsRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "clusterclouddetails", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviClusterCloudDetailsRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviClusterCloudDetailsDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAviClusterCloudDetailsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	objType := "clusterclouddetails"
-	client := meta.(*clients.AviClient)
-	if APIDeleteSystemDefaultCheck(d) {
-		return nil
-	}
-	uuid := d.Get("uuid").(string)
-	if uuid != "" {
-		path := "api/" + objType + "/" + uuid
-		err := client.AviSession.Delete(path)
-		if err != nil && !(strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "204") || strings.Contains(err.Error(), "403")) {
-			log.Println("[INFO] resourceAviClusterCloudDetailsDelete not found")
-			return err
-		}
-		d.SetId("")
-	}
-	return nil
+	return APIDelete(ctx, d, meta, objType)
 }
@@ -4,11 +4,11 @@
 package avi
 
 import (
+	"context"
 	"log"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/vmware/alb-sdk/go/clients"
 )
 
 func ResourceControllerPortalRegistrationSchema() map[string]*schema.Schema {
@@ -44,65 +44,50 @@ func ResourceControllerPortalRegistrationSchema() map[string]*schema.Schema {
 
 func resourceAviControllerPortalRegistration() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviControllerPortalRegistrationCreate,
-		Read:   ResourceAviControllerPortalRegistrationRead,
-		Update: resourceAviControllerPortalRegistrationUpdate,
-		Delete: resourceAviControllerPortalRegistrationDelete,
-		Schema: ResourceControllerPortalRegistrationSchema(),
+		CreateContext: resourceAviControllerPortalRegistrationCreate,
+		ReadContext:   ResourceAviControllerPortalRegistrationRead,
+		UpdateContext: resourceAviControllerPortalRegistrationUpdate,
+		DeleteContext: resourceAviControllerPortalRegistrationDelete,
+		Schema:        ResourceControllerPortalRegistrationSchema(),
 		Importer: &schema.ResourceImporter{
-			State: ResourceControllerPortalRegistrationImporter,
+			StateContext: ResourceControllerPortalRegistrationImporter,
 		},
 	}
 }
 
-func ResourceControllerPortalRegistrationImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func ResourceControllerPortalRegistrationImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	s := ResourceControllerPortalRegistrationSchema()
-	return ResourceImporter(d, m, "controllerportalregistration", s)
+	return ResourceImporter(ctx, d, m, "controllerportalregistration", s)
 }
 
-func ResourceAviControllerPortalRegistrationRead(d *schema.ResourceData, meta interface{}) error {
+func ResourceAviControllerPortalRegistrationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceControllerPortalRegistrationSchema()
-	err := APIRead(d, meta, "controllerportalregistration", s)
-	if err != nil {
-		log.Printf("[ERROR] in reading object %v\n", err)
+	diags := APIRead(ctx, d, meta, "controllerportalregistration", s)
+	if diags.HasError() {
+		log.Printf("[ERROR] in reading object %v\n", diags)
 	}
-	return err
+	return diags
 }
 
-func resourceAviControllerPortalRegistrationCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviControllerPortalRegistrationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceControllerPortalRegistrationSchema()
-	err := APICreateOrUpdate(d, meta, "controllerportalregistration", s)
-	if err == nil {
-		err = ResourceAviControllerPortalRegistrationRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "controllerportalregistration", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviControllerPortalRegistrationRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviControllerPortalRegistrationUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviControllerPortalRegistrationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceControllerPortalRegistrationSchema()
-	var err error
-	err = APICreateOrUpdate(d, meta, "controllerportalregistration", s)
-	if err == nil {
-		err = ResourceAviControllerPortalRegistrationRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "controllerportalregistration", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviControllerPortalRegistrationRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviControllerPortalRegistrationDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAviControllerPortalRegistrationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	objType := "controllerportalregistration"
-	client := meta.(*clients.AviClient)
-	if APIDeleteSystemDefaultCheck(d) {
-		return nil
-	}
-	uuid := d.Get("uuid").(string)
-	if uuid != "" {
-		path := "api/" + objType + "/" + uuid
-		err := client.AviSession.Delete(path)
-		if err != nil && !(strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "204") || strings.Contains(err.Error(), "403")) {
-			log.Println("[INFO] resourceAviControllerPortalRegistrationDelete not found")
-			return err
-		}
-		d.SetId("")
-	}
-	return nil
+	return APIDelete(ctx, d, meta, objType)
 }
@@ -4,11 +4,11 @@
 package avi
 
 import (
+	"context"
 	"log"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/vmware/alb-sdk/go/clients"
 )
 
 func ResourceControllerSiteSchema() map[string]*schema.Schema {
@@ -28,10 +28,9 @@ func ResourceControllerSiteSchema() map[string]*schema.Schema {
 			Required: true,
 		},
 		"port": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "443",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  443,
 		},
 		"tenant_ref": {
 			Type:     schema.TypeString,
@@ -48,65 +47,50 @@ func ResourceControllerSiteSchema() map[string]*schema.Schema {
 
 func resourceAviControllerSite() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviControllerSiteCreate,
-		Read:   ResourceAviControllerSiteRead,
-		Update: resourceAviControllerSiteUpdate,
-		Delete: resourceAviControllerSiteDelete,
-		Schema: ResourceControllerSiteSchema(),
+		CreateContext: resourceAviControllerSiteCreate,
+		ReadContext:   ResourceAviControllerSiteRead,
+		UpdateContext: resourceAviControllerSiteUpdate,
+		DeleteContext: resourceAviControllerSiteDelete,
+		Schema:        ResourceControllerSiteSchema(),
 		Importer: &schema.ResourceImporter{
-			State: ResourceControllerSiteImporter,
+			StateContext: ResourceControllerSiteImporter,
 		},
 	}
 }
 
-func ResourceControllerSiteImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func ResourceControllerSiteImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	s := ResourceControllerSiteSchema()
-	return ResourceImporter(d, m, "controllersite", s)
+	return ResourceImporter(ctx, d, m, "controllersite", s)
 }
 
-func ResourceAviControllerSiteRead(d *schema.ResourceData, meta interface{}) error {
+func ResourceAviControllerSiteRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceControllerSiteSchema()
-	err := APIRead(d, meta, "controllersite", s)
-	if err != nil {
-		log.Printf("[ERROR] in reading object %v\n", err)
+	diags := APIRead(ctx, d, meta, "controllersite", s)
+	if diags.HasError() {
+		log.Printf("[ERROR] in reading object %v\n", diags)
 	}
-	return err
+	return diags
 }
 
-func resourceAviControllerSiteCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviControllerSiteCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceControllerSiteSchema()
-	err := APICreateOrUpdate(d, meta, "controllersite", s)
-	if err == nil {
-		err = ResourceAviControllerSiteRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "controllersite", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviControllerSiteRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviControllerSiteUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviControllerSiteUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceControllerSiteSchema()
-	var err error
-	err = APICreateOrUpdate(d, meta, "controllersite", s)
-	if err == nil {
-		err = ResourceAviControllerSiteRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "controllersite", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviControllerSiteRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviControllerSiteDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAviControllerSiteDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	objType := "controllersite"
-	client := meta.(*clients.AviClient)
-	if APIDeleteSystemDefaultCheck(d) {
-		return nil
-	}
-	uuid := d.Get("uuid").(string)
-	if uuid != "" {
-		path := "api/" + objType + "/" + uuid
-		err := client.AviSession.Delete(path)
-		if err != nil && !(strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "204") || strings.Contains(err.Error(), "403")) {
-			log.Println("[INFO] resourceAviControllerSiteDelete not found")
-			return err
-		}
-		d.SetId("")
-	}
-	return nil
+	return APIDelete(ctx, d, meta, objType)
 }
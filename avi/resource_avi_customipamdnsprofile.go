@@ -4,11 +4,11 @@
 package avi
 
 import (
+	"context"
 	"log"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/vmware/alb-sdk/go/clients"
 )
 
 //nolint
@@ -49,70 +49,55 @@ func ResourceCustomIpamDnsProfileSchema() map[string]*schema.Schema {
 //nolint
 func resourceAviCustomIpamDnsProfile() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviCustomIpamDnsProfileCreate,
-		Read:   ResourceAviCustomIpamDnsProfileRead,
-		Update: resourceAviCustomIpamDnsProfileUpdate,
-		Delete: resourceAviCustomIpamDnsProfileDelete,
-		Schema: ResourceCustomIpamDnsProfileSchema(),
+		CreateContext: resourceAviCustomIpamDnsProfileCreate,
+		ReadContext:   ResourceAviCustomIpamDnsProfileRead,
+		UpdateContext: resourceAviCustomIpamDnsProfileUpdate,
+		DeleteContext: resourceAviCustomIpamDnsProfileDelete,
+		Schema:        ResourceCustomIpamDnsProfileSchema(),
 		Importer: &schema.ResourceImporter{
-			State: ResourceCustomIpamDnsProfileImporter,
+			StateContext: ResourceCustomIpamDnsProfileImporter,
 		},
 	}
 }
 
 //nolint
-func ResourceCustomIpamDnsProfileImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func ResourceCustomIpamDnsProfileImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	s := ResourceCustomIpamDnsProfileSchema()
-	return ResourceImporter(d, m, "customipamdnsprofile", s)
+	return ResourceImporter(ctx, d, m, "customipamdnsprofile", s)
 }
 
 //nolint
-func ResourceAviCustomIpamDnsProfileRead(d *schema.ResourceData, meta interface{}) error {
+func ResourceAviCustomIpamDnsProfileRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceCustomIpamDnsProfileSchema()
-	err := APIRead(d, meta, "customipamdnsprofile", s)
-	if err != nil {
-		log.Printf("[ERROR] in reading object %v\n", err)
+	diags := APIRead(ctx, d, meta, "customipamdnsprofile", s)
+	if diags.HasError() {
+		log.Printf("[ERROR] in reading object %v\n", diags)
 	}
-	return err
+	return diags
 }
 
 //nolint
-func resourceAviCustomIpamDnsProfileCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviCustomIpamDnsProfileCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceCustomIpamDnsProfileSchema()
-	err := APICreateOrUpdate(d, meta, "customipamdnsprofile", s)
-	if err == nil {
-		err = ResourceAviCustomIpamDnsProfileRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "customipamdnsprofile", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviCustomIpamDnsProfileRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
 //nolint
-func resourceAviCustomIpamDnsProfileUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviCustomIpamDnsProfileUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceCustomIpamDnsProfileSchema()
-	var err error
-	err = APICreateOrUpdate(d, meta, "customipamdnsprofile", s)
-	if err == nil {
-		err = ResourceAviCustomIpamDnsProfileRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "customipamdnsprofile", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviCustomIpamDnsProfileRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
 //nolint
-func resourceAviCustomIpamDnsProfileDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAviCustomIpamDnsProfileDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	objType := "customipamdnsprofile"
-	client := meta.(*clients.AviClient)
-	if APIDeleteSystemDefaultCheck(d) {
-		return nil
-	}
-	uuid := d.Get("uuid").(string)
-	if uuid != "" {
-		path := "api/" + objType + "/" + uuid
-		err := client.AviSession.Delete(path)
-		if err != nil && !(strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "204") || strings.Contains(err.Error(), "403")) {
-			log.Println("[INFO] resourceAviCustomIpamDnsProfileDelete not found")
-			return err
-		}
-		d.SetId("")
-	}
-	return nil
+	return APIDelete(ctx, d, meta, objType)
 }
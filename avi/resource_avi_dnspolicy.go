@@ -4,11 +4,11 @@
 package avi
 
 import (
+	"context"
 	"log"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/vmware/alb-sdk/go/clients"
 )
 
 //nolint
@@ -31,10 +31,9 @@ func ResourceDnsPolicySchema() map[string]*schema.Schema {
 			Computed: true,
 		},
 		"internal": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Computed:     true,
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Computed: true,
 		},
 		"markers": {
 			Type:     schema.TypeList,
@@ -66,70 +65,55 @@ func ResourceDnsPolicySchema() map[string]*schema.Schema {
 //nolint
 func resourceAviDnsPolicy() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviDnsPolicyCreate,
-		Read:   ResourceAviDnsPolicyRead,
-		Update: resourceAviDnsPolicyUpdate,
-		Delete: resourceAviDnsPolicyDelete,
-		Schema: ResourceDnsPolicySchema(),
+		CreateContext: resourceAviDnsPolicyCreate,
+		ReadContext:   ResourceAviDnsPolicyRead,
+		UpdateContext: resourceAviDnsPolicyUpdate,
+		DeleteContext: resourceAviDnsPolicyDelete,
+		Schema:        ResourceDnsPolicySchema(),
 		Importer: &schema.ResourceImporter{
-			State: ResourceDnsPolicyImporter,
+			StateContext: ResourceDnsPolicyImporter,
 		},
 	}
 }
 
 //nolint
-func ResourceDnsPolicyImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func ResourceDnsPolicyImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	s := ResourceDnsPolicySchema()
-	return ResourceImporter(d, m, "dnspolicy", s)
+	return ResourceImporter(ctx, d, m, "dnspolicy", s)
 }
 
 //nolint
-func ResourceAviDnsPolicyRead(d *schema.ResourceData, meta interface{}) error {
+func ResourceAviDnsPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceDnsPolicySchema()
-	err := APIRead(d, meta, "dnspolicy", s)
-	if err != nil {
-		log.Printf("[ERROR] in reading object %v\n", err)
+	diags := APIRead(ctx, d, meta, "dnspolicy", s)
+	if diags.HasError() {
+		log.Printf("[ERROR] in reading object %v\n", diags)
 	}
-	return err
+	return diags
 }
 
 //nolint
-func resourceAviDnsPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviDnsPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceDnsPolicySchema()
-	err := APICreateOrUpdate(d, meta, "dnspolicy", s)
-	if err == nil {
-		err = ResourceAviDnsPolicyRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "dnspolicy", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviDnsPolicyRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
 //nolint
-func resourceAviDnsPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviDnsPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceDnsPolicySchema()
-	var err error
-	err = APICreateOrUpdate(d, meta, "dnspolicy", s)
-	if err == nil {
-		err = ResourceAviDnsPolicyRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "dnspolicy", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviDnsPolicyRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
 //nolint
-func resourceAviDnsPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAviDnsPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	objType := "dnspolicy"
-	client := meta.(*clients.AviClient)
-	if APIDeleteSystemDefaultCheck(d) {
-		return nil
-	}
-	uuid := d.Get("uuid").(string)
-	if uuid != "" {
-		path := "api/" + objType + "/" + uuid
-		err := client.AviSession.Delete(path)
-		if err != nil && !(strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "204") || strings.Contains(err.Error(), "403")) {
-			log.Println("[INFO] resourceAviDnsPolicyDelete not found")
-			return err
-		}
-		d.SetId("")
-	}
-	return nil
+	return APIDelete(ctx, d, meta, objType)
 }
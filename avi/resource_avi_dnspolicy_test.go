@@ -7,7 +7,6 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
-	"github.com/vmware/alb-sdk/go/clients"
 )
 
 func TestAVIDnsPolicyBasic(t *testing.T) {
@@ -46,7 +45,7 @@ func TestAVIDnsPolicyBasic(t *testing.T) {
 //nolint
 func testAccCheckAVIDnsPolicyExists(resourcename string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*clients.AviClient).AviSession
+		conn := testAccProvider.Meta().(*ProviderClient).AviSession
 		var obj interface{}
 		rs, ok := s.RootModule().Resources[resourcename]
 		if !ok {
@@ -69,7 +68,7 @@ func testAccCheckAVIDnsPolicyExists(resourcename string) resource.TestCheckFunc
 
 //nolint
 func testAccCheckAVIDnsPolicyDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*clients.AviClient).AviSession
+	conn := testAccProvider.Meta().(*ProviderClient).AviSession
 	var obj interface{}
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "avi_dnspolicy" {
@@ -4,11 +4,11 @@
 package avi
 
 import (
+	"context"
 	"log"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/vmware/alb-sdk/go/clients"
 )
 
 //nolint
@@ -26,10 +26,9 @@ func ResourceDynamicDnsRecordSchema() map[string]*schema.Schema {
 			Elem:     ResourceDnsCnameRdataSchema(),
 		},
 		"delegated": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "false",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
 		},
 		"description": {
 			Type:     schema.TypeString,
@@ -77,10 +76,9 @@ func ResourceDynamicDnsRecordSchema() map[string]*schema.Schema {
 			Elem:     ResourceDnsNsRdataSchema(),
 		},
 		"num_records_in_response": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Computed:     true,
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Computed: true,
 		},
 		"service_locators": {
 			Type:     schema.TypeList,
@@ -93,10 +91,9 @@ func ResourceDynamicDnsRecordSchema() map[string]*schema.Schema {
 			Computed: true,
 		},
 		"ttl": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Computed:     true,
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Computed: true,
 		},
 		"txt_records": {
 			Type:     schema.TypeList,
@@ -114,10 +111,9 @@ func ResourceDynamicDnsRecordSchema() map[string]*schema.Schema {
 			Computed: true,
 		},
 		"wildcard_match": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "false",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
 		},
 	}
 }
@@ -125,70 +121,55 @@ func ResourceDynamicDnsRecordSchema() map[string]*schema.Schema {
 //nolint
 func resourceAviDynamicDnsRecord() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviDynamicDnsRecordCreate,
-		Read:   ResourceAviDynamicDnsRecordRead,
-		Update: resourceAviDynamicDnsRecordUpdate,
-		Delete: resourceAviDynamicDnsRecordDelete,
-		Schema: ResourceDynamicDnsRecordSchema(),
+		CreateContext: resourceAviDynamicDnsRecordCreate,
+		ReadContext:   ResourceAviDynamicDnsRecordRead,
+		UpdateContext: resourceAviDynamicDnsRecordUpdate,
+		DeleteContext: resourceAviDynamicDnsRecordDelete,
+		Schema:        ResourceDynamicDnsRecordSchema(),
 		Importer: &schema.ResourceImporter{
-			State: ResourceDynamicDnsRecordImporter,
+			StateContext: ResourceDynamicDnsRecordImporter,
 		},
 	}
 }
 
 //nolint
-func ResourceDynamicDnsRecordImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func ResourceDynamicDnsRecordImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	s := ResourceDynamicDnsRecordSchema()
-	return ResourceImporter(d, m, "dynamicdnsrecord", s)
+	return ResourceImporter(ctx, d, m, "dynamicdnsrecord", s)
 }
 
 //nolint
-func ResourceAviDynamicDnsRecordRead(d *schema.ResourceData, meta interface{}) error {
+func ResourceAviDynamicDnsRecordRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceDynamicDnsRecordSchema()
-	err := APIRead(d, meta, "dynamicdnsrecord", s)
-	if err != nil {
-		log.Printf("[ERROR] in reading object %v\n", err)
+	diags := APIRead(ctx, d, meta, "dynamicdnsrecord", s)
+	if diags.HasError() {
+		log.Printf("[ERROR] in reading object %v\n", diags)
 	}
-	return err
+	return diags
 }
 
 //nolint
-func resourceAviDynamicDnsRecordCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviDynamicDnsRecordCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceDynamicDnsRecordSchema()
-	err := APICreateOrUpdate(d, meta, "dynamicdnsrecord", s)
-	if err == nil {
-		err = ResourceAviDynamicDnsRecordRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "dynamicdnsrecord", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviDynamicDnsRecordRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
 //nolint
-func resourceAviDynamicDnsRecordUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviDynamicDnsRecordUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceDynamicDnsRecordSchema()
-	var err error
-	err = APICreateOrUpdate(d, meta, "dynamicdnsrecord", s)
-	if err == nil {
-		err = ResourceAviDynamicDnsRecordRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "dynamicdnsrecord", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviDynamicDnsRecordRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
 //nolint
-func resourceAviDynamicDnsRecordDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAviDynamicDnsRecordDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	objType := "dynamicdnsrecord"
-	client := meta.(*clients.AviClient)
-	if APIDeleteSystemDefaultCheck(d) {
-		return nil
-	}
-	uuid := d.Get("uuid").(string)
-	if uuid != "" {
-		path := "api/" + objType + "/" + uuid
-		err := client.AviSession.Delete(path)
-		if err != nil && !(strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "204") || strings.Contains(err.Error(), "403")) {
-			log.Println("[INFO] resourceAviDynamicDnsRecordDelete not found")
-			return err
-		}
-		d.SetId("")
-	}
-	return nil
+	return APIDelete(ctx, d, meta, objType)
 }
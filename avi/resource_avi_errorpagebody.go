@@ -4,11 +4,11 @@
 package avi
 
 import (
+	"context"
 	"log"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/vmware/alb-sdk/go/clients"
 )
 
 func ResourceErrorPageBodySchema() map[string]*schema.Schema {
@@ -52,65 +52,50 @@ func ResourceErrorPageBodySchema() map[string]*schema.Schema {
 
 func resourceAviErrorPageBody() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviErrorPageBodyCreate,
-		Read:   ResourceAviErrorPageBodyRead,
-		Update: resourceAviErrorPageBodyUpdate,
-		Delete: resourceAviErrorPageBodyDelete,
-		Schema: ResourceErrorPageBodySchema(),
+		CreateContext: resourceAviErrorPageBodyCreate,
+		ReadContext:   ResourceAviErrorPageBodyRead,
+		UpdateContext: resourceAviErrorPageBodyUpdate,
+		DeleteContext: resourceAviErrorPageBodyDelete,
+		Schema:        ResourceErrorPageBodySchema(),
 		Importer: &schema.ResourceImporter{
-			State: ResourceErrorPageBodyImporter,
+			StateContext: ResourceErrorPageBodyImporter,
 		},
 	}
 }
 
-func ResourceErrorPageBodyImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func ResourceErrorPageBodyImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	s := ResourceErrorPageBodySchema()
-	return ResourceImporter(d, m, "errorpagebody", s)
+	return ResourceImporter(ctx, d, m, "errorpagebody", s)
 }
 
-func ResourceAviErrorPageBodyRead(d *schema.ResourceData, meta interface{}) error {
+func ResourceAviErrorPageBodyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceErrorPageBodySchema()
-	err := APIRead(d, meta, "errorpagebody", s)
-	if err != nil {
-		log.Printf("[ERROR] in reading object %v\n", err)
+	diags := APIRead(ctx, d, meta, "errorpagebody", s)
+	if diags.HasError() {
+		log.Printf("[ERROR] in reading object %v\n", diags)
 	}
-	return err
+	return diags
 }
 
-func resourceAviErrorPageBodyCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviErrorPageBodyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceErrorPageBodySchema()
-	err := APICreateOrUpdate(d, meta, "errorpagebody", s)
-	if err == nil {
-		err = ResourceAviErrorPageBodyRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "errorpagebody", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviErrorPageBodyRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviErrorPageBodyUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviErrorPageBodyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceErrorPageBodySchema()
-	var err error
-	err = APICreateOrUpdate(d, meta, "errorpagebody", s)
-	if err == nil {
-		err = ResourceAviErrorPageBodyRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "errorpagebody", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviErrorPageBodyRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviErrorPageBodyDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAviErrorPageBodyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	objType := "errorpagebody"
-	client := meta.(*clients.AviClient)
-	if APIDeleteSystemDefaultCheck(d) {
-		return nil
-	}
-	uuid := d.Get("uuid").(string)
-	if uuid != "" {
-		path := "api/" + objType + "/" + uuid
-		err := client.AviSession.Delete(path)
-		if err != nil && !(strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "204") || strings.Contains(err.Error(), "403")) {
-			log.Println("[INFO] resourceAviErrorPageBodyDelete not found")
-			return err
-		}
-		d.SetId("")
-	}
-	return nil
+	return APIDelete(ctx, d, meta, objType)
 }
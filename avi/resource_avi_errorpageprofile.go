@@ -4,11 +4,11 @@
 package avi
 
 import (
+	"context"
 	"log"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/vmware/alb-sdk/go/clients"
 )
 
 func ResourceErrorPageProfileSchema() map[string]*schema.Schema {
@@ -48,65 +48,50 @@ func ResourceErrorPageProfileSchema() map[string]*schema.Schema {
 
 func resourceAviErrorPageProfile() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviErrorPageProfileCreate,
-		Read:   ResourceAviErrorPageProfileRead,
-		Update: resourceAviErrorPageProfileUpdate,
-		Delete: resourceAviErrorPageProfileDelete,
-		Schema: ResourceErrorPageProfileSchema(),
+		CreateContext: resourceAviErrorPageProfileCreate,
+		ReadContext:   ResourceAviErrorPageProfileRead,
+		UpdateContext: resourceAviErrorPageProfileUpdate,
+		DeleteContext: resourceAviErrorPageProfileDelete,
+		Schema:        ResourceErrorPageProfileSchema(),
 		Importer: &schema.ResourceImporter{
-			State: ResourceErrorPageProfileImporter,
+			StateContext: ResourceErrorPageProfileImporter,
 		},
 	}
 }
 
-func ResourceErrorPageProfileImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func ResourceErrorPageProfileImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	s := ResourceErrorPageProfileSchema()
-	return ResourceImporter(d, m, "errorpageprofile", s)
+	return ResourceImporter(ctx, d, m, "errorpageprofile", s)
 }
 
-func ResourceAviErrorPageProfileRead(d *schema.ResourceData, meta interface{}) error {
+func ResourceAviErrorPageProfileRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceErrorPageProfileSchema()
-	err := APIRead(d, meta, "errorpageprofile", s)
-	if err != nil {
-		log.Printf("[ERROR] in reading object %v\n", err)
+	diags := APIRead(ctx, d, meta, "errorpageprofile", s)
+	if diags.HasError() {
+		log.Printf("[ERROR] in reading object %v\n", diags)
 	}
-	return err
+	return diags
 }
 
-func resourceAviErrorPageProfileCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviErrorPageProfileCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceErrorPageProfileSchema()
-	err := APICreateOrUpdate(d, meta, "errorpageprofile", s)
-	if err == nil {
-		err = ResourceAviErrorPageProfileRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "errorpageprofile", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviErrorPageProfileRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviErrorPageProfileUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviErrorPageProfileUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceErrorPageProfileSchema()
-	var err error
-	err = APICreateOrUpdate(d, meta, "errorpageprofile", s)
-	if err == nil {
-		err = ResourceAviErrorPageProfileRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "errorpageprofile", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviErrorPageProfileRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviErrorPageProfileDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAviErrorPageProfileDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	objType := "errorpageprofile"
-	client := meta.(*clients.AviClient)
-	if APIDeleteSystemDefaultCheck(d) {
-		return nil
-	}
-	uuid := d.Get("uuid").(string)
-	if uuid != "" {
-		path := "api/" + objType + "/" + uuid
-		err := client.AviSession.Delete(path)
-		if err != nil && !(strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "204") || strings.Contains(err.Error(), "403")) {
-			log.Println("[INFO] resourceAviErrorPageProfileDelete not found")
-			return err
-		}
-		d.SetId("")
-	}
-	return nil
+	return APIDelete(ctx, d, meta, objType)
 }
@@ -4,11 +4,11 @@
 package avi
 
 import (
+	"context"
 	"log"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/vmware/alb-sdk/go/clients"
 )
 
 func ResourceFederationCheckpointSchema() map[string]*schema.Schema {
@@ -30,10 +30,9 @@ func ResourceFederationCheckpointSchema() map[string]*schema.Schema {
 			Computed: true,
 		},
 		"is_federated": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "true",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  true,
 		},
 		"name": {
 			Type:     schema.TypeString,
@@ -54,65 +53,50 @@ func ResourceFederationCheckpointSchema() map[string]*schema.Schema {
 
 func resourceAviFederationCheckpoint() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviFederationCheckpointCreate,
-		Read:   ResourceAviFederationCheckpointRead,
-		Update: resourceAviFederationCheckpointUpdate,
-		Delete: resourceAviFederationCheckpointDelete,
-		Schema: ResourceFederationCheckpointSchema(),
+		CreateContext: resourceAviFederationCheckpointCreate,
+		ReadContext:   ResourceAviFederationCheckpointRead,
+		UpdateContext: resourceAviFederationCheckpointUpdate,
+		DeleteContext: resourceAviFederationCheckpointDelete,
+		Schema:        ResourceFederationCheckpointSchema(),
 		Importer: &schema.ResourceImporter{
-			State: ResourceFederationCheckpointImporter,
+			StateContext: ResourceFederationCheckpointImporter,
 		},
 	}
 }
 
-func ResourceFederationCheckpointImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func ResourceFederationCheckpointImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	s := ResourceFederationCheckpointSchema()
-	return ResourceImporter(d, m, "federationcheckpoint", s)
+	return ResourceImporter(ctx, d, m, "federationcheckpoint", s)
 }
 
-func ResourceAviFederationCheckpointRead(d *schema.ResourceData, meta interface{}) error {
+func ResourceAviFederationCheckpointRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceFederationCheckpointSchema()
-	err := APIRead(d, meta, "federationcheckpoint", s)
-	if err != nil {
-		log.Printf("[ERROR] in reading object %v\n", err)
+	diags := APIRead(ctx, d, meta, "federationcheckpoint", s)
+	if diags.HasError() {
+		log.Printf("[ERROR] in reading object %v\n", diags)
 	}
-	return err
+	return diags
 }
 
-func resourceAviFederationCheckpointCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviFederationCheckpointCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceFederationCheckpointSchema()
-	err := APICreateOrUpdate(d, meta, "federationcheckpoint", s)
-	if err == nil {
-		err = ResourceAviFederationCheckpointRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "federationcheckpoint", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviFederationCheckpointRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviFederationCheckpointUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviFederationCheckpointUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceFederationCheckpointSchema()
-	var err error
-	err = APICreateOrUpdate(d, meta, "federationcheckpoint", s)
-	if err == nil {
-		err = ResourceAviFederationCheckpointRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "federationcheckpoint", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviFederationCheckpointRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviFederationCheckpointDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAviFederationCheckpointDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	objType := "federationcheckpoint"
-	client := meta.(*clients.AviClient)
-	if APIDeleteSystemDefaultCheck(d) {
-		return nil
-	}
-	uuid := d.Get("uuid").(string)
-	if uuid != "" {
-		path := "api/" + objType + "/" + uuid
-		err := client.AviSession.Delete(path)
-		if err != nil && !(strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "204") || strings.Contains(err.Error(), "403")) {
-			log.Println("[INFO] resourceAviFederationCheckpointDelete not found")
-			return err
-		}
-		d.SetId("")
-	}
-	return nil
+	return APIDelete(ctx, d, meta, objType)
 }
@@ -4,11 +4,11 @@
 package avi
 
 import (
+	"context"
 	"log"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/vmware/alb-sdk/go/clients"
 )
 
 func ResourceFileObjectSchema() map[string]*schema.Schema {
@@ -19,10 +19,9 @@ func ResourceFileObjectSchema() map[string]*schema.Schema {
 			Computed: true,
 		},
 		"compressed": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "false",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
 		},
 		"created": {
 			Type:     schema.TypeString,
@@ -40,10 +39,9 @@ func ResourceFileObjectSchema() map[string]*schema.Schema {
 			Computed: true,
 		},
 		"is_federated": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "false",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
 		},
 		"name": {
 			Type:     schema.TypeString,
@@ -55,22 +53,19 @@ func ResourceFileObjectSchema() map[string]*schema.Schema {
 			Computed: true,
 		},
 		"read_only": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Computed:     true,
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Computed: true,
 		},
 		"restrict_download": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Computed:     true,
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Computed: true,
 		},
 		"size": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Computed:     true,
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Computed: true,
 		},
 		"tenant_ref": {
 			Type:     schema.TypeString,
@@ -96,65 +91,50 @@ func ResourceFileObjectSchema() map[string]*schema.Schema {
 
 func resourceAviFileObject() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviFileObjectCreate,
-		Read:   ResourceAviFileObjectRead,
-		Update: resourceAviFileObjectUpdate,
-		Delete: resourceAviFileObjectDelete,
-		Schema: ResourceFileObjectSchema(),
+		CreateContext: resourceAviFileObjectCreate,
+		ReadContext:   ResourceAviFileObjectRead,
+		UpdateContext: resourceAviFileObjectUpdate,
+		DeleteContext: resourceAviFileObjectDelete,
+		Schema:        ResourceFileObjectSchema(),
 		Importer: &schema.ResourceImporter{
-			State: ResourceFileObjectImporter,
+			StateContext: ResourceFileObjectImporter,
 		},
 	}
 }
 
-func ResourceFileObjectImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func ResourceFileObjectImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	s := ResourceFileObjectSchema()
-	return ResourceImporter(d, m, "fileobject", s)
+	return ResourceImporter(ctx, d, m, "fileobject", s)
 }
 
-func ResourceAviFileObjectRead(d *schema.ResourceData, meta interface{}) error {
+func ResourceAviFileObjectRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceFileObjectSchema()
-	err := APIRead(d, meta, "fileobject", s)
-	if err != nil {
-		log.Printf("[ERROR] in reading object %v\n", err)
+	diags := APIRead(ctx, d, meta, "fileobject", s)
+	if diags.HasError() {
+		log.Printf("[ERROR] in reading object %v\n", diags)
 	}
-	return err
+	return diags
 }
 
-func resourceAviFileObjectCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviFileObjectCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceFileObjectSchema()
-	err := APICreateOrUpdate(d, meta, "fileobject", s)
-	if err == nil {
-		err = ResourceAviFileObjectRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "fileobject", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviFileObjectRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviFileObjectUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviFileObjectUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceFileObjectSchema()
-	var err error
-	err = APICreateOrUpdate(d, meta, "fileobject", s)
-	if err == nil {
-		err = ResourceAviFileObjectRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "fileobject", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviFileObjectRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviFileObjectDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAviFileObjectDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	objType := "fileobject"
-	client := meta.(*clients.AviClient)
-	if APIDeleteSystemDefaultCheck(d) {
-		return nil
-	}
-	uuid := d.Get("uuid").(string)
-	if uuid != "" {
-		path := "api/" + objType + "/" + uuid
-		err := client.AviSession.Delete(path)
-		if err != nil && !(strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "204") || strings.Contains(err.Error(), "403")) {
-			log.Println("[INFO] resourceAviFileObjectDelete not found")
-			return err
-		}
-		d.SetId("")
-	}
-	return nil
+	return APIDelete(ctx, d, meta, objType)
 }
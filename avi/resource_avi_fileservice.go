@@ -7,12 +7,13 @@
 package avi
 
 import (
+	"context"
 	"log"
 	"os"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/vmware/alb-sdk/go/clients"
 )
 
 func ResourceFileServiceSchema() map[string]*schema.Schema {
@@ -36,45 +37,49 @@ func ResourceFileServiceSchema() map[string]*schema.Schema {
 
 func resourceAviFileService() *schema.Resource {
 	return &schema.Resource{
-		Read:   ResourceAviFileServiceRead,
-		Create: ResourceAviFileServiceCreate,
-		Update: ResourceAviFileServiceUpdate,
-		Delete: ResourceAviFileServiceDelete,
-		Schema: ResourceFileServiceSchema(),
+		ReadContext:   ResourceAviFileServiceRead,
+		CreateContext: ResourceAviFileServiceCreate,
+		UpdateContext: ResourceAviFileServiceUpdate,
+		DeleteContext: ResourceAviFileServiceDelete,
+		Schema:        ResourceFileServiceSchema(),
 		Importer: &schema.ResourceImporter{
-			State: ResourceFileServiceImporter,
+			StateContext: ResourceFileServiceImporter,
 		},
 	}
 }
 
-func ResourceFileServiceImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func ResourceFileServiceImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	s := ResourceFileServiceSchema()
-	return ResourceImporter(d, m, "fileservice", s)
+	return ResourceImporter(ctx, d, m, "fileservice", s)
 }
 
-func ResourceAviFileServiceRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.AviClient)
+func ResourceAviFileServiceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ProviderClient)
 	var res interface{}
 	switch upload := d.Get("upload").(bool); upload {
 	case true:
 		switch uri := d.Get("uri").(string); uri {
 		case "license":
 			path := "/api/license"
-			err := client.AviSession.Get(path, &res)
+			err := aviSessionCall(ctx, client, func() error {
+				return client.AviSession.Get(path, &res)
+			})
 			log.Printf("[DEBUG] ResourceAviFileServiceRead response: %v\n\n", res)
 			if err != nil {
 				log.Printf("[ERROR] ResourceAviFileServiceRead %v in GET of path %v\n", err, path)
-				return err
+				return diag.FromErr(err)
 			}
 		default:
 			uri := strings.Split(d.Get("uri").(string), "?")[0]
 			path := "/api/fileservice?uri=controller://" + uri
 			log.Printf("[DEBUG] ResourceAviFileServiceRead reading fileservice API status path %v\n", path)
-			err := client.AviSession.Get(path, &res)
+			err := aviSessionCall(ctx, client, func() error {
+				return client.AviSession.Get(path, &res)
+			})
 			log.Printf("[DEBUG] ResourceAviFileServiceRead response: %v\n\n", res)
 			if err != nil {
 				log.Printf("[ERROR] ResourceAviFileServiceRead %v in GET of path %v\n", err, path)
-				return err
+				return diag.FromErr(err)
 			}
 		}
 	case false:
@@ -82,7 +87,7 @@ func ResourceAviFileServiceRead(d *schema.ResourceData, meta interface{}) error
 		log.Printf("[DEBUG] ResourceAviFileServiceRead reading local file %v\n", localFile)
 		if _, err := os.Stat(localFile); os.IsNotExist(err) {
 			log.Printf("File does not exist")
-			return err
+			return diag.FromErr(err)
 		}
 		log.Printf("File exists")
 		return nil
@@ -92,48 +97,52 @@ func ResourceAviFileServiceRead(d *schema.ResourceData, meta interface{}) error
 	return nil
 }
 
-func ResourceAviFileServiceCreate(d *schema.ResourceData, meta interface{}) error {
+func ResourceAviFileServiceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceFileServiceSchema()
-	err := MultipartUploadOrDownload(d, meta, s)
+	err := MultipartUploadOrDownload(ctx, d, meta, s)
 	if err != nil {
 		log.Printf("[ERROR] ResourceAviFileServiceCreate Error during upload/download %v\n", err)
-		return err
+		return diag.FromErr(err)
 	}
 	return nil
 }
 
-func ResourceAviFileServiceUpdate(d *schema.ResourceData, meta interface{}) error {
+func ResourceAviFileServiceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	log.Printf("[DEBUG] ResourceAviFileServiceUpdate")
 	s := ResourceFileServiceSchema()
-	err := MultipartUploadOrDownload(d, meta, s)
+	err := MultipartUploadOrDownload(ctx, d, meta, s)
 	if err != nil {
 		log.Printf("[ERROR] ResourceAviFileServiceUpdate Error during upload/download %v\n", err)
-		return err
+		return diag.FromErr(err)
 	}
-	err = ResourceAviFileServiceRead(d, meta)
-	return err
+	return ResourceAviFileServiceRead(ctx, d, meta)
 }
 
-func ResourceAviFileServiceDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.AviClient)
+func ResourceAviFileServiceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ProviderClient)
 	localFile := d.Get("local_file").(string)
 	switch upload := d.Get("upload").(bool); upload {
 	case true:
 		switch uri := d.Get("uri").(string); uri {
 		case "license":
 			path := "/api/" + uri + "/" + d.Id()
-			err := client.AviSession.Delete(path)
-			if err != nil {
+			err := aviSessionCall(ctx, client, func() error {
+				return client.AviSession.Delete(path)
+			})
+			if err != nil && !isAviObjectNotFound(err) {
 				log.Printf("[ERROR] ResourceAviFileServiceDelete %v Deleting file of path %v\n", err, path)
+				return diag.FromErr(err)
 			}
 		default:
 			uri := strings.Split(d.Get("uri").(string), "?")[0]
 			path := "/api/fileservice?uri=controller://" + uri + "/" + d.Id()
 			log.Printf("[DEBUG] ResourceAviFileServiceDelete deleting file using fileservice API status path %v\n", path)
-			err := client.AviSession.Delete(path)
-			if err != nil {
+			err := aviSessionCall(ctx, client, func() error {
+				return client.AviSession.Delete(path)
+			})
+			if err != nil && !isAviObjectNotFound(err) {
 				log.Printf("[ERROR] ResourceAviFileServiceDelete %v Deleting file of path %v\n", err, path)
-				return err
+				return diag.FromErr(err)
 			}
 		}
 	case false:
@@ -141,7 +150,7 @@ func ResourceAviFileServiceDelete(d *schema.ResourceData, meta interface{}) erro
 		var err = os.Remove(localFile)
 		if err != nil {
 			log.Printf("[ERROR] ResourceAviFileServiceDelete Error for deleting file %v\n", localFile)
-			return err
+			return diag.FromErr(err)
 		}
 		log.Printf("[INFO] ResourceAviFileServiceDelete file %v deleted\n", localFile)
 	default:
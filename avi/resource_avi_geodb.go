@@ -4,11 +4,11 @@
 package avi
 
 import (
+	"context"
 	"log"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/vmware/alb-sdk/go/clients"
 )
 
 func ResourceGeoDBSchema() map[string]*schema.Schema {
@@ -24,10 +24,9 @@ func ResourceGeoDBSchema() map[string]*schema.Schema {
 			Elem:     ResourceGeoDBFileSchema(),
 		},
 		"is_federated": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "false",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
 		},
 		"mappings": {
 			Type:     schema.TypeList,
@@ -53,65 +52,50 @@ func ResourceGeoDBSchema() map[string]*schema.Schema {
 
 func resourceAviGeoDB() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviGeoDBCreate,
-		Read:   ResourceAviGeoDBRead,
-		Update: resourceAviGeoDBUpdate,
-		Delete: resourceAviGeoDBDelete,
-		Schema: ResourceGeoDBSchema(),
+		CreateContext: resourceAviGeoDBCreate,
+		ReadContext:   ResourceAviGeoDBRead,
+		UpdateContext: resourceAviGeoDBUpdate,
+		DeleteContext: resourceAviGeoDBDelete,
+		Schema:        ResourceGeoDBSchema(),
 		Importer: &schema.ResourceImporter{
-			State: ResourceGeoDBImporter,
+			StateContext: ResourceGeoDBImporter,
 		},
 	}
 }
 
-func ResourceGeoDBImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func ResourceGeoDBImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	s := ResourceGeoDBSchema()
-	return ResourceImporter(d, m, "geodb", s)
+	return ResourceImporter(ctx, d, m, "geodb", s)
 }
 
-func ResourceAviGeoDBRead(d *schema.ResourceData, meta interface{}) error {
+func ResourceAviGeoDBRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceGeoDBSchema()
-	err := APIRead(d, meta, "geodb", s)
-	if err != nil {
-		log.Printf("[ERROR] in reading object %v\n", err)
+	diags := APIRead(ctx, d, meta, "geodb", s)
+	if diags.HasError() {
+		log.Printf("[ERROR] in reading object %v\n", diags)
 	}
-	return err
+	return diags
 }
 
-func resourceAviGeoDBCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviGeoDBCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceGeoDBSchema()
-	err := APICreateOrUpdate(d, meta, "geodb", s)
-	if err == nil {
-		err = ResourceAviGeoDBRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "geodb", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviGeoDBRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviGeoDBUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviGeoDBUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceGeoDBSchema()
-	var err error
-	err = APICreateOrUpdate(d, meta, "geodb", s)
-	if err == nil {
-		err = ResourceAviGeoDBRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "geodb", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviGeoDBRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviGeoDBDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAviGeoDBDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	objType := "geodb"
-	client := meta.(*clients.AviClient)
-	if APIDeleteSystemDefaultCheck(d) {
-		return nil
-	}
-	uuid := d.Get("uuid").(string)
-	if uuid != "" {
-		path := "api/" + objType + "/" + uuid
-		err := client.AviSession.Delete(path)
-		if err != nil && !(strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "204") || strings.Contains(err.Error(), "403")) {
-			log.Println("[INFO] resourceAviGeoDBDelete not found")
-			return err
-		}
-		d.SetId("")
-	}
-	return nil
+	return APIDelete(ctx, d, meta, objType)
 }
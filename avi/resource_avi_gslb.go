@@ -4,26 +4,25 @@
 package avi
 
 import (
+	"context"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/vmware/alb-sdk/go/clients"
 )
 
 func ResourceGslbSchema() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
 		"async_interval": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "0",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  0,
 		},
 		"clear_on_max_retries": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "20",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  20,
 		},
 		"client_ip_addr_group": {
 			Type:     schema.TypeSet,
@@ -48,32 +47,28 @@ func ResourceGslbSchema() map[string]*schema.Schema {
 			Elem:     ResourceDNSConfigSchema(),
 		},
 		"enable_config_by_members": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "false",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
 		},
 		"error_resync_interval": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "300",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  300,
 		},
 		"is_federated": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "true",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  true,
 		},
 		"leader_cluster_uuid": {
 			Type:     schema.TypeString,
 			Required: true,
 		},
 		"maintenance_mode": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "false",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
 		},
 		"name": {
 			Type:     schema.TypeString,
@@ -86,16 +81,14 @@ func ResourceGslbSchema() map[string]*schema.Schema {
 			Elem:     ResourceReplicationPolicySchema(),
 		},
 		"send_interval": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "15",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  15,
 		},
 		"send_interval_prior_to_maintenance_mode": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Computed:     true,
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Computed: true,
 		},
 		"sites": {
 			Type:     schema.TypeList,
@@ -108,10 +101,9 @@ func ResourceGslbSchema() map[string]*schema.Schema {
 			Computed: true,
 		},
 		"tenant_scoped": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "true",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  true,
 		},
 		"third_party_sites": {
 			Type:     schema.TypeList,
@@ -124,75 +116,64 @@ func ResourceGslbSchema() map[string]*schema.Schema {
 			Computed: true,
 		},
 		"view_id": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "0",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  0,
 		},
 	}
 }
 
 func resourceAviGslb() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviGslbCreate,
-		Read:   ResourceAviGslbRead,
-		Update: resourceAviGslbUpdate,
-		Delete: resourceAviGslbDelete,
-		Schema: ResourceGslbSchema(),
+		CreateContext: resourceAviGslbCreate,
+		ReadContext:   ResourceAviGslbRead,
+		UpdateContext: resourceAviGslbUpdate,
+		DeleteContext: resourceAviGslbDelete,
+		Schema:        ResourceGslbSchema(),
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: ResourceGslbImporter,
+			StateContext: ResourceGslbImporter,
 		},
 	}
 }
 
-func ResourceGslbImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func ResourceGslbImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	s := ResourceGslbSchema()
-	return ResourceImporter(d, m, "gslb", s)
+	return ResourceImporter(ctx, d, m, "gslb", s)
 }
 
-func ResourceAviGslbRead(d *schema.ResourceData, meta interface{}) error {
+func ResourceAviGslbRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceGslbSchema()
-	err := APIRead(d, meta, "gslb", s)
-	if err != nil {
-		log.Printf("[ERROR] in reading object %v\n", err)
+	diags := APIRead(ctx, d, meta, "gslb", s)
+	if diags.HasError() {
+		log.Printf("[ERROR] in reading object %v\n", diags)
 	}
-	return err
+	return diags
 }
 
-func resourceAviGslbCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviGslbCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceGslbSchema()
-	err := APICreateOrUpdate(d, meta, "gslb", s)
-	if err == nil {
-		err = ResourceAviGslbRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "gslb", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviGslbRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviGslbUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviGslbUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceGslbSchema()
-	var err error
-	err = APICreateOrUpdate(d, meta, "gslb", s)
-	if err == nil {
-		err = ResourceAviGslbRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "gslb", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviGslbRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviGslbDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAviGslbDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	objType := "gslb"
-	client := meta.(*clients.AviClient)
-	if APIDeleteSystemDefaultCheck(d) {
-		return nil
-	}
-	uuid := d.Get("uuid").(string)
-	if uuid != "" {
-		path := "api/" + objType + "/" + uuid
-		err := client.AviSession.Delete(path)
-		if err != nil && !(strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "204") || strings.Contains(err.Error(), "403")) {
-			log.Println("[INFO] resourceAviGslbDelete not found")
-			return err
-		}
-		d.SetId("")
-	}
-	return nil
+	return APIDelete(ctx, d, meta, objType)
 }
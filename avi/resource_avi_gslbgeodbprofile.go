@@ -4,11 +4,11 @@
 package avi
 
 import (
+	"context"
 	"log"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/vmware/alb-sdk/go/clients"
 )
 
 func ResourceGslbGeoDbProfileSchema() map[string]*schema.Schema {
@@ -30,10 +30,9 @@ func ResourceGslbGeoDbProfileSchema() map[string]*schema.Schema {
 			Elem:     ResourceGslbGeoDbEntrySchema(),
 		},
 		"is_federated": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "true",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  true,
 		},
 		"markers": {
 			Type:     schema.TypeList,
@@ -59,65 +58,50 @@ func ResourceGslbGeoDbProfileSchema() map[string]*schema.Schema {
 
 func resourceAviGslbGeoDbProfile() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviGslbGeoDbProfileCreate,
-		Read:   ResourceAviGslbGeoDbProfileRead,
-		Update: resourceAviGslbGeoDbProfileUpdate,
-		Delete: resourceAviGslbGeoDbProfileDelete,
-		Schema: ResourceGslbGeoDbProfileSchema(),
+		CreateContext: resourceAviGslbGeoDbProfileCreate,
+		ReadContext:   ResourceAviGslbGeoDbProfileRead,
+		UpdateContext: resourceAviGslbGeoDbProfileUpdate,
+		DeleteContext: resourceAviGslbGeoDbProfileDelete,
+		Schema:        ResourceGslbGeoDbProfileSchema(),
 		Importer: &schema.ResourceImporter{
-			State: ResourceGslbGeoDbProfileImporter,
+			StateContext: ResourceGslbGeoDbProfileImporter,
 		},
 	}
 }
 
-func ResourceGslbGeoDbProfileImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func ResourceGslbGeoDbProfileImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	s := ResourceGslbGeoDbProfileSchema()
-	return ResourceImporter(d, m, "gslbgeodbprofile", s)
+	return ResourceImporter(ctx, d, m, "gslbgeodbprofile", s)
 }
 
-func ResourceAviGslbGeoDbProfileRead(d *schema.ResourceData, meta interface{}) error {
+func ResourceAviGslbGeoDbProfileRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceGslbGeoDbProfileSchema()
-	err := APIRead(d, meta, "gslbgeodbprofile", s)
-	if err != nil {
-		log.Printf("[ERROR] in reading object %v\n", err)
+	diags := APIRead(ctx, d, meta, "gslbgeodbprofile", s)
+	if diags.HasError() {
+		log.Printf("[ERROR] in reading object %v\n", diags)
 	}
-	return err
+	return diags
 }
 
-func resourceAviGslbGeoDbProfileCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviGslbGeoDbProfileCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceGslbGeoDbProfileSchema()
-	err := APICreateOrUpdate(d, meta, "gslbgeodbprofile", s)
-	if err == nil {
-		err = ResourceAviGslbGeoDbProfileRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "gslbgeodbprofile", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviGslbGeoDbProfileRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviGslbGeoDbProfileUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviGslbGeoDbProfileUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceGslbGeoDbProfileSchema()
-	var err error
-	err = APICreateOrUpdate(d, meta, "gslbgeodbprofile", s)
-	if err == nil {
-		err = ResourceAviGslbGeoDbProfileRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "gslbgeodbprofile", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviGslbGeoDbProfileRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviGslbGeoDbProfileDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAviGslbGeoDbProfileDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	objType := "gslbgeodbprofile"
-	client := meta.(*clients.AviClient)
-	if APIDeleteSystemDefaultCheck(d) {
-		return nil
-	}
-	uuid := d.Get("uuid").(string)
-	if uuid != "" {
-		path := "api/" + objType + "/" + uuid
-		err := client.AviSession.Delete(path)
-		if err != nil && !(strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "204") || strings.Contains(err.Error(), "403")) {
-			log.Println("[INFO] resourceAviGslbGeoDbProfileDelete not found")
-			return err
-		}
-		d.SetId("")
-	}
-	return nil
+	return APIDelete(ctx, d, meta, objType)
 }
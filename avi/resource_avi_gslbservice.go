@@ -4,11 +4,11 @@
 package avi
 
 import (
+	"context"
 	"log"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/vmware/alb-sdk/go/clients"
 )
 
 func ResourceGslbServiceSchema() map[string]*schema.Schema {
@@ -25,10 +25,9 @@ func ResourceGslbServiceSchema() map[string]*schema.Schema {
 			Elem:     ResourceConfigPbAttributesSchema(),
 		},
 		"controller_health_status_enabled": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "true",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  true,
 		},
 		"created_by": {
 			Type:     schema.TypeString,
@@ -52,10 +51,9 @@ func ResourceGslbServiceSchema() map[string]*schema.Schema {
 			Elem:     ResourceGslbServiceDownResponseSchema(),
 		},
 		"enabled": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "true",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  true,
 		},
 		"groups": {
 			Type:     schema.TypeList,
@@ -73,16 +71,14 @@ func ResourceGslbServiceSchema() map[string]*schema.Schema {
 			Default:  "GSLB_SERVICE_HEALTH_MONITOR_ALL_MEMBERS",
 		},
 		"hm_off": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Computed:     true,
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Computed: true,
 		},
 		"is_federated": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "true",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  true,
 		},
 		"markers": {
 			Type:     schema.TypeList,
@@ -90,20 +86,18 @@ func ResourceGslbServiceSchema() map[string]*schema.Schema {
 			Elem:     ResourceRoleFilterMatchLabelSchema(),
 		},
 		"min_members": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "0",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  0,
 		},
 		"name": {
 			Type:     schema.TypeString,
 			Required: true,
 		},
 		"num_dns_ip": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Computed:     true,
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Computed: true,
 		},
 		"pki_profile_ref": {
 			Type:     schema.TypeString,
@@ -116,16 +110,14 @@ func ResourceGslbServiceSchema() map[string]*schema.Schema {
 			Default:  "GSLB_SERVICE_ALGORITHM_PRIORITY",
 		},
 		"resolve_cname": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "false",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
 		},
 		"site_persistence_enabled": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "false",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
 		},
 		"tenant_ref": {
 			Type:     schema.TypeString,
@@ -133,22 +125,19 @@ func ResourceGslbServiceSchema() map[string]*schema.Schema {
 			Computed: true,
 		},
 		"topology_policy_enabled": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Computed:     true,
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Computed: true,
 		},
 		"ttl": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Computed:     true,
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Computed: true,
 		},
 		"use_edns_client_subnet": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "true",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  true,
 		},
 		"uuid": {
 			Type:     schema.TypeString,
@@ -156,75 +145,59 @@ func ResourceGslbServiceSchema() map[string]*schema.Schema {
 			Computed: true,
 		},
 		"wildcard_match": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "false",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
 		},
 	}
 }
 
 func resourceAviGslbService() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviGslbServiceCreate,
-		Read:   ResourceAviGslbServiceRead,
-		Update: resourceAviGslbServiceUpdate,
-		Delete: resourceAviGslbServiceDelete,
-		Schema: ResourceGslbServiceSchema(),
+		CreateContext: resourceAviGslbServiceCreate,
+		ReadContext:   ResourceAviGslbServiceRead,
+		UpdateContext: resourceAviGslbServiceUpdate,
+		DeleteContext: resourceAviGslbServiceDelete,
+		Schema:        ResourceGslbServiceSchema(),
 		Importer: &schema.ResourceImporter{
-			State: ResourceGslbServiceImporter,
+			StateContext: ResourceGslbServiceImporter,
 		},
 	}
 }
 
-func ResourceGslbServiceImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func ResourceGslbServiceImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	s := ResourceGslbServiceSchema()
-	return ResourceImporter(d, m, "gslbservice", s)
+	return ResourceImporter(ctx, d, m, "gslbservice", s)
 }
 
-func ResourceAviGslbServiceRead(d *schema.ResourceData, meta interface{}) error {
+func ResourceAviGslbServiceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceGslbServiceSchema()
-	err := APIRead(d, meta, "gslbservice", s)
-	if err != nil {
-		log.Printf("[ERROR] in reading object %v\n", err)
+	diags := APIRead(ctx, d, meta, "gslbservice", s)
+	if diags.HasError() {
+		log.Printf("[ERROR] in reading object %v\n", diags)
 	}
-	return err
+	return diags
 }
 
-func resourceAviGslbServiceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviGslbServiceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceGslbServiceSchema()
-	err := APICreateOrUpdate(d, meta, "gslbservice", s)
-	if err == nil {
-		err = ResourceAviGslbServiceRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "gslbservice", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviGslbServiceRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviGslbServiceUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviGslbServiceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceGslbServiceSchema()
-	var err error
-	err = APICreateOrUpdate(d, meta, "gslbservice", s)
-	if err == nil {
-		err = ResourceAviGslbServiceRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "gslbservice", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviGslbServiceRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviGslbServiceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAviGslbServiceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	objType := "gslbservice"
-	client := meta.(*clients.AviClient)
-	if APIDeleteSystemDefaultCheck(d) {
-		return nil
-	}
-	uuid := d.Get("uuid").(string)
-	if uuid != "" {
-		path := "api/" + objType + "/" + uuid
-		err := client.AviSession.Delete(path)
-		if err != nil && !(strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "204") || strings.Contains(err.Error(), "403")) {
-			log.Println("[INFO] resourceAviGslbServiceDelete not found")
-			return err
-		}
-		d.SetId("")
-	}
-	return nil
+	return APIDelete(ctx, d, meta, objType)
 }
@@ -4,11 +4,11 @@
 package avi
 
 import (
+	"context"
 	"log"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/vmware/alb-sdk/go/clients"
 )
 
 func ResourceHardwareSecurityModuleGroupSchema() map[string]*schema.Schema {
@@ -48,65 +48,50 @@ func ResourceHardwareSecurityModuleGroupSchema() map[string]*schema.Schema {
 
 func resourceAviHardwareSecurityModuleGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviHardwareSecurityModuleGroupCreate,
-		Read:   ResourceAviHardwareSecurityModuleGroupRead,
-		Update: resourceAviHardwareSecurityModuleGroupUpdate,
-		Delete: resourceAviHardwareSecurityModuleGroupDelete,
-		Schema: ResourceHardwareSecurityModuleGroupSchema(),
+		CreateContext: resourceAviHardwareSecurityModuleGroupCreate,
+		ReadContext:   ResourceAviHardwareSecurityModuleGroupRead,
+		UpdateContext: resourceAviHardwareSecurityModuleGroupUpdate,
+		DeleteContext: resourceAviHardwareSecurityModuleGroupDelete,
+		Schema:        ResourceHardwareSecurityModuleGroupSchema(),
 		Importer: &schema.ResourceImporter{
-			State: ResourceHardwareSecurityModuleGroupImporter,
+			StateContext: ResourceHardwareSecurityModuleGroupImporter,
 		},
 	}
 }
 
-func ResourceHardwareSecurityModuleGroupImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func ResourceHardwareSecurityModuleGroupImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	s := ResourceHardwareSecurityModuleGroupSchema()
-	return ResourceImporter(d, m, "hardwaresecuritymodulegroup", s)
+	return ResourceImporter(ctx, d, m, "hardwaresecuritymodulegroup", s)
 }
 
-func ResourceAviHardwareSecurityModuleGroupRead(d *schema.ResourceData, meta interface{}) error {
+func ResourceAviHardwareSecurityModuleGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceHardwareSecurityModuleGroupSchema()
-	err := APIRead(d, meta, "hardwaresecuritymodulegroup", s)
-	if err != nil {
-		log.Printf("[ERROR] in reading object %v\n", err)
+	diags := APIRead(ctx, d, meta, "hardwaresecuritymodulegroup", s)
+	if diags.HasError() {
+		log.Printf("[ERROR] in reading object %v\n", diags)
 	}
-	return err
+	return diags
 }
 
-func resourceAviHardwareSecurityModuleGroupCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviHardwareSecurityModuleGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceHardwareSecurityModuleGroupSchema()
-	err := APICreateOrUpdate(d, meta, "hardwaresecuritymodulegroup", s)
-	if err == nil {
-		err = ResourceAviHardwareSecurityModuleGroupRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "hardwaresecuritymodulegroup", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviHardwareSecurityModuleGroupRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviHardwareSecurityModuleGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviHardwareSecurityModuleGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceHardwareSecurityModuleGroupSchema()
-	var err error
-	err = APICreateOrUpdate(d, meta, "hardwaresecuritymodulegroup", s)
-	if err == nil {
-		err = ResourceAviHardwareSecurityModuleGroupRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "hardwaresecuritymodulegroup", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviHardwareSecurityModuleGroupRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviHardwareSecurityModuleGroupDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAviHardwareSecurityModuleGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	objType := "hardwaresecuritymodulegroup"
-	client := meta.(*clients.AviClient)
-	if APIDeleteSystemDefaultCheck(d) {
-		return nil
-	}
-	uuid := d.Get("uuid").(string)
-	if uuid != "" {
-		path := "api/" + objType + "/" + uuid
-		err := client.AviSession.Delete(path)
-		if err != nil && !(strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "204") || strings.Contains(err.Error(), "403")) {
-			log.Println("[INFO] resourceAviHardwareSecurityModuleGroupDelete not found")
-			return err
-		}
-		d.SetId("")
-	}
-	return nil
+	return APIDelete(ctx, d, meta, objType)
 }
@@ -4,20 +4,19 @@
 package avi
 
 import (
+	"context"
 	"log"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/vmware/alb-sdk/go/clients"
 )
 
 func ResourceHealthMonitorSchema() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
 		"allow_duplicate_monitors": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Computed:     true,
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Computed: true,
 		},
 		"authentication": {
 			Type:     schema.TypeSet,
@@ -37,10 +36,9 @@ func ResourceHealthMonitorSchema() map[string]*schema.Schema {
 			Computed: true,
 		},
 		"disable_quickstart": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Computed:     true,
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Computed: true,
 		},
 		"dns_monitor": {
 			Type:     schema.TypeSet,
@@ -55,10 +53,9 @@ func ResourceHealthMonitorSchema() map[string]*schema.Schema {
 			Elem:     ResourceHealthMonitorExternalSchema(),
 		},
 		"failed_checks": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "2",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  2,
 		},
 		"ftp_monitor": {
 			Type:     schema.TypeSet,
@@ -97,10 +94,9 @@ func ResourceHealthMonitorSchema() map[string]*schema.Schema {
 			Elem:     ResourceHealthMonitorImapSchema(),
 		},
 		"is_federated": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "false",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
 		},
 		"ldap_monitor": {
 			Type:     schema.TypeSet,
@@ -120,10 +116,9 @@ func ResourceHealthMonitorSchema() map[string]*schema.Schema {
 			Elem:     ResourceRoleFilterMatchLabelSchema(),
 		},
 		"monitor_port": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Computed:     true,
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Computed: true,
 		},
 		"name": {
 			Type:     schema.TypeString,
@@ -148,10 +143,9 @@ func ResourceHealthMonitorSchema() map[string]*schema.Schema {
 			Elem:     ResourceHealthMonitorRadiusSchema(),
 		},
 		"receive_timeout": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "4",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  4,
 		},
 		"sctp_monitor": {
 			Type:     schema.TypeSet,
@@ -160,10 +154,9 @@ func ResourceHealthMonitorSchema() map[string]*schema.Schema {
 			Elem:     ResourceHealthMonitorSctpSchema(),
 		},
 		"send_interval": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "10",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  10,
 		},
 		"sip_monitor": {
 			Type:     schema.TypeSet,
@@ -184,10 +177,9 @@ func ResourceHealthMonitorSchema() map[string]*schema.Schema {
 			Elem:     ResourceHealthMonitorSmtpSchema(),
 		},
 		"successful_checks": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "2",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  2,
 		},
 		"tcp_monitor": {
 			Type:     schema.TypeSet,
@@ -220,65 +212,50 @@ func ResourceHealthMonitorSchema() map[string]*schema.Schema {
 
 func resourceAviHealthMonitor() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviHealthMonitorCreate,
-		Read:   ResourceAviHealthMonitorRead,
-		Update: resourceAviHealthMonitorUpdate,
-		Delete: resourceAviHealthMonitorDelete,
-		Schema: ResourceHealthMonitorSchema(),
+		CreateContext: resourceAviHealthMonitorCreate,
+		ReadContext:   ResourceAviHealthMonitorRead,
+		UpdateContext: resourceAviHealthMonitorUpdate,
+		DeleteContext: resourceAviHealthMonitorDelete,
+		Schema:        ResourceHealthMonitorSchema(),
 		Importer: &schema.ResourceImporter{
-			State: ResourceHealthMonitorImporter,
+			StateContext: ResourceHealthMonitorImporter,
 		},
 	}
 }
 
-func ResourceHealthMonitorImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func ResourceHealthMonitorImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	s := ResourceHealthMonitorSchema()
-	return ResourceImporter(d, m, "healthmonitor", s)
+	return ResourceImporter(ctx, d, m, "healthmonitor", s)
 }
 
-func ResourceAviHealthMonitorRead(d *schema.ResourceData, meta interface{}) error {
+func ResourceAviHealthMonitorRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceHealthMonitorSchema()
-	err := APIRead(d, meta, "healthmonitor", s)
-	if err != nil {
-		log.Printf("[ERROR] in reading object %v\n", err)
+	diags := APIRead(ctx, d, meta, "healthmonitor", s)
+	if diags.HasError() {
+		log.Printf("[ERROR] in reading object %v\n", diags)
 	}
-	return err
+	return diags
 }
 
-func resourceAviHealthMonitorCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviHealthMonitorCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceHealthMonitorSchema()
-	err := APICreateOrUpdate(d, meta, "healthmonitor", s)
-	if err == nil {
-		err = ResourceAviHealthMonitorRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "healthmonitor", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviHealthMonitorRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviHealthMonitorUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviHealthMonitorUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceHealthMonitorSchema()
-	var err error
-	err = APICreateOrUpdate(d, meta, "healthmonitor", s)
-	if err == nil {
-		err = ResourceAviHealthMonitorRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "healthmonitor", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviHealthMonitorRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviHealthMonitorDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAviHealthMonitorDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	objType := "healthmonitor"
-	client := meta.(*clients.AviClient)
-	if APIDeleteSystemDefaultCheck(d) {
-		return nil
-	}
-	uuid := d.Get("uuid").(string)
-	if uuid != "" {
-		path := "api/" + objType + "/" + uuid
-		err := client.AviSession.Delete(path)
-		if err != nil && !(strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "204") || strings.Contains(err.Error(), "403")) {
-			log.Println("[INFO] resourceAviHealthMonitorDelete not found")
-			return err
-		}
-		d.SetId("")
-	}
-	return nil
+	return APIDelete(ctx, d, meta, objType)
 }
@@ -4,11 +4,11 @@
 package avi
 
 import (
+	"context"
 	"log"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/vmware/alb-sdk/go/clients"
 )
 
 func ResourceHTTPPolicySetSchema() map[string]*schema.Schema {
@@ -63,10 +63,9 @@ func ResourceHTTPPolicySetSchema() map[string]*schema.Schema {
 			Computed: true,
 		},
 		"is_internal_policy": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "false",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
 		},
 		"markers": {
 			Type:     schema.TypeList,
@@ -92,65 +91,50 @@ func ResourceHTTPPolicySetSchema() map[string]*schema.Schema {
 
 func resourceAviHTTPPolicySet() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviHTTPPolicySetCreate,
-		Read:   ResourceAviHTTPPolicySetRead,
-		Update: resourceAviHTTPPolicySetUpdate,
-		Delete: resourceAviHTTPPolicySetDelete,
-		Schema: ResourceHTTPPolicySetSchema(),
+		CreateContext: resourceAviHTTPPolicySetCreate,
+		ReadContext:   ResourceAviHTTPPolicySetRead,
+		UpdateContext: resourceAviHTTPPolicySetUpdate,
+		DeleteContext: resourceAviHTTPPolicySetDelete,
+		Schema:        ResourceHTTPPolicySetSchema(),
 		Importer: &schema.ResourceImporter{
-			State: ResourceHTTPPolicySetImporter,
+			StateContext: ResourceHTTPPolicySetImporter,
 		},
 	}
 }
 
-func ResourceHTTPPolicySetImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func ResourceHTTPPolicySetImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	s := ResourceHTTPPolicySetSchema()
-	return ResourceImporter(d, m, "httppolicyset", s)
+	return ResourceImporter(ctx, d, m, "httppolicyset", s)
 }
 
-func ResourceAviHTTPPolicySetRead(d *schema.ResourceData, meta interface{}) error {
+func ResourceAviHTTPPolicySetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceHTTPPolicySetSchema()
-	err := APIRead(d, meta, "httppolicyset", s)
-	if err != nil {
-		log.Printf("[ERROR] in reading object %v\n", err)
+	diags := APIRead(ctx, d, meta, "httppolicyset", s)
+	if diags.HasError() {
+		log.Printf("[ERROR] in reading object %v\n", diags)
 	}
-	return err
+	return diags
 }
 
-func resourceAviHTTPPolicySetCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviHTTPPolicySetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceHTTPPolicySetSchema()
-	err := APICreateOrUpdate(d, meta, "httppolicyset", s)
-	if err == nil {
-		err = ResourceAviHTTPPolicySetRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "httppolicyset", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviHTTPPolicySetRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviHTTPPolicySetUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviHTTPPolicySetUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceHTTPPolicySetSchema()
-	var err error
-	err = APICreateOrUpdate(d, meta, "httppolicyset", s)
-	if err == nil {
-		err = ResourceAviHTTPPolicySetRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "httppolicyset", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviHTTPPolicySetRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviHTTPPolicySetDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAviHTTPPolicySetDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	objType := "httppolicyset"
-	client := meta.(*clients.AviClient)
-	if APIDeleteSystemDefaultCheck(d) {
-		return nil
-	}
-	uuid := d.Get("uuid").(string)
-	if uuid != "" {
-		path := "api/" + objType + "/" + uuid
-		err := client.AviSession.Delete(path)
-		if err != nil && !(strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "204") || strings.Contains(err.Error(), "403")) {
-			log.Println("[INFO] resourceAviHTTPPolicySetDelete not found")
-			return err
-		}
-		d.SetId("")
-	}
-	return nil
+	return APIDelete(ctx, d, meta, objType)
 }
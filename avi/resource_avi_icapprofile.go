@@ -4,26 +4,24 @@
 package avi
 
 import (
+	"context"
 	"log"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/vmware/alb-sdk/go/clients"
 )
 
 func ResourceIcapProfileSchema() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
 		"allow_204": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "true",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  true,
 		},
 		"buffer_size": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "51200",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  51200,
 		},
 		"buffer_size_exceed_action": {
 			Type:     schema.TypeString,
@@ -47,10 +45,9 @@ func ResourceIcapProfileSchema() map[string]*schema.Schema {
 			Computed: true,
 		},
 		"enable_preview": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "true",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  true,
 		},
 		"fail_action": {
 			Type:     schema.TypeString,
@@ -72,26 +69,23 @@ func ResourceIcapProfileSchema() map[string]*schema.Schema {
 			Required: true,
 		},
 		"preview_size": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "5000",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  5000,
 		},
 		"response_timeout": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "60000",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  60000,
 		},
 		"service_uri": {
 			Type:     schema.TypeString,
 			Required: true,
 		},
 		"slow_response_warning_threshold": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "10000",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  10000,
 		},
 		"tenant_ref": {
 			Type:     schema.TypeString,
@@ -113,65 +107,50 @@ func ResourceIcapProfileSchema() map[string]*schema.Schema {
 
 func resourceAviIcapProfile() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviIcapProfileCreate,
-		Read:   ResourceAviIcapProfileRead,
-		Update: resourceAviIcapProfileUpdate,
-		Delete: resourceAviIcapProfileDelete,
-		Schema: ResourceIcapProfileSchema(),
+		CreateContext: resourceAviIcapProfileCreate,
+		ReadContext:   ResourceAviIcapProfileRead,
+		UpdateContext: resourceAviIcapProfileUpdate,
+		DeleteContext: resourceAviIcapProfileDelete,
+		Schema:        ResourceIcapProfileSchema(),
 		Importer: &schema.ResourceImporter{
-			State: ResourceIcapProfileImporter,
+			StateContext: ResourceIcapProfileImporter,
 		},
 	}
 }
 
-func ResourceIcapProfileImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func ResourceIcapProfileImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	s := ResourceIcapProfileSchema()
-	return ResourceImporter(d, m, "icapprofile", s)
+	return ResourceImporter(ctx, d, m, "icapprofile", s)
 }
 
-func ResourceAviIcapProfileRead(d *schema.ResourceData, meta interface{}) error {
+func ResourceAviIcapProfileRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceIcapProfileSchema()
-	err := APIRead(d, meta, "icapprofile", s)
-	if err != nil {
-		log.Printf("[ERROR] in reading object %v\n", err)
+	diags := APIRead(ctx, d, meta, "icapprofile", s)
+	if diags.HasError() {
+		log.Printf("[ERROR] in reading object %v\n", diags)
 	}
-	return err
+	return diags
 }
 
-func resourceAviIcapProfileCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviIcapProfileCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceIcapProfileSchema()
-	err := APICreateOrUpdate(d, meta, "icapprofile", s)
-	if err == nil {
-		err = ResourceAviIcapProfileRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "icapprofile", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviIcapProfileRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviIcapProfileUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviIcapProfileUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceIcapProfileSchema()
-	var err error
-	err = APICreateOrUpdate(d, meta, "icapprofile", s)
-	if err == nil {
-		err = ResourceAviIcapProfileRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "icapprofile", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviIcapProfileRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviIcapProfileDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAviIcapProfileDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	objType := "icapprofile"
-	client := meta.(*clients.AviClient)
-	if APIDeleteSystemDefaultCheck(d) {
-		return nil
-	}
-	uuid := d.Get("uuid").(string)
-	if uuid != "" {
-		path := "api/" + objType + "/" + uuid
-		err := client.AviSession.Delete(path)
-		if err != nil && !(strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "204") || strings.Contains(err.Error(), "403")) {
-			log.Println("[INFO] resourceAviIcapProfileDelete not found")
-			return err
-		}
-		d.SetId("")
-	}
-	return nil
+	return APIDelete(ctx, d, meta, objType)
 }
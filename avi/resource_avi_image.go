@@ -4,11 +4,11 @@
 package avi
 
 import (
+	"context"
 	"log"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/vmware/alb-sdk/go/clients"
 )
 
 func ResourceImageSchema() map[string]*schema.Schema {
@@ -35,10 +35,9 @@ func ResourceImageSchema() map[string]*schema.Schema {
 			Computed: true,
 		},
 		"duration": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Computed:     true,
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Computed: true,
 		},
 		"end_time": {
 			Type:     schema.TypeString,
@@ -67,10 +66,9 @@ func ResourceImageSchema() map[string]*schema.Schema {
 			Required: true,
 		},
 		"progress": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "0",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  0,
 		},
 		"se_info": {
 			Type:     schema.TypeSet,
@@ -94,10 +92,9 @@ func ResourceImageSchema() map[string]*schema.Schema {
 			Computed: true,
 		},
 		"tasks_completed": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "0",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  0,
 		},
 		"tenant_ref": {
 			Type:     schema.TypeString,
@@ -105,10 +102,9 @@ func ResourceImageSchema() map[string]*schema.Schema {
 			Computed: true,
 		},
 		"total_tasks": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "0",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  0,
 		},
 		"type": {
 			Type:     schema.TypeString,
@@ -116,10 +112,9 @@ func ResourceImageSchema() map[string]*schema.Schema {
 			Computed: true,
 		},
 		"uber_bundle": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "false",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
 		},
 		"uuid": {
 			Type:     schema.TypeString,
@@ -131,65 +126,50 @@ func ResourceImageSchema() map[string]*schema.Schema {
 
 func resourceAviImage() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviImageCreate,
-		Read:   ResourceAviImageRead,
-		Update: resourceAviImageUpdate,
-		Delete: resourceAviImageDelete,
-		Schema: ResourceImageSchema(),
+		CreateContext: resourceAviImageCreate,
+		ReadContext:   ResourceAviImageRead,
+		UpdateContext: resourceAviImageUpdate,
+		DeleteContext: resourceAviImageDelete,
+		Schema:        ResourceImageSchema(),
 		Importer: &schema.ResourceImporter{
-			State: ResourceImageImporter,
+			StateContext: ResourceImageImporter,
 		},
 	}
 }
 
-func ResourceImageImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func ResourceImageImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	s := ResourceImageSchema()
-	return ResourceImporter(d, m, "image", s)
+	return ResourceImporter(ctx, d, m, "image", s)
 }
 
-func ResourceAviImageRead(d *schema.ResourceData, meta interface{}) error {
+func ResourceAviImageRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceImageSchema()
-	err := APIRead(d, meta, "image", s)
-	if err != nil {
-		log.Printf("[ERROR] in reading object %v\n", err)
+	diags := APIRead(ctx, d, meta, "image", s)
+	if diags.HasError() {
+		log.Printf("[ERROR] in reading object %v\n", diags)
 	}
-	return err
+	return diags
 }
 
-func resourceAviImageCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviImageCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceImageSchema()
-	err := APICreateOrUpdate(d, meta, "image", s)
-	if err == nil {
-		err = ResourceAviImageRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "image", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviImageRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviImageUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviImageUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceImageSchema()
-	var err error
-	err = APICreateOrUpdate(d, meta, "image", s)
-	if err == nil {
-		err = ResourceAviImageRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "image", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviImageRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviImageDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAviImageDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	objType := "image"
-	client := meta.(*clients.AviClient)
-	if APIDeleteSystemDefaultCheck(d) {
-		return nil
-	}
-	uuid := d.Get("uuid").(string)
-	if uuid != "" {
-		path := "api/" + objType + "/" + uuid
-		err := client.AviSession.Delete(path)
-		if err != nil && !(strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "204") || strings.Contains(err.Error(), "403")) {
-			log.Println("[INFO] resourceAviImageDelete not found")
-			return err
-		}
-		d.SetId("")
-	}
-	return nil
+	return APIDelete(ctx, d, meta, objType)
 }
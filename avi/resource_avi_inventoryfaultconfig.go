@@ -4,11 +4,11 @@
 package avi
 
 import (
+	"context"
 	"log"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/vmware/alb-sdk/go/clients"
 )
 
 func ResourceInventoryFaultConfigSchema() map[string]*schema.Schema {
@@ -57,65 +57,50 @@ func ResourceInventoryFaultConfigSchema() map[string]*schema.Schema {
 
 func resourceAviInventoryFaultConfig() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviInventoryFaultConfigCreate,
-		Read:   ResourceAviInventoryFaultConfigRead,
-		Update: resourceAviInventoryFaultConfigUpdate,
-		Delete: resourceAviInventoryFaultConfigDelete,
-		Schema: ResourceInventoryFaultConfigSchema(),
+		CreateContext: resourceAviInventoryFaultConfigCreate,
+		ReadContext:   ResourceAviInventoryFaultConfigRead,
+		UpdateContext: resourceAviInventoryFaultConfigUpdate,
+		DeleteContext: resourceAviInventoryFaultConfigDelete,
+		Schema:        ResourceInventoryFaultConfigSchema(),
 		Importer: &schema.ResourceImporter{
-			State: ResourceInventoryFaultConfigImporter,
+			StateContext: ResourceInventoryFaultConfigImporter,
 		},
 	}
 }
 
-func ResourceInventoryFaultConfigImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func ResourceInventoryFaultConfigImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	s := ResourceInventoryFaultConfigSchema()
-	return ResourceImporter(d, m, "inventoryfaultconfig", s)
+	return ResourceImporter(ctx, d, m, "inventoryfaultconfig", s)
 }
 
-func ResourceAviInventoryFaultConfigRead(d *schema.ResourceData, meta interface{}) error {
+func ResourceAviInventoryFaultConfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceInventoryFaultConfigSchema()
-	err := APIRead(d, meta, "inventoryfaultconfig", s)
-	if err != nil {
-		log.Printf("[ERROR] in reading object %v\n", err)
+	diags := APIRead(ctx, d, meta, "inventoryfaultconfig", s)
+	if diags.HasError() {
+		log.Printf("[ERROR] in reading object %v\n", diags)
 	}
-	return err
+	return diags
 }
 
-func resourceAviInventoryFaultConfigCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviInventoryFaultConfigCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceInventoryFaultConfigSchema()
-	err := APICreateOrUpdate(d, meta, "inventoryfaultconfig", s)
-	if err == nil {
-		err = ResourceAviInventoryFaultConfigRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "inventoryfaultconfig", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviInventoryFaultConfigRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviInventoryFaultConfigUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviInventoryFaultConfigUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceInventoryFaultConfigSchema()
-	var err error
-	err = APICreateOrUpdate(d, meta, "inventoryfaultconfig", s)
-	if err == nil {
-		err = ResourceAviInventoryFaultConfigRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "inventoryfaultconfig", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviInventoryFaultConfigRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviInventoryFaultConfigDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAviInventoryFaultConfigDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	objType := "inventoryfaultconfig"
-	client := meta.(*clients.AviClient)
-	if APIDeleteSystemDefaultCheck(d) {
-		return nil
-	}
-	uuid := d.Get("uuid").(string)
-	if uuid != "" {
-		path := "api/" + objType + "/" + uuid
-		err := client.AviSession.Delete(path)
-		if err != nil && !(strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "204") || strings.Contains(err.Error(), "403")) {
-			log.Println("[INFO] resourceAviInventoryFaultConfigDelete not found")
-			return err
-		}
-		d.SetId("")
-	}
-	return nil
+	return APIDelete(ctx, d, meta, objType)
 }
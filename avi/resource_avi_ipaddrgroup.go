@@ -4,11 +4,11 @@
 package avi
 
 import (
+	"context"
 	"log"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/vmware/alb-sdk/go/clients"
 )
 
 //nolint
@@ -46,10 +46,9 @@ func ResourceIpAddrGroupSchema() map[string]*schema.Schema {
 			Computed: true,
 		},
 		"marathon_service_port": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Computed:     true,
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Computed: true,
 		},
 		"markers": {
 			Type:     schema.TypeList,
@@ -86,70 +85,55 @@ func ResourceIpAddrGroupSchema() map[string]*schema.Schema {
 //nolint
 func resourceAviIpAddrGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviIpAddrGroupCreate,
-		Read:   ResourceAviIpAddrGroupRead,
-		Update: resourceAviIpAddrGroupUpdate,
-		Delete: resourceAviIpAddrGroupDelete,
-		Schema: ResourceIpAddrGroupSchema(),
+		CreateContext: resourceAviIpAddrGroupCreate,
+		ReadContext:   ResourceAviIpAddrGroupRead,
+		UpdateContext: resourceAviIpAddrGroupUpdate,
+		DeleteContext: resourceAviIpAddrGroupDelete,
+		Schema:        ResourceIpAddrGroupSchema(),
 		Importer: &schema.ResourceImporter{
-			State: ResourceIpAddrGroupImporter,
+			StateContext: ResourceIpAddrGroupImporter,
 		},
 	}
 }
 
 //nolint
-func ResourceIpAddrGroupImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func ResourceIpAddrGroupImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	s := ResourceIpAddrGroupSchema()
-	return ResourceImporter(d, m, "ipaddrgroup", s)
+	return ResourceImporter(ctx, d, m, "ipaddrgroup", s)
 }
 
 //nolint
-func ResourceAviIpAddrGroupRead(d *schema.ResourceData, meta interface{}) error {
+func ResourceAviIpAddrGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceIpAddrGroupSchema()
-	err := APIRead(d, meta, "ipaddrgroup", s)
-	if err != nil {
-		log.Printf("[ERROR] in reading object %v\n", err)
+	diags := APIRead(ctx, d, meta, "ipaddrgroup", s)
+	if diags.HasError() {
+		log.Printf("[ERROR] in reading object %v\n", diags)
 	}
-	return err
+	return diags
 }
 
 //nolint
-func resourceAviIpAddrGroupCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviIpAddrGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceIpAddrGroupSchema()
-	err := APICreateOrUpdate(d, meta, "ipaddrgroup", s)
-	if err == nil {
-		err = ResourceAviIpAddrGroupRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "ipaddrgroup", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviIpAddrGroupRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
 //nolint
-func resourceAviIpAddrGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviIpAddrGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceIpAddrGroupSchema()
-	var err error
-	err = APICreateOrUpdate(d, meta, "ipaddrgroup", s)
-	if err == nil {
-		err = ResourceAviIpAddrGroupRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "ipaddrgroup", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviIpAddrGroupRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
 //nolint
-func resourceAviIpAddrGroupDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAviIpAddrGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	objType := "ipaddrgroup"
-	client := meta.(*clients.AviClient)
-	if APIDeleteSystemDefaultCheck(d) {
-		return nil
-	}
-	uuid := d.Get("uuid").(string)
-	if uuid != "" {
-		path := "api/" + objType + "/" + uuid
-		err := client.AviSession.Delete(path)
-		if err != nil && !(strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "204") || strings.Contains(err.Error(), "403")) {
-			log.Println("[INFO] resourceAviIpAddrGroupDelete not found")
-			return err
-		}
-		d.SetId("")
-	}
-	return nil
+	return APIDelete(ctx, d, meta, objType)
 }
@@ -7,7 +7,6 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
-	"github.com/vmware/alb-sdk/go/clients"
 )
 
 func TestAVIIpAddrGroupBasic(t *testing.T) {
@@ -46,7 +45,7 @@ func TestAVIIpAddrGroupBasic(t *testing.T) {
 //nolint
 func testAccCheckAVIIpAddrGroupExists(resourcename string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*clients.AviClient).AviSession
+		conn := testAccProvider.Meta().(*ProviderClient).AviSession
 		var obj interface{}
 		rs, ok := s.RootModule().Resources[resourcename]
 		if !ok {
@@ -69,7 +68,7 @@ func testAccCheckAVIIpAddrGroupExists(resourcename string) resource.TestCheckFun
 
 //nolint
 func testAccCheckAVIIpAddrGroupDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*clients.AviClient).AviSession
+	conn := testAccProvider.Meta().(*ProviderClient).AviSession
 	var obj interface{}
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "avi_ipaddrgroup" {
@@ -4,21 +4,20 @@
 package avi
 
 import (
+	"context"
 	"log"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/vmware/alb-sdk/go/clients"
 )
 
 //nolint
 func ResourceIpamDnsProviderProfileSchema() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
 		"allocate_ip_in_vrf": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "false",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
 		},
 		"aws_profile": {
 			Type:     schema.TypeSet,
@@ -115,70 +114,55 @@ func ResourceIpamDnsProviderProfileSchema() map[string]*schema.Schema {
 //nolint
 func resourceAviIpamDnsProviderProfile() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviIpamDnsProviderProfileCreate,
-		Read:   ResourceAviIpamDnsProviderProfileRead,
-		Update: resourceAviIpamDnsProviderProfileUpdate,
-		Delete: resourceAviIpamDnsProviderProfileDelete,
-		Schema: ResourceIpamDnsProviderProfileSchema(),
+		CreateContext: resourceAviIpamDnsProviderProfileCreate,
+		ReadContext:   ResourceAviIpamDnsProviderProfileRead,
+		UpdateContext: resourceAviIpamDnsProviderProfileUpdate,
+		DeleteContext: resourceAviIpamDnsProviderProfileDelete,
+		Schema:        ResourceIpamDnsProviderProfileSchema(),
 		Importer: &schema.ResourceImporter{
-			State: ResourceIpamDnsProviderProfileImporter,
+			StateContext: ResourceIpamDnsProviderProfileImporter,
 		},
 	}
 }
 
 //nolint
-func ResourceIpamDnsProviderProfileImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func ResourceIpamDnsProviderProfileImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	s := ResourceIpamDnsProviderProfileSchema()
-	return ResourceImporter(d, m, "ipamdnsproviderprofile", s)
+	return ResourceImporter(ctx, d, m, "ipamdnsproviderprofile", s)
 }
 
 //nolint
-func ResourceAviIpamDnsProviderProfileRead(d *schema.ResourceData, meta interface{}) error {
+func ResourceAviIpamDnsProviderProfileRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceIpamDnsProviderProfileSchema()
-	err := APIRead(d, meta, "ipamdnsproviderprofile", s)
-	if err != nil {
-		log.Printf("[ERROR] in reading object %v\n", err)
+	diags := APIRead(ctx, d, meta, "ipamdnsproviderprofile", s)
+	if diags.HasError() {
+		log.Printf("[ERROR] in reading object %v\n", diags)
 	}
-	return err
+	return diags
 }
 
 //nolint
-func resourceAviIpamDnsProviderProfileCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviIpamDnsProviderProfileCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceIpamDnsProviderProfileSchema()
-	err := APICreateOrUpdate(d, meta, "ipamdnsproviderprofile", s)
-	if err == nil {
-		err = ResourceAviIpamDnsProviderProfileRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "ipamdnsproviderprofile", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviIpamDnsProviderProfileRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
 //nolint
-func resourceAviIpamDnsProviderProfileUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviIpamDnsProviderProfileUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceIpamDnsProviderProfileSchema()
-	var err error
-	err = APICreateOrUpdate(d, meta, "ipamdnsproviderprofile", s)
-	if err == nil {
-		err = ResourceAviIpamDnsProviderProfileRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "ipamdnsproviderprofile", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviIpamDnsProviderProfileRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
 //nolint
-func resourceAviIpamDnsProviderProfileDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAviIpamDnsProviderProfileDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	objType := "ipamdnsproviderprofile"
-	client := meta.(*clients.AviClient)
-	if APIDeleteSystemDefaultCheck(d) {
-		return nil
-	}
-	uuid := d.Get("uuid").(string)
-	if uuid != "" {
-		path := "api/" + objType + "/" + uuid
-		err := client.AviSession.Delete(path)
-		if err != nil && !(strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "204") || strings.Contains(err.Error(), "403")) {
-			log.Println("[INFO] resourceAviIpamDnsProviderProfileDelete not found")
-			return err
-		}
-		d.SetId("")
-	}
-	return nil
+	return APIDelete(ctx, d, meta, objType)
 }
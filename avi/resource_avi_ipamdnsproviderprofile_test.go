@@ -7,7 +7,6 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
-	"github.com/vmware/alb-sdk/go/clients"
 )
 
 func TestAVIIpamDnsProviderProfileBasic(t *testing.T) {
@@ -50,7 +49,7 @@ func TestAVIIpamDnsProviderProfileBasic(t *testing.T) {
 //nolint
 func testAccCheckAVIIpamDnsProviderProfileExists(resourcename string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*clients.AviClient).AviSession
+		conn := testAccProvider.Meta().(*ProviderClient).AviSession
 		var obj interface{}
 		rs, ok := s.RootModule().Resources[resourcename]
 		if !ok {
@@ -73,7 +72,7 @@ func testAccCheckAVIIpamDnsProviderProfileExists(resourcename string) resource.T
 
 //nolint
 func testAccCheckAVIIpamDnsProviderProfileDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*clients.AviClient).AviSession
+	conn := testAccProvider.Meta().(*ProviderClient).AviSession
 	var obj interface{}
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "avi_ipamdnsproviderprofile" {
@@ -4,11 +4,11 @@
 package avi
 
 import (
+	"context"
 	"log"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/vmware/alb-sdk/go/clients"
 )
 
 func ResourceIPReputationDBSchema() map[string]*schema.Schema {
@@ -73,65 +73,50 @@ func ResourceIPReputationDBSchema() map[string]*schema.Schema {
 
 func resourceAviIPReputationDB() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviIPReputationDBCreate,
-		Read:   ResourceAviIPReputationDBRead,
-		Update: resourceAviIPReputationDBUpdate,
-		Delete: resourceAviIPReputationDBDelete,
-		Schema: ResourceIPReputationDBSchema(),
+		CreateContext: resourceAviIPReputationDBCreate,
+		ReadContext:   ResourceAviIPReputationDBRead,
+		UpdateContext: resourceAviIPReputationDBUpdate,
+		DeleteContext: resourceAviIPReputationDBDelete,
+		Schema:        ResourceIPReputationDBSchema(),
 		Importer: &schema.ResourceImporter{
-			State: ResourceIPReputationDBImporter,
+			StateContext: ResourceIPReputationDBImporter,
 		},
 	}
 }
 
-func ResourceIPReputationDBImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func ResourceIPReputationDBImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	s := ResourceIPReputationDBSchema()
-	return ResourceImporter(d, m, "ipreputationdb", s)
+	return ResourceImporter(ctx, d, m, "ipreputationdb", s)
 }
 
-func ResourceAviIPReputationDBRead(d *schema.ResourceData, meta interface{}) error {
+func ResourceAviIPReputationDBRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceIPReputationDBSchema()
-	err := APIRead(d, meta, "ipreputationdb", s)
-	if err != nil {
-		log.Printf("[ERROR] in reading object %v\n", err)
+	diags := APIRead(ctx, d, meta, "ipreputationdb", s)
+	if diags.HasError() {
+		log.Printf("[ERROR] in reading object %v\n", diags)
 	}
-	return err
+	return diags
 }
 
-func resourceAviIPReputationDBCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviIPReputationDBCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceIPReputationDBSchema()
-	err := APICreateOrUpdate(d, meta, "ipreputationdb", s)
-	if err == nil {
-		err = ResourceAviIPReputationDBRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "ipreputationdb", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviIPReputationDBRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviIPReputationDBUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviIPReputationDBUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceIPReputationDBSchema()
-	var err error
-	err = APICreateOrUpdate(d, meta, "ipreputationdb", s)
-	if err == nil {
-		err = ResourceAviIPReputationDBRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "ipreputationdb", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviIPReputationDBRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviIPReputationDBDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAviIPReputationDBDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	objType := "ipreputationdb"
-	client := meta.(*clients.AviClient)
-	if APIDeleteSystemDefaultCheck(d) {
-		return nil
-	}
-	uuid := d.Get("uuid").(string)
-	if uuid != "" {
-		path := "api/" + objType + "/" + uuid
-		err := client.AviSession.Delete(path)
-		if err != nil && !(strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "204") || strings.Contains(err.Error(), "403")) {
-			log.Println("[INFO] resourceAviIPReputationDBDelete not found")
-			return err
-		}
-		d.SetId("")
-	}
-	return nil
+	return APIDelete(ctx, d, meta, objType)
 }
@@ -4,11 +4,11 @@
 package avi
 
 import (
+	"context"
 	"log"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/vmware/alb-sdk/go/clients"
 )
 
 func ResourceJWTServerProfileSchema() map[string]*schema.Schema {
@@ -26,10 +26,9 @@ func ResourceJWTServerProfileSchema() map[string]*schema.Schema {
 			Elem:     ResourceControllerInternalAuthSchema(),
 		},
 		"is_federated": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "false",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
 		},
 		"issuer": {
 			Type:     schema.TypeString,
@@ -65,65 +64,50 @@ func ResourceJWTServerProfileSchema() map[string]*schema.Schema {
 
 func resourceAviJWTServerProfile() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviJWTServerProfileCreate,
-		Read:   ResourceAviJWTServerProfileRead,
-		Update: resourceAviJWTServerProfileUpdate,
-		Delete: resourceAviJWTServerProfileDelete,
-		Schema: ResourceJWTServerProfileSchema(),
+		CreateContext: resourceAviJWTServerProfileCreate,
+		ReadContext:   ResourceAviJWTServerProfileRead,
+		UpdateContext: resourceAviJWTServerProfileUpdate,
+		DeleteContext: resourceAviJWTServerProfileDelete,
+		Schema:        ResourceJWTServerProfileSchema(),
 		Importer: &schema.ResourceImporter{
-			State: ResourceJWTServerProfileImporter,
+			StateContext: ResourceJWTServerProfileImporter,
 		},
 	}
 }
 
-func ResourceJWTServerProfileImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func ResourceJWTServerProfileImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	s := ResourceJWTServerProfileSchema()
-	return ResourceImporter(d, m, "jwtserverprofile", s)
+	return ResourceImporter(ctx, d, m, "jwtserverprofile", s)
 }
 
-func ResourceAviJWTServerProfileRead(d *schema.ResourceData, meta interface{}) error {
+func ResourceAviJWTServerProfileRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceJWTServerProfileSchema()
-	err := APIRead(d, meta, "jwtserverprofile", s)
-	if err != nil {
-		log.Printf("[ERROR] in reading object %v\n", err)
+	diags := APIRead(ctx, d, meta, "jwtserverprofile", s)
+	if diags.HasError() {
+		log.Printf("[ERROR] in reading object %v\n", diags)
 	}
-	return err
+	return diags
 }
 
-func resourceAviJWTServerProfileCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviJWTServerProfileCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceJWTServerProfileSchema()
-	err := APICreateOrUpdate(d, meta, "jwtserverprofile", s)
-	if err == nil {
-		err = ResourceAviJWTServerProfileRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "jwtserverprofile", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviJWTServerProfileRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviJWTServerProfileUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviJWTServerProfileUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceJWTServerProfileSchema()
-	var err error
-	err = APICreateOrUpdate(d, meta, "jwtserverprofile", s)
-	if err == nil {
-		err = ResourceAviJWTServerProfileRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "jwtserverprofile", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviJWTServerProfileRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviJWTServerProfileDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAviJWTServerProfileDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	objType := "jwtserverprofile"
-	client := meta.(*clients.AviClient)
-	if APIDeleteSystemDefaultCheck(d) {
-		return nil
-	}
-	uuid := d.Get("uuid").(string)
-	if uuid != "" {
-		path := "api/" + objType + "/" + uuid
-		err := client.AviSession.Delete(path)
-		if err != nil && !(strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "204") || strings.Contains(err.Error(), "403")) {
-			log.Println("[INFO] resourceAviJWTServerProfileDelete not found")
-			return err
-		}
-		d.SetId("")
-	}
-	return nil
+	return APIDelete(ctx, d, meta, objType)
 }
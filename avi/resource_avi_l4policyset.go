@@ -4,11 +4,11 @@
 package avi
 
 import (
+	"context"
 	"log"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/vmware/alb-sdk/go/clients"
 )
 
 func ResourceL4PolicySetSchema() map[string]*schema.Schema {
@@ -30,10 +30,9 @@ func ResourceL4PolicySetSchema() map[string]*schema.Schema {
 			Computed: true,
 		},
 		"is_internal_policy": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "false",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
 		},
 		"l4_connection_policy": {
 			Type:     schema.TypeSet,
@@ -65,65 +64,50 @@ func ResourceL4PolicySetSchema() map[string]*schema.Schema {
 
 func resourceAviL4PolicySet() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviL4PolicySetCreate,
-		Read:   ResourceAviL4PolicySetRead,
-		Update: resourceAviL4PolicySetUpdate,
-		Delete: resourceAviL4PolicySetDelete,
-		Schema: ResourceL4PolicySetSchema(),
+		CreateContext: resourceAviL4PolicySetCreate,
+		ReadContext:   ResourceAviL4PolicySetRead,
+		UpdateContext: resourceAviL4PolicySetUpdate,
+		DeleteContext: resourceAviL4PolicySetDelete,
+		Schema:        ResourceL4PolicySetSchema(),
 		Importer: &schema.ResourceImporter{
-			State: ResourceL4PolicySetImporter,
+			StateContext: ResourceL4PolicySetImporter,
 		},
 	}
 }
 
-func ResourceL4PolicySetImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func ResourceL4PolicySetImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	s := ResourceL4PolicySetSchema()
-	return ResourceImporter(d, m, "l4policyset", s)
+	return ResourceImporter(ctx, d, m, "l4policyset", s)
 }
 
-func ResourceAviL4PolicySetRead(d *schema.ResourceData, meta interface{}) error {
+func ResourceAviL4PolicySetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceL4PolicySetSchema()
-	err := APIRead(d, meta, "l4policyset", s)
-	if err != nil {
-		log.Printf("[ERROR] in reading object %v\n", err)
+	diags := APIRead(ctx, d, meta, "l4policyset", s)
+	if diags.HasError() {
+		log.Printf("[ERROR] in reading object %v\n", diags)
 	}
-	return err
+	return diags
 }
 
-func resourceAviL4PolicySetCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviL4PolicySetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceL4PolicySetSchema()
-	err := APICreateOrUpdate(d, meta, "l4policyset", s)
-	if err == nil {
-		err = ResourceAviL4PolicySetRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "l4policyset", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviL4PolicySetRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviL4PolicySetUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviL4PolicySetUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceL4PolicySetSchema()
-	var err error
-	err = APICreateOrUpdate(d, meta, "l4policyset", s)
-	if err == nil {
-		err = ResourceAviL4PolicySetRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "l4policyset", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviL4PolicySetRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviL4PolicySetDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAviL4PolicySetDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	objType := "l4policyset"
-	client := meta.(*clients.AviClient)
-	if APIDeleteSystemDefaultCheck(d) {
-		return nil
-	}
-	uuid := d.Get("uuid").(string)
-	if uuid != "" {
-		path := "api/" + objType + "/" + uuid
-		err := client.AviSession.Delete(path)
-		if err != nil && !(strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "204") || strings.Contains(err.Error(), "403")) {
-			log.Println("[INFO] resourceAviL4PolicySetDelete not found")
-			return err
-		}
-		d.SetId("")
-	}
-	return nil
+	return APIDelete(ctx, d, meta, objType)
 }
@@ -4,11 +4,11 @@
 package avi
 
 import (
+	"context"
 	"log"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/vmware/alb-sdk/go/clients"
 )
 
 func ResourceLabelGroupSchema() map[string]*schema.Schema {
@@ -38,65 +38,50 @@ func ResourceLabelGroupSchema() map[string]*schema.Schema {
 
 func resourceAviLabelGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviLabelGroupCreate,
-		Read:   ResourceAviLabelGroupRead,
-		Update: resourceAviLabelGroupUpdate,
-		Delete: resourceAviLabelGroupDelete,
-		Schema: ResourceLabelGroupSchema(),
+		CreateContext: resourceAviLabelGroupCreate,
+		ReadContext:   ResourceAviLabelGroupRead,
+		UpdateContext: resourceAviLabelGroupUpdate,
+		DeleteContext: resourceAviLabelGroupDelete,
+		Schema:        ResourceLabelGroupSchema(),
 		Importer: &schema.ResourceImporter{
-			State: ResourceLabelGroupImporter,
+			StateContext: ResourceLabelGroupImporter,
 		},
 	}
 }
 
-func ResourceLabelGroupImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func ResourceLabelGroupImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	s := ResourceLabelGroupSchema()
-	return ResourceImporter(d, m, "labelgroup", s)
+	return ResourceImporter(ctx, d, m, "labelgroup", s)
 }
 
-func ResourceAviLabelGroupRead(d *schema.ResourceData, meta interface{}) error {
+func ResourceAviLabelGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceLabelGroupSchema()
-	err := APIRead(d, meta, "labelgroup", s)
-	if err != nil {
-		log.Printf("[ERROR] in reading object %v\n", err)
+	diags := APIRead(ctx, d, meta, "labelgroup", s)
+	if diags.HasError() {
+		log.Printf("[ERROR] in reading object %v\n", diags)
 	}
-	return err
+	return diags
 }
 
-func resourceAviLabelGroupCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviLabelGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceLabelGroupSchema()
-	err := APICreateOrUpdate(d, meta, "labelgroup", s)
-	if err == nil {
-		err = ResourceAviLabelGroupRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "labelgroup", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviLabelGroupRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviLabelGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviLabelGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceLabelGroupSchema()
-	var err error
-	err = APICreateOrUpdate(d, meta, "labelgroup", s)
-	if err == nil {
-		err = ResourceAviLabelGroupRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "labelgroup", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviLabelGroupRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviLabelGroupDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAviLabelGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	objType := "labelgroup"
-	client := meta.(*clients.AviClient)
-	if APIDeleteSystemDefaultCheck(d) {
-		return nil
-	}
-	uuid := d.Get("uuid").(string)
-	if uuid != "" {
-		path := "api/" + objType + "/" + uuid
-		err := client.AviSession.Delete(path)
-		if err != nil && !(strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "204") || strings.Contains(err.Error(), "403")) {
-			log.Println("[INFO] resourceAviLabelGroupDelete not found")
-			return err
-		}
-		d.SetId("")
-	}
-	return nil
+	return APIDelete(ctx, d, meta, objType)
 }
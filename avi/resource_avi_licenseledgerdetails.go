@@ -4,11 +4,11 @@
 package avi
 
 import (
+	"context"
 	"log"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/vmware/alb-sdk/go/clients"
 )
 
 func ResourceLicenseLedgerDetailsSchema() map[string]*schema.Schema {
@@ -38,65 +38,50 @@ func ResourceLicenseLedgerDetailsSchema() map[string]*schema.Schema {
 
 func resourceAviLicenseLedgerDetails() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviLicenseLedgerDetailsCreate,
-		Read:   ResourceAviLicenseLedgerDetailsRead,
-		Update: resourceAviLicenseLedgerDetailsUpdate,
-		Delete: resourceAviLicenseLedgerDetailsDelete,
-		Schema: ResourceLicenseLedgerDetailsSchema(),
+		CreateContext: resourceAviLicenseLedgerDetailsCreate,
+		ReadContext:   ResourceAviLicenseLedgerDetailsRead,
+		UpdateContext: resourceAviLicenseLedgerDetailsUpdate,
+		DeleteContext: resourceAviLicenseLedgerDetailsDelete,
+		Schema:        ResourceLicenseLedgerDetailsSchema(),
 		Importer: &schema.ResourceImporter{
-			State: ResourceLicenseLedgerDetailsImporter,
+			StateContext: ResourceLicenseLedgerDetailsImporter,
 		},
 	}
 }
 
-func ResourceLicenseLedgerDetailsImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func ResourceLicenseLedgerDetailsImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	s := ResourceLicenseLedgerDetailsSchema()
-	return ResourceImporter(d, m, "licenseledgerdetails", s)
+	return ResourceImporter(ctx, d, m, "licenseledgerdetails", s)
 }
 
-func ResourceAviLicenseLedgerDetailsRead(d *schema.ResourceData, meta interface{}) error {
+func ResourceAviLicenseLedgerDetailsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceLicenseLedgerDetailsSchema()
-	err := APIRead(d, meta, "licenseledgerdetails", s)
-	if err != nil {
-		log.Printf("[ERROR] in reading object %v\n", err)
+	diags := APIRead(ctx, d, meta, "licenseledgerdetails", s)
+	if diags.HasError() {
+		log.Printf("[ERROR] in reading object %v\n", diags)
 	}
-	return err
+	return diags
 }
 
-func resourceAviLicenseLedgerDetailsCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviLicenseLedgerDetailsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceLicenseLedgerDetailsSchema()
-	err := APICreateOrUpdate(d, meta, "licenseledgerdetails", s)
-	if err == nil {
-		err = ResourceAviLicenseLedgerDetailsRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "licenseledgerdetails", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviLicenseLedgerDetailsRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviLicenseLedgerDetailsUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviLicenseLedgerDetailsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceLicenseLedgerDetailsSchema()
-	var err error
-	err = APICreateOrUpdate(d, meta, "licenseledgerdetails", s)
-	if err == nil {
-		err = ResourceAviLicenseLedgerDetailsRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "licenseledgerdetails", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviLicenseLedgerDetailsRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviLicenseLedgerDetailsDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAviLicenseLedgerDetailsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	objType := "licenseledgerdetails"
-	client := meta.(*clients.AviClient)
-	if APIDeleteSystemDefaultCheck(d) {
-		return nil
-	}
-	uuid := d.Get("uuid").(string)
-	if uuid != "" {
-		path := "api/" + objType + "/" + uuid
-		err := client.AviSession.Delete(path)
-		if err != nil && !(strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "204") || strings.Contains(err.Error(), "403")) {
-			log.Println("[INFO] resourceAviLicenseLedgerDetailsDelete not found")
-			return err
-		}
-		d.SetId("")
-	}
-	return nil
+	return APIDelete(ctx, d, meta, objType)
 }
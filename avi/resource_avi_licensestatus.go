@@ -4,11 +4,11 @@
 package avi
 
 import (
+	"context"
 	"log"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/vmware/alb-sdk/go/clients"
 )
 
 func ResourceLicenseStatusSchema() map[string]*schema.Schema {
@@ -41,65 +41,50 @@ func ResourceLicenseStatusSchema() map[string]*schema.Schema {
 
 func resourceAviLicenseStatus() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviLicenseStatusCreate,
-		Read:   ResourceAviLicenseStatusRead,
-		Update: resourceAviLicenseStatusUpdate,
-		Delete: resourceAviLicenseStatusDelete,
-		Schema: ResourceLicenseStatusSchema(),
+		CreateContext: resourceAviLicenseStatusCreate,
+		ReadContext:   ResourceAviLicenseStatusRead,
+		UpdateContext: resourceAviLicenseStatusUpdate,
+		DeleteContext: resourceAviLicenseStatusDelete,
+		Schema:        ResourceLicenseStatusSchema(),
 		Importer: &schema.ResourceImporter{
-			State: ResourceLicenseStatusImporter,
+			StateContext: ResourceLicenseStatusImporter,
 		},
 	}
 }
 
-func ResourceLicenseStatusImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func ResourceLicenseStatusImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	s := ResourceLicenseStatusSchema()
-	return ResourceImporter(d, m, "licensestatus", s)
+	return ResourceImporter(ctx, d, m, "licensestatus", s)
 }
 
-func ResourceAviLicenseStatusRead(d *schema.ResourceData, meta interface{}) error {
+func ResourceAviLicenseStatusRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceLicenseStatusSchema()
-	err := APIRead(d, meta, "licensestatus", s)
-	if err != nil {
-		log.Printf("[ERROR] in reading object %v\n", err)
+	diags := APIRead(ctx, d, meta, "licensestatus", s)
+	if diags.HasError() {
+		log.Printf("[ERROR] in reading object %v\n", diags)
 	}
-	return err
+	return diags
 }
 
-func resourceAviLicenseStatusCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviLicenseStatusCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceLicenseStatusSchema()
-	err := APICreateOrUpdate(d, meta, "licensestatus", s)
-	if err == nil {
-		err = ResourceAviLicenseStatusRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "licensestatus", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviLicenseStatusRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviLicenseStatusUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviLicenseStatusUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceLicenseStatusSchema()
-	var err error
-	err = APICreateOrUpdate(d, meta, "licensestatus", s)
-	if err == nil {
-		err = ResourceAviLicenseStatusRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "licensestatus", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviLicenseStatusRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviLicenseStatusDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAviLicenseStatusDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	objType := "licensestatus"
-	client := meta.(*clients.AviClient)
-	if APIDeleteSystemDefaultCheck(d) {
-		return nil
-	}
-	uuid := d.Get("uuid").(string)
-	if uuid != "" {
-		path := "api/" + objType + "/" + uuid
-		err := client.AviSession.Delete(path)
-		if err != nil && !(strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "204") || strings.Contains(err.Error(), "403")) {
-			log.Println("[INFO] resourceAviLicenseStatusDelete not found")
-			return err
-		}
-		d.SetId("")
-	}
-	return nil
+	return APIDelete(ctx, d, meta, objType)
 }
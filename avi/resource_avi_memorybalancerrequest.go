@@ -4,11 +4,11 @@
 package avi
 
 import (
+	"context"
 	"log"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/vmware/alb-sdk/go/clients"
 )
 
 func ResourceMemoryBalancerRequestSchema() map[string]*schema.Schema {
@@ -65,65 +65,50 @@ func ResourceMemoryBalancerRequestSchema() map[string]*schema.Schema {
 
 func resourceAviMemoryBalancerRequest() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviMemoryBalancerRequestCreate,
-		Read:   ResourceAviMemoryBalancerRequestRead,
-		Update: resourceAviMemoryBalancerRequestUpdate,
-		Delete: resourceAviMemoryBalancerRequestDelete,
-		Schema: ResourceMemoryBalancerRequestSchema(),
+		CreateContext: resourceAviMemoryBalancerRequestCreate,
+		ReadContext:   ResourceAviMemoryBalancerRequestRead,
+		UpdateContext: resourceAviMemoryBalancerRequestUpdate,
+		DeleteContext: resourceAviMemoryBalancerRequestDelete,
+		Schema:        ResourceMemoryBalancerRequestSchema(),
 		Importer: &schema.ResourceImporter{
-			State: ResourceMemoryBalancerRequestImporter,
+			StateContext: ResourceMemoryBalancerRequestImporter,
 		},
 	}
 }
 
-func ResourceMemoryBalancerRequestImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func ResourceMemoryBalancerRequestImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	s := ResourceMemoryBalancerRequestSchema()
-	return ResourceImporter(d, m, "memorybalancerrequest", s)
+	return ResourceImporter(ctx, d, m, "memorybalancerrequest", s)
 }
 
-func ResourceAviMemoryBalancerRequestRead(d *schema.ResourceData, meta interface{}) error {
+func ResourceAviMemoryBalancerRequestRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceMemoryBalancerRequestSchema()
-	err := APIRead(d, meta, "memorybalancerrequest", s)
-	if err != nil {
-		log.Printf("[ERROR] in reading object %v\n", err)
+	diags := APIRead(ctx, d, meta, "memorybalancerrequest", s)
+	if diags.HasError() {
+		log.Printf("[ERROR] in reading object %v\n", diags)
 	}
-	return err
+	return diags
 }
 
-func resourceAviMemoryBalancerRequestCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviMemoryBalancerRequestCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceMemoryBalancerRequestSchema()
-	err := APICreateOrUpdate(d, meta, "memorybalancerrequest", s)
-	if err == nil {
-		err = ResourceAviMemoryBalancerRequestRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "memorybalancerrequest", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviMemoryBalancerRequestRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviMemoryBalancerRequestUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviMemoryBalancerRequestUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceMemoryBalancerRequestSchema()
-	var err error
-	err = APICreateOrUpdate(d, meta, "memorybalancerrequest", s)
-	if err == nil {
-		err = ResourceAviMemoryBalancerRequestRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "memorybalancerrequest", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviMemoryBalancerRequestRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviMemoryBalancerRequestDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAviMemoryBalancerRequestDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	objType := "memorybalancerrequest"
-	client := meta.(*clients.AviClient)
-	if APIDeleteSystemDefaultCheck(d) {
-		return nil
-	}
-	uuid := d.Get("uuid").(string)
-	if uuid != "" {
-		path := "api/" + objType + "/" + uuid
-		err := client.AviSession.Delete(path)
-		if err != nil && !(strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "204") || strings.Contains(err.Error(), "403")) {
-			log.Println("[INFO] resourceAviMemoryBalancerRequestDelete not found")
-			return err
-		}
-		d.SetId("")
-	}
-	return nil
+	return APIDelete(ctx, d, meta, objType)
 }
@@ -4,11 +4,11 @@
 package avi
 
 import (
+	"context"
 	"log"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/vmware/alb-sdk/go/clients"
 )
 
 func ResourceMicroServiceGroupSchema() map[string]*schema.Schema {
@@ -53,65 +53,50 @@ func ResourceMicroServiceGroupSchema() map[string]*schema.Schema {
 
 func resourceAviMicroServiceGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviMicroServiceGroupCreate,
-		Read:   ResourceAviMicroServiceGroupRead,
-		Update: resourceAviMicroServiceGroupUpdate,
-		Delete: resourceAviMicroServiceGroupDelete,
-		Schema: ResourceMicroServiceGroupSchema(),
+		CreateContext: resourceAviMicroServiceGroupCreate,
+		ReadContext:   ResourceAviMicroServiceGroupRead,
+		UpdateContext: resourceAviMicroServiceGroupUpdate,
+		DeleteContext: resourceAviMicroServiceGroupDelete,
+		Schema:        ResourceMicroServiceGroupSchema(),
 		Importer: &schema.ResourceImporter{
-			State: ResourceMicroServiceGroupImporter,
+			StateContext: ResourceMicroServiceGroupImporter,
 		},
 	}
 }
 
-func ResourceMicroServiceGroupImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func ResourceMicroServiceGroupImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	s := ResourceMicroServiceGroupSchema()
-	return ResourceImporter(d, m, "microservicegroup", s)
+	return ResourceImporter(ctx, d, m, "microservicegroup", s)
 }
 
-func ResourceAviMicroServiceGroupRead(d *schema.ResourceData, meta interface{}) error {
+func ResourceAviMicroServiceGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceMicroServiceGroupSchema()
-	err := APIRead(d, meta, "microservicegroup", s)
-	if err != nil {
-		log.Printf("[ERROR] in reading object %v\n", err)
+	diags := APIRead(ctx, d, meta, "microservicegroup", s)
+	if diags.HasError() {
+		log.Printf("[ERROR] in reading object %v\n", diags)
 	}
-	return err
+	return diags
 }
 
-func resourceAviMicroServiceGroupCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviMicroServiceGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceMicroServiceGroupSchema()
-	err := APICreateOrUpdate(d, meta, "microservicegroup", s)
-	if err == nil {
-		err = ResourceAviMicroServiceGroupRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "microservicegroup", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviMicroServiceGroupRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviMicroServiceGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviMicroServiceGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceMicroServiceGroupSchema()
-	var err error
-	err = APICreateOrUpdate(d, meta, "microservicegroup", s)
-	if err == nil {
-		err = ResourceAviMicroServiceGroupRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "microservicegroup", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviMicroServiceGroupRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviMicroServiceGroupDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAviMicroServiceGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	objType := "microservicegroup"
-	client := meta.(*clients.AviClient)
-	if APIDeleteSystemDefaultCheck(d) {
-		return nil
-	}
-	uuid := d.Get("uuid").(string)
-	if uuid != "" {
-		path := "api/" + objType + "/" + uuid
-		err := client.AviSession.Delete(path)
-		if err != nil && !(strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "204") || strings.Contains(err.Error(), "403")) {
-			log.Println("[INFO] resourceAviMicroServiceGroupDelete not found")
-			return err
-		}
-		d.SetId("")
-	}
-	return nil
+	return APIDelete(ctx, d, meta, objType)
 }
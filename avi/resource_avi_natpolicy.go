@@ -4,11 +4,11 @@
 package avi
 
 import (
+	"context"
 	"log"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/vmware/alb-sdk/go/clients"
 )
 
 func ResourceNatPolicySchema() map[string]*schema.Schema {
@@ -59,65 +59,50 @@ func ResourceNatPolicySchema() map[string]*schema.Schema {
 
 func resourceAviNatPolicy() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviNatPolicyCreate,
-		Read:   ResourceAviNatPolicyRead,
-		Update: resourceAviNatPolicyUpdate,
-		Delete: resourceAviNatPolicyDelete,
-		Schema: ResourceNatPolicySchema(),
+		CreateContext: resourceAviNatPolicyCreate,
+		ReadContext:   ResourceAviNatPolicyRead,
+		UpdateContext: resourceAviNatPolicyUpdate,
+		DeleteContext: resourceAviNatPolicyDelete,
+		Schema:        ResourceNatPolicySchema(),
 		Importer: &schema.ResourceImporter{
-			State: ResourceNatPolicyImporter,
+			StateContext: ResourceNatPolicyImporter,
 		},
 	}
 }
 
-func ResourceNatPolicyImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func ResourceNatPolicyImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	s := ResourceNatPolicySchema()
-	return ResourceImporter(d, m, "natpolicy", s)
+	return ResourceImporter(ctx, d, m, "natpolicy", s)
 }
 
-func ResourceAviNatPolicyRead(d *schema.ResourceData, meta interface{}) error {
+func ResourceAviNatPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceNatPolicySchema()
-	err := APIRead(d, meta, "natpolicy", s)
-	if err != nil {
-		log.Printf("[ERROR] in reading object %v\n", err)
+	diags := APIRead(ctx, d, meta, "natpolicy", s)
+	if diags.HasError() {
+		log.Printf("[ERROR] in reading object %v\n", diags)
 	}
-	return err
+	return diags
 }
 
-func resourceAviNatPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviNatPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceNatPolicySchema()
-	err := APICreateOrUpdate(d, meta, "natpolicy", s)
-	if err == nil {
-		err = ResourceAviNatPolicyRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "natpolicy", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviNatPolicyRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviNatPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviNatPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceNatPolicySchema()
-	var err error
-	err = APICreateOrUpdate(d, meta, "natpolicy", s)
-	if err == nil {
-		err = ResourceAviNatPolicyRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "natpolicy", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviNatPolicyRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviNatPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAviNatPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	objType := "natpolicy"
-	client := meta.(*clients.AviClient)
-	if APIDeleteSystemDefaultCheck(d) {
-		return nil
-	}
-	uuid := d.Get("uuid").(string)
-	if uuid != "" {
-		path := "api/" + objType + "/" + uuid
-		err := client.AviSession.Delete(path)
-		if err != nil && !(strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "204") || strings.Contains(err.Error(), "403")) {
-			log.Println("[INFO] resourceAviNatPolicyDelete not found")
-			return err
-		}
-		d.SetId("")
-	}
-	return nil
+	return APIDelete(ctx, d, meta, objType)
 }
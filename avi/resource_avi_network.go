@@ -4,11 +4,11 @@
 package avi
 
 import (
+	"context"
 	"log"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/vmware/alb-sdk/go/clients"
 )
 
 func ResourceNetworkSchema() map[string]*schema.Schema {
@@ -35,22 +35,19 @@ func ResourceNetworkSchema() map[string]*schema.Schema {
 			Elem:     ResourceSubnetSchema(),
 		},
 		"dhcp_enabled": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "true",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  true,
 		},
 		"exclude_discovered_subnets": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "false",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
 		},
 		"ip6_autocfg_enabled": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "true",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  true,
 		},
 		"markers": {
 			Type:     schema.TypeList,
@@ -62,10 +59,9 @@ func ResourceNetworkSchema() map[string]*schema.Schema {
 			Required: true,
 		},
 		"synced_from_se": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "false",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
 		},
 		"tenant_ref": {
 			Type:     schema.TypeString,
@@ -78,10 +74,9 @@ func ResourceNetworkSchema() map[string]*schema.Schema {
 			Computed: true,
 		},
 		"vcenter_dvs": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "true",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  true,
 		},
 		"vimgrnw_ref": {
 			Type:     schema.TypeString,
@@ -98,65 +93,50 @@ func ResourceNetworkSchema() map[string]*schema.Schema {
 
 func resourceAviNetwork() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviNetworkCreate,
-		Read:   ResourceAviNetworkRead,
-		Update: resourceAviNetworkUpdate,
-		Delete: resourceAviNetworkDelete,
-		Schema: ResourceNetworkSchema(),
+		CreateContext: resourceAviNetworkCreate,
+		ReadContext:   ResourceAviNetworkRead,
+		UpdateContext: resourceAviNetworkUpdate,
+		DeleteContext: resourceAviNetworkDelete,
+		Schema:        ResourceNetworkSchema(),
 		Importer: &schema.ResourceImporter{
-			State: ResourceNetworkImporter,
+			StateContext: ResourceNetworkImporter,
 		},
 	}
 }
 
-func ResourceNetworkImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func ResourceNetworkImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	s := ResourceNetworkSchema()
-	return ResourceImporter(d, m, "network", s)
+	return ResourceImporter(ctx, d, m, "network", s)
 }
 
-func ResourceAviNetworkRead(d *schema.ResourceData, meta interface{}) error {
+func ResourceAviNetworkRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceNetworkSchema()
-	err := APIRead(d, meta, "network", s)
-	if err != nil {
-		log.Printf("[ERROR] in reading object %v\n", err)
+	diags := APIRead(ctx, d, meta, "network", s)
+	if diags.HasError() {
+		log.Printf("[ERROR] in reading object %v\n", diags)
 	}
-	return err
+	return diags
 }
 
-func resourceAviNetworkCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviNetworkCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceNetworkSchema()
-	err := APICreateOrUpdate(d, meta, "network", s)
-	if err == nil {
-		err = ResourceAviNetworkRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "network", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviNetworkRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviNetworkUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviNetworkUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceNetworkSchema()
-	var err error
-	err = APICreateOrUpdate(d, meta, "network", s)
-	if err == nil {
-		err = ResourceAviNetworkRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "network", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviNetworkRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviNetworkDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAviNetworkDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	objType := "network"
-	client := meta.(*clients.AviClient)
-	if APIDeleteSystemDefaultCheck(d) {
-		return nil
-	}
-	uuid := d.Get("uuid").(string)
-	if uuid != "" {
-		path := "api/" + objType + "/" + uuid
-		err := client.AviSession.Delete(path)
-		if err != nil && !(strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "204") || strings.Contains(err.Error(), "403")) {
-			log.Println("[INFO] resourceAviNetworkDelete not found")
-			return err
-		}
-		d.SetId("")
-	}
-	return nil
+	return APIDelete(ctx, d, meta, objType)
 }
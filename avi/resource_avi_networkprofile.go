@@ -4,11 +4,11 @@
 package avi
 
 import (
+	"context"
 	"log"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/vmware/alb-sdk/go/clients"
 )
 
 func ResourceNetworkProfileSchema() map[string]*schema.Schema {
@@ -20,10 +20,9 @@ func ResourceNetworkProfileSchema() map[string]*schema.Schema {
 			Elem:     ResourceConfigPbAttributesSchema(),
 		},
 		"connection_mirror": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "false",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
 		},
 		"description": {
 			Type:     schema.TypeString,
@@ -59,65 +58,50 @@ func ResourceNetworkProfileSchema() map[string]*schema.Schema {
 
 func resourceAviNetworkProfile() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviNetworkProfileCreate,
-		Read:   ResourceAviNetworkProfileRead,
-		Update: resourceAviNetworkProfileUpdate,
-		Delete: resourceAviNetworkProfileDelete,
-		Schema: ResourceNetworkProfileSchema(),
+		CreateContext: resourceAviNetworkProfileCreate,
+		ReadContext:   ResourceAviNetworkProfileRead,
+		UpdateContext: resourceAviNetworkProfileUpdate,
+		DeleteContext: resourceAviNetworkProfileDelete,
+		Schema:        ResourceNetworkProfileSchema(),
 		Importer: &schema.ResourceImporter{
-			State: ResourceNetworkProfileImporter,
+			StateContext: ResourceNetworkProfileImporter,
 		},
 	}
 }
 
-func ResourceNetworkProfileImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func ResourceNetworkProfileImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	s := ResourceNetworkProfileSchema()
-	return ResourceImporter(d, m, "networkprofile", s)
+	return ResourceImporter(ctx, d, m, "networkprofile", s)
 }
 
-func ResourceAviNetworkProfileRead(d *schema.ResourceData, meta interface{}) error {
+func ResourceAviNetworkProfileRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceNetworkProfileSchema()
-	err := APIRead(d, meta, "networkprofile", s)
-	if err != nil {
-		log.Printf("[ERROR] in reading object %v\n", err)
+	diags := APIRead(ctx, d, meta, "networkprofile", s)
+	if diags.HasError() {
+		log.Printf("[ERROR] in reading object %v\n", diags)
 	}
-	return err
+	return diags
 }
 
-func resourceAviNetworkProfileCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviNetworkProfileCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceNetworkProfileSchema()
-	err := APICreateOrUpdate(d, meta, "networkprofile", s)
-	if err == nil {
-		err = ResourceAviNetworkProfileRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "networkprofile", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviNetworkProfileRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviNetworkProfileUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviNetworkProfileUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceNetworkProfileSchema()
-	var err error
-	err = APICreateOrUpdate(d, meta, "networkprofile", s)
-	if err == nil {
-		err = ResourceAviNetworkProfileRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "networkprofile", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviNetworkProfileRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviNetworkProfileDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAviNetworkProfileDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	objType := "networkprofile"
-	client := meta.(*clients.AviClient)
-	if APIDeleteSystemDefaultCheck(d) {
-		return nil
-	}
-	uuid := d.Get("uuid").(string)
-	if uuid != "" {
-		path := "api/" + objType + "/" + uuid
-		err := client.AviSession.Delete(path)
-		if err != nil && !(strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "204") || strings.Contains(err.Error(), "403")) {
-			log.Println("[INFO] resourceAviNetworkProfileDelete not found")
-			return err
-		}
-		d.SetId("")
-	}
-	return nil
+	return APIDelete(ctx, d, meta, objType)
 }
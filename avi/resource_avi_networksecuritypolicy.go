@@ -4,11 +4,11 @@
 package avi
 
 import (
+	"context"
 	"log"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/vmware/alb-sdk/go/clients"
 )
 
 func ResourceNetworkSecurityPolicySchema() map[string]*schema.Schema {
@@ -40,10 +40,9 @@ func ResourceNetworkSecurityPolicySchema() map[string]*schema.Schema {
 			Computed: true,
 		},
 		"internal": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Computed:     true,
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Computed: true,
 		},
 		"ip_reputation_db_ref": {
 			Type:     schema.TypeString,
@@ -80,65 +79,50 @@ func ResourceNetworkSecurityPolicySchema() map[string]*schema.Schema {
 
 func resourceAviNetworkSecurityPolicy() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviNetworkSecurityPolicyCreate,
-		Read:   ResourceAviNetworkSecurityPolicyRead,
-		Update: resourceAviNetworkSecurityPolicyUpdate,
-		Delete: resourceAviNetworkSecurityPolicyDelete,
-		Schema: ResourceNetworkSecurityPolicySchema(),
+		CreateContext: resourceAviNetworkSecurityPolicyCreate,
+		ReadContext:   ResourceAviNetworkSecurityPolicyRead,
+		UpdateContext: resourceAviNetworkSecurityPolicyUpdate,
+		DeleteContext: resourceAviNetworkSecurityPolicyDelete,
+		Schema:        ResourceNetworkSecurityPolicySchema(),
 		Importer: &schema.ResourceImporter{
-			State: ResourceNetworkSecurityPolicyImporter,
+			StateContext: ResourceNetworkSecurityPolicyImporter,
 		},
 	}
 }
 
-func ResourceNetworkSecurityPolicyImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func ResourceNetworkSecurityPolicyImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	s := ResourceNetworkSecurityPolicySchema()
-	return ResourceImporter(d, m, "networksecuritypolicy", s)
+	return ResourceImporter(ctx, d, m, "networksecuritypolicy", s)
 }
 
-func ResourceAviNetworkSecurityPolicyRead(d *schema.ResourceData, meta interface{}) error {
+func ResourceAviNetworkSecurityPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceNetworkSecurityPolicySchema()
-	err := APIRead(d, meta, "networksecuritypolicy", s)
-	if err != nil {
-		log.Printf("[ERROR] in reading object %v\n", err)
+	diags := APIRead(ctx, d, meta, "networksecuritypolicy", s)
+	if diags.HasError() {
+		log.Printf("[ERROR] in reading object %v\n", diags)
 	}
-	return err
+	return diags
 }
 
-func resourceAviNetworkSecurityPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviNetworkSecurityPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceNetworkSecurityPolicySchema()
-	err := APICreateOrUpdate(d, meta, "networksecuritypolicy", s)
-	if err == nil {
-		err = ResourceAviNetworkSecurityPolicyRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "networksecuritypolicy", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviNetworkSecurityPolicyRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviNetworkSecurityPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviNetworkSecurityPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceNetworkSecurityPolicySchema()
-	var err error
-	err = APICreateOrUpdate(d, meta, "networksecuritypolicy", s)
-	if err == nil {
-		err = ResourceAviNetworkSecurityPolicyRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "networksecuritypolicy", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviNetworkSecurityPolicyRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviNetworkSecurityPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAviNetworkSecurityPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	objType := "networksecuritypolicy"
-	client := meta.(*clients.AviClient)
-	if APIDeleteSystemDefaultCheck(d) {
-		return nil
-	}
-	uuid := d.Get("uuid").(string)
-	if uuid != "" {
-		path := "api/" + objType + "/" + uuid
-		err := client.AviSession.Delete(path)
-		if err != nil && !(strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "204") || strings.Contains(err.Error(), "403")) {
-			log.Println("[INFO] resourceAviNetworkSecurityPolicyDelete not found")
-			return err
-		}
-		d.SetId("")
-	}
-	return nil
+	return APIDelete(ctx, d, meta, objType)
 }
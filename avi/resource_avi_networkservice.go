@@ -4,11 +4,11 @@
 package avi
 
 import (
+	"context"
 	"log"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/vmware/alb-sdk/go/clients"
 )
 
 func ResourceNetworkServiceSchema() map[string]*schema.Schema {
@@ -66,65 +66,50 @@ func ResourceNetworkServiceSchema() map[string]*schema.Schema {
 
 func resourceAviNetworkService() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviNetworkServiceCreate,
-		Read:   ResourceAviNetworkServiceRead,
-		Update: resourceAviNetworkServiceUpdate,
-		Delete: resourceAviNetworkServiceDelete,
-		Schema: ResourceNetworkServiceSchema(),
+		CreateContext: resourceAviNetworkServiceCreate,
+		ReadContext:   ResourceAviNetworkServiceRead,
+		UpdateContext: resourceAviNetworkServiceUpdate,
+		DeleteContext: resourceAviNetworkServiceDelete,
+		Schema:        ResourceNetworkServiceSchema(),
 		Importer: &schema.ResourceImporter{
-			State: ResourceNetworkServiceImporter,
+			StateContext: ResourceNetworkServiceImporter,
 		},
 	}
 }
 
-func ResourceNetworkServiceImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func ResourceNetworkServiceImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	s := ResourceNetworkServiceSchema()
-	return ResourceImporter(d, m, "networkservice", s)
+	return ResourceImporter(ctx, d, m, "networkservice", s)
 }
 
-func ResourceAviNetworkServiceRead(d *schema.ResourceData, meta interface{}) error {
+func ResourceAviNetworkServiceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceNetworkServiceSchema()
-	err := APIRead(d, meta, "networkservice", s)
-	if err != nil {
-		log.Printf("[ERROR] in reading object %v\n", err)
+	diags := APIRead(ctx, d, meta, "networkservice", s)
+	if diags.HasError() {
+		log.Printf("[ERROR] in reading object %v\n", diags)
 	}
-	return err
+	return diags
 }
 
-func resourceAviNetworkServiceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviNetworkServiceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceNetworkServiceSchema()
-	err := APICreateOrUpdate(d, meta, "networkservice", s)
-	if err == nil {
-		err = ResourceAviNetworkServiceRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "networkservice", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviNetworkServiceRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviNetworkServiceUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviNetworkServiceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceNetworkServiceSchema()
-	var err error
-	err = APICreateOrUpdate(d, meta, "networkservice", s)
-	if err == nil {
-		err = ResourceAviNetworkServiceRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "networkservice", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviNetworkServiceRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviNetworkServiceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAviNetworkServiceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	objType := "networkservice"
-	client := meta.(*clients.AviClient)
-	if APIDeleteSystemDefaultCheck(d) {
-		return nil
-	}
-	uuid := d.Get("uuid").(string)
-	if uuid != "" {
-		path := "api/" + objType + "/" + uuid
-		err := client.AviSession.Delete(path)
-		if err != nil && !(strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "204") || strings.Contains(err.Error(), "403")) {
-			log.Println("[INFO] resourceAviNetworkServiceDelete not found")
-			return err
-		}
-		d.SetId("")
-	}
-	return nil
+	return APIDelete(ctx, d, meta, objType)
 }
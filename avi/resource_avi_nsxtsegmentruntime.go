@@ -4,11 +4,11 @@
 package avi
 
 import (
+	"context"
 	"log"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/vmware/alb-sdk/go/clients"
 )
 
 func ResourceNsxtSegmentRuntimeSchema() map[string]*schema.Schema {
@@ -24,10 +24,9 @@ func ResourceNsxtSegmentRuntimeSchema() map[string]*schema.Schema {
 			Elem:     &schema.Schema{Type: schema.TypeString},
 		},
 		"dhcp_enabled": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "true",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  true,
 		},
 		"dhcp_ranges": {
 			Type:     schema.TypeList,
@@ -60,10 +59,9 @@ func ResourceNsxtSegmentRuntimeSchema() map[string]*schema.Schema {
 			Computed: true,
 		},
 		"security_only_nsxt": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "false",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
 		},
 		"segment_gw": {
 			Type:     schema.TypeString,
@@ -125,65 +123,50 @@ func ResourceNsxtSegmentRuntimeSchema() map[string]*schema.Schema {
 
 func resourceAviNsxtSegmentRuntime() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviNsxtSegmentRuntimeCreate,
-		Read:   ResourceAviNsxtSegmentRuntimeRead,
-		Update: resourceAviNsxtSegmentRuntimeUpdate,
-		Delete: resourceAviNsxtSegmentRuntimeDelete,
-		Schema: ResourceNsxtSegmentRuntimeSchema(),
+		CreateContext: resourceAviNsxtSegmentRuntimeCreate,
+		ReadContext:   ResourceAviNsxtSegmentRuntimeRead,
+		UpdateContext: resourceAviNsxtSegmentRuntimeUpdate,
+		DeleteContext: resourceAviNsxtSegmentRuntimeDelete,
+		Schema:        ResourceNsxtSegmentRuntimeSchema(),
 		Importer: &schema.ResourceImporter{
-			State: ResourceNsxtSegmentRuntimeImporter,
+			StateContext: ResourceNsxtSegmentRuntimeImporter,
 		},
 	}
 }
 
-func ResourceNsxtSegmentRuntimeImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func ResourceNsxtSegmentRuntimeImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	s := ResourceNsxtSegmentRuntimeSchema()
-	return ResourceImporter(d, m, "nsxtsegmentruntime", s)
+	return ResourceImporter(ctx, d, m, "nsxtsegmentruntime", s)
 }
 
-func ResourceAviNsxtSegmentRuntimeRead(d *schema.ResourceData, meta interface{}) error {
+func ResourceAviNsxtSegmentRuntimeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceNsxtSegmentRuntimeSchema()
-	err := APIRead(d, meta, "nsxtsegmentruntime", s)
-	if err != nil {
-		log.Printf("[ERROR] in reading object %v\n", err)
+	diags := APIRead(ctx, d, meta, "nsxtsegmentruntime", s)
+	if diags.HasError() {
+		log.Printf("[ERROR] in reading object %v\n", diags)
 	}
-	return err
+	return diags
 }
 
-func resourceAviNsxtSegmentRuntimeCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviNsxtSegmentRuntimeCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceNsxtSegmentRuntimeSchema()
-	err := APICreateOrUpdate(d, meta, "nsxtsegmentruntime", s)
-	if err == nil {
-		err = ResourceAviNsxtSegmentRuntimeRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "nsxtsegmentruntime", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviNsxtSegmentRuntimeRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviNsxtSegmentRuntimeUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviNsxtSegmentRuntimeUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceNsxtSegmentRuntimeSchema()
-	var err error
-	err = APICreateOrUpdate(d, meta, "nsxtsegmentruntime", s)
-	if err == nil {
-		err = ResourceAviNsxtSegmentRuntimeRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "nsxtsegmentruntime", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviNsxtSegmentRuntimeRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviNsxtSegmentRuntimeDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAviNsxtSegmentRuntimeDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	objType := "nsxtsegmentruntime"
-	client := meta.(*clients.AviClient)
-	if APIDeleteSystemDefaultCheck(d) {
-		return nil
-	}
-	uuid := d.Get("uuid").(string)
-	if uuid != "" {
-		path := "api/" + objType + "/" + uuid
-		err := client.AviSession.Delete(path)
-		if err != nil && !(strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "204") || strings.Contains(err.Error(), "403")) {
-			log.Println("[INFO] resourceAviNsxtSegmentRuntimeDelete not found")
-			return err
-		}
-		d.SetId("")
-	}
-	return nil
+	return APIDelete(ctx, d, meta, objType)
 }
@@ -4,11 +4,11 @@
 package avi
 
 import (
+	"context"
 	"log"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/vmware/alb-sdk/go/clients"
 )
 
 func ResourcePingAccessAgentSchema() map[string]*schema.Schema {
@@ -61,65 +61,50 @@ func ResourcePingAccessAgentSchema() map[string]*schema.Schema {
 
 func resourceAviPingAccessAgent() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviPingAccessAgentCreate,
-		Read:   ResourceAviPingAccessAgentRead,
-		Update: resourceAviPingAccessAgentUpdate,
-		Delete: resourceAviPingAccessAgentDelete,
-		Schema: ResourcePingAccessAgentSchema(),
+		CreateContext: resourceAviPingAccessAgentCreate,
+		ReadContext:   ResourceAviPingAccessAgentRead,
+		UpdateContext: resourceAviPingAccessAgentUpdate,
+		DeleteContext: resourceAviPingAccessAgentDelete,
+		Schema:        ResourcePingAccessAgentSchema(),
 		Importer: &schema.ResourceImporter{
-			State: ResourcePingAccessAgentImporter,
+			StateContext: ResourcePingAccessAgentImporter,
 		},
 	}
 }
 
-func ResourcePingAccessAgentImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func ResourcePingAccessAgentImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	s := ResourcePingAccessAgentSchema()
-	return ResourceImporter(d, m, "pingaccessagent", s)
+	return ResourceImporter(ctx, d, m, "pingaccessagent", s)
 }
 
-func ResourceAviPingAccessAgentRead(d *schema.ResourceData, meta interface{}) error {
+func ResourceAviPingAccessAgentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourcePingAccessAgentSchema()
-	err := APIRead(d, meta, "pingaccessagent", s)
-	if err != nil {
-		log.Printf("[ERROR] in reading object %v\n", err)
+	diags := APIRead(ctx, d, meta, "pingaccessagent", s)
+	if diags.HasError() {
+		log.Printf("[ERROR] in reading object %v\n", diags)
 	}
-	return err
+	return diags
 }
 
-func resourceAviPingAccessAgentCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviPingAccessAgentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourcePingAccessAgentSchema()
-	err := APICreateOrUpdate(d, meta, "pingaccessagent", s)
-	if err == nil {
-		err = ResourceAviPingAccessAgentRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "pingaccessagent", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviPingAccessAgentRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviPingAccessAgentUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviPingAccessAgentUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourcePingAccessAgentSchema()
-	var err error
-	err = APICreateOrUpdate(d, meta, "pingaccessagent", s)
-	if err == nil {
-		err = ResourceAviPingAccessAgentRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "pingaccessagent", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviPingAccessAgentRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviPingAccessAgentDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAviPingAccessAgentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	objType := "pingaccessagent"
-	client := meta.(*clients.AviClient)
-	if APIDeleteSystemDefaultCheck(d) {
-		return nil
-	}
-	uuid := d.Get("uuid").(string)
-	if uuid != "" {
-		path := "api/" + objType + "/" + uuid
-		err := client.AviSession.Delete(path)
-		if err != nil && !(strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "204") || strings.Contains(err.Error(), "403")) {
-			log.Println("[INFO] resourceAviPingAccessAgentDelete not found")
-			return err
-		}
-		d.SetId("")
-	}
-	return nil
+	return APIDelete(ctx, d, meta, objType)
 }
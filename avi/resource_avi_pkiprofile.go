@@ -4,11 +4,11 @@
 package avi
 
 import (
+	"context"
 	"log"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/vmware/alb-sdk/go/clients"
 )
 
 func ResourcePKIProfileSchema() map[string]*schema.Schema {
@@ -30,10 +30,9 @@ func ResourcePKIProfileSchema() map[string]*schema.Schema {
 			Computed: true,
 		},
 		"crl_check": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "true",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  true,
 		},
 		"crls": {
 			Type:     schema.TypeList,
@@ -41,16 +40,14 @@ func ResourcePKIProfileSchema() map[string]*schema.Schema {
 			Elem:     ResourceCRLSchema(),
 		},
 		"ignore_peer_chain": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "false",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
 		},
 		"is_federated": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "false",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
 		},
 		"markers": {
 			Type:     schema.TypeList,
@@ -72,75 +69,59 @@ func ResourcePKIProfileSchema() map[string]*schema.Schema {
 			Computed: true,
 		},
 		"validate_only_leaf_crl": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "true",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  true,
 		},
 	}
 }
 
 func resourceAviPKIProfile() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviPKIProfileCreate,
-		Read:   ResourceAviPKIProfileRead,
-		Update: resourceAviPKIProfileUpdate,
-		Delete: resourceAviPKIProfileDelete,
-		Schema: ResourcePKIProfileSchema(),
+		CreateContext: resourceAviPKIProfileCreate,
+		ReadContext:   ResourceAviPKIProfileRead,
+		UpdateContext: resourceAviPKIProfileUpdate,
+		DeleteContext: resourceAviPKIProfileDelete,
+		Schema:        ResourcePKIProfileSchema(),
 		Importer: &schema.ResourceImporter{
-			State: ResourcePKIProfileImporter,
+			StateContext: ResourcePKIProfileImporter,
 		},
 	}
 }
 
-func ResourcePKIProfileImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func ResourcePKIProfileImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	s := ResourcePKIProfileSchema()
-	return ResourceImporter(d, m, "pkiprofile", s)
+	return ResourceImporter(ctx, d, m, "pkiprofile", s)
 }
 
-func ResourceAviPKIProfileRead(d *schema.ResourceData, meta interface{}) error {
+func ResourceAviPKIProfileRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourcePKIProfileSchema()
-	err := APIRead(d, meta, "pkiprofile", s)
-	if err != nil {
-		log.Printf("[ERROR] in reading object %v\n", err)
+	diags := APIRead(ctx, d, meta, "pkiprofile", s)
+	if diags.HasError() {
+		log.Printf("[ERROR] in reading object %v\n", diags)
 	}
-	return err
+	return diags
 }
 
-func resourceAviPKIProfileCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviPKIProfileCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourcePKIProfileSchema()
-	err := APICreateOrUpdate(d, meta, "pkiprofile", s)
-	if err == nil {
-		err = ResourceAviPKIProfileRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "pkiprofile", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviPKIProfileRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviPKIProfileUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviPKIProfileUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourcePKIProfileSchema()
-	var err error
-	err = APICreateOrUpdate(d, meta, "pkiprofile", s)
-	if err == nil {
-		err = ResourceAviPKIProfileRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "pkiprofile", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviPKIProfileRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviPKIProfileDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAviPKIProfileDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	objType := "pkiprofile"
-	client := meta.(*clients.AviClient)
-	if APIDeleteSystemDefaultCheck(d) {
-		return nil
-	}
-	uuid := d.Get("uuid").(string)
-	if uuid != "" {
-		path := "api/" + objType + "/" + uuid
-		err := client.AviSession.Delete(path)
-		if err != nil && !(strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "204") || strings.Contains(err.Error(), "403")) {
-			log.Println("[INFO] resourceAviPKIProfileDelete not found")
-			return err
-		}
-		d.SetId("")
-	}
-	return nil
+	return APIDelete(ctx, d, meta, objType)
 }
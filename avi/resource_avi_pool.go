@@ -4,12 +4,11 @@
 package avi
 
 import (
-	"errors"
+	"context"
 	"log"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/vmware/alb-sdk/go/clients"
 )
 
 func ResourcePoolSchema() map[string]*schema.Schema {
@@ -56,16 +55,14 @@ func ResourcePoolSchema() map[string]*schema.Schema {
 			Computed: true,
 		},
 		"capacity_estimation": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "false",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
 		},
 		"capacity_estimation_ttfb_thresh": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "0",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  0,
 		},
 		"cloud_config_cksum": {
 			Type:     schema.TypeString,
@@ -90,10 +87,9 @@ func ResourcePoolSchema() map[string]*schema.Schema {
 			Elem:     ResourceConnPoolPropertiesSchema(),
 		},
 		"connection_ramp_duration": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "10",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  10,
 		},
 		"created_by": {
 			Type:     schema.TypeString,
@@ -101,16 +97,14 @@ func ResourcePoolSchema() map[string]*schema.Schema {
 			Computed: true,
 		},
 		"default_server_port": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "80",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  80,
 		},
 		"delete_server_on_dns_refresh": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "true",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  true,
 		},
 		"description": {
 			Type:     schema.TypeString,
@@ -123,22 +117,19 @@ func ResourcePoolSchema() map[string]*schema.Schema {
 			Elem:     &schema.Schema{Type: schema.TypeString},
 		},
 		"east_west": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Computed:     true,
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Computed: true,
 		},
 		"enable_http2": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "false",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
 		},
 		"enabled": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "true",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  true,
 		},
 		"external_autoscale_groups": {
 			Type:     schema.TypeList,
@@ -152,22 +143,19 @@ func ResourcePoolSchema() map[string]*schema.Schema {
 			Elem:     ResourceFailActionSchema(),
 		},
 		"fewest_tasks_feedback_delay": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "10",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  10,
 		},
 		"graceful_disable_timeout": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "1",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  1,
 		},
 		"gslb_sp_enabled": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Computed:     true,
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Computed: true,
 		},
 		"health_monitor_refs": {
 			Type:     schema.TypeList,
@@ -181,10 +169,9 @@ func ResourcePoolSchema() map[string]*schema.Schema {
 			Elem:     ResourceHorizonProfileSchema(),
 		},
 		"host_check_enabled": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "false",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
 		},
 		"http2_properties": {
 			Type:     schema.TypeSet,
@@ -193,16 +180,14 @@ func ResourcePoolSchema() map[string]*schema.Schema {
 			Elem:     ResourceHTTP2PoolPropertiesSchema(),
 		},
 		"ignore_server_port": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "false",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
 		},
 		"inline_health_monitor": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "true",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  true,
 		},
 		"ipaddrgroup_ref": {
 			Type:     schema.TypeString,
@@ -210,10 +195,9 @@ func ResourcePoolSchema() map[string]*schema.Schema {
 			Computed: true,
 		},
 		"lb_algo_rr_per_se": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "false",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
 		},
 		"lb_algorithm": {
 			Type:     schema.TypeString,
@@ -226,10 +210,9 @@ func ResourcePoolSchema() map[string]*schema.Schema {
 			Computed: true,
 		},
 		"lb_algorithm_core_nonaffinity": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "2",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  2,
 		},
 		"lb_algorithm_hash": {
 			Type:     schema.TypeString,
@@ -237,10 +220,9 @@ func ResourcePoolSchema() map[string]*schema.Schema {
 			Default:  "LB_ALGORITHM_CONSISTENT_HASH_SOURCE_IP_ADDRESS",
 		},
 		"lookup_server_by_name": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "false",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
 		},
 		"markers": {
 			Type:     schema.TypeList,
@@ -248,10 +230,9 @@ func ResourcePoolSchema() map[string]*schema.Schema {
 			Elem:     ResourceRoleFilterMatchLabelSchema(),
 		},
 		"max_concurrent_connections_per_server": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "0",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  0,
 		},
 		"max_conn_rate_per_server": {
 			Type:     schema.TypeSet,
@@ -260,16 +241,14 @@ func ResourcePoolSchema() map[string]*schema.Schema {
 			Elem:     ResourceRateProfileSchema(),
 		},
 		"min_health_monitors_up": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Computed:     true,
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Computed: true,
 		},
 		"min_servers_up": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Computed:     true,
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Computed: true,
 		},
 		"name": {
 			Type:     schema.TypeString,
@@ -301,40 +280,34 @@ func ResourcePoolSchema() map[string]*schema.Schema {
 			Default:  "POOL_TYPE_GENERIC_APP",
 		},
 		"request_queue_depth": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "128",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  128,
 		},
 		"request_queue_enabled": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "false",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
 		},
 		"resolve_pool_by_dns": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Computed:     true,
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Computed: true,
 		},
 		"rewrite_host_header_to_server_name": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "false",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
 		},
 		"rewrite_host_header_to_sni": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "false",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
 		},
 		"routing_pool": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "false",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
 		},
 		"server_disable_type": {
 			Type:     schema.TypeString,
@@ -353,10 +326,9 @@ func ResourcePoolSchema() map[string]*schema.Schema {
 			Elem:     ResourceHTTPServerReselectSchema(),
 		},
 		"server_timeout": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "0",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  0,
 		},
 		"servers": {
 			Type:     schema.TypeList,
@@ -369,10 +341,9 @@ func ResourcePoolSchema() map[string]*schema.Schema {
 			Computed: true,
 		},
 		"sni_enabled": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "true",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  true,
 		},
 		"ssl_key_and_certificate_ref": {
 			Type:     schema.TypeString,
@@ -395,16 +366,14 @@ func ResourcePoolSchema() map[string]*schema.Schema {
 			Computed: true,
 		},
 		"use_service_port": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "false",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
 		},
 		"use_service_ssl_mode": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "false",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
 		},
 		"uuid": {
 			Type:     schema.TypeString,
@@ -421,27 +390,27 @@ func ResourcePoolSchema() map[string]*schema.Schema {
 
 func resourceAviPool() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviPoolCreate,
-		Read:   ResourceAviPoolRead,
-		Update: resourceAviPoolUpdate,
-		Delete: resourceAviPoolDelete,
-		Schema: ResourcePoolSchema(),
+		CreateContext: resourceAviPoolCreate,
+		ReadContext:   ResourceAviPoolRead,
+		UpdateContext: resourceAviPoolUpdate,
+		DeleteContext: resourceAviPoolDelete,
+		Schema:        ResourcePoolSchema(),
 		Importer: &schema.ResourceImporter{
-			State: ResourcePoolImporter,
+			StateContext: ResourcePoolImporter,
 		},
 	}
 }
 
-func ResourcePoolImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func ResourcePoolImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	s := ResourcePoolSchema()
-	return ResourceImporter(d, m, "pool", s)
+	return ResourceImporter(ctx, d, m, "pool", s)
 }
 
-func ResourceAviPoolRead(d *schema.ResourceData, meta interface{}) error {
+func ResourceAviPoolRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourcePoolSchema()
-	err := APIRead(d, meta, "pool", s)
-	if err != nil {
-		log.Printf("[ERROR] ResourceAviPoolRead in reading object %v\n", err)
+	diags := APIRead(ctx, d, meta, "pool", s)
+	if diags.HasError() {
+		log.Printf("[ERROR] ResourceAviPoolRead in reading object %v\n", diags)
 	} else {
 		if ignoreServers, ok := d.GetOk("ignore_servers"); ok {
 			log.Printf("[DEBUG] ResourceAviPoolRead ignore_servers %v so clearing servers\n", ignoreServers)
@@ -449,23 +418,22 @@ func ResourceAviPoolRead(d *schema.ResourceData, meta interface{}) error {
 			d.Set("ignore_servers", ignoreServers.(bool))
 		}
 	}
-	return err
+	return diags
 }
 
-func resourceAviPoolCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviPoolCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourcePoolSchema()
 	ignoreServers := false
 	if ignServers, ok := d.GetOk("ignore_servers"); ok {
 		if servers, ok := d.GetOk("servers"); ok && ignServers.(bool) && servers != nil {
 			log.Printf("[ERROR] cannot set ignore_servers and servers together.")
-			err := errors.New("Error Invalid Plan. cannot set ignore_servers and servers together")
-			return err
+			return diag.Errorf("Error Invalid Plan. cannot set ignore_servers and servers together")
 		}
 		ignoreServers = true
 	}
-	err := APICreateOrUpdate(d, meta, "pool", s)
-	if err == nil {
-		err = ResourceAviPoolRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "pool", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviPoolRead(ctx, d, meta)...)
 	}
 
 	if ignoreServers {
@@ -474,57 +442,41 @@ func resourceAviPoolCreate(d *schema.ResourceData, meta interface{}) error {
 		d.Set("ignore_servers", ignoreServers)
 	}
 
-	return err
+	return diags
 }
 
-func resourceAviPoolUpdate(d *schema.ResourceData, meta interface{}) error {
-	var err error
+func resourceAviPoolUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
 	s := ResourcePoolSchema()
 
 	if ignoreServers, ok := d.GetOk("ignore_servers"); ok && ignoreServers.(bool) {
 		if servers, ok := d.GetOk("servers"); ok && ignoreServers.(bool) && servers != nil {
 			log.Printf("[ERROR] cannot set ignore_servers and servers together.")
-			err = errors.New("Error Invalid Plan. cannot set ignore_servers and servers together")
-			return err
+			return diag.Errorf("Error Invalid Plan. cannot set ignore_servers and servers together")
 		}
-		err = APICreateOrUpdate(d, meta, "pool", s, true)
-		if err == nil {
-			err = ResourceAviPoolRead(d, meta)
+		diags = APICreateOrUpdate(ctx, d, meta, "pool", s, true)
+		if !diags.HasError() {
+			diags = append(diags, ResourceAviPoolRead(ctx, d, meta)...)
 		}
 		d.Set("servers", nil)
 		d.Set("ignore_servers", true)
 	} else {
-		err = APICreateOrUpdate(d, meta, "pool", s)
-		if err == nil {
-			err = ResourceAviPoolRead(d, meta)
+		diags = APICreateOrUpdate(ctx, d, meta, "pool", s)
+		if !diags.HasError() {
+			diags = append(diags, ResourceAviPoolRead(ctx, d, meta)...)
 		}
 	}
 
-	return err
+	return diags
 }
 
-func resourceAviPoolDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAviPoolDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	if ignoreServers, ok := d.GetOk("ignore_servers"); ok {
 		if servers, ok := d.GetOk("servers"); ok && ignoreServers.(bool) && servers != nil {
 			log.Printf("[ERROR] cannot set ignore_servers and servers together.")
-			err := errors.New("Error Invalid Plan. cannot set ignore_servers and servers together")
-			return err
+			return diag.Errorf("Error Invalid Plan. cannot set ignore_servers and servers together")
 		}
 	}
 	objType := "pool"
-	if APIDeleteSystemDefaultCheck(d) {
-		return nil
-	}
-	client := meta.(*clients.AviClient)
-	uuid := d.Get("uuid").(string)
-	if uuid != "" {
-		path := "api/" + objType + "/" + uuid
-		err := client.AviSession.Delete(path)
-		if err != nil && !(strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "204") || strings.Contains(err.Error(), "403")) {
-			log.Println("[ERROR] resourceAviPoolDelete not found")
-			return err
-		}
-		d.SetId("")
-	}
-	return nil
+	return APIDelete(ctx, d, meta, objType)
 }
@@ -4,12 +4,16 @@
 package avi
 
 import (
+	"context"
+	"fmt"
 	"log"
 	"strconv"
+	"strings"
+	"sync"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/vmware/alb-sdk/go/clients"
-	"github.com/vmware/alb-sdk/go/models"
+	"github.com/vmware/alb-sdk/go/session"
 )
 
 func ResourceAviPoolServerSchema() map[string]*schema.Schema {
@@ -48,10 +52,9 @@ func ResourceAviPoolServerSchema() map[string]*schema.Schema {
 			Elem:     ResourceDiscoveredNetworkSchema(),
 		},
 		"enabled": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "true",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  true,
 		},
 		"external_orchestration_id": {
 			Type:     schema.TypeString,
@@ -85,16 +88,14 @@ func ResourceAviPoolServerSchema() map[string]*schema.Schema {
 			Computed: true,
 		},
 		"port": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Computed:     true,
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Computed: true,
 		},
 		"preference_order": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Computed:     true,
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Computed: true,
 		},
 		"prst_hdr_val": {
 			Type:     schema.TypeString,
@@ -102,22 +103,19 @@ func ResourceAviPoolServerSchema() map[string]*schema.Schema {
 			Computed: true,
 		},
 		"ratio": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "1",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  1,
 		},
 		"resolve_server_by_dns": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "false",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
 		},
 		"rewrite_host_header": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "false",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
 		},
 		"server_node": {
 			Type:     schema.TypeString,
@@ -125,16 +123,14 @@ func ResourceAviPoolServerSchema() map[string]*schema.Schema {
 			Computed: true,
 		},
 		"static": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "false",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
 		},
 		"verify_network": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "false",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
 		},
 		"vm_ref": {
 			Type:     schema.TypeString,
@@ -146,273 +142,364 @@ func ResourceAviPoolServerSchema() map[string]*schema.Schema {
 
 func resourceAviServer() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviServerCreateOrUpdate,
-		Read:   ResourceAviServerRead,
-		Update: resourceAviServerCreateOrUpdate,
-		Delete: resourceAviServerDelete,
-		Schema: ResourceAviPoolServerSchema(),
+		CreateContext: resourceAviServerCreateOrUpdate,
+		ReadContext:   ResourceAviServerRead,
+		UpdateContext: resourceAviServerCreateOrUpdate,
+		DeleteContext: resourceAviServerDelete,
+		Schema:        ResourceAviPoolServerSchema(),
+		Importer: &schema.ResourceImporter{
+			StateContext: ResourceAviServerImporter,
+		},
 	}
 }
 
-func resourceAviServerCreateOrUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.AviClient)
-	pUUID, poolObj, pserver, err := resourceAviServerReadAPI(d, meta)
-	//added check for err and poolObj.
-	if err != nil || poolObj == nil {
-		log.Printf("[ERROR] resourceAviServerCreateOrUpdate Error during fetching pool object using pool_ref %v", err)
-		return err
-	}
-	if pserver == nil {
-		// not found
-		newServer := models.Server{}
-		if port, ok := d.GetOk("port"); ok {
-			if portV, err := strconv.ParseInt(port.(string), 10, 32); err == nil {
-				portV32 := int32(portV)
-				newServer.Port = &portV32
-			} else {
-				log.Printf("[ERROR] resourceAviServerCreateOrUpdate while converting port to integer %v\n", err)
-			}
-		}
-		pserver = &newServer
-	}
-	log.Printf("[INFO] resourceAviServerCreateOrUpdate pool %v server %v", pUUID, pserver)
-	//set other attributes from server.
-	if hostname, ok := d.GetOk("hostname"); ok {
-		hostnameStr := hostname.(string)
-		pserver.Hostname = &hostnameStr
-	}
-	if AutoscalingGroupName, ok := d.GetOk("autoscaling_group_name"); ok {
-		agV := AutoscalingGroupName.(string)
-		pserver.AutoscalingGroupName = &agV
-	}
-	if AvailabilityZone, ok := d.GetOk("availability_zone"); ok {
-		az := AvailabilityZone.(string)
-		pserver.AvailabilityZone = &az
-	}
-	if Description, ok := d.GetOk("description"); ok {
-		desc := Description.(string)
-		pserver.Description = &desc
-	}
-	if DiscoveredNetworks, ok := d.GetOk("discovered_networks"); ok {
-		pserver.DiscoveredNetworks = DiscoveredNetworks.([]*models.DiscoveredNetwork)
-	}
-	if Enabled, ok := d.GetOk("enabled"); ok {
-		if en, err := strconv.ParseBool(Enabled.(string)); err == nil {
-			pserver.Enabled = &en
-		} else {
-			log.Printf("[ERROR] resourceAviServerCreateOrUpdate while converting enabled to boolean %v\n", err)
-		}
+// ResourceAviServerImporter imports a pool server by the id "<pool uuid>:<ip>:<port>" or
+// "<pool name>:<ip>:<port>". Port 0 selects the server without port. The ip can be an IPv6
+// address, so the id is split at its first and last colon.
+func ResourceAviServerImporter(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	client := meta.(*ProviderClient)
+	id := d.Id()
+	first := strings.Index(id, ":")
+	last := strings.LastIndex(id, ":")
+	if first <= 0 || first == last || last == len(id)-1 {
+		return nil, fmt.Errorf("unexpected format of ID %q, expected <pool uuid or name>:<ip>:<port>", id)
 	}
-	if ExternalOrchestrationID, ok := d.GetOk("external_orchestration_id"); ok {
-		extOrc := ExternalOrchestrationID.(string)
-		pserver.ExternalOrchestrationID = &extOrc
+	poolID, ip, portStr := id[:first], id[first+1:last], id[last+1:]
+	if _, err := strconv.ParseInt(portStr, 10, 32); err != nil {
+		return nil, fmt.Errorf("invalid port %q in ID %q", portStr, id)
 	}
-	if ExternalUUID, ok := d.GetOk("external_uuid"); ok {
-		extUUID := ExternalUUID.(string)
-		pserver.ExternalUUID = &extUUID
+	var pool map[string]interface{}
+	err := aviSessionCall(ctx, client, func() error {
+		return client.AviSession.Get("api/pool/"+poolID, &pool)
+	})
+	if isAviObjectNotFound(err) {
+		log.Printf("[DEBUG] ResourceAviServerImporter pool uuid %v not found, looking up pool by name", poolID)
+		pool = nil
+		err = aviSessionCall(ctx, client, func() error {
+			return client.AviSession.GetObject("pool", session.SetName(poolID), session.SetResult(&pool))
+		})
 	}
-	if Location, ok := d.GetOk("location"); ok {
-		pserver.Location = Location.(*models.GeoLocation)
+	if err != nil {
+		return nil, fmt.Errorf("pool %q of ID %q not found: %s", poolID, id, err)
 	}
-	if MacAddress, ok := d.GetOk("mac_address"); ok {
-		mac := MacAddress.(string)
-		pserver.MacAddress = &mac
+	port := portStr
+	if port == "0" {
+		port = ""
 	}
-	if NwRef, ok := d.GetOk("nw_ref"); ok {
-		nRef := NwRef.(string)
-		pserver.NwRef = &nRef
+	server := findPoolServer(pool, ip, port)
+	if server == nil {
+		return nil, fmt.Errorf("server %s:%s not found in pool %q", ip, portStr, poolID)
 	}
-	if PrstHdrVal, ok := d.GetOk("prst_hdr_val"); ok {
-		pHdrVal := PrstHdrVal.(string)
-		pserver.PrstHdrVal = &pHdrVal
+	d.SetId(pool["uuid"].(string) + ":" + ip + ":" + portStr)
+	d.Set("pool_ref", pool["url"])
+	d.Set("ip", ip)
+	if port != "" {
+		p, _ := strconv.Atoi(port)
+		d.Set("port", p)
 	}
-	if Ratio, ok := d.GetOk("ratio"); ok {
-		if r, err := strconv.ParseInt(Ratio.(string), 10, 32); err == nil {
-			ratio32 := int32(r)
-			pserver.Ratio = &ratio32
-		} else {
-			log.Printf("[ERROR] resourceAviServerCreateOrUpdate while converting ratio to integer %v\n", err)
-		}
+	return []*schema.ResourceData{d}, nil
+}
+
+// resourceAviServerFieldSchema returns the schema of the avi_server attributes that are fields of
+// the server object in the pool. pool_ref selects the pool, and ip and type make up the ip field.
+func resourceAviServerFieldSchema() map[string]*schema.Schema {
+	s := ResourceAviPoolServerSchema()
+	delete(s, "pool_ref")
+	delete(s, "ip")
+	delete(s, "type")
+	return s
+}
+
+// poolServerPort returns the port of a server of a pool api object, or "" if it has none.
+func poolServerPort(server map[string]interface{}) string {
+	if port, ok := server["port"].(float64); ok {
+		return strconv.FormatFloat(port, 'f', -1, 64)
 	}
-	if ResolveServerByDNS, ok := d.GetOk("resolve_server_by_dns"); ok {
-		if resolveSvrByDNS, err := strconv.ParseBool(ResolveServerByDNS.(string)); err == nil {
-			pserver.ResolveServerByDNS = &resolveSvrByDNS
-		} else {
-			log.Printf("[ERROR] resourceAviServerCreateOrUpdate while converting resolve_server_by_dns to boolean %v\n", err)
+	return ""
+}
+
+// findPoolServer returns the server of the pool api object with the given ip and port. An empty
+// port selects the server without port.
+func findPoolServer(pool map[string]interface{}, ip string, port string) map[string]interface{} {
+	servers, _ := pool["servers"].([]interface{})
+	for _, s := range servers {
+		server, ok := s.(map[string]interface{})
+		if !ok {
+			continue
 		}
-	}
-	if RewriteHostHeader, ok := d.GetOk("rewrite_host_header"); ok {
-		if rewriteHostHdr, err := strconv.ParseBool(RewriteHostHeader.(string)); err == nil {
-			pserver.RewriteHostHeader = &rewriteHostHdr
-		} else {
-			log.Printf("[ERROR] resourceAviServerCreateOrUpdate while converting rewrite_host_header to boolean %v\n", err)
+		serverIP, _ := server["ip"].(map[string]interface{})
+		if serverIP["addr"] == ip && poolServerPort(server) == port {
+			return server
 		}
 	}
-	if ServerNode, ok := d.GetOk("server_node"); ok {
-		sn := ServerNode.(string)
-		pserver.ServerNode = &sn
-	}
-	if Static, ok := d.GetOk("static"); ok {
-		if s, err := strconv.ParseBool(Static.(string)); err == nil {
-			pserver.Static = &s
-		} else {
-			log.Printf("[ERROR] resourceAviServerCreateOrUpdate while converting static to boolean %v\n", err)
+	return nil
+}
+
+// poolServerLocks serializes the changes of the servers of a pool. Terraform applies avi_server
+// resources in parallel, and concurrent PATCHes of the same pool conflict on the controller. The
+// lock only holds within the provider process; the changes of another process are not lost as
+// PATCH add and delete only touch the servers they carry.
+var poolServerLocks = newKeyedMutex()
+
+// poolServerChange is the add or delete of a server of a pool, waiting to be sent in a batch.
+type poolServerChange struct {
+	// op is the PATCH operation, "add" or "delete".
+	op string
+	// server is the server to add.
+	server map[string]interface{}
+	// ip and port select the server to delete.
+	ip, port string
+
+	err error
+	// done is closed once the change was sent, and err is set.
+	done chan struct{}
+}
+
+// poolServerBatcher groups the changes of the servers of a pool that wait for the lock of the
+// pool. The first of them to get the lock sends all the changes queued so far, with one PATCH
+// per operation, so that many avi_server resources of a pool take a few requests rather than one
+// request each. A failed PATCH fails all the changes it carried. A batch is sent with a context
+// of its own, so that one resource that is cancelled does not fail the changes of the others.
+type poolServerBatcher struct {
+	mu      sync.Mutex
+	pending map[string][]*poolServerChange
+}
+
+var poolServerBatches = &poolServerBatcher{pending: map[string][]*poolServerChange{}}
+
+// apply queues change for the pool pUUID and returns once it was sent, by this call or by another
+// call that got the lock of the pool first. When ctx is done before, the change is dropped, unless
+// a batch already took it: then apply waits for the batch, as the change is sent anyway.
+func (b *poolServerBatcher) apply(ctx context.Context, client *ProviderClient, pUUID string,
+	change *poolServerChange) error {
+	change.done = make(chan struct{})
+	b.mu.Lock()
+	b.pending[pUUID] = append(b.pending[pUUID], change)
+	b.mu.Unlock()
+
+	unlock, err := poolServerLocks.Lock(ctx, pUUID)
+	if err != nil {
+		if b.remove(pUUID, change) {
+			return err
 		}
+		<-change.done
+		return change.err
 	}
-	if VerifyNetwork, ok := d.GetOk("verify_network"); ok {
-		if verifyNet, err := strconv.ParseBool(VerifyNetwork.(string)); err == nil {
-			pserver.VerifyNetwork = &verifyNet
-		} else {
-			log.Printf("[ERROR] resourceAviServerCreateOrUpdate while converting verify_network to boolean %v\n", err)
-		}
+	defer unlock()
+	b.mu.Lock()
+	changes := b.pending[pUUID]
+	delete(b.pending, pUUID)
+	b.mu.Unlock()
+	// The change is among the changes taken unless a previous holder of the lock sent it.
+	if len(changes) > 0 {
+		b.send(client, pUUID, changes)
 	}
-	if VMRef, ok := d.GetOk("vm_ref"); ok {
-		vmRefStr := VMRef.(string)
-		pserver.VMRef = &vmRefStr
+	<-change.done
+	return change.err
+}
+
+// remove drops change from the queue of the pool and reports whether it was still queued, or
+// else taken by a batch.
+func (b *poolServerBatcher) remove(pUUID string, change *poolServerChange) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	changes := b.pending[pUUID]
+	removed := false
+	for i, c := range changes {
+		if c == change {
+			b.pending[pUUID] = append(changes[:i:i], changes[i+1:]...)
+			removed = true
+			break
+		}
 	}
-	if t, ok := d.GetOk("type"); ok {
-		tStr := t.(string)
-		ip := d.Get("ip").(string)
-		pserver.IP = &models.IPAddr{Type: &tStr, Addr: &ip}
+	if len(b.pending[pUUID]) == 0 {
+		delete(b.pending, pUUID)
 	}
-
-	uri := "api/pool/" + pUUID
-	var response interface{}
-	patchPool := models.Pool{}
-	patchPool.Name = poolObj.Name
-	patchPool.TenantRef = poolObj.TenantRef
-	patchPool.CloudRef = poolObj.CloudRef
-	patchPool.Servers = append(patchPool.Servers, pserver)
-	err = client.AviSession.Patch(uri, patchPool, "add", response)
-	log.Printf("[INFO] resourceAviServerCreateOrUpdate pool %v poolobj %v err %v response %v",
-		pUUID, patchPool, err, response)
-	if err == nil {
-		err = ResourceAviServerRead(d, meta)
-	}
-	return err
+	return removed
 }
 
-func ResourceAviServerRead(d *schema.ResourceData, meta interface{}) error {
-	pUUID, _, pserver, err := resourceAviServerReadAPI(d, meta)
-	if err == nil && pserver != nil {
-		//Set id to include port number. if port is not in tf then use 0
-		var sUUID string
-		portStr := "0"
-		ip := d.Get("ip").(string)
-		if port, ok := d.GetOk("port"); ok {
-			portStr = port.(string)
+// send applies changes to the pool pUUID, with the lock of the pool held, and marks them done.
+func (b *poolServerBatcher) send(client *ProviderClient, pUUID string, changes []*poolServerChange) {
+	defer func() {
+		for _, change := range changes {
+			close(change.done)
 		}
-		sUUID = pUUID + ":" + ip + ":" + portStr
-		log.Printf("[INFO] pool %v ip %v port %v", pUUID, ip, portStr)
-		d.SetId(sUUID)
-		// Fill in the server information
-		if pserver.Hostname != nil {
-			d.Set("hostname", pserver.Hostname)
+	}()
+	ctx := context.Background()
+	uri := "api/pool/" + pUUID
+	var pool map[string]interface{}
+	err := aviSessionCall(ctx, client, func() error {
+		return client.AviSession.Get(uri, &pool)
+	})
+	var adds, deletes []*poolServerChange
+	var added, deleted []interface{}
+	for _, change := range changes {
+		change.err = err
+		if err != nil {
+			continue
 		}
-
-		d.Set("enabled", pserver.Enabled)
-
-		if pserver.AutoscalingGroupName != nil {
-			d.Set("autoscaling_group_name", pserver.AutoscalingGroupName)
+		if change.op == "add" {
+			adds = append(adds, change)
+			added = append(added, change.server)
+		} else if server := findPoolServer(pool, change.ip, change.port); server != nil {
+			deletes = append(deletes, change)
+			deleted = append(deleted, server)
 		}
-		if pserver.AvailabilityZone != nil {
-			d.Set("availability_zone", pserver.AvailabilityZone)
+	}
+	if len(added) > 0 {
+		patchPool := map[string]interface{}{
+			"name":       pool["name"],
+			"tenant_ref": pool["tenant_ref"],
+			"cloud_ref":  pool["cloud_ref"],
+			"servers":    added,
 		}
-		if pserver.Description != nil {
-			d.Set("description", pserver.Description)
+		var response interface{}
+		err := aviSessionCall(ctx, client, func() error {
+			return client.AviSession.Patch(uri, patchPool, "add", &response)
+		})
+		log.Printf("[INFO] poolServerBatcher added %d servers to pool %v err %v", len(added), pUUID, err)
+		for _, change := range adds {
+			change.err = err
 		}
-		if pserver.DiscoveredNetworks != nil {
-			d.Set("discovered_networks", pserver.DiscoveredNetworks)
+	}
+	if len(deleted) > 0 {
+		var response interface{}
+		err := aviSessionCall(ctx, client, func() error {
+			return client.AviSession.Patch(uri, map[string]interface{}{"servers": deleted}, "delete", &response)
+		})
+		log.Printf("[INFO] poolServerBatcher deleted %d servers from pool %v err %v", len(deleted), pUUID, err)
+		for _, change := range deletes {
+			change.err = err
 		}
+	}
+}
 
-		if pserver.ExternalUUID != nil {
-			d.Set("external_uuid", pserver.ExternalUUID)
-		}
-		if pserver.ExternalOrchestrationID != nil {
-			d.Set("external_orchestration_id", pserver.ExternalOrchestrationID)
-		}
+// resourceAviServerPort returns the port of the server resource as found by findPoolServer. An
+// unset port and port 0 both select the server without port.
+func resourceAviServerPort(d *schema.ResourceData) string {
+	if p, ok := d.GetOk("port"); ok {
+		return strconv.Itoa(p.(int))
+	}
+	return ""
+}
 
-		if pserver.Location != nil {
-			d.Set("mac_address", pserver.Location)
-		}
-		if pserver.NwRef != nil {
-			d.Set("nw_ref", pserver.NwRef)
-		}
-		if pserver.PrstHdrVal != nil {
-			d.Set("prst_hdr_val", pserver.PrstHdrVal)
-		}
-		d.Set("ratio", pserver.Ratio)
-		d.Set("resolve_server_by_dns", pserver.ResolveServerByDNS)
-		d.Set("rewrite_host_header", pserver.RewriteHostHeader)
-		if pserver.ServerNode != nil {
-			d.Set("server_node", pserver.ServerNode)
-		}
-		d.Set("static", pserver.Static)
-		d.Set("verify_network", pserver.VerifyNetwork)
-		if pserver.VMRef != nil {
-			d.Set("vm_ref", pserver.VMRef)
+func resourceAviServerCreateOrUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ProviderClient)
+	pUUID := UUIDFromID(d.Get("pool_ref").(string))
+	s := resourceAviServerFieldSchema()
+	data, err := SchemaToAviData(d, s)
+	if err != nil {
+		log.Printf("[ERROR] resourceAviServerCreateOrUpdate Error %v", err)
+		return diag.FromErr(err)
+	}
+	server := data.(map[string]interface{})
+	server["ip"] = map[string]interface{}{
+		"addr": d.Get("ip").(string),
+		"type": d.Get("type").(string),
+	}
+	log.Printf("[INFO] resourceAviServerCreateOrUpdate pool %v server %v", pUUID, server)
+	err = poolServerBatches.apply(ctx, client, pUUID, &poolServerChange{op: "add", server: server})
+	if err != nil {
+		log.Printf("[ERROR] resourceAviServerCreateOrUpdate failed to add server to pool %v: %v", pUUID, err)
+		return diagFromAviError(err, "failed to add server to pool "+pUUID, s)
+	}
+	// The server is in the pool, keep it in the state also when the read that follows fails.
+	port := resourceAviServerPort(d)
+	if port == "" {
+		port = "0"
+	}
+	d.SetId(pUUID + ":" + d.Get("ip").(string) + ":" + port)
+	return ResourceAviServerRead(ctx, d, meta)
+}
+
+func ResourceAviServerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	pUUID, _, server, err := resourceAviServerReadAPI(ctx, d, meta)
+	if err != nil {
+		if !isAviObjectNotFound(err) {
+			log.Printf("[ERROR] ResourceAviServerRead in reading object %v\n", err)
+			return diag.FromErr(err)
 		}
-		// Add more fields to read.
-	} else if err != nil {
 		d.SetId("")
-		log.Printf("[ERROR] ResourceAviServerRead in reading object %v\n", err)
+		log.Printf("[ERROR] ResourceAviServerRead pool %v not found %v\n", pUUID, err)
+		return nil
+	}
+	if server == nil {
+		// The pool exists but no longer has this server.
+		d.SetId("")
+		log.Printf("[ERROR] ResourceAviServerRead server %v not found in pool %v\n", d.Get("ip"), pUUID)
 		return nil
 	}
-	return err
+	serverIP, _ := server["ip"].(map[string]interface{})
+	ip, _ := serverIP["addr"].(string)
+	d.Set("ip", ip)
+	if ipType, ok := serverIP["type"]; ok {
+		d.Set("type", ipType)
+	}
+	// Fill in the server fields through the same conversion as every other object.
+	s := resourceAviServerFieldSchema()
+	apiRes := map[string]interface{}{}
+	for k, v := range server {
+		if k != "ip" {
+			apiRes[k] = v
+		}
+	}
+	localData, err := SchemaToAviData(d, s)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	modAPIRes, err := SetDefaultsInAPIRes(apiRes, localData, s, "avi_pool.servers")
+	if err != nil {
+		log.Printf("[ERROR] ResourceAviServerRead in modifying api response object %v\n", err)
+	}
+	modAPIRes, err = PreprocessAPIRes(modAPIRes, s)
+	if err != nil {
+		log.Printf("[ERROR] ResourceAviServerRead in modifying api response object for conversion %v\n", err)
+	}
+	if _, err := APIDataToSchema(modAPIRes, d, s); err != nil {
+		log.Printf("[ERROR] ResourceAviServerRead in setting read object %v\n", err)
+		return diag.FromErr(err)
+	}
+	//Set id to include port number. if port is not in the server then use 0
+	portStr := poolServerPort(server)
+	if portStr == "" {
+		portStr = "0"
+	}
+	log.Printf("[INFO] pool %v ip %v port %v", pUUID, ip, portStr)
+	d.SetId(pUUID + ":" + ip + ":" + portStr)
+	return nil
 }
 
-func resourceAviServerReadAPI(d *schema.ResourceData, meta interface{}) (string, *models.Pool, *models.Server, error) {
-	client := meta.(*clients.AviClient)
+// resourceAviServerReadAPI returns the uuid of the pool of the server, the pool api object and the
+// server in it, which is nil if the pool does not have the server.
+func resourceAviServerReadAPI(ctx context.Context, d *schema.ResourceData,
+	meta interface{}) (string, map[string]interface{}, map[string]interface{}, error) {
+	client := meta.(*ProviderClient)
 	pUUID := UUIDFromID(d.Get("pool_ref").(string))
 	uri := "api/pool/" + pUUID
-	var poolObj *models.Pool
-	err := client.AviSession.Get(uri, &poolObj)
+	var pool map[string]interface{}
+	err := aviSessionCall(ctx, client, func() error {
+		return client.AviSession.Get(uri, &pool)
+	})
 	if err != nil {
 		log.Printf("[ERROR] pool uuid %v not found", pUUID)
 		return pUUID, nil, nil, err
 	}
-	log.Printf("[INFO] found pool %v", poolObj.Name)
-	ip := d.Get("ip").(string)
-	port := d.Get("port")
-
-	// find the server in the pool object.
-	var matchedServer *models.Server = nil
-	for i := 0; i < len(poolObj.Servers); i++ {
-		sObj := poolObj.Servers[i]
-		if *sObj.IP.Addr == ip {
-			if port == nil && sObj.Port == nil {
-				matchedServer = sObj
-				break
-			} else if port != nil && sObj.Port != nil {
-				if portV, errInt := strconv.ParseInt(port.(string), 10, 32); errInt == nil {
-					portV32 := int32(portV)
-					if portV32 == *sObj.Port {
-						matchedServer = sObj
-						break
-					}
-				} else {
-					log.Printf("[ERROR] resourceAviServerReadAPI while converting port to integer %v\n", err)
-				}
-			}
-		}
-	}
-	return pUUID, poolObj, matchedServer, nil
+	log.Printf("[INFO] found pool %v", pool["name"])
+	return pUUID, pool, findPoolServer(pool, d.Get("ip").(string), resourceAviServerPort(d)), nil
 }
 
-func resourceAviServerDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.AviClient)
-	pUUID, poolObj, pserver, err := resourceAviServerReadAPI(d, meta)
-	log.Printf("[DEBUG] pool %v %v server %v", pUUID, poolObj.Name, d.Id())
-	if pserver != nil {
-		uri := "api/pool/" + pUUID
-		var response interface{}
-		var patchPool = make(map[string]interface{})
-		var servers = make([]models.Server, 1)
-		servers[0] = *pserver
-		patchPool["servers"] = servers
-		err = client.AviSession.Patch(uri, patchPool, "delete", response)
-		log.Printf("[INFO] pool %v server %v deleted err %v", patchPool, d.Id(), err)
+func resourceAviServerDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ProviderClient)
+	pUUID := UUIDFromID(d.Get("pool_ref").(string))
+	err := poolServerBatches.apply(ctx, client, pUUID, &poolServerChange{
+		op:   "delete",
+		ip:   d.Get("ip").(string),
+		port: resourceAviServerPort(d),
+	})
+	log.Printf("[INFO] pool %v server %v deleted err %v", pUUID, d.Id(), err)
+	if err != nil && !isAviObjectNotFound(err) {
+		return diag.FromErr(err)
 	}
 	d.SetId("")
-	return err
+	return nil
 }
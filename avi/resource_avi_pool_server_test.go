@@ -0,0 +1,372 @@
+package avi
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAVIServerBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAVIServerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAVIServerConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAVIServerExists("avi_server.testServer"),
+					resource.TestCheckResourceAttr(
+						"avi_server.testServer", "ip", "10.90.64.10"),
+					resource.TestCheckResourceAttr(
+						"avi_server.testServer", "port", "8080"),
+				),
+			},
+			{
+				ResourceName:      "avi_server.testServer",
+				ImportState:       true,
+				ImportStateVerify: false,
+				ImportStateIdFunc: testAccAVIServerImportID("avi_pool.testPool", "uuid"),
+				ImportStateCheck:  testAccCheckAVIServerImported,
+				Config:            testAccAVIServerConfig,
+			},
+			{
+				ResourceName:      "avi_server.testServer",
+				ImportState:       true,
+				ImportStateVerify: false,
+				ImportStateIdFunc: testAccAVIServerImportID("avi_pool.testPool", "name"),
+				ImportStateCheck:  testAccCheckAVIServerImported,
+				Config:            testAccAVIServerConfig,
+			},
+		},
+	})
+
+}
+
+// testAccAVIServerImportID returns the import ID of the server using the given attribute of the pool.
+func testAccAVIServerImportID(poolResource string, poolAttr string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[poolResource]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", poolResource)
+		}
+		return rs.Primary.Attributes[poolAttr] + ":10.90.64.10:8080", nil
+	}
+}
+
+func testAccCheckAVIServerImported(states []*terraform.InstanceState) error {
+	if len(states) != 1 {
+		return fmt.Errorf("expected 1 imported server, got %d", len(states))
+	}
+	state := states[0]
+	if !strings.HasSuffix(state.ID, ":10.90.64.10:8080") {
+		return fmt.Errorf("unexpected ID of imported server %s", state.ID)
+	}
+	if state.Attributes["ip"] != "10.90.64.10" || state.Attributes["port"] != "8080" {
+		return fmt.Errorf("unexpected attributes of imported server %v", state.Attributes)
+	}
+	if !strings.Contains(state.Attributes["pool_ref"], "/api/pool/") {
+		return fmt.Errorf("unexpected pool_ref of imported server %s", state.Attributes["pool_ref"])
+	}
+	return nil
+}
+
+func testAccCheckAVIServerExists(resourcename string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourcename]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourcename)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No AVI Server ID is set")
+		}
+		found, err := testAccAVIServerInPool(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if !found {
+			return fmt.Errorf("AVI Server %s not found in pool", rs.Primary.ID)
+		}
+		return nil
+	}
+
+}
+
+func testAccCheckAVIServerDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "avi_server" {
+			continue
+		}
+		found, err := testAccAVIServerInPool(rs.Primary.ID)
+		if err != nil {
+			if strings.Contains(err.Error(), "404") {
+				return nil
+			}
+			return err
+		}
+		if found {
+			return fmt.Errorf("AVI Server still exists")
+		}
+	}
+	return nil
+}
+
+// testAccAVIServerInPool reports whether the server with ID "<pool uuid>:<ip>:<port>" is in its pool.
+func testAccAVIServerInPool(id string) (bool, error) {
+	conn := testAccProvider.Meta().(*ProviderClient).AviSession
+	parts := strings.Split(id, ":")
+	var pool map[string]interface{}
+	if err := conn.Get("api/pool/"+parts[0], &pool); err != nil {
+		return false, err
+	}
+	servers, _ := pool["servers"].([]interface{})
+	for _, server := range servers {
+		ip := server.(map[string]interface{})["ip"].(map[string]interface{})
+		port := fmt.Sprintf("%v", server.(map[string]interface{})["port"])
+		if ip["addr"] == parts[1] && port == parts[2] {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// Servers added and removed in parallel to the same pool must all end up in and out of the pool,
+// even though the PATCH of the controller is not atomic, with fewer requests than servers.
+func TestAVIServerConcurrentCreate(t *testing.T) {
+	fc := newFakeController()
+	defer fc.Close()
+	client := newFakeControllerClient(t, fc)
+	var pool map[string]interface{}
+	if err := client.AviSession.Post("api/pool", map[string]interface{}{"name": "concurrent-pool"}, &pool); err != nil {
+		t.Fatalf("failed to create pool: %v", err)
+	}
+	uri := "api/pool/" + pool["uuid"].(string)
+	fc.mu.Lock()
+	fc.patchDelay = 20 * time.Millisecond
+	fc.mu.Unlock()
+	const count = 20
+	servers := make([]*schema.ResourceData, count)
+	for i := range servers {
+		servers[i] = schema.TestResourceDataRaw(t, ResourceAviPoolServerSchema(), map[string]interface{}{
+			"pool_ref": pool["url"],
+			"ip":       fmt.Sprintf("10.0.0.%d", i+1),
+			"port":     "80",
+		})
+	}
+	apply := func(op func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics) {
+		var wg sync.WaitGroup
+		results := make(chan diag.Diagnostics, count)
+		for _, d := range servers {
+			wg.Add(1)
+			go func(d *schema.ResourceData) {
+				defer wg.Done()
+				results <- op(context.Background(), d, client)
+			}(d)
+		}
+		wg.Wait()
+		close(results)
+		for diags := range results {
+			if diags.HasError() {
+				t.Errorf("server change failed: %v", diags)
+			}
+		}
+	}
+	patches := func() int {
+		n := 0
+		for _, request := range fc.Requests() {
+			if request == "PATCH /"+uri {
+				n++
+			}
+		}
+		return n
+	}
+
+	apply(resourceAviServerCreateOrUpdate)
+	if err := client.AviSession.Get(uri, &pool); err != nil {
+		t.Fatalf("failed to read pool: %v", err)
+	}
+	if servers, _ := pool["servers"].([]interface{}); len(servers) != count {
+		t.Errorf("expected %d servers in pool, got %d", count, len(servers))
+	}
+	added := patches()
+	if added >= count {
+		t.Errorf("got %d PATCH requests for %d servers, want them batched", added, count)
+	}
+
+	apply(resourceAviServerDelete)
+	pool = nil
+	if err := client.AviSession.Get(uri, &pool); err != nil {
+		t.Fatalf("failed to read pool: %v", err)
+	}
+	if servers, _ := pool["servers"].([]interface{}); len(servers) != 0 {
+		t.Errorf("expected no servers in pool, got %d", len(servers))
+	}
+	if deleted := patches() - added; deleted >= count {
+		t.Errorf("got %d PATCH requests to delete %d servers, want them batched", deleted, count)
+	}
+}
+
+// A server whose create is cancelled after a batch took its change is added anyway, so the create
+// must wait for the batch and succeed, and its cancellation must not fail the other changes.
+func TestAVIServerCancelledCreate(t *testing.T) {
+	fc := newFakeController()
+	defer fc.Close()
+	client := newFakeControllerClient(t, fc)
+	var pool map[string]interface{}
+	if err := client.AviSession.Post("api/pool", map[string]interface{}{"name": "cancel-pool"}, &pool); err != nil {
+		t.Fatalf("failed to create pool: %v", err)
+	}
+	pUUID := pool["uuid"].(string)
+	fc.mu.Lock()
+	fc.patchDelay = 200 * time.Millisecond
+	fc.mu.Unlock()
+
+	// Both changes are queued while the pool is locked, so that one batch takes them together.
+	unlock, err := poolServerLocks.Lock(context.Background(), pUUID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	contexts := []context.Context{ctx, context.Background()}
+	servers := make([]*schema.ResourceData, len(contexts))
+	results := make([]chan diag.Diagnostics, len(contexts))
+	for i, c := range contexts {
+		servers[i] = schema.TestResourceDataRaw(t, ResourceAviPoolServerSchema(), map[string]interface{}{
+			"pool_ref": pool["url"],
+			"ip":       fmt.Sprintf("10.0.0.%d", i+1),
+			"port":     "80",
+		})
+		results[i] = make(chan diag.Diagnostics, 1)
+		go func(i int, c context.Context) { results[i] <- resourceAviServerCreateOrUpdate(c, servers[i], client) }(i, c)
+	}
+	for {
+		poolServerBatches.mu.Lock()
+		queued := len(poolServerBatches.pending[pUUID])
+		poolServerBatches.mu.Unlock()
+		if queued == 2 {
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+	unlock()
+	time.Sleep(50 * time.Millisecond)
+	cancel()
+
+	// The read after the add of the cancelled server may fail, but the server must be in the state.
+	<-results[0]
+	if servers[0].Id() == "" {
+		t.Errorf("server added by the batch of a cancelled create is not in the state")
+	}
+	if diags := <-results[1]; diags.HasError() {
+		t.Errorf("server change failed with the error of a cancelled neighbour: %v", diags)
+	}
+	if err := client.AviSession.Get("api/pool/"+pUUID, &pool); err != nil {
+		t.Fatalf("failed to read pool: %v", err)
+	}
+	if servers, _ := pool["servers"].([]interface{}); len(servers) != 2 {
+		t.Errorf("expected 2 servers in pool, got %d", len(servers))
+	}
+}
+
+// Every server field written by the resource must be read back into the state.
+func TestAVIServerRoundTrip(t *testing.T) {
+	fc := newFakeController()
+	defer fc.Close()
+	client := newFakeControllerClient(t, fc)
+	var pool map[string]interface{}
+	if err := client.AviSession.Post("api/pool", map[string]interface{}{"name": "roundtrip-pool"}, &pool); err != nil {
+		t.Fatalf("failed to create pool: %v", err)
+	}
+	config := map[string]interface{}{
+		"pool_ref":         pool["url"],
+		"ip":               "10.0.0.1",
+		"port":             8080,
+		"hostname":         "web-1",
+		"description":      "web server",
+		"mac_address":      "00:50:56:aa:bb:cc",
+		"preference_order": 2,
+		"ratio":            5,
+		"enabled":          false,
+		"location": []interface{}{map[string]interface{}{
+			"name":      "dc-1",
+			"latitude":  37.5,
+			"longitude": -122.25,
+		}},
+	}
+	d := schema.TestResourceDataRaw(t, ResourceAviPoolServerSchema(), config)
+	if diags := resourceAviServerCreateOrUpdate(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("server create failed: %v", diags)
+	}
+	if d.Id() != pool["uuid"].(string)+":10.0.0.1:8080" {
+		t.Errorf("unexpected server id %v", d.Id())
+	}
+
+	read := schema.TestResourceDataRaw(t, ResourceAviPoolServerSchema(), map[string]interface{}{
+		"pool_ref": pool["url"],
+		"ip":       "10.0.0.1",
+		"port":     "8080",
+	})
+	if diags := ResourceAviServerRead(context.Background(), read, client); diags.HasError() {
+		t.Fatalf("server read failed: %v", diags)
+	}
+	for _, k := range []string{"port", "hostname", "description", "mac_address", "preference_order", "ratio", "enabled"} {
+		if got := read.Get(k); got != config[k] {
+			t.Errorf("%s: expected %v, got %v", k, config[k], got)
+		}
+	}
+	locations := read.Get("location").(*schema.Set).List()
+	if len(locations) != 1 || locations[0].(map[string]interface{})["name"] != "dc-1" {
+		t.Errorf("unexpected location %v", locations)
+	}
+}
+
+// A server without port is only selected by an empty port, not by a server of the same ip.
+func TestFindPoolServer(t *testing.T) {
+	server := func(ip string, port interface{}) map[string]interface{} {
+		s := map[string]interface{}{"ip": map[string]interface{}{"addr": ip, "type": "V4"}}
+		if port != nil {
+			s["port"] = port
+		}
+		return s
+	}
+	pool := map[string]interface{}{"servers": []interface{}{server("10.0.0.1", float64(80))}}
+	if got := findPoolServer(pool, "10.0.0.1", ""); got != nil {
+		t.Errorf("got server %v for no port, want none", got)
+	}
+	if got := findPoolServer(pool, "10.0.0.1", "80"); got == nil {
+		t.Errorf("got no server for port 80")
+	}
+	pool["servers"] = append(pool["servers"].([]interface{}), server("10.0.0.1", nil))
+	if got := findPoolServer(pool, "10.0.0.1", ""); got == nil || got["port"] != nil {
+		t.Errorf("got server %v for no port, want the server without port", got)
+	}
+}
+
+const testAccAVIServerConfig = `
+data "avi_tenant" "default_tenant"{
+    name= "admin"
+}
+data "avi_cloud" "default_cloud" {
+    name= "Default-Cloud"
+}
+resource "avi_pool" "testPool" {
+	name = "test-server-pool-abc"
+	tenant_ref = data.avi_tenant.default_tenant.id
+	cloud_ref = data.avi_cloud.default_cloud.id
+	ignore_servers = true
+}
+resource "avi_server" "testServer" {
+	pool_ref = avi_pool.testPool.id
+	ip = "10.90.64.10"
+	port = "8080"
+}
+`
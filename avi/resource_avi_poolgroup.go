@@ -4,11 +4,11 @@
 package avi
 
 import (
+	"context"
 	"log"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/vmware/alb-sdk/go/clients"
 )
 
 func ResourcePoolGroupSchema() map[string]*schema.Schema {
@@ -35,10 +35,9 @@ func ResourcePoolGroupSchema() map[string]*schema.Schema {
 			Computed: true,
 		},
 		"deactivate_primary_pool_on_down": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "false",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
 		},
 		"deployment_policy_ref": {
 			Type:     schema.TypeString,
@@ -51,10 +50,9 @@ func ResourcePoolGroupSchema() map[string]*schema.Schema {
 			Computed: true,
 		},
 		"enable_http2": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "false",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
 		},
 		"fail_action": {
 			Type:     schema.TypeSet,
@@ -63,10 +61,9 @@ func ResourcePoolGroupSchema() map[string]*schema.Schema {
 			Elem:     ResourceFailActionSchema(),
 		},
 		"implicit_priority_labels": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "false",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
 		},
 		"markers": {
 			Type:     schema.TypeList,
@@ -79,10 +76,9 @@ func ResourcePoolGroupSchema() map[string]*schema.Schema {
 			Elem:     ResourcePoolGroupMemberSchema(),
 		},
 		"min_servers": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "0",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  0,
 		},
 		"name": {
 			Type:     schema.TypeString,
@@ -113,65 +109,50 @@ func ResourcePoolGroupSchema() map[string]*schema.Schema {
 
 func resourceAviPoolGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviPoolGroupCreate,
-		Read:   ResourceAviPoolGroupRead,
-		Update: resourceAviPoolGroupUpdate,
-		Delete: resourceAviPoolGroupDelete,
-		Schema: ResourcePoolGroupSchema(),
+		CreateContext: resourceAviPoolGroupCreate,
+		ReadContext:   ResourceAviPoolGroupRead,
+		UpdateContext: resourceAviPoolGroupUpdate,
+		DeleteContext: resourceAviPoolGroupDelete,
+		Schema:        ResourcePoolGroupSchema(),
 		Importer: &schema.ResourceImporter{
-			State: ResourcePoolGroupImporter,
+			StateContext: ResourcePoolGroupImporter,
 		},
 	}
 }
 
-func ResourcePoolGroupImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func ResourcePoolGroupImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	s := ResourcePoolGroupSchema()
-	return ResourceImporter(d, m, "poolgroup", s)
+	return ResourceImporter(ctx, d, m, "poolgroup", s)
 }
 
-func ResourceAviPoolGroupRead(d *schema.ResourceData, meta interface{}) error {
+func ResourceAviPoolGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourcePoolGroupSchema()
-	err := APIRead(d, meta, "poolgroup", s)
-	if err != nil {
-		log.Printf("[ERROR] in reading object %v\n", err)
+	diags := APIRead(ctx, d, meta, "poolgroup", s)
+	if diags.HasError() {
+		log.Printf("[ERROR] in reading object %v\n", diags)
 	}
-	return err
+	return diags
 }
 
-func resourceAviPoolGroupCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviPoolGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourcePoolGroupSchema()
-	err := APICreateOrUpdate(d, meta, "poolgroup", s)
-	if err == nil {
-		err = ResourceAviPoolGroupRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "poolgroup", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviPoolGroupRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviPoolGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviPoolGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourcePoolGroupSchema()
-	var err error
-	err = APICreateOrUpdate(d, meta, "poolgroup", s)
-	if err == nil {
-		err = ResourceAviPoolGroupRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "poolgroup", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviPoolGroupRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviPoolGroupDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAviPoolGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	objType := "poolgroup"
-	client := meta.(*clients.AviClient)
-	if APIDeleteSystemDefaultCheck(d) {
-		return nil
-	}
-	uuid := d.Get("uuid").(string)
-	if uuid != "" {
-		path := "api/" + objType + "/" + uuid
-		err := client.AviSession.Delete(path)
-		if err != nil && !(strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "204") || strings.Contains(err.Error(), "403")) {
-			log.Println("[INFO] resourceAviPoolGroupDelete not found")
-			return err
-		}
-		d.SetId("")
-	}
-	return nil
+	return APIDelete(ctx, d, meta, objType)
 }
@@ -4,20 +4,19 @@
 package avi
 
 import (
+	"context"
 	"log"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/vmware/alb-sdk/go/clients"
 )
 
 func ResourcePoolGroupDeploymentPolicySchema() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
 		"auto_disable_old_prod_pools": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "true",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  true,
 		},
 		"configpb_attributes": {
 			Type:     schema.TypeSet,
@@ -31,10 +30,9 @@ func ResourcePoolGroupDeploymentPolicySchema() map[string]*schema.Schema {
 			Computed: true,
 		},
 		"evaluation_duration": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "300",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  300,
 		},
 		"markers": {
 			Type:     schema.TypeList,
@@ -56,10 +54,9 @@ func ResourcePoolGroupDeploymentPolicySchema() map[string]*schema.Schema {
 			Default:  "BLUE_GREEN",
 		},
 		"target_test_traffic_ratio": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "100",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  100,
 		},
 		"tenant_ref": {
 			Type:     schema.TypeString,
@@ -67,10 +64,9 @@ func ResourcePoolGroupDeploymentPolicySchema() map[string]*schema.Schema {
 			Computed: true,
 		},
 		"test_traffic_ratio_rampup": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "100",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  100,
 		},
 		"uuid": {
 			Type:     schema.TypeString,
@@ -87,65 +83,50 @@ func ResourcePoolGroupDeploymentPolicySchema() map[string]*schema.Schema {
 
 func resourceAviPoolGroupDeploymentPolicy() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviPoolGroupDeploymentPolicyCreate,
-		Read:   ResourceAviPoolGroupDeploymentPolicyRead,
-		Update: resourceAviPoolGroupDeploymentPolicyUpdate,
-		Delete: resourceAviPoolGroupDeploymentPolicyDelete,
-		Schema: ResourcePoolGroupDeploymentPolicySchema(),
+		CreateContext: resourceAviPoolGroupDeploymentPolicyCreate,
+		ReadContext:   ResourceAviPoolGroupDeploymentPolicyRead,
+		UpdateContext: resourceAviPoolGroupDeploymentPolicyUpdate,
+		DeleteContext: resourceAviPoolGroupDeploymentPolicyDelete,
+		Schema:        ResourcePoolGroupDeploymentPolicySchema(),
 		Importer: &schema.ResourceImporter{
-			State: ResourcePoolGroupDeploymentPolicyImporter,
+			StateContext: ResourcePoolGroupDeploymentPolicyImporter,
 		},
 	}
 }
 
-func ResourcePoolGroupDeploymentPolicyImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func ResourcePoolGroupDeploymentPolicyImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	s := ResourcePoolGroupDeploymentPolicySchema()
-	return ResourceImporter(d, m, "poolgroupdeploymentpolicy", s)
+	return ResourceImporter(ctx, d, m, "poolgroupdeploymentpolicy", s)
 }
 
-func ResourceAviPoolGroupDeploymentPolicyRead(d *schema.ResourceData, meta interface{}) error {
+func ResourceAviPoolGroupDeploymentPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourcePoolGroupDeploymentPolicySchema()
-	err := APIRead(d, meta, "poolgroupdeploymentpolicy", s)
-	if err != nil {
-		log.Printf("[ERROR] in reading object %v\n", err)
+	diags := APIRead(ctx, d, meta, "poolgroupdeploymentpolicy", s)
+	if diags.HasError() {
+		log.Printf("[ERROR] in reading object %v\n", diags)
 	}
-	return err
+	return diags
 }
 
-func resourceAviPoolGroupDeploymentPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviPoolGroupDeploymentPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourcePoolGroupDeploymentPolicySchema()
-	err := APICreateOrUpdate(d, meta, "poolgroupdeploymentpolicy", s)
-	if err == nil {
-		err = ResourceAviPoolGroupDeploymentPolicyRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "poolgroupdeploymentpolicy", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviPoolGroupDeploymentPolicyRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviPoolGroupDeploymentPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviPoolGroupDeploymentPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourcePoolGroupDeploymentPolicySchema()
-	var err error
-	err = APICreateOrUpdate(d, meta, "poolgroupdeploymentpolicy", s)
-	if err == nil {
-		err = ResourceAviPoolGroupDeploymentPolicyRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "poolgroupdeploymentpolicy", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviPoolGroupDeploymentPolicyRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviPoolGroupDeploymentPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAviPoolGroupDeploymentPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	objType := "poolgroupdeploymentpolicy"
-	client := meta.(*clients.AviClient)
-	if APIDeleteSystemDefaultCheck(d) {
-		return nil
-	}
-	uuid := d.Get("uuid").(string)
-	if uuid != "" {
-		path := "api/" + objType + "/" + uuid
-		err := client.AviSession.Delete(path)
-		if err != nil && !(strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "204") || strings.Contains(err.Error(), "403")) {
-			log.Println("[INFO] resourceAviPoolGroupDeploymentPolicyDelete not found")
-			return err
-		}
-		d.SetId("")
-	}
-	return nil
+	return APIDelete(ctx, d, meta, objType)
 }
@@ -4,11 +4,11 @@
 package avi
 
 import (
+	"context"
 	"log"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/vmware/alb-sdk/go/clients"
 )
 
 func ResourcePriorityLabelsSchema() map[string]*schema.Schema {
@@ -58,65 +58,50 @@ func ResourcePriorityLabelsSchema() map[string]*schema.Schema {
 
 func resourceAviPriorityLabels() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviPriorityLabelsCreate,
-		Read:   ResourceAviPriorityLabelsRead,
-		Update: resourceAviPriorityLabelsUpdate,
-		Delete: resourceAviPriorityLabelsDelete,
-		Schema: ResourcePriorityLabelsSchema(),
+		CreateContext: resourceAviPriorityLabelsCreate,
+		ReadContext:   ResourceAviPriorityLabelsRead,
+		UpdateContext: resourceAviPriorityLabelsUpdate,
+		DeleteContext: resourceAviPriorityLabelsDelete,
+		Schema:        ResourcePriorityLabelsSchema(),
 		Importer: &schema.ResourceImporter{
-			State: ResourcePriorityLabelsImporter,
+			StateContext: ResourcePriorityLabelsImporter,
 		},
 	}
 }
 
-func ResourcePriorityLabelsImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func ResourcePriorityLabelsImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	s := ResourcePriorityLabelsSchema()
-	return ResourceImporter(d, m, "prioritylabels", s)
+	return ResourceImporter(ctx, d, m, "prioritylabels", s)
 }
 
-func ResourceAviPriorityLabelsRead(d *schema.ResourceData, meta interface{}) error {
+func ResourceAviPriorityLabelsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourcePriorityLabelsSchema()
-	err := APIRead(d, meta, "prioritylabels", s)
-	if err != nil {
-		log.Printf("[ERROR] in reading object %v\n", err)
+	diags := APIRead(ctx, d, meta, "prioritylabels", s)
+	if diags.HasError() {
+		log.Printf("[ERROR] in reading object %v\n", diags)
 	}
-	return err
+	return diags
 }
 
-func resourceAviPriorityLabelsCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviPriorityLabelsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourcePriorityLabelsSchema()
-	err := APICreateOrUpdate(d, meta, "prioritylabels", s)
-	if err == nil {
-		err = ResourceAviPriorityLabelsRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "prioritylabels", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviPriorityLabelsRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviPriorityLabelsUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviPriorityLabelsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourcePriorityLabelsSchema()
-	var err error
-	err = APICreateOrUpdate(d, meta, "prioritylabels", s)
-	if err == nil {
-		err = ResourceAviPriorityLabelsRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "prioritylabels", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviPriorityLabelsRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviPriorityLabelsDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAviPriorityLabelsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	objType := "prioritylabels"
-	client := meta.(*clients.AviClient)
-	if APIDeleteSystemDefaultCheck(d) {
-		return nil
-	}
-	uuid := d.Get("uuid").(string)
-	if uuid != "" {
-		path := "api/" + objType + "/" + uuid
-		err := client.AviSession.Delete(path)
-		if err != nil && !(strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "204") || strings.Contains(err.Error(), "403")) {
-			log.Println("[INFO] resourceAviPriorityLabelsDelete not found")
-			return err
-		}
-		d.SetId("")
-	}
-	return nil
+	return APIDelete(ctx, d, meta, objType)
 }
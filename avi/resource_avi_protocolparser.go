@@ -4,11 +4,11 @@
 package avi
 
 import (
+	"context"
 	"log"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/vmware/alb-sdk/go/clients"
 )
 
 func ResourceProtocolParserSchema() map[string]*schema.Schema {
@@ -52,65 +52,50 @@ func ResourceProtocolParserSchema() map[string]*schema.Schema {
 
 func resourceAviProtocolParser() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviProtocolParserCreate,
-		Read:   ResourceAviProtocolParserRead,
-		Update: resourceAviProtocolParserUpdate,
-		Delete: resourceAviProtocolParserDelete,
-		Schema: ResourceProtocolParserSchema(),
+		CreateContext: resourceAviProtocolParserCreate,
+		ReadContext:   ResourceAviProtocolParserRead,
+		UpdateContext: resourceAviProtocolParserUpdate,
+		DeleteContext: resourceAviProtocolParserDelete,
+		Schema:        ResourceProtocolParserSchema(),
 		Importer: &schema.ResourceImporter{
-			State: ResourceProtocolParserImporter,
+			StateContext: ResourceProtocolParserImporter,
 		},
 	}
 }
 
-func ResourceProtocolParserImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func ResourceProtocolParserImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	s := ResourceProtocolParserSchema()
-	return ResourceImporter(d, m, "protocolparser", s)
+	return ResourceImporter(ctx, d, m, "protocolparser", s)
 }
 
-func ResourceAviProtocolParserRead(d *schema.ResourceData, meta interface{}) error {
+func ResourceAviProtocolParserRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceProtocolParserSchema()
-	err := APIRead(d, meta, "protocolparser", s)
-	if err != nil {
-		log.Printf("[ERROR] in reading object %v\n", err)
+	diags := APIRead(ctx, d, meta, "protocolparser", s)
+	if diags.HasError() {
+		log.Printf("[ERROR] in reading object %v\n", diags)
 	}
-	return err
+	return diags
 }
 
-func resourceAviProtocolParserCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviProtocolParserCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceProtocolParserSchema()
-	err := APICreateOrUpdate(d, meta, "protocolparser", s)
-	if err == nil {
-		err = ResourceAviProtocolParserRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "protocolparser", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviProtocolParserRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviProtocolParserUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviProtocolParserUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceProtocolParserSchema()
-	var err error
-	err = APICreateOrUpdate(d, meta, "protocolparser", s)
-	if err == nil {
-		err = ResourceAviProtocolParserRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "protocolparser", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviProtocolParserRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviProtocolParserDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAviProtocolParserDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	objType := "protocolparser"
-	client := meta.(*clients.AviClient)
-	if APIDeleteSystemDefaultCheck(d) {
-		return nil
-	}
-	uuid := d.Get("uuid").(string)
-	if uuid != "" {
-		path := "api/" + objType + "/" + uuid
-		err := client.AviSession.Delete(path)
-		if err != nil && !(strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "204") || strings.Contains(err.Error(), "403")) {
-			log.Println("[INFO] resourceAviProtocolParserDelete not found")
-			return err
-		}
-		d.SetId("")
-	}
-	return nil
+	return APIDelete(ctx, d, meta, objType)
 }
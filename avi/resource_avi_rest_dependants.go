@@ -430,10 +430,9 @@ func ResourceAbPoolSchema() *schema.Resource {
 				Required: true,
 			},
 			"ratio": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "0",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  0,
 			},
 		},
 	}
@@ -466,10 +465,9 @@ func ResourceAdminAuthConfigurationSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"allow_local_user_login": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "true",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 			"remote_auth_configurations": {
 				Type:     schema.TypeList,
@@ -518,10 +516,9 @@ func ResourceAlertSchema() *schema.Resource {
 				Elem:     ResourceEventLogSchema(),
 			},
 			"last_throttle_timestamp": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"level": {
 				Type:     schema.TypeString,
@@ -572,21 +569,18 @@ func ResourceAlertSchema() *schema.Resource {
 				Computed: true,
 			},
 			"threshold": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"throttle_count": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "0",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  0,
 			},
 			"timestamp": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Required: true,
 			},
 			"uuid": {
 				Type:     schema.TypeString,
@@ -621,10 +615,9 @@ func ResourceAlertMetricThresholdSchema() *schema.Resource {
 				Required: true,
 			},
 			"threshold": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 		},
 	}
@@ -735,10 +728,9 @@ func ResourceAlertRuleEventSchema() *schema.Resource {
 				Computed: true,
 			},
 			"not_cond": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 		},
 	}
@@ -748,10 +740,9 @@ func ResourceAlertRuleMetricSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"duration": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"metric_id": {
 				Type:     schema.TypeString,
@@ -771,10 +762,9 @@ func ResourceAlertSyslogServerSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"anon_auth": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"format": {
 				Type:     schema.TypeString,
@@ -796,21 +786,18 @@ func ResourceAlertSyslogServerSchema() *schema.Resource {
 				Required: true,
 			},
 			"syslog_server_port": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "514",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  514,
 			},
 			"tls_enable": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"udp": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Required: true,
 			},
 		},
 	}
@@ -825,15 +812,13 @@ func ResourceAllSeUpgradeEventDetailsSchema() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
 			"num_se": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Required: true,
 			},
 			"num_vs": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"request": {
 				Type:     schema.TypeSet,
@@ -854,9 +839,8 @@ func ResourceAlternateAuthConfigurationSchema() *schema.Resource {
 				Computed: true,
 			},
 			"index": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Required: true,
 			},
 			"mapping_rules": {
 				Type:     schema.TypeList,
@@ -871,10 +855,9 @@ func ResourceAnalyticsPolicySchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"all_headers": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"client_insights": {
 				Type:     schema.TypeString,
@@ -911,16 +894,14 @@ func ResourceAnalyticsPolicySchema() *schema.Resource {
 				Elem:     ResourceMetricsRealTimeUpdateSchema(),
 			},
 			"significant_log_throttle": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "10",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  10,
 			},
 			"udf_log_throttle": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "10",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  10,
 			},
 		},
 	}
@@ -930,10 +911,9 @@ func ResourceAnomalyEventDetailsSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"deviation": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "0",
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Default:  0.0,
 			},
 			"metric_id": {
 				Type:     schema.TypeString,
@@ -983,9 +963,8 @@ func ResourceAnomalyEventDetailsSchema() *schema.Resource {
 				Computed: true,
 			},
 			"value": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Required: true,
 			},
 		},
 	}
@@ -1054,10 +1033,9 @@ func ResourceAppCookiePersistenceProfileSchema() *schema.Resource {
 				Required: true,
 			},
 			"timeout": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "20",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  20,
 			},
 		},
 	}
@@ -1101,28 +1079,24 @@ func ResourceAppLearningConfidenceOverrideSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"confid_high_value": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "9500",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  9500,
 			},
 			"confid_low_value": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "7500",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  7500,
 			},
 			"confid_probable_value": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "9000",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  9000,
 			},
 			"confid_very_high_value": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "9999",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  9999,
 			},
 		},
 	}
@@ -1132,40 +1106,34 @@ func ResourceAppLearningParamsSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"enable_per_uri_learning": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "true",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 			"learn_from_authenticated_clients_only": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"max_params": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "100",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  100,
 			},
 			"max_uris": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "500",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  500,
 			},
 			"min_hits_to_learn": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "10000",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  10000,
 			},
 			"sampling_percent": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "1",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  1,
 			},
 			"trusted_ipgroup_ref": {
 				Type:     schema.TypeString,
@@ -1173,10 +1141,9 @@ func ResourceAppLearningParamsSchema() *schema.Resource {
 				Computed: true,
 			},
 			"update_interval": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "30",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  30,
 			},
 		},
 	}
@@ -1186,10 +1153,9 @@ func ResourceAppSignatureConfigSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"app_signature_sync_interval": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "1440",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  1440,
 			},
 		},
 	}
@@ -1258,9 +1224,8 @@ func ResourceApplicationLogSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"adf": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Required: true,
 			},
 			"all_request_headers": {
 				Type:     schema.TypeString,
@@ -1273,10 +1238,9 @@ func ResourceApplicationLogSchema() *schema.Resource {
 				Computed: true,
 			},
 			"app_response_time": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"auth_status": {
 				Type:     schema.TypeString,
@@ -1284,16 +1248,14 @@ func ResourceApplicationLogSchema() *schema.Resource {
 				Default:  "AUTH_STATUS_NO_AUTHENTICATION",
 			},
 			"avg_ingress_latency_be": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"avg_ingress_latency_fe": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"body_updated": {
 				Type:     schema.TypeString,
@@ -1307,22 +1269,19 @@ func ResourceApplicationLogSchema() *schema.Resource {
 				Elem:     ResourceBotManagementLogSchema(),
 			},
 			"cache_disabled_by_ds": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"cache_hit": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"cacheable": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"cipher_bytes": {
 				Type:     schema.TypeString,
@@ -1341,9 +1300,8 @@ func ResourceApplicationLogSchema() *schema.Resource {
 				Elem:     ResourceSSLCipherListSchema(),
 			},
 			"client_dest_port": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Required: true,
 			},
 			"client_device": {
 				Type:     schema.TypeString,
@@ -1362,9 +1320,8 @@ func ResourceApplicationLogSchema() *schema.Resource {
 				Computed: true,
 			},
 			"client_ip": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Required: true,
 			},
 			"client_ip6": {
 				Type:     schema.TypeString,
@@ -1387,14 +1344,12 @@ func ResourceApplicationLogSchema() *schema.Resource {
 				Computed: true,
 			},
 			"client_rtt": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Required: true,
 			},
 			"client_src_port": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Required: true,
 			},
 			"compression": {
 				Type:     schema.TypeString,
@@ -1402,22 +1357,19 @@ func ResourceApplicationLogSchema() *schema.Resource {
 				Computed: true,
 			},
 			"compression_percentage": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"conn_est_time_be": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"conn_est_time_fe": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"connection_error_info": {
 				Type:     schema.TypeSet,
@@ -1426,16 +1378,14 @@ func ResourceApplicationLogSchema() *schema.Resource {
 				Elem:     ResourceConnErrorInfoSchema(),
 			},
 			"critical_error_encountered": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"data_transfer_time": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"datascript_error_trace": {
 				Type:     schema.TypeSet,
@@ -1464,10 +1414,9 @@ func ResourceApplicationLogSchema() *schema.Resource {
 				Computed: true,
 			},
 			"grpc_status": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"grpc_status_reason_phrase": {
 				Type:     schema.TypeString,
@@ -1490,10 +1439,9 @@ func ResourceApplicationLogSchema() *schema.Resource {
 				Computed: true,
 			},
 			"http2_stream_id": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"http_request_policy_rule_name": {
 				Type:     schema.TypeString,
@@ -1528,21 +1476,18 @@ func ResourceApplicationLogSchema() *schema.Resource {
 				Elem:     ResourceJwtLogSchema(),
 			},
 			"log_id": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Required: true,
 			},
 			"max_ingress_latency_be": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"max_ingress_latency_fe": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"method": {
 				Type:     schema.TypeString,
@@ -1577,10 +1522,9 @@ func ResourceApplicationLogSchema() *schema.Resource {
 				Elem:     ResourceOauthLogSchema(),
 			},
 			"ocsp_status_resp_sent": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"oob_log": {
 				Type:     schema.TypeSet,
@@ -1595,16 +1539,14 @@ func ResourceApplicationLogSchema() *schema.Resource {
 				Elem:     ResourcePaaLogSchema(),
 			},
 			"persistence_used": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"persistent_session_id": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"pool": {
 				Type:     schema.TypeString,
@@ -1627,9 +1569,8 @@ func ResourceApplicationLogSchema() *schema.Resource {
 				Computed: true,
 			},
 			"report_timestamp": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Required: true,
 			},
 			"request_content_type": {
 				Type:     schema.TypeString,
@@ -1637,10 +1578,9 @@ func ResourceApplicationLogSchema() *schema.Resource {
 				Computed: true,
 			},
 			"request_headers": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"request_id": {
 				Type:     schema.TypeString,
@@ -1648,16 +1588,14 @@ func ResourceApplicationLogSchema() *schema.Resource {
 				Computed: true,
 			},
 			"request_length": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"request_served_locally_remote_site_down": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"request_state": {
 				Type:     schema.TypeString,
@@ -1665,10 +1603,9 @@ func ResourceApplicationLogSchema() *schema.Resource {
 				Computed: true,
 			},
 			"response_code": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"response_content_type": {
 				Type:     schema.TypeString,
@@ -1676,28 +1613,24 @@ func ResourceApplicationLogSchema() *schema.Resource {
 				Computed: true,
 			},
 			"response_headers": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"response_length": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"response_time_first_byte": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"response_time_last_byte": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"rewritten_uri_path": {
 				Type:     schema.TypeString,
@@ -1710,28 +1643,24 @@ func ResourceApplicationLogSchema() *schema.Resource {
 				Computed: true,
 			},
 			"saml_auth_request_generated": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"saml_auth_response_received": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"saml_auth_session_id": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"saml_authentication_used": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"saml_log": {
 				Type:     schema.TypeSet,
@@ -1740,16 +1669,14 @@ func ResourceApplicationLogSchema() *schema.Resource {
 				Elem:     ResourceSamlLogSchema(),
 			},
 			"saml_session_cookie_valid": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"server_conn_src_ip": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"server_conn_src_ip6": {
 				Type:     schema.TypeString,
@@ -1757,22 +1684,19 @@ func ResourceApplicationLogSchema() *schema.Resource {
 				Computed: true,
 			},
 			"server_connection_reused": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"server_dest_port": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"server_ip": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"server_ip6": {
 				Type:     schema.TypeString,
@@ -1785,46 +1709,39 @@ func ResourceApplicationLogSchema() *schema.Resource {
 				Computed: true,
 			},
 			"server_push_initiated": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 			"server_pushed_request": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 			"server_response_code": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"server_response_length": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"server_response_time_first_byte": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"server_response_time_last_byte": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"server_rtt": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"server_side_redirect_uri": {
 				Type:     schema.TypeString,
@@ -1832,10 +1749,9 @@ func ResourceApplicationLogSchema() *schema.Resource {
 				Computed: true,
 			},
 			"server_src_port": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"server_ssl_session_id": {
 				Type:     schema.TypeString,
@@ -1843,16 +1759,14 @@ func ResourceApplicationLogSchema() *schema.Resource {
 				Computed: true,
 			},
 			"server_ssl_session_reused": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"servers_tried": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "0",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  0,
 			},
 			"service_engine": {
 				Type:     schema.TypeString,
@@ -1869,9 +1783,8 @@ func ResourceApplicationLogSchema() *schema.Resource {
 				Computed: true,
 			},
 			"significant": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Required: true,
 			},
 			"significant_log": {
 				Type:     schema.TypeList,
@@ -1884,10 +1797,9 @@ func ResourceApplicationLogSchema() *schema.Resource {
 				Computed: true,
 			},
 			"source_ip": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"source_ip6": {
 				Type:     schema.TypeString,
@@ -1915,15 +1827,13 @@ func ResourceApplicationLogSchema() *schema.Resource {
 				Computed: true,
 			},
 			"total_time": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"udf": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Required: true,
 			},
 			"uri_path": {
 				Type:     schema.TypeString,
@@ -1946,19 +1856,17 @@ func ResourceApplicationLogSchema() *schema.Resource {
 				Computed: true,
 			},
 			"vcpu_id": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Required: true,
 			},
 			"virtualservice": {
 				Type:     schema.TypeString,
 				Required: true,
 			},
 			"vs_ip": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"vs_ip6": {
 				Type:     schema.TypeString,
@@ -2016,10 +1924,9 @@ func ResourceAttackDnsAmplificationSchema() *schema.Resource {
 				Computed: true,
 			},
 			"src_port": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 		},
 	}
@@ -2040,10 +1947,9 @@ func ResourceAttackMetaDataSchema() *schema.Resource {
 				Computed: true,
 			},
 			"max_resp_time": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"url": {
 				Type:     schema.TypeString,
@@ -2058,10 +1964,9 @@ func ResourceAttackMitigationActionSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"deny": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "true",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 		},
 	}
@@ -2086,10 +1991,9 @@ func ResourceAuditComplianceEventInfoSchema() *schema.Resource {
 				Computed: true,
 			},
 			"event_generated_by_se": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 			"fingerprint": {
 				Type:     schema.TypeString,
@@ -2209,15 +2113,13 @@ func ResourceAuthMappingRuleSchema() *schema.Resource {
 				Elem:     ResourceAuthMatchGroupMembershipSchema(),
 			},
 			"index": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Required: true,
 			},
 			"is_superuser": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 			"policy_attribute_name": {
 				Type:     schema.TypeString,
@@ -2299,10 +2201,9 @@ func ResourceAuthProfileHTTPClientParamsSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"cache_expiration_time": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "5",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  5,
 			},
 			"request_header": {
 				Type:     schema.TypeString,
@@ -2322,10 +2223,9 @@ func ResourceAuthTacacsPlusAttributeValuePairSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"mandatory": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 			"name": {
 				Type:     schema.TypeString,
@@ -2405,15 +2305,13 @@ func ResourceAuthenticationRuleSchema() *schema.Resource {
 				Elem:     ResourceAuthenticationActionSchema(),
 			},
 			"enable": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "true",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 			"index": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Required: true,
 			},
 			"match": {
 				Type:     schema.TypeSet,
@@ -2520,15 +2418,13 @@ func ResourceAuthorizationRuleSchema() *schema.Resource {
 				Elem:     ResourceAuthorizationActionSchema(),
 			},
 			"enable": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "true",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 			"index": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Required: true,
 			},
 			"match": {
 				Type:     schema.TypeSet,
@@ -2564,10 +2460,9 @@ func ResourceAutoScaleMesosSettingsSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"force": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "true",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 		},
 	}
@@ -2577,16 +2472,14 @@ func ResourceAutoScaleMgrDebugFilterSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"enable_aws_autoscale_integration": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"intelligent_autoscale_period": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"pool_ref": {
 				Type:     schema.TypeString,
@@ -2618,10 +2511,9 @@ func ResourceAvgUptimeChangeDetailsSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"current_value": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"metric_id": {
 				Type:     schema.TypeString,
@@ -2639,10 +2531,9 @@ func ResourceAvgUptimeChangeDetailsSchema() *schema.Resource {
 				Computed: true,
 			},
 			"threshold": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 		},
 	}
@@ -2659,10 +2550,9 @@ func ResourceAwsConfigurationSchema() *schema.Resource {
 				DiffSuppressFunc: suppressSensitiveFieldDiffs,
 			},
 			"asg_poll_interval": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "600",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  600,
 			},
 			"ebs_encryption": {
 				Type:     schema.TypeSet,
@@ -2671,10 +2561,9 @@ func ResourceAwsConfigurationSchema() *schema.Resource {
 				Elem:     ResourceAwsEncryptionSchema(),
 			},
 			"free_elasticips": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "true",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 			"iam_assume_role": {
 				Type:     schema.TypeString,
@@ -2682,10 +2571,9 @@ func ResourceAwsConfigurationSchema() *schema.Resource {
 				Computed: true,
 			},
 			"publish_vip_to_public_zone": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"region": {
 				Type:     schema.TypeString,
@@ -2693,10 +2581,9 @@ func ResourceAwsConfigurationSchema() *schema.Resource {
 				Default:  "us-west-1",
 			},
 			"route53_integration": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"s3_encryption": {
 				Type:     schema.TypeSet,
@@ -2718,22 +2605,19 @@ func ResourceAwsConfigurationSchema() *schema.Resource {
 				Elem:     ResourceAwsEncryptionSchema(),
 			},
 			"ttl": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "60",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  60,
 			},
 			"use_iam_roles": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"use_sns_sqs": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"vpc": {
 				Type:     schema.TypeString,
@@ -2860,28 +2744,24 @@ func ResourceAzureConfigurationSchema() *schema.Resource {
 				Computed: true,
 			},
 			"use_azure_dns": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"use_enhanced_ha": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"use_managed_disks": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "true",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 			"use_standard_alb": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 		},
 	}
@@ -3134,22 +3014,19 @@ func ResourceBOTLimitsSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"allow_rules": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"hdrs": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"mapping_rules": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 		},
 	}
@@ -3159,22 +3036,19 @@ func ResourceBfdProfileSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"minrx": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "1000",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  1000,
 			},
 			"mintx": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "1000",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  1000,
 			},
 			"multi": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "3",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  3,
 			},
 		},
 	}
@@ -3184,58 +3058,49 @@ func ResourceBgpPeerSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"advertise_snat_ip": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "true",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 			"advertise_vip": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "true",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 			"advertisement_interval": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "5",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  5,
 			},
 			"bfd": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "true",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 			"connect_timer": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "10",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  10,
 			},
 			"ebgp_multihop": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "0",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  0,
 			},
 			"hold_time": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"ibgp_local_as_override": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 			"keepalive_interval": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"label": {
 				Type:     schema.TypeString,
@@ -3243,10 +3108,9 @@ func ResourceBgpPeerSchema() *schema.Resource {
 				Computed: true,
 			},
 			"local_as": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"md5_secret": {
 				Type:             schema.TypeString,
@@ -3273,16 +3137,14 @@ func ResourceBgpPeerSchema() *schema.Resource {
 				Elem:     ResourceIpAddrSchema(),
 			},
 			"remote_as": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"shutdown": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"subnet": {
 				Type:     schema.TypeSet,
@@ -3309,15 +3171,13 @@ func ResourceBgpProfileSchema() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
 			"hold_time": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "180",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  180,
 			},
 			"ibgp": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Required: true,
 			},
 			"ip_communities": {
 				Type:     schema.TypeList,
@@ -3325,27 +3185,23 @@ func ResourceBgpProfileSchema() *schema.Resource {
 				Elem:     ResourceIpCommunitySchema(),
 			},
 			"keepalive_interval": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "60",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  60,
 			},
 			"local_as": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Required: true,
 			},
 			"local_preference": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"num_as_path_prepend": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"peers": {
 				Type:     schema.TypeList,
@@ -3358,16 +3214,14 @@ func ResourceBgpProfileSchema() *schema.Resource {
 				Elem:     ResourceBgpRoutingOptionsSchema(),
 			},
 			"send_community": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "true",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 			"shutdown": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 		},
 	}
@@ -3377,38 +3231,33 @@ func ResourceBgpRoutingOptionsSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"advertise_default_route": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 			"advertise_learned_routes": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 			"label": {
 				Type:     schema.TypeString,
 				Required: true,
 			},
 			"learn_only_default_route": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 			"learn_routes": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 			"max_learn_limit": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "50",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  50,
 			},
 		},
 	}
@@ -3439,9 +3288,8 @@ func ResourceBotAllowRuleSchema() *schema.Resource {
 				Elem:     ResourceMatchTargetSchema(),
 			},
 			"index": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Required: true,
 			},
 			"name": {
 				Type:     schema.TypeString,
@@ -3489,10 +3337,9 @@ func ResourceBotConfigIPLocationSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"enabled": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "true",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 			"ip_location_db_ref": {
 				Type:     schema.TypeString,
@@ -3517,10 +3364,9 @@ func ResourceBotConfigIPReputationSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"enabled": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "true",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 			"ip_reputation_db_ref": {
 				Type:     schema.TypeString,
@@ -3540,16 +3386,14 @@ func ResourceBotConfigUserAgentSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"enabled": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "true",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 			"use_tls_fingerprint": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "true",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 		},
 	}
@@ -3648,9 +3492,8 @@ func ResourceBotMappingRuleSchema() *schema.Resource {
 				Elem:     ResourceBotClassificationSchema(),
 			},
 			"index": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Required: true,
 			},
 			"match": {
 				Type:     schema.TypeSet,
@@ -3745,10 +3588,9 @@ func ResourceBuildInfoSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"build_no": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"date": {
 				Type:     schema.TypeString,
@@ -3793,10 +3635,9 @@ func ResourceBurstLicenseDetailsSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"cores": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"end_time": {
 				Type:     schema.TypeString,
@@ -3885,70 +3726,59 @@ func ResourceCC_AgentPropertiesSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"async_retries": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "5",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  5,
 			},
 			"async_retries_delay": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "10",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  10,
 			},
 			"poll_duration_target": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "10",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  10,
 			},
 			"poll_fast_target": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "60",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  60,
 			},
 			"poll_slow_target": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "240",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  240,
 			},
 			"vcenter_host_ping_interval": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "300",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  300,
 			},
 			"vcenter_inventory_max_object_updates": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "100",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  100,
 			},
 			"vcenter_max_datastore_go_routines": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "20",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  20,
 			},
 			"vcenter_reconcile_interval": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "3600",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  3600,
 			},
 			"vnic_retries": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "60",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  60,
 			},
 			"vnic_retries_delay": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "5",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  5,
 			},
 		},
 	}
@@ -3958,16 +3788,14 @@ func ResourceCC_PropertiesSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"rpc_poll_interval": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "60",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  60,
 			},
 			"rpc_queue_size": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "100",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  100,
 			},
 		},
 	}
@@ -4018,16 +3846,14 @@ func ResourceCPUUsageSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"cpu_percent": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"num_cores": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 		},
 	}
@@ -4117,10 +3943,9 @@ func ResourceCRLSchema() *schema.Resource {
 				Computed: true,
 			},
 			"update_interval": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 		},
 	}
@@ -4198,16 +4023,14 @@ func ResourceCaptureFileSizeSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"absolute_size": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "0",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  0,
 			},
 			"percentage_size": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "0",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  0,
 			},
 		},
 	}
@@ -4229,16 +4052,14 @@ func ResourceCaptureFiltersSchema() *schema.Resource {
 				Elem:     ResourceCaptureIPCSchema(),
 			},
 			"dst_port_end": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"dst_port_start": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"eth_proto": {
 				Type:     schema.TypeString,
@@ -4251,40 +4072,34 @@ func ResourceCaptureFiltersSchema() *schema.Resource {
 				Computed: true,
 			},
 			"src_port": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"src_port_range_end": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"tcp_ack": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 			"tcp_fin": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 			"tcp_push": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 			"tcp_syn": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 		},
 	}
@@ -4294,76 +4109,64 @@ func ResourceCaptureIPCSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"flow_del_probe": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 			"flow_mirror_add": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 			"flow_mirror_all": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 			"flow_mirror_del": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 			"flow_probe": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 			"flow_probe_all": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 			"ipc_batched": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 			"ipc_rx_req": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 			"ipc_rx_res": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 			"ipc_tx_req": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 			"ipc_tx_res": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 			"vs_hb": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 		},
 	}
@@ -4373,22 +4176,19 @@ func ResourceCaseConfigSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"enable_auto_case_creation_on_controller_failure": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"enable_auto_case_creation_on_se_failure": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"enable_cleanup_of_attached_files": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "true",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 		},
 	}
@@ -4440,10 +4240,9 @@ func ResourceCentralLicenseRefreshDetailsSchema() *schema.Resource {
 				Computed: true,
 			},
 			"service_units": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 		},
 	}
@@ -4482,16 +4281,14 @@ func ResourceCfgStateSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"cfg_version": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"cfg_version_in_flight": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"last_changed_time": {
 				Type:     schema.TypeSet,
@@ -4527,16 +4324,14 @@ func ResourceChildProcessInfoSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"memory": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"pid": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 		},
 	}
@@ -4594,10 +4389,9 @@ func ResourceClientLogConfigurationSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"enable_significant_log_collection": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "true",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 			"filtered_log_processing": {
 				Type:     schema.TypeString,
@@ -4622,10 +4416,9 @@ func ResourceClientLogFilterSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"all_headers": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"client_ip": {
 				Type:     schema.TypeSet,
@@ -4634,20 +4427,17 @@ func ResourceClientLogFilterSchema() *schema.Resource {
 				Elem:     ResourceIpAddrMatchSchema(),
 			},
 			"duration": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "30",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  30,
 			},
 			"enabled": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Required: true,
 			},
 			"index": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Required: true,
 			},
 			"name": {
 				Type:     schema.TypeString,
@@ -4671,10 +4461,9 @@ func ResourceClientLogStreamingConfigSchema() *schema.Resource {
 				Required: true,
 			},
 			"external_server_port": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "514",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  514,
 			},
 			"format_config": {
 				Type:     schema.TypeSet,
@@ -4688,10 +4477,9 @@ func ResourceClientLogStreamingConfigSchema() *schema.Resource {
 				Default:  "LOGS_ALL",
 			},
 			"max_logs_per_second": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "100",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  100,
 			},
 			"protocol": {
 				Type:     schema.TypeString,
@@ -4833,10 +4621,9 @@ func ResourceCloudConnectorDebugFilterSchema() *schema.Resource {
 				Computed: true,
 			},
 			"disable_se_reboot": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 			"se_id": {
 				Type:     schema.TypeString,
@@ -4900,44 +4687,38 @@ func ResourceCloudFlavorSchema() *schema.Resource {
 				Computed: true,
 			},
 			"disk_gb": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"enhanced_nw": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 			"id": {
 				Type:     schema.TypeString,
 				Required: true,
 			},
 			"is_recommended": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 			"max_ip6s_per_nic": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"max_ips_per_nic": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"max_nics": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"meta": {
 				Type:     schema.TypeList,
@@ -4949,22 +4730,19 @@ func ResourceCloudFlavorSchema() *schema.Resource {
 				Required: true,
 			},
 			"public": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "true",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 			"ram_mb": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"vcpus": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 		},
 	}
@@ -5021,10 +4799,9 @@ func ResourceCloudHealthSchema() *schema.Resource {
 				Computed: true,
 			},
 			"num_fails": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"vtype": {
 				Type:     schema.TypeString,
@@ -5100,16 +4877,14 @@ func ResourceCloudIpChangeSchema() *schema.Resource {
 				Elem:     ResourceIpAddrSchema(),
 			},
 			"ip6_mask": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "128",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  128,
 			},
 			"ip_mask": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "32",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  32,
 			},
 			"mac_addr": {
 				Type:     schema.TypeString,
@@ -5185,10 +4960,9 @@ func ResourceCloudRuntimeSchema() *schema.Resource {
 				Required: true,
 			},
 			"network_sync_complete": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"tenant_ref": {
 				Type:     schema.TypeString,
@@ -5351,28 +5125,24 @@ func ResourceCloudTenantCleanupSchema() *schema.Resource {
 				Computed: true,
 			},
 			"num_ports": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"num_se": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"num_secgrp": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"num_svrgrp": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 		},
 	}
@@ -5515,10 +5285,9 @@ func ResourceClusterHAConfigSchema() *schema.Resource {
 				Computed: true,
 			},
 			"override_vsphere_ha": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"vmg_name": {
 				Type:     schema.TypeString,
@@ -5640,10 +5409,9 @@ func ResourceClusterNodeDbFailedEventSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"failure_count": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"ip": {
 				Type:     schema.TypeSet,
@@ -5790,9 +5558,8 @@ func ResourceCompressionFilterSchema() *schema.Resource {
 				Computed: true,
 			},
 			"index": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Required: true,
 			},
 			"ip_addr_prefixes": {
 				Type:     schema.TypeList,
@@ -5840,16 +5607,14 @@ func ResourceCompressionProfileSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"buf_num": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "128",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  128,
 			},
 			"buf_size": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "4096",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  4096,
 			},
 			"compressible_content_ref": {
 				Type:     schema.TypeString,
@@ -5857,9 +5622,8 @@ func ResourceCompressionProfileSchema() *schema.Resource {
 				Computed: true,
 			},
 			"compression": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Required: true,
 			},
 			"filter": {
 				Type:     schema.TypeList,
@@ -5867,40 +5631,34 @@ func ResourceCompressionProfileSchema() *schema.Resource {
 				Elem:     ResourceCompressionFilterSchema(),
 			},
 			"hash_size": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "16384",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  16384,
 			},
 			"level_aggressive": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "5",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  5,
 			},
 			"level_normal": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "1",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  1,
 			},
 			"max_low_rtt": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "10",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  10,
 			},
 			"min_high_rtt": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "200",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  200,
 			},
 			"min_length": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "128",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  128,
 			},
 			"mobile_str_ref": {
 				Type:     schema.TypeString,
@@ -5908,19 +5666,17 @@ func ResourceCompressionProfileSchema() *schema.Resource {
 				Computed: true,
 			},
 			"remove_accept_encoding_header": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Required: true,
 			},
 			"type": {
 				Type:     schema.TypeString,
 				Required: true,
 			},
 			"window_size": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "4096",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  4096,
 			},
 		},
 	}
@@ -6071,10 +5827,9 @@ func ResourceConfigInfoSchema() *schema.Resource {
 				Elem:     ResourceVersionInfoSchema(),
 			},
 			"reader_count": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"state": {
 				Type:     schema.TypeString,
@@ -6082,10 +5837,9 @@ func ResourceConfigInfoSchema() *schema.Resource {
 				Computed: true,
 			},
 			"writer_count": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 		},
 	}
@@ -6095,10 +5849,9 @@ func ResourceConfigPbAttributesSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"version": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 		},
 	}
@@ -6249,10 +6002,9 @@ func ResourceConfigUserLoginSchema() *schema.Resource {
 				Computed: true,
 			},
 			"local": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 			"remote_attributes": {
 				Type:     schema.TypeString,
@@ -6287,10 +6039,9 @@ func ResourceConfigUserLogoutSchema() *schema.Resource {
 				Computed: true,
 			},
 			"local": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 			"status": {
 				Type:     schema.TypeString,
@@ -6386,94 +6137,79 @@ func ResourceConnErrorInfoSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"num_syn_retransmit": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"num_window_shrink": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"out_of_orders": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"retransmits": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"rx_pkts": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"server_num_window_shrink": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"server_out_of_orders": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"server_retransmits": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"server_rx_pkts": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"server_timeouts": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"server_tx_pkts": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"server_zero_window_size_events": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"timeouts": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"tx_pkts": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"zero_window_size_events": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 		},
 	}
@@ -6483,28 +6219,24 @@ func ResourceConnPoolPropertiesSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"upstream_connpool_conn_idle_tmo": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "60000",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  60000,
 			},
 			"upstream_connpool_conn_life_tmo": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "600000",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  600000,
 			},
 			"upstream_connpool_conn_max_reuse": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "0",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  0,
 			},
 			"upstream_connpool_server_max_cache": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "0",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  0,
 			},
 		},
 	}
@@ -6524,10 +6256,9 @@ func ResourceConnectionEventDetailsSchema() *schema.Resource {
 				Computed: true,
 			},
 			"port": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 		},
 	}
@@ -6537,37 +6268,31 @@ func ResourceConnectionLogSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"adf": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Required: true,
 			},
 			"average_turntime": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"avg_ingress_latency_be": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"avg_ingress_latency_fe": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"client_dest_port": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Required: true,
 			},
 			"client_ip": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Required: true,
 			},
 			"client_ip6": {
 				Type:     schema.TypeString,
@@ -6585,31 +6310,26 @@ func ResourceConnectionLogSchema() *schema.Resource {
 				Computed: true,
 			},
 			"client_rtt": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Required: true,
 			},
 			"client_src_port": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Required: true,
 			},
 			"conn_est_time_be": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"conn_est_time_fe": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"connection_ended": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Required: true,
 			},
 			"dns_etype": {
 				Type:     schema.TypeString,
@@ -6664,21 +6384,18 @@ func ResourceConnectionLogSchema() *schema.Resource {
 				Computed: true,
 			},
 			"log_id": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Required: true,
 			},
 			"max_ingress_latency_be": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"max_ingress_latency_fe": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"microservice": {
 				Type:     schema.TypeString,
@@ -6691,9 +6408,8 @@ func ResourceConnectionLogSchema() *schema.Resource {
 				Computed: true,
 			},
 			"mss": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Required: true,
 			},
 			"network_security_policy_rule_name": {
 				Type:     schema.TypeString,
@@ -6701,39 +6417,33 @@ func ResourceConnectionLogSchema() *schema.Resource {
 				Computed: true,
 			},
 			"num_syn_retransmit": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"num_transaction": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"num_window_shrink": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"ocsp_status_resp_sent": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"out_of_orders": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Required: true,
 			},
 			"persistence_used": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"pool": {
 				Type:     schema.TypeString,
@@ -6756,29 +6466,24 @@ func ResourceConnectionLogSchema() *schema.Resource {
 				Computed: true,
 			},
 			"report_timestamp": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Required: true,
 			},
 			"retransmits": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Required: true,
 			},
 			"rx_bytes": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Required: true,
 			},
 			"rx_pkts": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Required: true,
 			},
 			"server_conn_src_ip": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Required: true,
 			},
 			"server_conn_src_ip6": {
 				Type:     schema.TypeString,
@@ -6786,14 +6491,12 @@ func ResourceConnectionLogSchema() *schema.Resource {
 				Computed: true,
 			},
 			"server_dest_port": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Required: true,
 			},
 			"server_ip": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Required: true,
 			},
 			"server_ip6": {
 				Type:     schema.TypeString,
@@ -6806,70 +6509,57 @@ func ResourceConnectionLogSchema() *schema.Resource {
 				Computed: true,
 			},
 			"server_num_window_shrink": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"server_out_of_orders": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Required: true,
 			},
 			"server_retransmits": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Required: true,
 			},
 			"server_rtt": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Required: true,
 			},
 			"server_rx_bytes": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Required: true,
 			},
 			"server_rx_pkts": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Required: true,
 			},
 			"server_src_port": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Required: true,
 			},
 			"server_timeouts": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Required: true,
 			},
 			"server_total_bytes": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Required: true,
 			},
 			"server_total_pkts": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Required: true,
 			},
 			"server_tx_bytes": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Required: true,
 			},
 			"server_tx_pkts": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Required: true,
 			},
 			"server_zero_window_size_events": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Required: true,
 			},
 			"service_engine": {
 				Type:     schema.TypeString,
@@ -6882,9 +6572,8 @@ func ResourceConnectionLogSchema() *schema.Resource {
 				Computed: true,
 			},
 			"significant": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Required: true,
 			},
 			"significant_log": {
 				Type:     schema.TypeList,
@@ -6918,62 +6607,52 @@ func ResourceConnectionLogSchema() *schema.Resource {
 				Computed: true,
 			},
 			"start_timestamp": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Required: true,
 			},
 			"timeouts": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Required: true,
 			},
 			"total_bytes": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "0",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  0,
 			},
 			"total_pkts": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "0",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  0,
 			},
 			"total_time": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "0",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  0,
 			},
 			"tx_bytes": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Required: true,
 			},
 			"tx_pkts": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Required: true,
 			},
 			"udf": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Required: true,
 			},
 			"vcpu_id": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Required: true,
 			},
 			"virtualservice": {
 				Type:     schema.TypeString,
 				Required: true,
 			},
 			"vs_ip": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"vs_ip6": {
 				Type:     schema.TypeString,
@@ -6981,9 +6660,8 @@ func ResourceConnectionLogSchema() *schema.Resource {
 				Computed: true,
 			},
 			"zero_window_size_events": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Required: true,
 			},
 		},
 	}
@@ -7042,10 +6720,9 @@ func ResourceContainerCloudSetupSchema() *schema.Resource {
 				Computed: true,
 			},
 			"cloud_access": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 			"failed_hosts": {
 				Type:     schema.TypeList,
@@ -7083,10 +6760,9 @@ func ResourceContainerCloudSetupSchema() *schema.Resource {
 				Computed: true,
 			},
 			"se_deploy_method_access": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 			"se_name": {
 				Type:     schema.TypeString,
@@ -7152,10 +6828,9 @@ func ResourceControllerCloudLimitsSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"num_clouds": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"type": {
 				Type:     schema.TypeString,
@@ -7197,40 +6872,34 @@ func ResourceControllerFaultsSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"backup_scheduler_faults": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "true",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 			"cluster_faults": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "true",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 			"deprecated_api_version_faults": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "true",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 			"license_faults": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "true",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 			"migration_faults": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "true",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 			"sslprofile_faults": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "true",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 		},
 	}
@@ -7240,10 +6909,9 @@ func ResourceControllerInfoSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"current_controller_mem_usage": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 		},
 	}
@@ -7315,26 +6983,23 @@ func ResourceControllerLicenseSchema() *schema.Resource {
 				Elem:     ResourceBurstResourceSchema(),
 			},
 			"burst_cores": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"cores": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"customer_name": {
 				Type:     schema.TypeString,
 				Required: true,
 			},
 			"disable_enforcement": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 			"expired_burst_resources": {
 				Type:     schema.TypeList,
@@ -7342,10 +7007,9 @@ func ResourceControllerLicenseSchema() *schema.Resource {
 				Elem:     ResourceBurstResourceSchema(),
 			},
 			"initialized": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 			"license_id": {
 				Type:     schema.TypeString,
@@ -7368,10 +7032,9 @@ func ResourceControllerLicenseSchema() *schema.Resource {
 				Elem:     ResourceSingleLicenseSchema(),
 			},
 			"max_ses": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"name": {
 				Type:     schema.TypeString,
@@ -7384,16 +7047,14 @@ func ResourceControllerLicenseSchema() *schema.Resource {
 				Elem:     ResourceSEBandwidthLimitSchema(),
 			},
 			"service_cores": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"sockets": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"start_on": {
 				Type:     schema.TypeString,
@@ -7417,52 +7078,44 @@ func ResourceControllerLicenseReconcileDetailsSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"new_available_service_cores": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"new_consumed_service_cores": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"new_escrow_service_cores": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"new_remaining_service_cores": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"old_available_service_cores": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"old_consumed_service_cores": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"old_escrow_service_cores": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"old_remaining_service_cores": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"tenant_uuid": {
 				Type:     schema.TypeString,
@@ -7488,10 +7141,9 @@ func ResourceControllerLimitsSchema() *schema.Resource {
 				Elem:     ResourceBOTLimitsSchema(),
 			},
 			"certificates_per_virtualservice": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"controller_cloud_limits": {
 				Type:     schema.TypeList,
@@ -7504,16 +7156,14 @@ func ResourceControllerLimitsSchema() *schema.Resource {
 				Elem:     ResourceControllerSizingLimitsSchema(),
 			},
 			"default_routes_per_vrfcontext": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"gateway_mon_per_vrf": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"ipaddress_limits": {
 				Type:     schema.TypeSet,
@@ -7522,10 +7172,9 @@ func ResourceControllerLimitsSchema() *schema.Resource {
 				Elem:     ResourceIPAddrLimitsSchema(),
 			},
 			"ips_per_ipgroup": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"l7_limits": {
 				Type:     schema.TypeSet,
@@ -7534,70 +7183,59 @@ func ResourceControllerLimitsSchema() *schema.Resource {
 				Elem:     ResourceL7limitsSchema(),
 			},
 			"poolgroups_per_virtualservice": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"pools_per_poolgroup": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"pools_per_virtualservice": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"routes_per_vrfcontext": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"rules_per_nat_policy": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"rules_per_networksecuritypolicy": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"servers_per_pool": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"sni_children_per_parent": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"strings_per_stringgroup": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"vs_bgp_scaleout": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"vs_l2_scaleout": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"waf_limits": {
 				Type:     schema.TypeSet,
@@ -7656,16 +7294,14 @@ func ResourceControllerSizeSchema() *schema.Resource {
 				Computed: true,
 			},
 			"min_cpus": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"min_memory": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 		},
 	}
@@ -7675,10 +7311,9 @@ func ResourceControllerSizingCloudLimitsSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"num_clouds": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"type": {
 				Type:     schema.TypeString,
@@ -7703,52 +7338,44 @@ func ResourceControllerSizingLimitsSchema() *schema.Resource {
 				Computed: true,
 			},
 			"num_clouds": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"num_east_west_virtualservices": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"num_servers": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"num_serviceengines": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"num_tenants": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"num_virtualservices": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"num_virtualservices_rt_metrics": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"num_vrfs": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 		},
 	}
@@ -7758,10 +7385,9 @@ func ResourceControllerVersionSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"fips_mode": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 			"name": {
 				Type:     schema.TypeString,
@@ -7809,22 +7435,19 @@ func ResourceCumulativeLicenseSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"burst_cores": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"cores": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"max_ses": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"se_bandwidth_limits": {
 				Type:     schema.TypeList,
@@ -7832,16 +7455,14 @@ func ResourceCumulativeLicenseSchema() *schema.Resource {
 				Elem:     ResourceSEBandwidthLimitSchema(),
 			},
 			"service_cores": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"sockets": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"tier_type": {
 				Type:     schema.TypeString,
@@ -7879,16 +7500,14 @@ func ResourceCustomParamsSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"is_dynamic": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"is_sensitive": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"name": {
 				Type:     schema.TypeString,
@@ -7989,10 +7608,9 @@ func ResourceDNSRegisterInfoSchema() *schema.Resource {
 				Elem:     ResourceIpAddrSchema(),
 			},
 			"total_records": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"vip": {
 				Type:     schema.TypeSet,
@@ -8028,10 +7646,9 @@ func ResourceDNSVsSyncInfoSchema() *schema.Resource {
 				Computed: true,
 			},
 			"total_records": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 		},
 	}
@@ -8061,10 +7678,9 @@ func ResourceDSRequestLogSchema() *schema.Resource {
 				Computed: true,
 			},
 			"http_response_code": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"http_version": {
 				Type:     schema.TypeString,
@@ -8087,22 +7703,19 @@ func ResourceDSRequestLogSchema() *schema.Resource {
 				Computed: true,
 			},
 			"request_length": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"response_length": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"server_ip": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"server_name": {
 				Type:     schema.TypeString,
@@ -8110,28 +7723,24 @@ func ResourceDSRequestLogSchema() *schema.Resource {
 				Computed: true,
 			},
 			"server_port": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"servers_tried": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "0",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  0,
 			},
 			"source_port": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"total_time": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"uri_path": {
 				Type:     schema.TypeString,
@@ -8405,10 +8014,9 @@ func ResourceDebugSeAgentSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"log_every_n": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "0",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  0,
 			},
 			"log_level": {
 				Type:     schema.TypeString,
@@ -8430,14 +8038,12 @@ func ResourceDebugSeCpuSharesSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"cpu": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Required: true,
 			},
 			"shares": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Required: true,
 			},
 		},
 	}
@@ -8463,46 +8069,39 @@ func ResourceDebugSeFaultSchema() *schema.Resource {
 				Elem:     ResourceSeFaultSchema(),
 			},
 			"se_malloc_fail_frequency": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "0",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  0,
 			},
 			"se_malloc_fail_type": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "0",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  0,
 			},
 			"se_mbuf_cl_sanity": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"se_shm_malloc_fail_frequency": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "0",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  0,
 			},
 			"se_shm_malloc_fail_type": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "0",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  0,
 			},
 			"se_waf_alloc_fail_frequency": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "0",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  0,
 			},
 			"se_waf_learning_alloc_fail_frequency": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "0",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  0,
 			},
 		},
 	}
@@ -8532,10 +8131,9 @@ func ResourceDebugServiceEngineSchema() *schema.Resource {
 				Default:  "SE_BENCHMARK_DISABLE_HASH",
 			},
 			"capture": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 			"capture_filters": {
 				Type:     schema.TypeSet,
@@ -8561,16 +8159,14 @@ func ResourceDebugServiceEngineSchema() *schema.Resource {
 				Elem:     ResourceDebugIpAddrSchema(),
 			},
 			"enable_kdump": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"enable_rpc_timing_profiler": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"fault": {
 				Type:     schema.TypeSet,
@@ -8623,10 +8219,9 @@ func ResourceDebugTraceMallocTypesSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"malloc_type_index": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 		},
 	}
@@ -8653,10 +8248,9 @@ func ResourceDebugTraceShmMallocTypesSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"shm_malloc_type_index": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 		},
 	}
@@ -8666,10 +8260,9 @@ func ResourceDebugVirtualServiceSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"capture": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 			"capture_filters": {
 				Type:     schema.TypeSet,
@@ -8727,10 +8320,9 @@ func ResourceDebugVirtualServiceSchema() *schema.Resource {
 				Elem:     ResourceDebugVirtualServiceObjSyncSchema(),
 			},
 			"resync_flows": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"se_params": {
 				Type:     schema.TypeSet,
@@ -8762,40 +8354,34 @@ func ResourceDebugVirtualServiceCaptureSchema() *schema.Resource {
 				Elem:     ResourceCaptureFileSizeSchema(),
 			},
 			"duration": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "0",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  0,
 			},
 			"enable_ssl_session_key_capture": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"file_count": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "2",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  2,
 			},
 			"num_pkts": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"pcap_ng": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "true",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 			"pkt_size": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "128",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  128,
 			},
 		},
 	}
@@ -8805,10 +8391,9 @@ func ResourceDebugVirtualServiceObjSyncSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"trigger_initial_sync": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 		},
 	}
@@ -8841,16 +8426,14 @@ func ResourceDebugVrfContextSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"command_buffer_interval": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "2",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  2,
 			},
 			"command_buffer_size": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "32768",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  32768,
 			},
 			"flags": {
 				Type:     schema.TypeList,
@@ -9026,16 +8609,14 @@ func ResourceDnsAttackSchema() *schema.Resource {
 				Required: true,
 			},
 			"enabled": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "true",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 			"max_mitigation_age": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "60",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  60,
 			},
 			"mitigation_action": {
 				Type:     schema.TypeSet,
@@ -9044,10 +8625,9 @@ func ResourceDnsAttackSchema() *schema.Resource {
 				Elem:     ResourceAttackMitigationActionSchema(),
 			},
 			"threshold": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 		},
 	}
@@ -9079,10 +8659,9 @@ func ResourceDnsClientIpMatchSchema() *schema.Resource {
 				Elem:     ResourceIpAddrMatchSchema(),
 			},
 			"use_edns_client_subnet_ip": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "true",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 		},
 	}
@@ -9115,32 +8694,28 @@ func ResourceDnsEdnsOptionSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"addr_family": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"code": {
 				Type:     schema.TypeString,
 				Required: true,
 			},
 			"scope_prefix_len": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"source_prefix_len": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"subnet_ip": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"subnet_ip6": {
 				Type:     schema.TypeString,
@@ -9169,10 +8744,9 @@ func ResourceDnsGeoLocationMatchSchema() *schema.Resource {
 				Required: true,
 			},
 			"use_edns_client_subnet_ip": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "true",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 		},
 	}
@@ -9203,16 +8777,14 @@ func ResourceDnsInfoSchema() *schema.Resource {
 				Computed: true,
 			},
 			"num_records_in_response": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"ttl": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"type": {
 				Type:     schema.TypeString,
@@ -9231,9 +8803,8 @@ func ResourceDnsMxRdataSchema() *schema.Resource {
 				Required: true,
 			},
 			"priority": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Required: true,
 			},
 		},
 	}
@@ -9266,10 +8837,9 @@ func ResourceDnsOptRecordSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"dnssec_ok": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 			"options": {
 				Type:     schema.TypeList,
@@ -9277,16 +8847,14 @@ func ResourceDnsOptRecordSchema() *schema.Resource {
 				Elem:     ResourceDnsEdnsOptionSchema(),
 			},
 			"udp_payload_size": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"version": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 		},
 	}
@@ -9300,9 +8868,8 @@ func ResourceDnsPoliciesSchema() *schema.Resource {
 				Required: true,
 			},
 			"index": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Required: true,
 			},
 		},
 	}
@@ -9383,10 +8950,9 @@ func ResourceDnsRecordSchema() *schema.Resource {
 				Elem:     ResourceDnsCnameRdataSchema(),
 			},
 			"delegated": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"description": {
 				Type:     schema.TypeString,
@@ -9424,10 +8990,9 @@ func ResourceDnsRecordSchema() *schema.Resource {
 				Elem:     ResourceDnsNsRdataSchema(),
 			},
 			"num_records_in_response": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "0",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  0,
 			},
 			"service_locator": {
 				Type:     schema.TypeList,
@@ -9435,10 +9000,9 @@ func ResourceDnsRecordSchema() *schema.Resource {
 				Elem:     ResourceDnsSrvRdataSchema(),
 			},
 			"ttl": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"txt_records": {
 				Type:     schema.TypeList,
@@ -9450,10 +9014,9 @@ func ResourceDnsRecordSchema() *schema.Resource {
 				Required: true,
 			},
 			"wildcard_match": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 		},
 	}
@@ -9463,28 +9026,24 @@ func ResourceDnsRequestSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"additional_records_count": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"answer_records_count": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"authentic_data": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 			"checking_disabled": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 			"client_location": {
 				Type:     schema.TypeSet,
@@ -9493,16 +9052,14 @@ func ResourceDnsRequestSchema() *schema.Resource {
 				Elem:     ResourceGeoLocationSchema(),
 			},
 			"identifier": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"nameserver_records_count": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"opcode": {
 				Type:     schema.TypeString,
@@ -9516,22 +9073,19 @@ func ResourceDnsRequestSchema() *schema.Resource {
 				Elem:     ResourceDnsOptRecordSchema(),
 			},
 			"query_or_response": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 			"question_count": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"recursion_desired": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 		},
 	}
@@ -9541,16 +9095,14 @@ func ResourceDnsResolverSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"fixed_ttl": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"min_ttl": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "5",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  5,
 			},
 			"nameserver_ips": {
 				Type:     schema.TypeList,
@@ -9562,10 +9114,9 @@ func ResourceDnsResolverSchema() *schema.Resource {
 				Required: true,
 			},
 			"use_mgmt": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 		},
 	}
@@ -9580,10 +9131,9 @@ func ResourceDnsResourceRecordSchema() *schema.Resource {
 				Computed: true,
 			},
 			"addr_ip": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"cname": {
 				Type:     schema.TypeString,
@@ -9591,10 +9141,9 @@ func ResourceDnsResourceRecordSchema() *schema.Resource {
 				Computed: true,
 			},
 			"dclass": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"location": {
 				Type:     schema.TypeSet,
@@ -9618,16 +9167,14 @@ func ResourceDnsResourceRecordSchema() *schema.Resource {
 				Computed: true,
 			},
 			"port": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"priority": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"site_name": {
 				Type:     schema.TypeString,
@@ -9640,9 +9187,8 @@ func ResourceDnsResourceRecordSchema() *schema.Resource {
 				Computed: true,
 			},
 			"ttl": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Required: true,
 			},
 			"type": {
 				Type:     schema.TypeString,
@@ -9661,40 +9207,34 @@ func ResourceDnsResponseSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"additional_records_count": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"answer_records_count": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"authoritative_answer": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 			"fallback_algorithm_used": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 			"is_wildcard": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 			"nameserver_records_count": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"opcode": {
 				Type:     schema.TypeString,
@@ -9708,16 +9248,14 @@ func ResourceDnsResponseSchema() *schema.Resource {
 				Elem:     ResourceDnsOptRecordSchema(),
 			},
 			"query_or_response": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 			"question_count": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"records": {
 				Type:     schema.TypeList,
@@ -9725,16 +9263,14 @@ func ResourceDnsResponseSchema() *schema.Resource {
 				Elem:     ResourceDnsResourceRecordSchema(),
 			},
 			"recursion_available": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 			"recursion_desired": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 			"response_code": {
 				Type:     schema.TypeString,
@@ -9742,10 +9278,9 @@ func ResourceDnsResponseSchema() *schema.Resource {
 				Computed: true,
 			},
 			"truncation": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 		},
 	}
@@ -9780,9 +9315,8 @@ func ResourceDnsRrSetSchema() *schema.Resource {
 				Elem:     ResourceDnsNsRdataSchema(),
 			},
 			"ttl": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Required: true,
 			},
 			"type": {
 				Type:     schema.TypeString,
@@ -9802,21 +9336,18 @@ func ResourceDnsRuleSchema() *schema.Resource {
 				Elem:     ResourceDnsRuleActionSchema(),
 			},
 			"enable": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "true",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 			"index": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Required: true,
 			},
 			"log": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 			"match": {
 				Type:     schema.TypeSet,
@@ -9879,16 +9410,14 @@ func ResourceDnsRuleActionAllowDropSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"allow": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "true",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 			"reset_conn": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "true",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 		},
 	}
@@ -9914,10 +9443,9 @@ func ResourceDnsRuleActionGslbSiteSelectionSchema() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
 			"is_site_preferred": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "true",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 			"site_name": {
 				Type:     schema.TypeString,
@@ -9948,10 +9476,9 @@ func ResourceDnsRuleActionResponseSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"authoritative": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "true",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 			"rcode": {
 				Type:     schema.TypeString,
@@ -9964,10 +9491,9 @@ func ResourceDnsRuleActionResponseSchema() *schema.Resource {
 				Elem:     ResourceDnsRuleDnsRrSetSchema(),
 			},
 			"truncation": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 		},
 	}
@@ -10049,10 +9575,9 @@ func ResourceDnsServiceApplicationProfileSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"aaaa_empty_response": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "true",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 			"admin_email": {
 				Type:     schema.TypeString,
@@ -10060,10 +9585,9 @@ func ResourceDnsServiceApplicationProfileSchema() *schema.Resource {
 				Default:  "hostmaster",
 			},
 			"dns_over_tcp_enabled": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "true",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 			"dns_zones": {
 				Type:     schema.TypeList,
@@ -10076,22 +9600,19 @@ func ResourceDnsServiceApplicationProfileSchema() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
 			"ecs_stripping_enabled": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "true",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 			"edns": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "true",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 			"edns_client_subnet_prefix_len": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"error_response": {
 				Type:     schema.TypeString,
@@ -10104,22 +9625,19 @@ func ResourceDnsServiceApplicationProfileSchema() *schema.Resource {
 				Computed: true,
 			},
 			"negative_caching_ttl": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "30",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  30,
 			},
 			"num_dns_ip": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "1",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  1,
 			},
 			"ttl": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "30",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  30,
 			},
 		},
 	}
@@ -10133,16 +9651,14 @@ func ResourceDnsServiceDomainSchema() *schema.Resource {
 				Required: true,
 			},
 			"pass_through": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "true",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 			"record_ttl": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 		},
 	}
@@ -10152,15 +9668,13 @@ func ResourceDnsSrvRdataSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"port": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Required: true,
 			},
 			"priority": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "0",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  0,
 			},
 			"target": {
 				Type:     schema.TypeString,
@@ -10168,10 +9682,9 @@ func ResourceDnsSrvRdataSchema() *schema.Resource {
 				Default:  "default.host",
 			},
 			"weight": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "0",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  0,
 			},
 		},
 	}
@@ -10228,10 +9741,9 @@ func ResourceDockerConfigurationSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"app_sync_frequency": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "60",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  60,
 			},
 			"ca_tls_key_and_certificate_ref": {
 				Type:     schema.TypeString,
@@ -10244,10 +9756,9 @@ func ResourceDockerConfigurationSchema() *schema.Resource {
 				Computed: true,
 			},
 			"container_port_match_http_service": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "true",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 			"coredump_directory": {
 				Type:     schema.TypeString,
@@ -10255,22 +9766,19 @@ func ResourceDockerConfigurationSchema() *schema.Resource {
 				Default:  "/var/lib/systemd/coredump",
 			},
 			"disable_auto_backend_service_sync": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"disable_auto_frontend_service_sync": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"disable_auto_se_creation": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"docker_registry_se": {
 				Type:     schema.TypeSet,
@@ -10285,22 +9793,19 @@ func ResourceDockerConfigurationSchema() *schema.Resource {
 				Elem:     ResourceIpAddrPrefixSchema(),
 			},
 			"enable_event_subscription": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "true",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 			"feproxy_container_port_as_service": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"feproxy_vips_enable_proxy_arp": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "true",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 			"fleet_endpoint": {
 				Type:     schema.TypeString,
@@ -10328,10 +9833,9 @@ func ResourceDockerConfigurationSchema() *schema.Resource {
 				Elem:     ResourceMesosAttributeSchema(),
 			},
 			"se_spawn_rate": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "25",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  25,
 			},
 			"se_volume": {
 				Type:     schema.TypeString,
@@ -10339,10 +9843,9 @@ func ResourceDockerConfigurationSchema() *schema.Resource {
 				Default:  "/opt/avi",
 			},
 			"services_accessible_all_interfaces": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"ssh_user_ref": {
 				Type:     schema.TypeString,
@@ -10355,16 +9858,14 @@ func ResourceDockerConfigurationSchema() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
 			"use_container_ip_port": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"use_controller_image": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 		},
 	}
@@ -10387,10 +9888,9 @@ func ResourceDockerRegistrySchema() *schema.Resource {
 				DiffSuppressFunc: suppressSensitiveFieldDiffs,
 			},
 			"private": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"registry": {
 				Type:     schema.TypeString,
@@ -10415,10 +9915,9 @@ func ResourceDockerUCPSetupSchema() *schema.Resource {
 				Computed: true,
 			},
 			"docker_ucp_access": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 			"failed_hosts": {
 				Type:     schema.TypeList,
@@ -10451,10 +9950,9 @@ func ResourceDockerUCPSetupSchema() *schema.Resource {
 				Computed: true,
 			},
 			"se_deploy_method_access": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 			"se_name": {
 				Type:     schema.TypeString,
@@ -10483,9 +9981,8 @@ func ResourceDosAttackEventDetailsSchema() *schema.Resource {
 				Required: true,
 			},
 			"attack_count": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Required: true,
 			},
 			"ipgroup_uuids": {
 				Type:     schema.TypeList,
@@ -10538,14 +10035,12 @@ func ResourceDosThresholdSchema() *schema.Resource {
 				Required: true,
 			},
 			"max_value": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Required: true,
 			},
 			"min_value": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Required: true,
 			},
 		},
 	}
@@ -10560,9 +10055,8 @@ func ResourceDosThresholdProfileSchema() *schema.Resource {
 				Elem:     ResourceDosThresholdSchema(),
 			},
 			"thresh_period": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Required: true,
 			},
 		},
 	}
@@ -10601,10 +10095,9 @@ func ResourceEmailConfigurationSchema() *schema.Resource {
 				Computed: true,
 			},
 			"disable_tls": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"email_timezone": {
 				Type:     schema.TypeString,
@@ -10627,10 +10120,9 @@ func ResourceEmailConfigurationSchema() *schema.Resource {
 				Default:  "localhost",
 			},
 			"mail_server_port": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "25",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  25,
 			},
 			"smtp_type": {
 				Type:     schema.TypeString,
@@ -10656,10 +10148,9 @@ func ResourceErrorPageSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"enable": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "true",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 			"error_page_body_ref": {
 				Type:     schema.TypeString,
@@ -10672,10 +10163,9 @@ func ResourceErrorPageSchema() *schema.Resource {
 				Computed: true,
 			},
 			"index": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"match": {
 				Type:     schema.TypeSet,
@@ -10691,10 +10181,9 @@ func ResourceEventCacheSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"dns_state": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 			"exceptions": {
 				Type:     schema.TypeList,
@@ -12061,10 +11550,9 @@ func ResourceEventLogSchema() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
 			"ignore_event_details_display": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"internal": {
 				Type:     schema.TypeString,
@@ -12072,10 +11560,9 @@ func ResourceEventLogSchema() *schema.Resource {
 				Default:  "EVENT_INTERNAL",
 			},
 			"is_security_event": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"module": {
 				Type:     schema.TypeString,
@@ -12107,9 +11594,8 @@ func ResourceEventLogSchema() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
 			"report_timestamp": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Required: true,
 			},
 			"tenant": {
 				Type:     schema.TypeString,
@@ -12156,16 +11642,14 @@ func ResourceFTPProfileSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"deactivate_active": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"deactivate_passive": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 		},
 	}
@@ -12275,28 +11759,24 @@ func ResourceFalsePositiveLearningConfigSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"max_apps_supported": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "5",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  5,
 			},
 			"min_monitor_time": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "10080",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  10080,
 			},
 			"min_trans_per_application": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "5000000",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  5000000,
 			},
 			"min_trans_per_uri": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "10000",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  10000,
 			},
 		},
 	}
@@ -12306,22 +11786,19 @@ func ResourceFalsePositiveResultSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"attack": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 			"confidence": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"false_positive": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 			"fp_result_header": {
 				Type:     schema.TypeSet,
@@ -12388,10 +11865,9 @@ func ResourceFalsePositiveResultHeaderSchema() *schema.Resource {
 				Computed: true,
 			},
 			"transactions_count": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 		},
 	}
@@ -12458,16 +11934,14 @@ func ResourceFeProxyRoutePublishConfigSchema() *schema.Resource {
 				Default:  "FE_PROXY_ROUTE_PUBLISH_NONE",
 			},
 			"publisher_port": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "80",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  80,
 			},
 			"subnet": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "32",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  32,
 			},
 			"token": {
 				Type:     schema.TypeString,
@@ -12504,46 +11978,39 @@ func ResourceFlowtableProfileSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"icmp_idle_timeout": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "60",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  60,
 			},
 			"tcp_closed_timeout": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "5",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  5,
 			},
 			"tcp_connection_setup_timeout": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "10",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  10,
 			},
 			"tcp_half_closed_timeout": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "30",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  30,
 			},
 			"tcp_idle_timeout": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "300",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  300,
 			},
 			"tcp_reset_timeout": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "1",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  1,
 			},
 			"udp_idle_timeout": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "10",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  10,
 			},
 		},
 	}
@@ -12553,21 +12020,18 @@ func ResourceFullClientLogsSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"duration": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "30",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  30,
 			},
 			"enabled": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Required: true,
 			},
 			"throttle": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "10",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  10,
 			},
 		},
 	}
@@ -12983,16 +12447,14 @@ func ResourceGCPVIPRoutesSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"match_se_group_subnet": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"route_priority": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "2000",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  2000,
 			},
 		},
 	}
@@ -13007,22 +12469,19 @@ func ResourceGatewayMonitorSchema() *schema.Resource {
 				Elem:     ResourceIpAddrSchema(),
 			},
 			"gateway_monitor_fail_threshold": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "10",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  10,
 			},
 			"gateway_monitor_interval": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "1000",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  1000,
 			},
 			"gateway_monitor_success_threshold": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "15",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  15,
 			},
 			"subnet": {
 				Type:     schema.TypeSet,
@@ -13072,19 +12531,17 @@ func ResourceGeoDBFileSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"enabled": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "true",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 			"file_ref": {
 				Type:     schema.TypeString,
 				Required: true,
 			},
 			"index": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Required: true,
 			},
 			"name": {
 				Type:     schema.TypeString,
@@ -13139,16 +12596,14 @@ func ResourceGeoLocationSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"latitude": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"longitude": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"name": {
 				Type:     schema.TypeString,
@@ -13227,16 +12682,14 @@ func ResourceGslbDnsGsStatusSchema() *schema.Resource {
 				Elem:     ResourceTimeStampSchema(),
 			},
 			"num_partial_updates": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"partial_update_threshold": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "10",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  10,
 			},
 			"state": {
 				Type:     schema.TypeString,
@@ -13256,10 +12709,9 @@ func ResourceGslbDnsInfoSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"dns_active": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 			"dns_vs_states": {
 				Type:     schema.TypeList,
@@ -13273,10 +12725,9 @@ func ResourceGslbDnsInfoSchema() *schema.Resource {
 				Elem:     ResourceGslbDnsGsStatusSchema(),
 			},
 			"retry_count": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 		},
 	}
@@ -13292,10 +12743,9 @@ func ResourceGslbDnsUpdateSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"clear_on_max_retries": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"gslb_geo_db_profile_uuids": {
 				Type:     schema.TypeList,
@@ -13318,10 +12768,9 @@ func ResourceGslbDnsUpdateSchema() *schema.Resource {
 				Elem:     ResourceGslbObjectInfoSchema(),
 			},
 			"send_interval": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"uuid": {
 				Type:     schema.TypeString,
@@ -13359,10 +12808,9 @@ func ResourceGslbGeoDbEntrySchema() *schema.Resource {
 				Elem:     ResourceGslbGeoDbFileSchema(),
 			},
 			"priority": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "10",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  10,
 			},
 		},
 	}
@@ -13392,10 +12840,9 @@ func ResourceGslbGeoDbFileSchema() *schema.Resource {
 				Default:  "GSLB_GEODB_FILE_FORMAT_AVI",
 			},
 			"timestamp": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 		},
 	}
@@ -13540,10 +12987,9 @@ func ResourceGslbPerDnsStateSchema() *schema.Resource {
 				Computed: true,
 			},
 			"valid_dns_vs": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 		},
 	}
@@ -13558,16 +13004,14 @@ func ResourceGslbPoolSchema() *schema.Resource {
 				Default:  "GSLB_ALGORITHM_ROUND_ROBIN",
 			},
 			"consistent_hash_mask": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"consistent_hash_mask6": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"description": {
 				Type:     schema.TypeString,
@@ -13575,10 +13019,9 @@ func ResourceGslbPoolSchema() *schema.Resource {
 				Computed: true,
 			},
 			"enabled": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "true",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 			"fallback_algorithm": {
 				Type:     schema.TypeString,
@@ -13591,20 +13034,18 @@ func ResourceGslbPoolSchema() *schema.Resource {
 				Elem:     ResourceGslbPoolMemberSchema(),
 			},
 			"min_health_monitors_up": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"name": {
 				Type:     schema.TypeString,
 				Required: true,
 			},
 			"priority": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "10",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  10,
 			},
 		},
 	}
@@ -13629,10 +13070,9 @@ func ResourceGslbPoolMemberSchema() *schema.Resource {
 				Computed: true,
 			},
 			"enabled": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "true",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 			"fqdn": {
 				Type:     schema.TypeString,
@@ -13657,10 +13097,9 @@ func ResourceGslbPoolMemberSchema() *schema.Resource {
 				Elem:     ResourceGslbGeoLocationSchema(),
 			},
 			"preference_order": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "1",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  1,
 			},
 			"public_ip": {
 				Type:     schema.TypeSet,
@@ -13669,16 +13108,14 @@ func ResourceGslbPoolMemberSchema() *schema.Resource {
 				Elem:     ResourceGslbIpAddrSchema(),
 			},
 			"ratio": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "1",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  1,
 			},
 			"resolve_fqdn_to_v6": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"vs_uuid": {
 				Type:     schema.TypeString,
@@ -13759,10 +13196,9 @@ func ResourceGslbPoolMemberRuntimeInfoSchema() *schema.Resource {
 				Elem:     ResourceIpAddrSchema(),
 			},
 			"ip_value_to_se": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"ipv6_value_to_se": {
 				Type:     schema.TypeList,
@@ -13853,22 +13289,19 @@ func ResourceGslbReplicationStatsSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"acknowledged_version": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"pending_object_count": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"received_version": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 		},
 	}
@@ -13883,16 +13316,14 @@ func ResourceGslbRuntimeSchema() *schema.Resource {
 				Computed: true,
 			},
 			"delete_in_progress": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 			"dns_enabled": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 			"event_cache": {
 				Type:     schema.TypeSet,
@@ -14005,16 +13436,14 @@ func ResourceGslbServiceRuntimeSchema() *schema.Resource {
 				Elem:     ResourceOperationalStatusSchema(),
 			},
 			"send_event": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 			"send_status": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 			"services_state": {
 				Type:     schema.TypeString,
@@ -14045,10 +13474,9 @@ func ResourceGslbServiceSitePersistencePoolSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"enable_http2": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 			"name": {
 				Type:     schema.TypeString,
@@ -14056,16 +13484,14 @@ func ResourceGslbServiceSitePersistencePoolSchema() *schema.Resource {
 				Computed: true,
 			},
 			"num_servers": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"num_servers_up": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"servers": {
 				Type:     schema.TypeList,
@@ -14127,10 +13553,9 @@ func ResourceGslbSiteSchema() *schema.Resource {
 				Elem:     ResourceGslbSiteDnsVsSchema(),
 			},
 			"enabled": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "true",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 			"hm_proxies": {
 				Type:     schema.TypeList,
@@ -14138,10 +13563,9 @@ func ResourceGslbSiteSchema() *schema.Resource {
 				Elem:     ResourceGslbHealthMonitorProxySchema(),
 			},
 			"hm_shard_enabled": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"ip_addresses": {
 				Type:     schema.TypeList,
@@ -14170,22 +13594,19 @@ func ResourceGslbSiteSchema() *schema.Resource {
 				DiffSuppressFunc: suppressSensitiveFieldDiffs,
 			},
 			"port": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "443",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  443,
 			},
 			"ratio": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"suspend_mode": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"username": {
 				Type:     schema.TypeString,
@@ -14305,10 +13726,9 @@ func ResourceGslbSiteHealthStatusSchema() *schema.Resource {
 				Computed: true,
 			},
 			"timestamp": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 		},
 	}
@@ -14318,10 +13738,9 @@ func ResourceGslbSiteRuntimeSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"clear_on_max_retries": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"glb_uuid": {
 				Type:     schema.TypeString,
@@ -14341,10 +13760,9 @@ func ResourceGslbSiteRuntimeSchema() *schema.Resource {
 				Elem:     ResourceGslbSiteHealthStatusSchema(),
 			},
 			"send_interval": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"site_cfg": {
 				Type:     schema.TypeSet,
@@ -14370,10 +13788,9 @@ func ResourceGslbSiteRuntimeSchema() *schema.Resource {
 				Computed: true,
 			},
 			"view_id": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 		},
 	}
@@ -14472,10 +13889,9 @@ func ResourceGslbSiteRuntimeInfoSchema() *schema.Resource {
 				Elem:     ResourceGslbDnsInfoSchema(),
 			},
 			"enabled": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 			"event_cache": {
 				Type:     schema.TypeSet,
@@ -14484,10 +13900,9 @@ func ResourceGslbSiteRuntimeInfoSchema() *schema.Resource {
 				Elem:     ResourceEventCacheSchema(),
 			},
 			"hs_state": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 			"last_changed_time": {
 				Type:     schema.TypeSet,
@@ -14501,10 +13916,9 @@ func ResourceGslbSiteRuntimeInfoSchema() *schema.Resource {
 				Computed: true,
 			},
 			"num_of_retries": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "0",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  0,
 			},
 			"oper_status": {
 				Type:     schema.TypeSet,
@@ -14550,340 +13964,284 @@ func ResourceGslbSiteRuntimeStatsSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"num_file_cr_txed": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"num_file_del_txed": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"num_gap_cr_rxed": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"num_gap_cr_txed": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"num_gap_del_rxed": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"num_gap_del_txed": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"num_gap_upd_rxed": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"num_gap_upd_txed": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"num_geo_cr_rxed": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"num_geo_cr_txed": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"num_geo_del_rxed": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"num_geo_del_txed": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"num_geo_upd_rxed": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"num_geo_upd_txed": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"num_ghm_cr_rxed": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"num_ghm_cr_txed": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"num_ghm_del_rxed": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"num_ghm_del_txed": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"num_ghm_upd_rxed": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"num_ghm_upd_txed": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"num_gjwt_cr_rxed": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"num_gjwt_cr_txed": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"num_gjwt_del_rxed": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"num_gjwt_del_txed": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"num_gjwt_upd_rxed": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"num_gjwt_upd_txed": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"num_glb_cr_rxed": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"num_glb_cr_txed": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"num_glb_del_rxed": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"num_glb_del_txed": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"num_glb_upd_rxed": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"num_glb_upd_txed": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"num_gpki_cr_rxed": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"num_gpki_cr_txed": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"num_gpki_del_rxed": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"num_gpki_del_txed": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"num_gpki_upd_rxed": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"num_gpki_upd_txed": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"num_gs_cr_rxed": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"num_gs_cr_txed": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"num_gs_del_rxed": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"num_gs_del_txed": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"num_gs_upd_rxed": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"num_gs_upd_txed": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"num_gssl_cert_cr_rxed": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"num_gssl_cert_del_rxed": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"num_gssl_cert_upd_rxed": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"num_gssl_cr_rxed": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"num_gssl_del_rxed": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"num_gssl_upd_rxed": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"num_health_msgs_rxed": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"num_health_msgs_txed": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"num_of_bad_responses": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"num_of_events_generated": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"num_of_skip_outstanding_requests": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"num_of_timeouts": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 		},
 	}
@@ -14933,10 +14291,9 @@ func ResourceGslbSubDomainPlacementRuntimeSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"placement_allowed": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 			"sub_domain": {
 				Type:     schema.TypeString,
@@ -14961,10 +14318,9 @@ func ResourceGslbThirdPartySiteSchema() *schema.Resource {
 				Computed: true,
 			},
 			"enabled": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "true",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 			"hm_proxies": {
 				Type:     schema.TypeList,
@@ -14982,10 +14338,9 @@ func ResourceGslbThirdPartySiteSchema() *schema.Resource {
 				Required: true,
 			},
 			"ratio": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"uuid": {
 				Type:     schema.TypeString,
@@ -15073,16 +14428,14 @@ func ResourceHSMSafenetClientInfoSchema() *schema.Resource {
 				DiffSuppressFunc: suppressSensitiveFieldDiffs,
 			},
 			"session_major_number": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"session_minor_number": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 		},
 	}
@@ -15092,15 +14445,13 @@ func ResourceHSMSafenetLunaSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"ha_group_num": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"is_ha": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Required: true,
 			},
 			"node_info": {
 				Type:     schema.TypeList,
@@ -15118,10 +14469,9 @@ func ResourceHSMSafenetLunaSchema() *schema.Resource {
 				Computed: true,
 			},
 			"use_dedicated_network": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 		},
 	}
@@ -15131,9 +14481,8 @@ func ResourceHSMSafenetLunaServerSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"index": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Required: true,
 			},
 			"partition_passwd": {
 				Type:             schema.TypeString,
@@ -15171,15 +14520,13 @@ func ResourceHSMThalesNetHsmSchema() *schema.Resource {
 				Required: true,
 			},
 			"module_id": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "0",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  0,
 			},
 			"priority": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Required: true,
 			},
 			"remote_ip": {
 				Type:     schema.TypeSet,
@@ -15187,10 +14534,9 @@ func ResourceHSMThalesNetHsmSchema() *schema.Resource {
 				Elem:     ResourceIpAddrSchema(),
 			},
 			"remote_port": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "9004",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  9004,
 			},
 		},
 	}
@@ -15205,10 +14551,9 @@ func ResourceHSMThalesRFSSchema() *schema.Resource {
 				Elem:     ResourceIpAddrSchema(),
 			},
 			"port": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "9004",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  9004,
 			},
 		},
 	}
@@ -15228,10 +14573,9 @@ func ResourceHSMgrDebugFilterSchema() *schema.Resource {
 				Computed: true,
 			},
 			"period": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"pool": {
 				Type:     schema.TypeString,
@@ -15244,10 +14588,9 @@ func ResourceHSMgrDebugFilterSchema() *schema.Resource {
 				Computed: true,
 			},
 			"skip_hs_db_writes": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 		},
 	}
@@ -15257,58 +14600,49 @@ func ResourceHTTP2ApplicationProfileSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"enable_http2_server_push": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"http2_initial_window_size": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "64",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  64,
 			},
 			"max_http2_concurrent_pushes_per_connection": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "10",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  10,
 			},
 			"max_http2_concurrent_streams_per_connection": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "128",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  128,
 			},
 			"max_http2_control_frames_per_connection": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "1000",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  1000,
 			},
 			"max_http2_empty_data_frames_per_connection": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "1000",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  1000,
 			},
 			"max_http2_header_field_size": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "4096",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  4096,
 			},
 			"max_http2_queued_frames_to_client_per_connection": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "1000",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  1000,
 			},
 			"max_http2_requests_per_connection": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "1000",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  1000,
 			},
 		},
 	}
@@ -15318,16 +14652,14 @@ func ResourceHTTP2PoolPropertiesSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"max_http2_control_frames_per_connection": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "0",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  0,
 			},
 			"max_http2_header_field_size": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "4096",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  4096,
 			},
 		},
 	}
@@ -15337,10 +14669,9 @@ func ResourceHTTPApplicationProfileSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"allow_dots_in_header_name": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"cache_config": {
 				Type:     schema.TypeSet,
@@ -15349,40 +14680,34 @@ func ResourceHTTPApplicationProfileSchema() *schema.Resource {
 				Elem:     ResourceHttpCacheConfigSchema(),
 			},
 			"client_body_timeout": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "30000",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  30000,
 			},
 			"client_header_timeout": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "10000",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  10000,
 			},
 			"client_max_body_size": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "0",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  0,
 			},
 			"client_max_header_size": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "12",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  12,
 			},
 			"client_max_request_size": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "48",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  48,
 			},
 			"collect_client_tls_fingerprint": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"compression_profile": {
 				Type:     schema.TypeSet,
@@ -15391,76 +14716,64 @@ func ResourceHTTPApplicationProfileSchema() *schema.Resource {
 				Elem:     ResourceCompressionProfileSchema(),
 			},
 			"connection_multiplexing_enabled": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "true",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 			"detect_ntlm_app": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "true",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 			"disable_keepalive_posts_msie6": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "true",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 			"disable_sni_hostname_check": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"enable_chunk_merge": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "true",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 			"enable_fire_and_forget": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"enable_request_body_buffering": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"enable_request_body_metrics": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"fwd_close_hdr_for_bound_connections": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "true",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 			"hsts_enabled": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"hsts_max_age": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "365",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  365,
 			},
 			"hsts_subdomains_enabled": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "true",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 			"http2_profile": {
 				Type:     schema.TypeSet,
@@ -15469,106 +14782,89 @@ func ResourceHTTPApplicationProfileSchema() *schema.Resource {
 				Elem:     ResourceHTTP2ApplicationProfileSchema(),
 			},
 			"http_to_https": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"http_upstream_buffer_size": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "0",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  0,
 			},
 			"httponly_enabled": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"keepalive_header": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"keepalive_timeout": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "30000",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  30000,
 			},
 			"max_bad_rps_cip": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "0",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  0,
 			},
 			"max_bad_rps_cip_uri": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "0",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  0,
 			},
 			"max_bad_rps_uri": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "0",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  0,
 			},
 			"max_header_count": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "64",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  64,
 			},
 			"max_keepalive_requests": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "100",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  100,
 			},
 			"max_response_headers_size": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "48",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  48,
 			},
 			"max_rps_cip": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "0",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  0,
 			},
 			"max_rps_cip_uri": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "0",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  0,
 			},
 			"max_rps_unknown_cip": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "0",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  0,
 			},
 			"max_rps_unknown_uri": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "0",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  0,
 			},
 			"max_rps_uri": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "0",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  0,
 			},
 			"pass_through_x_accel_headers": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"pki_profile_ref": {
 				Type:     schema.TypeString,
@@ -15576,34 +14872,29 @@ func ResourceHTTPApplicationProfileSchema() *schema.Resource {
 				Computed: true,
 			},
 			"post_accept_timeout": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "30000",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  30000,
 			},
 			"reset_conn_http_on_ssl_port": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"respond_with_100_continue": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "true",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 			"secure_cookie_enabled": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"server_side_redirect_to_https": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"ssl_client_certificate_action": {
 				Type:     schema.TypeSet,
@@ -15623,28 +14914,24 @@ func ResourceHTTPApplicationProfileSchema() *schema.Resource {
 				Elem:     ResourceTrueClientIPConfigSchema(),
 			},
 			"use_app_keepalive_timeout": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"use_true_client_ip": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"websockets_enabled": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "true",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 			"x_forwarded_proto_enabled": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"xff_alternate_name": {
 				Type:     schema.TypeString,
@@ -15652,10 +14939,9 @@ func ResourceHTTPApplicationProfileSchema() *schema.Resource {
 				Default:  "X-Forwarded-For",
 			},
 			"xff_enabled": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "true",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 			"xff_update": {
 				Type:     schema.TypeString,
@@ -15731,10 +15017,9 @@ func ResourceHTTPHdrActionSchema() *schema.Resource {
 				Elem:     ResourceHTTPHdrDataSchema(),
 			},
 			"hdr_index": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 		},
 	}
@@ -15762,10 +15047,9 @@ func ResourceHTTPHdrValueSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"is_sensitive": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"val": {
 				Type:     schema.TypeString,
@@ -15793,10 +15077,9 @@ func ResourceHTTPLocalFileSchema() *schema.Resource {
 				Required: true,
 			},
 			"file_length": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 		},
 	}
@@ -15810,9 +15093,8 @@ func ResourceHTTPPoliciesSchema() *schema.Resource {
 				Required: true,
 			},
 			"index": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Required: true,
 			},
 		},
 	}
@@ -15833,10 +15115,9 @@ func ResourceHTTPRedirectActionSchema() *schema.Resource {
 				Elem:     ResourceURIParamSchema(),
 			},
 			"keep_query": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "true",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 			"path": {
 				Type:     schema.TypeSet,
@@ -15845,10 +15126,9 @@ func ResourceHTTPRedirectActionSchema() *schema.Resource {
 				Elem:     ResourceURIParamSchema(),
 			},
 			"port": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"protocol": {
 				Type:     schema.TypeString,
@@ -15879,15 +15159,13 @@ func ResourceHTTPRequestRuleSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"all_headers": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 			"enable": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Required: true,
 			},
 			"hdr_action": {
 				Type:     schema.TypeList,
@@ -15895,15 +15173,13 @@ func ResourceHTTPRequestRuleSchema() *schema.Resource {
 				Elem:     ResourceHTTPHdrActionSchema(),
 			},
 			"index": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Required: true,
 			},
 			"log": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 			"match": {
 				Type:     schema.TypeSet,
@@ -15975,15 +15251,13 @@ func ResourceHTTPResponseRuleSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"all_headers": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 			"enable": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Required: true,
 			},
 			"hdr_action": {
 				Type:     schema.TypeList,
@@ -15991,9 +15265,8 @@ func ResourceHTTPResponseRuleSchema() *schema.Resource {
 				Elem:     ResourceHTTPHdrActionSchema(),
 			},
 			"index": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Required: true,
 			},
 			"loc_hdr_action": {
 				Type:     schema.TypeSet,
@@ -16002,10 +15275,9 @@ func ResourceHTTPResponseRuleSchema() *schema.Resource {
 				Elem:     ResourceHTTPRewriteLocHdrActionSchema(),
 			},
 			"log": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 			"match": {
 				Type:     schema.TypeSet,
@@ -16031,10 +15303,9 @@ func ResourceHTTPRewriteLocHdrActionSchema() *schema.Resource {
 				Elem:     ResourceURIParamSchema(),
 			},
 			"keep_query": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "true",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 			"path": {
 				Type:     schema.TypeSet,
@@ -16043,10 +15314,9 @@ func ResourceHTTPRewriteLocHdrActionSchema() *schema.Resource {
 				Elem:     ResourceURIParamSchema(),
 			},
 			"port": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"protocol": {
 				Type:     schema.TypeString,
@@ -16095,10 +15365,9 @@ func ResourceHTTPSecurityActionSchema() *schema.Resource {
 				Elem:     ResourceHTTPLocalFileSchema(),
 			},
 			"https_port": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"rate_profile": {
 				Type:     schema.TypeSet,
@@ -16124,16 +15393,14 @@ func ResourceHTTPSecurityActionRateProfileSchema() *schema.Resource {
 				Elem:     ResourceRateLimiterActionSchema(),
 			},
 			"per_client_ip": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 			"per_uri_path": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 			"rate_limiter": {
 				Type:     schema.TypeSet,
@@ -16166,20 +15433,17 @@ func ResourceHTTPSecurityRuleSchema() *schema.Resource {
 				Elem:     ResourceHTTPSecurityActionSchema(),
 			},
 			"enable": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Required: true,
 			},
 			"index": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Required: true,
 			},
 			"log": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 			"match": {
 				Type:     schema.TypeSet,
@@ -16199,27 +15463,23 @@ func ResourceHTTPServerReselectSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"enabled": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Required: true,
 			},
 			"num_retries": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "4",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  4,
 			},
 			"retry_nonidempotent": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"retry_timeout": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "0",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  0,
 			},
 			"svr_resp_code": {
 				Type:     schema.TypeSet,
@@ -16256,14 +15516,12 @@ func ResourceHTTPStatusRangeSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"begin": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Required: true,
 			},
 			"end": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Required: true,
 			},
 		},
 	}
@@ -16518,10 +15776,9 @@ func ResourceHealthMonitorHttpSchema() *schema.Resource {
 				Computed: true,
 			},
 			"exact_http_request": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"http_request": {
 				Type:     schema.TypeString,
@@ -16554,10 +15811,9 @@ func ResourceHealthMonitorHttpSchema() *schema.Resource {
 				Computed: true,
 			},
 			"response_size": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"ssl_attributes": {
 				Type:     schema.TypeSet,
@@ -16762,10 +16018,9 @@ func ResourceHealthMonitorTcpSchema() *schema.Resource {
 				Computed: true,
 			},
 			"tcp_half_open": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"tcp_request": {
 				Type:     schema.TypeString,
@@ -16807,10 +16062,9 @@ func ResourceHealthScoreDetailsSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"anomaly_penalty": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "0",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  0,
 			},
 			"anomaly_reason": {
 				Type:     schema.TypeString,
@@ -16823,15 +16077,13 @@ func ResourceHealthScoreDetailsSchema() *schema.Resource {
 				Computed: true,
 			},
 			"performance_score": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "100",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  100,
 			},
 			"previous_value": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Required: true,
 			},
 			"reason": {
 				Type:     schema.TypeString,
@@ -16839,10 +16091,9 @@ func ResourceHealthScoreDetailsSchema() *schema.Resource {
 				Computed: true,
 			},
 			"resources_penalty": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "0",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  0,
 			},
 			"resources_reason": {
 				Type:     schema.TypeString,
@@ -16850,10 +16101,9 @@ func ResourceHealthScoreDetailsSchema() *schema.Resource {
 				Computed: true,
 			},
 			"security_penalty": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "0",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  0,
 			},
 			"security_reason": {
 				Type:     schema.TypeString,
@@ -16861,10 +16111,9 @@ func ResourceHealthScoreDetailsSchema() *schema.Resource {
 				Computed: true,
 			},
 			"step": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"sub_resource_prefix": {
 				Type:     schema.TypeString,
@@ -16876,9 +16125,8 @@ func ResourceHealthScoreDetailsSchema() *schema.Resource {
 				Required: true,
 			},
 			"value": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Required: true,
 			},
 		},
 	}
@@ -16888,16 +16136,14 @@ func ResourceHorizonProfileSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"blast_port": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "8443",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  8443,
 			},
 			"pcoip_port": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "4172",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  4172,
 			},
 		},
 	}
@@ -16966,58 +16212,49 @@ func ResourceHttpCacheConfigSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"age_header": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "true",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 			"aggressive": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"date_header": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "true",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 			"default_expire": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "600",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  600,
 			},
 			"enabled": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"heuristic_expire": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"ignore_request_cache_control": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"max_cache_size": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "0",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  0,
 			},
 			"max_object_size": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "4194304",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  4194304,
 			},
 			"mime_types_block_group_refs": {
 				Type:     schema.TypeList,
@@ -17040,16 +16277,14 @@ func ResourceHttpCacheConfigSchema() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
 			"min_object_size": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "100",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  100,
 			},
 			"query_cacheable": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"uri_non_cacheable": {
 				Type:     schema.TypeSet,
@@ -17058,10 +16293,9 @@ func ResourceHttpCacheConfigSchema() *schema.Resource {
 				Elem:     ResourcePathMatchSchema(),
 			},
 			"xcache_header": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "true",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 		},
 	}
@@ -17093,10 +16327,9 @@ func ResourceHttpCookiePersistenceProfileSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"always_send_cookie": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"cookie_name": {
 				Type:     schema.TypeString,
@@ -17109,16 +16342,14 @@ func ResourceHttpCookiePersistenceProfileSchema() *schema.Resource {
 				Computed: true,
 			},
 			"http_only": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"is_persistent_cookie": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"key": {
 				Type:     schema.TypeList,
@@ -17126,10 +16357,9 @@ func ResourceHttpCookiePersistenceProfileSchema() *schema.Resource {
 				Elem:     ResourceHttpCookiePersistenceKeySchema(),
 			},
 			"timeout": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 		},
 	}
@@ -17143,16 +16373,14 @@ func ResourceHypervisor_PropertiesSchema() *schema.Resource {
 				Required: true,
 			},
 			"max_ips_per_nic": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"max_nics": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 		},
 	}
@@ -17162,28 +16390,24 @@ func ResourceIPAddrLimitsSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"ip_address_group_per_match_criteria": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"ip_address_prefix_per_match_criteria": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"ip_address_range_per_match_criteria": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"ip_addresses_per_match_criteria": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 		},
 	}
@@ -17227,16 +16451,14 @@ func ResourceIPPersistenceProfileSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"ip_mask": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"ip_persistent_timeout": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "5",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  5,
 			},
 		},
 	}
@@ -17335,10 +16557,9 @@ func ResourceIcapNSXDefenderLogSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"score": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"status_url": {
 				Type:     schema.TypeString,
@@ -17397,10 +16618,9 @@ func ResourceIcapRequestLogSchema() *schema.Resource {
 				Computed: true,
 			},
 			"complete_body_sent": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 			"http_method": {
 				Type:     schema.TypeString,
@@ -17408,10 +16628,9 @@ func ResourceIcapRequestLogSchema() *schema.Resource {
 				Computed: true,
 			},
 			"http_response_code": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"icap_absolute_uri": {
 				Type:     schema.TypeString,
@@ -17434,34 +16653,29 @@ func ResourceIcapRequestLogSchema() *schema.Resource {
 				Computed: true,
 			},
 			"icap_response_code": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"icap_server_ip": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"icap_server_port": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"latency": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"modified_content_length": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"nsx_defender_log": {
 				Type:     schema.TypeSet,
@@ -17486,10 +16700,9 @@ func ResourceIcapRequestLogSchema() *schema.Resource {
 				Computed: true,
 			},
 			"source_port": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"vendor": {
 				Type:     schema.TypeString,
@@ -17560,10 +16773,9 @@ func ResourceImageEventSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"duration": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"end_time": {
 				Type:     schema.TypeString,
@@ -17685,28 +16897,24 @@ func ResourceInternalGatewayMonitorSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"disable_gateway_monitor": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"gateway_monitor_failure_threshold": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "10",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  10,
 			},
 			"gateway_monitor_interval": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "1000",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  1000,
 			},
 			"gateway_monitor_success_threshold": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "15",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  15,
 			},
 		},
 	}
@@ -17778,9 +16986,8 @@ func ResourceIpAddrPortSchema() *schema.Resource {
 				Computed: true,
 			},
 			"port": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Required: true,
 			},
 		},
 	}
@@ -17795,9 +17002,8 @@ func ResourceIpAddrPrefixSchema() *schema.Resource {
 				Elem:     ResourceIpAddrSchema(),
 			},
 			"mask": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Required: true,
 			},
 		},
 	}
@@ -17867,16 +17073,14 @@ func ResourceIpReputationConfigSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"ip_reputation_file_object_expiry_duration": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "3",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  3,
 			},
 			"ip_reputation_sync_interval": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "60",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  60,
 			},
 		},
 	}
@@ -17903,10 +17107,9 @@ func ResourceIpamDnsAwsProfileSchema() *schema.Resource {
 				Computed: true,
 			},
 			"publish_vip_to_public_zone": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"region": {
 				Type:     schema.TypeString,
@@ -17921,10 +17124,9 @@ func ResourceIpamDnsAwsProfileSchema() *schema.Resource {
 				DiffSuppressFunc: suppressSensitiveFieldDiffs,
 			},
 			"ttl": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "60",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  60,
 			},
 			"usable_domains": {
 				Type:     schema.TypeList,
@@ -17937,10 +17139,9 @@ func ResourceIpamDnsAwsProfileSchema() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
 			"use_iam_roles": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"vpc": {
 				Type:     schema.TypeString,
@@ -18001,16 +17202,14 @@ func ResourceIpamDnsAzureProfileSchema() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
 			"use_enhanced_ha": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"use_standard_alb": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"virtual_network_ids": {
 				Type:     schema.TypeList,
@@ -18052,10 +17251,9 @@ func ResourceIpamDnsGCPProfileSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"match_se_group_subnet": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"network_host_project_id": {
 				Type:     schema.TypeString,
@@ -18078,10 +17276,9 @@ func ResourceIpamDnsGCPProfileSchema() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
 			"use_gcp_network": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"vpc_network_name": {
 				Type:     schema.TypeString,
@@ -18158,10 +17355,9 @@ func ResourceIpamDnsInternalProfileSchema() *schema.Resource {
 				Computed: true,
 			},
 			"ttl": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "30",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  30,
 			},
 			"usable_networks": {
 				Type:     schema.TypeList,
@@ -18401,21 +17597,18 @@ func ResourceJWTClaimMatchSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"bool_match": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 			"int_match": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"is_mandatory": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Required: true,
 			},
 			"name": {
 				Type:     schema.TypeString,
@@ -18432,9 +17625,8 @@ func ResourceJWTClaimMatchSchema() *schema.Resource {
 				Required: true,
 			},
 			"validate": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Required: true,
 			},
 		},
 	}
@@ -18539,10 +17731,9 @@ func ResourceJwtLogSchema() *schema.Resource {
 				Elem:     ResourceAuthzRuleMatchSchema(),
 			},
 			"is_jwt_verified": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 			"token_payload": {
 				Type:     schema.TypeString,
@@ -18725,9 +17916,8 @@ func ResourceL4PoliciesSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"index": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Required: true,
 			},
 			"l4_policy_set_ref": {
 				Type:     schema.TypeString,
@@ -18747,15 +17937,13 @@ func ResourceL4RuleSchema() *schema.Resource {
 				Elem:     ResourceL4RuleActionSchema(),
 			},
 			"enable": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "true",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 			"index": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Required: true,
 			},
 			"match": {
 				Type:     schema.TypeSet,
@@ -18870,10 +18058,9 @@ func ResourceL4SSLApplicationProfileSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"ssl_stream_idle_timeout": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "3600",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  3600,
 			},
 		},
 	}
@@ -18883,82 +18070,69 @@ func ResourceL7limitsSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"http_policies_per_vs": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"num_compression_filters": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"num_custom_str": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"num_matches_per_rule": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"num_rules_per_evh_host": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"num_rules_per_http_policy": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"num_strgroups_per_match": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"str_cache_mime": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"str_groups_cache_mime": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"str_groups_no_cache_mime": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"str_groups_no_cache_uri": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"str_no_cache_mime": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"str_no_cache_uri": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 		},
 	}
@@ -18973,46 +18147,39 @@ func ResourceLDAPVSConfigSchema() *schema.Resource {
 				Computed: true,
 			},
 			"se_auth_ldap_bind_timeout": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "5000",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  5000,
 			},
 			"se_auth_ldap_cache_size": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "100000",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  100000,
 			},
 			"se_auth_ldap_connect_timeout": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "10000",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  10000,
 			},
 			"se_auth_ldap_conns_per_server": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "1",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  1,
 			},
 			"se_auth_ldap_reconnect_timeout": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "10000",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  10000,
 			},
 			"se_auth_ldap_request_timeout": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "10000",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  10000,
 			},
 			"se_auth_ldap_servers_failover_only": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 		},
 	}
@@ -19027,10 +18194,9 @@ func ResourceLatencyAuditPropertiesSchema() *schema.Resource {
 				Computed: true,
 			},
 			"conn_est_threshold": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"latency_audit_mode": {
 				Type:     schema.TypeString,
@@ -19038,10 +18204,9 @@ func ResourceLatencyAuditPropertiesSchema() *schema.Resource {
 				Computed: true,
 			},
 			"latency_threshold": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 		},
 	}
@@ -19056,10 +18221,9 @@ func ResourceLdapAuthSettingsSchema() *schema.Resource {
 				Computed: true,
 			},
 			"bind_as_administrator": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "true",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 			"email_attribute": {
 				Type:     schema.TypeString,
@@ -19072,10 +18236,9 @@ func ResourceLdapAuthSettingsSchema() *schema.Resource {
 				Default:  "name",
 			},
 			"port": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "389",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  389,
 			},
 			"security_mode": {
 				Type:     schema.TypeString,
@@ -19122,10 +18285,9 @@ func ResourceLdapDirectorySettingsSchema() *schema.Resource {
 				Default:  "member",
 			},
 			"group_member_is_full_dn": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "true",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 			"group_search_dn": {
 				Type:     schema.TypeString,
@@ -19138,10 +18300,9 @@ func ResourceLdapDirectorySettingsSchema() *schema.Resource {
 				Default:  "AUTH_LDAP_SCOPE_SUBTREE",
 			},
 			"ignore_referrals": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"password": {
 				Type:             schema.TypeString,
@@ -19205,10 +18366,9 @@ func ResourceLearningLogPolicySchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"enabled": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"host": {
 				Type:     schema.TypeString,
@@ -19216,10 +18376,9 @@ func ResourceLearningLogPolicySchema() *schema.Resource {
 				Computed: true,
 			},
 			"port": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 		},
 	}
@@ -19229,10 +18388,9 @@ func ResourceLicenseDetailsSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"backend_servers": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"expiry_at": {
 				Type:     schema.TypeString,
@@ -19262,28 +18420,24 @@ func ResourceLicenseExpiryDetailsSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"backend_servers": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"burst_cores": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"cores": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"cpu_cores": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"expiry_at": {
 				Type:     schema.TypeString,
@@ -19306,16 +18460,14 @@ func ResourceLicenseExpiryDetailsSchema() *schema.Resource {
 				Computed: true,
 			},
 			"max_apps": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"max_ses": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"name": {
 				Type:     schema.TypeString,
@@ -19323,22 +18475,19 @@ func ResourceLicenseExpiryDetailsSchema() *schema.Resource {
 				Computed: true,
 			},
 			"service_cores": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"sockets": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"throughput": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 		},
 	}
@@ -19348,14 +18497,12 @@ func ResourceLicenseInfoSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"last_updated": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Required: true,
 			},
 			"service_cores": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Required: true,
 			},
 			"tenant_uuid": {
 				Type:     schema.TypeString,
@@ -19457,10 +18604,9 @@ func ResourceLicenseTransactionDetailsSchema() *schema.Resource {
 				Computed: true,
 			},
 			"licensed_service_cores": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"operation": {
 				Type:     schema.TypeString,
@@ -19468,16 +18614,14 @@ func ResourceLicenseTransactionDetailsSchema() *schema.Resource {
 				Computed: true,
 			},
 			"overdraft": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 			"service_cores": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"tenant_uuid": {
 				Type:     schema.TypeString,
@@ -19497,28 +18641,24 @@ func ResourceLicenseUsageSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"available": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "0",
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Default:  0.0,
 			},
 			"consumed": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "0",
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Default:  0.0,
 			},
 			"escrow": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "0",
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Default:  0.0,
 			},
 			"remaining": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "0",
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Default:  0.0,
 			},
 		},
 	}
@@ -19533,10 +18673,9 @@ func ResourceLinuxConfigurationSchema() *schema.Resource {
 				Computed: true,
 			},
 			"cis_mode": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"motd": {
 				Type:     schema.TypeString,
@@ -19556,10 +18695,9 @@ func ResourceLinuxServerConfigurationSchema() *schema.Resource {
 				Elem:     ResourceLinuxServerHostSchema(),
 			},
 			"se_inband_mgmt": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"se_log_disk_path": {
 				Type:     schema.TypeString,
@@ -19567,10 +18705,9 @@ func ResourceLinuxServerConfigurationSchema() *schema.Resource {
 				Computed: true,
 			},
 			"se_log_disk_size_gb": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "5",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  5,
 			},
 			"se_sys_disk_path": {
 				Type:     schema.TypeString,
@@ -19578,10 +18715,9 @@ func ResourceLinuxServerConfigurationSchema() *schema.Resource {
 				Computed: true,
 			},
 			"se_sys_disk_size_gb": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "10",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  10,
 			},
 			"ssh_user_ref": {
 				Type:     schema.TypeString,
@@ -19717,10 +18853,9 @@ func ResourceLogControllerMappingSchema() *schema.Resource {
 				Default:  "METRICS_MGR_PORT_0",
 			},
 			"static_mapping": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"tenant_ref": {
 				Type:     schema.TypeString,
@@ -19821,16 +18956,14 @@ func ResourceMarathonConfigurationSchema() *schema.Resource {
 				Default:  "admin",
 			},
 			"use_token_auth": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"vs_name_tag_framework": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 		},
 	}
@@ -19881,9 +19014,8 @@ func ResourceMarathonServicePortConflictSchema() *schema.Resource {
 				Required: true,
 			},
 			"port": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Required: true,
 			},
 		},
 	}
@@ -20005,10 +19137,9 @@ func ResourceMemberInterfaceSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"active": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"if_name": {
 				Type:     schema.TypeString,
@@ -20032,16 +19163,14 @@ func ResourceMemoryBalancerInfoSchema() *schema.Resource {
 				Elem:     ResourceChildProcessInfoSchema(),
 			},
 			"controller_memory": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"controller_memory_usage_percent": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"debug_message": {
 				Type:     schema.TypeString,
@@ -20049,22 +19178,19 @@ func ResourceMemoryBalancerInfoSchema() *schema.Resource {
 				Computed: true,
 			},
 			"limit": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"memory_used": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"pid": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"process": {
 				Type:     schema.TypeString,
@@ -20082,10 +19208,9 @@ func ResourceMemoryBalancerInfoSchema() *schema.Resource {
 				Computed: true,
 			},
 			"threshold_percent": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 		},
 	}
@@ -20095,16 +19220,14 @@ func ResourceMemoryUsageSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"free": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"total": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 		},
 	}
@@ -20160,22 +19283,19 @@ func ResourceMesosConfigurationSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"all_vses_are_feproxy": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"app_sync_frequency": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "60",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  60,
 			},
 			"container_port_match_http_service": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "true",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 			"coredump_directory": {
 				Type:     schema.TypeString,
@@ -20183,28 +19303,24 @@ func ResourceMesosConfigurationSchema() *schema.Resource {
 				Default:  "/var/lib/systemd/coredump",
 			},
 			"disable_auto_backend_service_sync": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"disable_auto_frontend_service_sync": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"disable_auto_gs_sync": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"disable_auto_se_creation": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"docker_registry_se": {
 				Type:     schema.TypeSet,
@@ -20219,10 +19335,9 @@ func ResourceMesosConfigurationSchema() *schema.Resource {
 				Elem:     ResourceIpAddrPrefixSchema(),
 			},
 			"enable_event_subscription": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "true",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 			"feproxy_bridge_name": {
 				Type:     schema.TypeString,
@@ -20230,10 +19345,9 @@ func ResourceMesosConfigurationSchema() *schema.Resource {
 				Default:  "cbr1",
 			},
 			"feproxy_container_port_as_service": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "true",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 			"feproxy_route_publish": {
 				Type:     schema.TypeSet,
@@ -20242,10 +19356,9 @@ func ResourceMesosConfigurationSchema() *schema.Resource {
 				Elem:     ResourceFeProxyRoutePublishConfigSchema(),
 			},
 			"feproxy_vips_enable_proxy_arp": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "true",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 			"fleet_endpoint": {
 				Type:     schema.TypeString,
@@ -20305,10 +19418,9 @@ func ResourceMesosConfigurationSchema() *schema.Resource {
 				Elem:     ResourceMesosSeResourcesSchema(),
 			},
 			"se_spawn_rate": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "25",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  25,
 			},
 			"se_volume": {
 				Type:     schema.TypeString,
@@ -20316,10 +19428,9 @@ func ResourceMesosConfigurationSchema() *schema.Resource {
 				Default:  "/opt/avi/se",
 			},
 			"services_accessible_all_interfaces": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"ssh_user_ref": {
 				Type:     schema.TypeString,
@@ -20327,28 +19438,24 @@ func ResourceMesosConfigurationSchema() *schema.Resource {
 				Computed: true,
 			},
 			"use_bridge_ip_as_vip": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"use_container_ip_port": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"use_controller_image": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"use_vips_for_east_west_services": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "true",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 			"vip": {
 				Type:     schema.TypeSet,
@@ -20379,10 +19486,9 @@ func ResourceMesosMetricsDebugFilterSchema() *schema.Resource {
 				Computed: true,
 			},
 			"metrics_collection_frq": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "60",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  60,
 			},
 		},
 	}
@@ -20400,16 +19506,14 @@ func ResourceMesosSeResourcesSchema() *schema.Resource {
 				Required: true,
 			},
 			"cpu": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "2",
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Default:  2.0,
 			},
 			"memory": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "4096",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  4096,
 			},
 		},
 	}
@@ -20439,10 +19543,9 @@ func ResourceMesosSetupSchema() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
 			"mesos_access": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 			"mesos_url": {
 				Type:     schema.TypeString,
@@ -20465,10 +19568,9 @@ func ResourceMesosSetupSchema() *schema.Resource {
 				Computed: true,
 			},
 			"se_deploy_method_access": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 			"se_name": {
 				Type:     schema.TypeString,
@@ -20504,26 +19606,23 @@ func ResourceMetricLogSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"end_timestamp": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"metric_id": {
 				Type:     schema.TypeString,
 				Required: true,
 			},
 			"report_timestamp": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"step": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"time_series": {
 				Type:     schema.TypeSet,
@@ -20532,9 +19631,8 @@ func ResourceMetricLogSchema() *schema.Resource {
 				Elem:     ResourceMetricsQueryResponseSchema(),
 			},
 			"value": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Required: true,
 			},
 		},
 	}
@@ -20544,16 +19642,14 @@ func ResourceMetricStatisticsSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"last_sample": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"max": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"max_ts": {
 				Type:     schema.TypeString,
@@ -20561,16 +19657,14 @@ func ResourceMetricStatisticsSchema() *schema.Resource {
 				Computed: true,
 			},
 			"mean": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"min": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"min_ts": {
 				Type:     schema.TypeString,
@@ -20578,22 +19672,19 @@ func ResourceMetricStatisticsSchema() *schema.Resource {
 				Computed: true,
 			},
 			"num_samples": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"sum": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"trend": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 		},
 	}
@@ -20603,10 +19694,9 @@ func ResourceMetricThresoldUpDetailsSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"current_value": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"entity_uuid": {
 				Type:     schema.TypeString,
@@ -20633,10 +19723,9 @@ func ResourceMetricThresoldUpDetailsSchema() *schema.Resource {
 				Computed: true,
 			},
 			"threshold": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 		},
 	}
@@ -20658,106 +19747,89 @@ func ResourceMetricsDataSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"application_response_time": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"blocking_time": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"browser_rendering_time": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"client_rtt": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"connection_time": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"dns_lookup_time": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"dom_content_load_time": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"is_null": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 			"num_samples": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"page_download_time": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"page_load_time": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"prediction_interval_high": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"prediction_interval_low": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"redirection_time": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"rum_client_data_transfer_time": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"server_rtt": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"service_time": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"timestamp": {
 				Type:     schema.TypeString,
@@ -20765,9 +19837,8 @@ func ResourceMetricsDataSchema() *schema.Resource {
 				Computed: true,
 			},
 			"value": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Required: true,
 			},
 			"value_str": {
 				Type:     schema.TypeString,
@@ -20780,10 +19851,9 @@ func ResourceMetricsDataSchema() *schema.Resource {
 				Computed: true,
 			},
 			"waiting_time": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 		},
 	}
@@ -20814,16 +19884,14 @@ func ResourceMetricsDataHeaderSchema() *schema.Resource {
 				Computed: true,
 			},
 			"metrics_min_scale": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"metrics_sum_agg_invalid": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"missing_intervals": {
 				Type:     schema.TypeList,
@@ -20850,10 +19918,9 @@ func ResourceMetricsDataHeaderSchema() *schema.Resource {
 				Computed: true,
 			},
 			"priority": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "true",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 			"server": {
 				Type:     schema.TypeString,
@@ -20911,14 +19978,12 @@ func ResourceMetricsDbDiskEventDetailsSchema() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
 			"metrics_free_sz": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Required: true,
 			},
 			"metrics_quota": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Required: true,
 			},
 		},
 	}
@@ -20928,22 +19993,19 @@ func ResourceMetricsDbQueueFullEventDetailsSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"high": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"instanceport": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"low": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"nodeid": {
 				Type:     schema.TypeString,
@@ -20962,10 +20024,9 @@ func ResourceMetricsDbQueueFullEventDetailsSchema() *schema.Resource {
 				Elem:     ResourceMetricsDbRuntimeSchema(),
 			},
 			"watermark": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 		},
 	}
@@ -20975,22 +20036,19 @@ func ResourceMetricsDbQueueHealthyEventDetailsSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"high": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"instanceport": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"low": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"nodeid": {
 				Type:     schema.TypeString,
@@ -21009,10 +20067,9 @@ func ResourceMetricsDbQueueHealthyEventDetailsSchema() *schema.Resource {
 				Elem:     ResourceMetricsDbRuntimeSchema(),
 			},
 			"watermark": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 		},
 	}
@@ -21022,52 +20079,44 @@ func ResourceMetricsDbRuntimeSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"db_num_client_queries": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"db_num_client_resp": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"db_num_db_queries": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"db_num_db_resp": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"db_num_oom": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"db_queue_size": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"db_rum_queries": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"db_rum_rows": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 		},
 	}
@@ -21103,16 +20152,14 @@ func ResourceMetricsDerivationDataSchema() *schema.Resource {
 				Required: true,
 			},
 			"exclude_derived_metric": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"include_derivation_metrics": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"join_tables": {
 				Type:     schema.TypeString,
@@ -21124,22 +20171,19 @@ func ResourceMetricsDerivationDataSchema() *schema.Resource {
 				Required: true,
 			},
 			"result_has_additional_fields": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"second_order_derivation": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"skip_backend_derivation": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 		},
 	}
@@ -21168,10 +20212,9 @@ func ResourceMetricsEventThresholdSchema() *schema.Resource {
 				Required: true,
 			},
 			"reset_threshold": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"watermark_thresholds": {
 				Type:     schema.TypeList,
@@ -21280,10 +20323,9 @@ func ResourceMetricsQueryResponseSchema() *schema.Resource {
 				Computed: true,
 			},
 			"limit": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"metric_entity": {
 				Type:     schema.TypeString,
@@ -21306,10 +20348,9 @@ func ResourceMetricsQueryResponseSchema() *schema.Resource {
 				Computed: true,
 			},
 			"step": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"stop": {
 				Type:     schema.TypeString,
@@ -21324,15 +20365,13 @@ func ResourceMetricsRealTimeUpdateSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"duration": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "30",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  30,
 			},
 			"enabled": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Required: true,
 			},
 		},
 	}
@@ -21409,10 +20448,9 @@ func ResourceMicroServiceSchema() *schema.Resource {
 				Computed: true,
 			},
 			"ip_list": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 			"name": {
 				Type:     schema.TypeString,
@@ -21455,10 +20493,9 @@ func ResourceMicroServiceContainerSchema() *schema.Resource {
 				Elem:     ResourceIpAddrSchema(),
 			},
 			"port": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"task_id": {
 				Type:     schema.TypeString,
@@ -21503,10 +20540,9 @@ func ResourceMustChecksInfoSchema() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
 			"duration": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"end_time": {
 				Type:     schema.TypeString,
@@ -21540,9 +20576,8 @@ func ResourceNTPAuthenticationKeySchema() *schema.Resource {
 				Required: true,
 			},
 			"key_number": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Required: true,
 			},
 		},
 	}
@@ -21574,10 +20609,9 @@ func ResourceNTPServerSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"key_number": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"server": {
 				Type:     schema.TypeSet,
@@ -21662,14 +20696,12 @@ func ResourceNatRuleSchema() *schema.Resource {
 				Computed: true,
 			},
 			"enable": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Required: true,
 			},
 			"index": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Required: true,
 			},
 			"match": {
 				Type:     schema.TypeSet,
@@ -21824,14 +20856,12 @@ func ResourceNetworkSecurityPolicyActionRLParamSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"burst_size": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Required: true,
 			},
 			"max_rate": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Required: true,
 			},
 		},
 	}
@@ -21845,10 +20875,9 @@ func ResourceNetworkSecurityRuleSchema() *schema.Resource {
 				Required: true,
 			},
 			"age": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "0",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  0,
 			},
 			"created_by": {
 				Type:     schema.TypeString,
@@ -21856,20 +20885,17 @@ func ResourceNetworkSecurityRuleSchema() *schema.Resource {
 				Computed: true,
 			},
 			"enable": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Required: true,
 			},
 			"index": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Required: true,
 			},
 			"log": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"match": {
 				Type:     schema.TypeSet,
@@ -21910,10 +20936,9 @@ func ResourceNetworkSubnetInfoSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"free": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"network_name": {
 				Type:     schema.TypeString,
@@ -21932,10 +20957,9 @@ func ResourceNetworkSubnetInfoSchema() *schema.Resource {
 				Elem:     ResourceIpAddrPrefixSchema(),
 			},
 			"total": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"type": {
 				Type:     schema.TypeString,
@@ -21943,10 +20967,9 @@ func ResourceNetworkSubnetInfoSchema() *schema.Resource {
 				Computed: true,
 			},
 			"used": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 		},
 	}
@@ -21967,10 +20990,9 @@ func ResourceNsxtClustersSchema() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
 			"include": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 		},
 	}
@@ -21980,10 +21002,9 @@ func ResourceNsxtConfigurationSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"automate_dfw_rules": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"data_network_config": {
 				Type:     schema.TypeSet,
@@ -22050,10 +21071,9 @@ func ResourceNsxtDatastoresSchema() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
 			"include": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 		},
 	}
@@ -22068,10 +21088,9 @@ func ResourceNsxtHostsSchema() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
 			"include": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 		},
 	}
@@ -22187,10 +21206,9 @@ func ResourceNsxtSIRuleDetailsSchema() *schema.Resource {
 				Computed: true,
 			},
 			"destexclude": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 			"dests": {
 				Type:     schema.TypeList,
@@ -22284,10 +21302,9 @@ func ResourceNsxtTier1SegmentAutomaticModeSchema() *schema.Resource {
 				Elem:     ResourceIpAddrPrefixSchema(),
 			},
 			"num_se_per_segment": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "16",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  16,
 			},
 			"tier1_lr_ids": {
 				Type:     schema.TypeList,
@@ -22338,10 +21355,9 @@ func ResourceNtlmLogSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"ntlm_detected": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 			"ntlm_status": {
 				Type:     schema.TypeString,
@@ -22368,10 +21384,9 @@ func ResourceNuageSDNControllerSchema() *schema.Resource {
 				DiffSuppressFunc: suppressSensitiveFieldDiffs,
 			},
 			"nuage_port": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "8443",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  8443,
 			},
 			"nuage_username": {
 				Type:     schema.TypeString,
@@ -22468,10 +21483,9 @@ func ResourceOAuthProfileSchema() *schema.Resource {
 				Computed: true,
 			},
 			"jwks_timeout": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "0",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  0,
 			},
 			"jwks_uri": {
 				Type:     schema.TypeString,
@@ -22479,10 +21493,9 @@ func ResourceOAuthProfileSchema() *schema.Resource {
 				Computed: true,
 			},
 			"oauth_resp_buffer_sz": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "102400",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  102400,
 			},
 			"pool_ref": {
 				Type:     schema.TypeString,
@@ -22510,10 +21523,9 @@ func ResourceOAuthResourceServerSchema() *schema.Resource {
 				Default:  "ACCESS_TOKEN_TYPE_JWT",
 			},
 			"introspection_data_timeout": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "0",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  0,
 			},
 			"jwt_params": {
 				Type:     schema.TypeSet,
@@ -22563,10 +21575,9 @@ func ResourceOAuthVSConfigSchema() *schema.Resource {
 				Computed: true,
 			},
 			"cookie_timeout": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "60",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  60,
 			},
 			"key": {
 				Type:     schema.TypeList,
@@ -22671,28 +21682,24 @@ func ResourceOCSPConfigSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"failed_ocsp_jobs_retry_interval": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "3600",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  3600,
 			},
 			"max_tries": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "10",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  10,
 			},
 			"ocsp_req_interval": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "86400",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  86400,
 			},
 			"ocsp_resp_timeout": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"responder_url_lists": {
 				Type:     schema.TypeList,
@@ -22751,22 +21758,19 @@ func ResourceOIDCConfigSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"oidc_enable": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 			"profile": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "true",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 			"userinfo": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 		},
 	}
@@ -22776,16 +21780,14 @@ func ResourceOShiftK8SConfigurationSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"app_sync_frequency": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "300",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  300,
 			},
 			"auto_assign_fqdn": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "true",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 			"avi_bridge_subnet": {
 				Type:     schema.TypeSet,
@@ -22809,10 +21811,9 @@ func ResourceOShiftK8SConfigurationSchema() *schema.Resource {
 				Computed: true,
 			},
 			"container_port_match_http_service": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "true",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 			"coredump_directory": {
 				Type:     schema.TypeString,
@@ -22820,34 +21821,29 @@ func ResourceOShiftK8SConfigurationSchema() *schema.Resource {
 				Default:  "/var/lib/systemd/coredump",
 			},
 			"default_service_as_east_west_service": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "true",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 			"disable_auto_backend_service_sync": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"disable_auto_frontend_service_sync": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"disable_auto_gs_sync": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"disable_auto_se_creation": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"docker_endpoint": {
 				Type:     schema.TypeString,
@@ -22867,22 +21863,19 @@ func ResourceOShiftK8SConfigurationSchema() *schema.Resource {
 				Elem:     ResourceIpAddrPrefixSchema(),
 			},
 			"enable_event_subscription": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "true",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 			"enable_route_ingress_hardening": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "true",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 			"feproxy_vips_enable_proxy_arp": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "true",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 			"http_container_ports": {
 				Type:     schema.TypeList,
@@ -22900,10 +21893,9 @@ func ResourceOShiftK8SConfigurationSchema() *schema.Resource {
 				Elem:     ResourceIngAttributeSchema(),
 			},
 			"l4_health_monitoring": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "true",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 			"master_nodes": {
 				Type:     schema.TypeList,
@@ -22926,16 +21918,14 @@ func ResourceOShiftK8SConfigurationSchema() *schema.Resource {
 				Elem:     ResourceMesosAttributeSchema(),
 			},
 			"num_shards": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "0",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  0,
 			},
 			"override_service_ports": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "true",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 			"persistent_volume_claim": {
 				Type:     schema.TypeString,
@@ -22948,10 +21938,9 @@ func ResourceOShiftK8SConfigurationSchema() *schema.Resource {
 				Elem:     ResourceRouteInfoSchema(),
 			},
 			"sdn_overlay": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "true",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 			"se_deployment_method": {
 				Type:     schema.TypeString,
@@ -22989,16 +21978,14 @@ func ResourceOShiftK8SConfigurationSchema() *schema.Resource {
 				Computed: true,
 			},
 			"se_restart_batch_size": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "1",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  1,
 			},
 			"se_restart_force": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"se_volume": {
 				Type:     schema.TypeString,
@@ -23006,10 +21993,9 @@ func ResourceOShiftK8SConfigurationSchema() *schema.Resource {
 				Default:  "/var/lib/avi",
 			},
 			"secure_egress_mode": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"service_account_token": {
 				Type:             schema.TypeString,
@@ -23024,10 +22010,9 @@ func ResourceOShiftK8SConfigurationSchema() *schema.Resource {
 				Computed: true,
 			},
 			"shared_virtualservice_namespace": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"ssh_user_ref": {
 				Type:     schema.TypeString,
@@ -23035,34 +22020,29 @@ func ResourceOShiftK8SConfigurationSchema() *schema.Resource {
 				Computed: true,
 			},
 			"sync_not_ready_addresses": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "true",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 			"use_controller_image": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"use_resource_definition_as_ssot": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"use_scheduling_disabled_nodes": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"use_service_cluster_ip_as_ew_vip": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"vip_default_gateway": {
 				Type:     schema.TypeSet,
@@ -23090,10 +22070,9 @@ func ResourceOauthLogSchema() *schema.Resource {
 				Elem:     ResourceAuthzRuleMatchSchema(),
 			},
 			"is_session_cookie_expired": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 			"jwks_subrequest": {
 				Type:     schema.TypeSet,
@@ -23166,22 +22145,19 @@ func ResourceObjSyncConfigSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"objsync_cpu_limit": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "30",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  30,
 			},
 			"objsync_hub_elect_interval": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "60",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  60,
 			},
 			"objsync_reconcile_interval": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "10",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  10,
 			},
 		},
 	}
@@ -23191,16 +22167,14 @@ func ResourceOmittedWafLogStatsSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"match_elements": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "0",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  0,
 			},
 			"rules": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "0",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  0,
 			},
 		},
 	}
@@ -23294,16 +22268,14 @@ func ResourceOpenStackConfigurationSchema() *schema.Resource {
 				Computed: true,
 			},
 			"allowed_address_pairs": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "true",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 			"anti_affinity": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "true",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 			"auth_url": {
 				Type:     schema.TypeString,
@@ -23311,16 +22283,14 @@ func ResourceOpenStackConfigurationSchema() *schema.Resource {
 				Computed: true,
 			},
 			"config_drive": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "true",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 			"contrail_disable_policy": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"contrail_endpoint": {
 				Type:     schema.TypeString,
@@ -23328,10 +22298,9 @@ func ResourceOpenStackConfigurationSchema() *schema.Resource {
 				Computed: true,
 			},
 			"contrail_plugin": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"custom_se_image_properties": {
 				Type:     schema.TypeList,
@@ -23339,28 +22308,24 @@ func ResourceOpenStackConfigurationSchema() *schema.Resource {
 				Elem:     ResourcePropertySchema(),
 			},
 			"enable_os_object_caching": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"enable_tagging": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"external_networks": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"free_floatingips": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"hypervisor": {
 				Type:     schema.TypeString,
@@ -23378,16 +22343,14 @@ func ResourceOpenStackConfigurationSchema() *schema.Resource {
 				Default:  "OS_IMG_FMT_AUTO",
 			},
 			"import_keystone_tenants": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "true",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 			"insecure": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "true",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 			"keystone_host": {
 				Type:     schema.TypeString,
@@ -23395,10 +22358,9 @@ func ResourceOpenStackConfigurationSchema() *schema.Resource {
 				Computed: true,
 			},
 			"map_admin_to_cloudadmin": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"mgmt_network_name": {
 				Type:     schema.TypeString,
@@ -23410,16 +22372,14 @@ func ResourceOpenStackConfigurationSchema() *schema.Resource {
 				Computed: true,
 			},
 			"name_owner": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "true",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 			"neutron_rbac": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "true",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 			"password": {
 				Type:             schema.TypeString,
@@ -23453,44 +22413,38 @@ func ResourceOpenStackConfigurationSchema() *schema.Resource {
 				Elem:     ResourceOpenStackRoleMappingSchema(),
 			},
 			"security_groups": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "true",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 			"tenant_se": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "true",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 			"use_admin_url": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "true",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 			"use_internal_endpoints": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"use_keystone_auth": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "true",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 			"username": {
 				Type:     schema.TypeString,
 				Required: true,
 			},
 			"vip_port_in_admin_tenant": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 		},
 	}
@@ -23562,10 +22516,9 @@ func ResourceOpenStackLbPluginOpSchema() *schema.Resource {
 				Computed: true,
 			},
 			"elapsed": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"id": {
 				Type:     schema.TypeString,
@@ -23598,10 +22551,9 @@ func ResourceOpenStackLbProvAuditCheckSchema() *schema.Resource {
 				Computed: true,
 			},
 			"elapsed": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"id": {
 				Type:     schema.TypeString,
@@ -23731,10 +22683,9 @@ func ResourceOperationalStatusSchema() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
 			"reason_code": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"reason_code_string": {
 				Type:     schema.TypeString,
@@ -23754,10 +22705,9 @@ func ResourceOpsHistorySchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"duration": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"end_time": {
 				Type:     schema.TypeString,
@@ -23819,10 +22769,9 @@ func ResourceOrgServiceUnitsSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"available_service_units": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"org_id": {
 				Type:     schema.TypeString,
@@ -23830,10 +22779,9 @@ func ResourceOrgServiceUnitsSchema() *schema.Resource {
 				Computed: true,
 			},
 			"used_service_units": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 		},
 	}
@@ -23889,9 +22837,8 @@ func ResourceOverallInfoSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"available": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Required: true,
 			},
 			"free_percent": {
 				Type:     schema.TypeString,
@@ -23908,14 +22855,12 @@ func ResourceOverallInfoSchema() *schema.Resource {
 				Required: true,
 			},
 			"size": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Required: true,
 			},
 			"used": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Required: true,
 			},
 		},
 	}
@@ -23935,10 +22880,9 @@ func ResourcePGDeploymentRuleSchema() *schema.Resource {
 				Default:  "CO_GE",
 			},
 			"threshold": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 		},
 	}
@@ -23948,16 +22892,14 @@ func ResourcePGDeploymentRuleResultSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"metric_value": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"result": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 			"rule": {
 				Type:     schema.TypeSet,
@@ -23972,16 +22914,14 @@ func ResourcePaaLogSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"cache_hit": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 			"client_request_body_sent": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 			"request_logs": {
 				Type:     schema.TypeList,
@@ -24021,22 +22961,19 @@ func ResourcePaaRequestLogSchema() *schema.Resource {
 				Computed: true,
 			},
 			"response_code": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"server_ip": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"servers_tried": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "0",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  0,
 			},
 			"uri_path": {
 				Type:     schema.TypeString,
@@ -24097,10 +23034,9 @@ func ResourceParamInfoSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"param_hits": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"param_key": {
 				Type:     schema.TypeString,
@@ -24125,10 +23061,9 @@ func ResourceParamSizeClassSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"hits": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"len": {
 				Type:     schema.TypeString,
@@ -24143,10 +23078,9 @@ func ResourceParamTypeClassSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"hits": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"type": {
 				Type:     schema.TypeString,
@@ -24177,15 +23111,13 @@ func ResourcePartitionInfoSchema() *schema.Resource {
 				Required: true,
 			},
 			"quota": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"size": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Required: true,
 			},
 		},
 	}
@@ -24199,10 +23131,9 @@ func ResourcePatchControllerParamsSchema() *schema.Resource {
 				Required: true,
 			},
 			"skip_warnings": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 		},
 	}
@@ -24239,10 +23170,9 @@ func ResourcePatchInfoSchema() *schema.Resource {
 				Computed: true,
 			},
 			"reboot": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 			"reboot_list": {
 				Type:     schema.TypeList,
@@ -24273,10 +23203,9 @@ func ResourcePatchSeGroupParamsSchema() *schema.Resource {
 				Computed: true,
 			},
 			"skip_warnings": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 		},
 	}
@@ -24300,10 +23229,9 @@ func ResourcePatchSystemParamsSchema() *schema.Resource {
 				Required: true,
 			},
 			"skip_warnings": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 		},
 	}
@@ -24322,10 +23250,9 @@ func ResourcePathMatchSchema() *schema.Resource {
 				Required: true,
 			},
 			"match_decoded_string": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "true",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 			"match_str": {
 				Type:     schema.TypeList,
@@ -24345,16 +23272,14 @@ func ResourcePerformanceLimitsSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"max_concurrent_connections": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"max_throughput": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 		},
 	}
@@ -24455,10 +23380,9 @@ func ResourcePodTolerationSchema() *schema.Resource {
 				Default:  "EQUAL",
 			},
 			"toleration_seconds": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"value": {
 				Type:     schema.TypeString,
@@ -24473,10 +23397,9 @@ func ResourcePoolAnalyticsPolicySchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"enable_realtime_metrics": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 		},
 	}
@@ -24496,10 +23419,9 @@ func ResourcePoolDeploymentFailureInfoSchema() *schema.Resource {
 				Computed: true,
 			},
 			"ratio": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"results": {
 				Type:     schema.TypeList,
@@ -24517,10 +23439,9 @@ func ResourcePoolDeploymentFailureInfoSchema() *schema.Resource {
 				Computed: true,
 			},
 			"webhook_result": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 		},
 	}
@@ -24540,10 +23461,9 @@ func ResourcePoolDeploymentSuccessInfoSchema() *schema.Resource {
 				Computed: true,
 			},
 			"ratio": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"results": {
 				Type:     schema.TypeList,
@@ -24561,10 +23481,9 @@ func ResourcePoolDeploymentSuccessInfoSchema() *schema.Resource {
 				Computed: true,
 			},
 			"webhook_result": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 		},
 	}
@@ -24579,16 +23498,14 @@ func ResourcePoolDeploymentUpdateInfoSchema() *schema.Resource {
 				Computed: true,
 			},
 			"evaluation_duration": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"ratio": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"results": {
 				Type:     schema.TypeList,
@@ -24606,10 +23523,9 @@ func ResourcePoolDeploymentUpdateInfoSchema() *schema.Resource {
 				Computed: true,
 			},
 			"webhook_result": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 		},
 	}
@@ -24633,10 +23549,9 @@ func ResourcePoolGroupMemberSchema() *schema.Resource {
 				Computed: true,
 			},
 			"ratio": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "1",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  1,
 			},
 		},
 	}
@@ -24656,10 +23571,9 @@ func ResourcePoolServerSchema() *schema.Resource {
 				Elem:     ResourceIpAddrSchema(),
 			},
 			"port": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 		},
 	}
@@ -24706,14 +23620,12 @@ func ResourcePortRangeSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"end": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Required: true,
 			},
 			"start": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Required: true,
 			},
 		},
 	}
@@ -24723,76 +23635,64 @@ func ResourcePortalConfigurationSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"allow_basic_authentication": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"api_force_timeout": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "24",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  24,
 			},
 			"disable_remote_cli_shell": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"disable_swagger": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"enable_clickjacking_protection": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "true",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 			"enable_http": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "true",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 			"enable_https": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "true",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 			"http_port": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"https_port": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"minimum_password_length": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "8",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  8,
 			},
 			"password_strength_check": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"redirect_to_https": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "true",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 			"sslkeyandcertificate_refs": {
 				Type:     schema.TypeList,
@@ -24805,10 +23705,9 @@ func ResourcePortalConfigurationSchema() *schema.Resource {
 				Computed: true,
 			},
 			"use_uuid_from_input": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 		},
 	}
@@ -24818,34 +23717,29 @@ func ResourcePortalFeatureOptInSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"enable_appsignature_sync": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"enable_ip_reputation": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"enable_pulse_case_management": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "true",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 			"enable_pulse_waf_management": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "true",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 			"enable_user_agent_db_sync": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 		},
 	}
@@ -24855,16 +23749,14 @@ func ResourceProactiveSupportDefaultsSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"attach_core_dump": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"attach_tech_support": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "true",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 			"case_severity": {
 				Type:     schema.TypeString,
@@ -24879,28 +23771,24 @@ func ResourceProcessInfoSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"current_process_id": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"current_process_mem_usage": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"intimation_count": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"memory_limit": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"memory_trend_usage": {
 				Type:     schema.TypeString,
@@ -24913,10 +23801,9 @@ func ResourceProcessInfoSchema() *schema.Resource {
 				Computed: true,
 			},
 			"threshold_percent": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 		},
 	}
@@ -24968,9 +23855,8 @@ func ResourceProxyConfigurationSchema() *schema.Resource {
 				DiffSuppressFunc: suppressSensitiveFieldDiffs,
 			},
 			"port": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Required: true,
 			},
 			"username": {
 				Type:     schema.TypeString,
@@ -25011,10 +23897,9 @@ func ResourceQueryMatchSchema() *schema.Resource {
 				Required: true,
 			},
 			"match_decoded_string": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "true",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 			"match_str": {
 				Type:     schema.TypeList,
@@ -25039,16 +23924,14 @@ func ResourceRancherConfigurationSchema() *schema.Resource {
 				Computed: true,
 			},
 			"app_sync_frequency": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "60",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  60,
 			},
 			"container_port_match_http_service": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "true",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 			"coredump_directory": {
 				Type:     schema.TypeString,
@@ -25056,22 +23939,19 @@ func ResourceRancherConfigurationSchema() *schema.Resource {
 				Default:  "/var/lib/systemd/coredump",
 			},
 			"disable_auto_backend_service_sync": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"disable_auto_frontend_service_sync": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"disable_auto_se_creation": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"docker_registry_se": {
 				Type:     schema.TypeSet,
@@ -25086,22 +23966,19 @@ func ResourceRancherConfigurationSchema() *schema.Resource {
 				Elem:     ResourceIpAddrPrefixSchema(),
 			},
 			"enable_event_subscription": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "true",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 			"feproxy_container_port_as_service": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"feproxy_vips_enable_proxy_arp": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "true",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 			"fleet_endpoint": {
 				Type:     schema.TypeString,
@@ -25140,10 +24017,9 @@ func ResourceRancherConfigurationSchema() *schema.Resource {
 				Elem:     ResourceMesosAttributeSchema(),
 			},
 			"se_spawn_rate": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "25",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  25,
 			},
 			"se_volume": {
 				Type:     schema.TypeString,
@@ -25158,10 +24034,9 @@ func ResourceRancherConfigurationSchema() *schema.Resource {
 				DiffSuppressFunc: suppressSensitiveFieldDiffs,
 			},
 			"services_accessible_all_interfaces": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"ssh_user_ref": {
 				Type:     schema.TypeString,
@@ -25169,16 +24044,14 @@ func ResourceRancherConfigurationSchema() *schema.Resource {
 				Computed: true,
 			},
 			"use_container_ip_port": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"use_controller_image": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 		},
 	}
@@ -25188,16 +24061,14 @@ func ResourceRateLimiterSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"burst_sz": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "0",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  0,
 			},
 			"count": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "1000000000",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  1000000000,
 			},
 			"name": {
 				Type:     schema.TypeString,
@@ -25205,10 +24076,9 @@ func ResourceRateLimiterSchema() *schema.Resource {
 				Computed: true,
 			},
 			"period": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "1",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  1,
 			},
 		},
 	}
@@ -25346,16 +24216,14 @@ func ResourceRateLimiterPropertiesSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"msf_num_stages": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "1",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  1,
 			},
 			"msf_stage_size": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "16384",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  16384,
 			},
 		},
 	}
@@ -25370,16 +24238,14 @@ func ResourceRateProfileSchema() *schema.Resource {
 				Elem:     ResourceRateLimiterActionSchema(),
 			},
 			"explicit_tracking": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"fine_grain": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"http_cookie": {
 				Type:     schema.TypeString,
@@ -25461,10 +24327,9 @@ func ResourceRebootDataSchema() *schema.Resource {
 				Computed: true,
 			},
 			"reboot": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 		},
 	}
@@ -25482,9 +24347,8 @@ func ResourceRemoteAuthConfigurationSchema() *schema.Resource {
 				Required: true,
 			},
 			"index": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Required: true,
 			},
 		},
 	}
@@ -25628,10 +24492,9 @@ func ResourceResumeSeGroupParamsSchema() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
 			"skip_warnings": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 		},
 	}
@@ -25720,10 +24583,9 @@ func ResourceRmBindVsSeEventDetailsSchema() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
 			"primary": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 			"se_name": {
 				Type:     schema.TypeString,
@@ -25731,10 +24593,9 @@ func ResourceRmBindVsSeEventDetailsSchema() *schema.Resource {
 				Computed: true,
 			},
 			"standby": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 			"type": {
 				Type:     schema.TypeString,
@@ -25814,10 +24675,9 @@ func ResourceRmDeleteSeEventDetailsSchema() *schema.Resource {
 				Computed: true,
 			},
 			"status_code": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 		},
 	}
@@ -25982,10 +24842,9 @@ func ResourceRmSpawnSeEventDetailsSchema() *schema.Resource {
 				Computed: true,
 			},
 			"memory": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"network_names": {
 				Type:     schema.TypeList,
@@ -26028,16 +24887,14 @@ func ResourceRmSpawnSeEventDetailsSchema() *schema.Resource {
 				Computed: true,
 			},
 			"status_code": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"vcpus": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"vs_name": {
 				Type:     schema.TypeString,
@@ -26094,10 +24951,9 @@ func ResourceRoleFilterSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"enabled": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "true",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 			"match_label": {
 				Type:     schema.TypeSet,
@@ -26155,10 +25011,9 @@ func ResourceRollbackControllerParamsSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"skip_warnings": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 		},
 	}
@@ -26168,10 +25023,9 @@ func ResourceRollbackPatchControllerParamsSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"skip_warnings": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 		},
 	}
@@ -26192,10 +25046,9 @@ func ResourceRollbackPatchSeGroupParamsSchema() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
 			"skip_warnings": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 		},
 	}
@@ -26211,10 +25064,9 @@ func ResourceRollbackPatchSystemParamsSchema() *schema.Resource {
 				Elem:     ResourceSeGroupOptionsSchema(),
 			},
 			"skip_warnings": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 		},
 	}
@@ -26235,10 +25087,9 @@ func ResourceRollbackSeGroupParamsSchema() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
 			"skip_warnings": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 		},
 	}
@@ -26254,10 +25105,9 @@ func ResourceRollbackSystemParamsSchema() *schema.Resource {
 				Elem:     ResourceSeGroupOptionsSchema(),
 			},
 			"skip_warnings": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 		},
 	}
@@ -26295,34 +25145,29 @@ func ResourceRoutingServiceSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"advertise_backend_networks": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"enable_auto_gateway": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"enable_routing": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"enable_vip_on_all_interfaces": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "true",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 			"enable_vmac": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"floating_intf_ip": {
 				Type:     schema.TypeList,
@@ -26341,10 +25186,9 @@ func ResourceRoutingServiceSchema() *schema.Resource {
 				Elem:     ResourceFlowtableProfileSchema(),
 			},
 			"graceful_restart": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"nat_policy_ref": {
 				Type:     schema.TypeString,
@@ -26352,10 +25196,9 @@ func ResourceRoutingServiceSchema() *schema.Resource {
 				Computed: true,
 			},
 			"routing_by_linux_ipstack": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 		},
 	}
@@ -26365,16 +25208,14 @@ func ResourceRspContentRewriteRuleSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"enable": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 			"index": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"name": {
 				Type:     schema.TypeString,
@@ -26416,10 +25257,9 @@ func ResourceSAMLSPConfigSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"acs_index": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "0",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  0,
 			},
 			"authn_req_acs_type": {
 				Type:     schema.TypeString,
@@ -26432,10 +25272,9 @@ func ResourceSAMLSPConfigSchema() *schema.Resource {
 				Computed: true,
 			},
 			"cookie_timeout": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "60",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  60,
 			},
 			"entity_id": {
 				Type:     schema.TypeString,
@@ -26461,10 +25300,9 @@ func ResourceSAMLSPConfigSchema() *schema.Resource {
 				Computed: true,
 			},
 			"use_idp_session_timeout": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 		},
 	}
@@ -26474,10 +25312,9 @@ func ResourceSCPoolServerStateInfoSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"is_server": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"oper_status": {
 				Type:     schema.TypeSet,
@@ -26525,10 +25362,9 @@ func ResourceSCServerStateInfoSchema() *schema.Resource {
 				Elem:     ResourceIpAddrSchema(),
 			},
 			"server_port": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 		},
 	}
@@ -26538,16 +25374,14 @@ func ResourceSCTPFastPathProfileSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"enable_init_chunk_protection": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"idle_timeout": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "0",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  0,
 			},
 		},
 	}
@@ -26557,52 +25391,44 @@ func ResourceSCTPProxyProfileSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"cookie_expiration_timeout": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "60",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  60,
 			},
 			"heartbeat_interval": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "30",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  30,
 			},
 			"idle_timeout": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "0",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  0,
 			},
 			"max_retransmissions_association": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "10",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  10,
 			},
 			"max_retransmissions_init_chunks": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "8",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  8,
 			},
 			"number_of_streams": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "10",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  10,
 			},
 			"receive_window": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "256",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  256,
 			},
 			"reset_timeout": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "0",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  0,
 			},
 		},
 	}
@@ -26645,10 +25471,9 @@ func ResourceSEBandwidthLimitSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"count": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"type": {
 				Type:     schema.TypeString,
@@ -26668,16 +25493,14 @@ func ResourceSETimeTrackerPropertiesSchema() *schema.Resource {
 				Default:  "SE_TT_AUDIT_OFF",
 			},
 			"egress_threshold": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "20",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  20,
 			},
 			"event_gen_window": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "300",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  300,
 			},
 			"ingress_audit_mode": {
 				Type:     schema.TypeString,
@@ -26685,10 +25508,9 @@ func ResourceSETimeTrackerPropertiesSchema() *schema.Resource {
 				Default:  "SE_TT_AUDIT_OFF",
 			},
 			"ingress_threshold": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "20",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  20,
 			},
 		},
 	}
@@ -26714,16 +25536,14 @@ func ResourceSSLCertificateSchema() *schema.Resource {
 				Computed: true,
 			},
 			"chain_verified": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 			"days_until_expire": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "365",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  365,
 			},
 			"expiry_status": {
 				Type:     schema.TypeString,
@@ -26763,10 +25583,9 @@ func ResourceSSLCertificateSchema() *schema.Resource {
 				Computed: true,
 			},
 			"self_signed": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 			"serial_number": {
 				Type:     schema.TypeString,
@@ -26876,10 +25695,9 @@ func ResourceSSLClientCertificateActionSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"close_connection": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"headers": {
 				Type:     schema.TypeList,
@@ -26911,10 +25729,9 @@ func ResourceSSLExpireDetailsSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"days_left": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"name": {
 				Type:     schema.TypeString,
@@ -26993,10 +25810,9 @@ func ResourceSSLKeyRSAParamsSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"exponent": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "65537",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  65537,
 			},
 			"key_size": {
 				Type:     schema.TypeString,
@@ -27106,16 +25922,14 @@ func ResourceSaasLicensingInfoSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"max_service_units": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "0",
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Default:  0.0,
 			},
 			"reserve_service_units": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "0",
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Default:  0.0,
 			},
 		},
 	}
@@ -27125,22 +25939,19 @@ func ResourceSaasLicensingStatusSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"connected": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 			"enabled": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 			"expired": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 			"message": {
 				Type:     schema.TypeString,
@@ -27158,10 +25969,9 @@ func ResourceSaasLicensingStatusSchema() *schema.Resource {
 				Computed: true,
 			},
 			"reserve_service_units": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 		},
 	}
@@ -27234,10 +26044,9 @@ func ResourceSamlLogSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"is_saml_authentication_used": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 			"saml_attribute_lists": {
 				Type:     schema.TypeList,
@@ -27262,10 +26071,9 @@ func ResourceSamlLogSchema() *schema.Resource {
 				Elem:     ResourceSamlAuthzRuleMatchSchema(),
 			},
 			"saml_session_cookie_expired": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 			"userid": {
 				Type:     schema.TypeString,
@@ -27376,10 +26184,9 @@ func ResourceScaleStatusSchema() *schema.Resource {
 				Computed: true,
 			},
 			"action_success": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 			"end_time_str": {
 				Type:     schema.TypeString,
@@ -27387,22 +26194,19 @@ func ResourceScaleStatusSchema() *schema.Resource {
 				Computed: true,
 			},
 			"num_se_assigned": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"num_se_requested": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"placement_read_fail_cnt": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"reason": {
 				Type:     schema.TypeList,
@@ -27410,10 +26214,9 @@ func ResourceScaleStatusSchema() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
 			"reason_code": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"reason_code_string": {
 				Type:     schema.TypeString,
@@ -27449,10 +26252,9 @@ func ResourceScheduledScalingSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"autoscaling_duration": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "1",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  1,
 			},
 			"cron_expression": {
 				Type:     schema.TypeString,
@@ -27460,16 +26262,14 @@ func ResourceScheduledScalingSchema() *schema.Resource {
 				Computed: true,
 			},
 			"desired_capacity": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"enable": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "true",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 			"end_date": {
 				Type:     schema.TypeString,
@@ -27477,10 +26277,9 @@ func ResourceScheduledScalingSchema() *schema.Resource {
 				Computed: true,
 			},
 			"schedule_max_step": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "1",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  1,
 			},
 			"start_date": {
 				Type:     schema.TypeString,
@@ -27527,154 +26326,129 @@ func ResourceSeAgentPropertiesSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"controller_echo_miss_aggressive_limit": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "2",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  2,
 			},
 			"controller_echo_miss_limit": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "4",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  4,
 			},
 			"controller_echo_rpc_aggressive_timeout": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "2000",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  2000,
 			},
 			"controller_echo_rpc_timeout": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "2000",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  2000,
 			},
 			"controller_heartbeat_miss_limit": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "6",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  6,
 			},
 			"controller_heartbeat_timeout_sec": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "12",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  12,
 			},
 			"controller_registration_timeout_sec": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "30",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  30,
 			},
 			"controller_rpc_timeout": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "10",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  10,
 			},
 			"cpustats_interval": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "5",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  5,
 			},
 			"ctrl_reg_pending_max_wait_time": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "150",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  150,
 			},
 			"debug_mode": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"dp_aggressive_deq_interval_msec": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "1",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  1,
 			},
 			"dp_aggressive_enq_interval_msec": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "1",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  1,
 			},
 			"dp_batch_size": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "100",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  100,
 			},
 			"dp_deq_interval_msec": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "20",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  20,
 			},
 			"dp_enq_interval_msec": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "20",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  20,
 			},
 			"dp_max_wait_rsp_time_sec": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "60",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  60,
 			},
 			"dp_reg_pending_max_wait_time": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "75",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  75,
 			},
 			"headless_timeout_sec": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "0",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  0,
 			},
 			"ignore_docker_mac_change": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "true",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 			"ns_helper_deq_interval_msec": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "20",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  20,
 			},
 			"sdb_flush_interval": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "100",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  100,
 			},
 			"sdb_pipeline_size": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "100",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  100,
 			},
 			"sdb_scan_count": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "1000",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  1000,
 			},
 			"se_grp_change_disruptive": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "true",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 			"seagent_statecache_properties": {
 				Type:     schema.TypeSet,
@@ -27683,52 +26457,44 @@ func ResourceSeAgentPropertiesSchema() *schema.Resource {
 				Elem:     ResourceSeAgentStateCachePropertiesSchema(),
 			},
 			"send_se_ready_timeout": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "300",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  300,
 			},
 			"states_flush_interval": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "100",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  100,
 			},
 			"vnic_dhcp_ip_check_interval": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "6",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  6,
 			},
 			"vnic_dhcp_ip_max_retries": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "10",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  10,
 			},
 			"vnic_ip_delete_interval": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "5",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  5,
 			},
 			"vnic_probe_interval": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "5",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  5,
 			},
 			"vnic_rpc_retry_interval": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "5",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  5,
 			},
 			"vnicdb_cmd_history_size": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "256",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  256,
 			},
 		},
 	}
@@ -27738,76 +26504,64 @@ func ResourceSeAgentStateCachePropertiesSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"sc_batch_buffer_flush_limit": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "300",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  300,
 			},
 			"sc_cfg_q_batch_dequeue_limit": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "100",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  100,
 			},
 			"sc_cfg_q_max_size": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "4096",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  4096,
 			},
 			"sc_dns_q_batch_dequeue_limit": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "100",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  100,
 			},
 			"sc_dns_q_max_size": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "4096",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  4096,
 			},
 			"sc_shard_cleanup_max_time": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "900",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  900,
 			},
 			"sc_state_ring_batch_dequeue_limit": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "300",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  300,
 			},
 			"sc_states_flush_interval": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "100",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  100,
 			},
 			"sc_stream_check_interval": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "5000",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  5000,
 			},
 			"sc_thread_q_batch_dequeue_limit": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "100",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  100,
 			},
 			"sc_thread_q_max_size": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "4096",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  4096,
 			},
 			"sc_thread_sleep_interval": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "10",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  10,
 			},
 		},
 	}
@@ -27858,40 +26612,34 @@ func ResourceSeBootupCompressionPropertiesSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"buf_num": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "128",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  128,
 			},
 			"buf_size": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "4096",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  4096,
 			},
 			"hash_size": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "16384",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  16384,
 			},
 			"level_aggressive": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "5",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  5,
 			},
 			"level_normal": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "1",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  1,
 			},
 			"window_size": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "4096",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  4096,
 			},
 		},
 	}
@@ -27901,52 +26649,44 @@ func ResourceSeBootupPropertiesSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"docker_backend_portend": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "30720",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  30720,
 			},
 			"docker_backend_portstart": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "20480",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  20480,
 			},
 			"fair_queueing_enabled": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"geo_db_granularity": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "1",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  1,
 			},
 			"l7_conns_per_core": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "16384",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  16384,
 			},
 			"l7_resvd_listen_conns_per_core": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "256",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  256,
 			},
 			"log_agent_debug_enabled": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"log_agent_trace_enabled": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "true",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 			"se_dp_compression": {
 				Type:     schema.TypeSet,
@@ -27955,76 +26695,64 @@ func ResourceSeBootupPropertiesSchema() *schema.Resource {
 				Elem:     ResourceSeBootupCompressionPropertiesSchema(),
 			},
 			"se_emulated_cores": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "0",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  0,
 			},
 			"se_ip_encap_ipc": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "0",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  0,
 			},
 			"se_l3_encap_ipc": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "0",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  0,
 			},
 			"se_log_buffer_app_blocking_dequeue": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"se_log_buffer_applog_size": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "4096",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  4096,
 			},
 			"se_log_buffer_chunk_count": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "1024",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  1024,
 			},
 			"se_log_buffer_conn_blocking_dequeue": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"se_log_buffer_connlog_size": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "1024",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  1024,
 			},
 			"se_log_buffer_events_blocking_dequeue": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "true",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 			"se_log_buffer_events_size": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "512",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  512,
 			},
 			"ssl_sess_cache_per_vs": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "4096",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  4096,
 			},
 			"tcp_syncache_hashsize": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "8192",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  8192,
 			},
 		},
 	}
@@ -28034,10 +26762,9 @@ func ResourceSeDiscontinuousTimeChangeEventDetailsSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"drift_time": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"from_time": {
 				Type:     schema.TypeString,
@@ -28099,10 +26826,9 @@ func ResourceSeFaultSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"arg": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"fault_name": {
 				Type:     schema.TypeString,
@@ -28114,16 +26840,14 @@ func ResourceSeFaultSchema() *schema.Resource {
 				Computed: true,
 			},
 			"num_executions": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "1",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  1,
 			},
 			"num_skips": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "0",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  0,
 			},
 		},
 	}
@@ -28234,10 +26958,9 @@ func ResourceSeGroupOptionsSchema() *schema.Resource {
 				Default:  "SUSPEND_UPGRADE_OPS_ON_ERROR",
 			},
 			"disruptive": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 		},
 	}
@@ -28252,16 +26975,14 @@ func ResourceSeGroupResumeOptionsSchema() *schema.Resource {
 				Default:  "SUSPEND_UPGRADE_OPS_ON_ERROR",
 			},
 			"disruptive": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"skip_suspended": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 		},
 	}
@@ -28301,10 +27022,9 @@ func ResourceSeGroupStatusSchema() *schema.Resource {
 				Computed: true,
 			},
 			"in_progress": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 			"notes": {
 				Type:     schema.TypeList,
@@ -28312,46 +27032,39 @@ func ResourceSeGroupStatusSchema() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
 			"num_se": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"num_se_with_no_vs": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"num_se_with_vs_not_scaledout": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"num_se_with_vs_scaledout": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"num_vs": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"num_vs_disrupted": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"progress": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"reason": {
 				Type:     schema.TypeList,
@@ -28506,10 +27219,9 @@ func ResourceSeGroupVersionSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"fips_mode": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 			"name": {
 				Type:     schema.TypeString,
@@ -28534,10 +27246,9 @@ func ResourceSeHBEventDetailsSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"hb_type": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "1",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  1,
 			},
 			"remote_se_ref": {
 				Type:     schema.TypeString,
@@ -28562,10 +27273,9 @@ func ResourceSeHbRecoveredEventDetailsSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"hb_type": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "1",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  1,
 			},
 			"remote_se_ref": {
 				Type:     schema.TypeString,
@@ -28590,16 +27300,14 @@ func ResourceSeHighEgressProcLatencyEventDetailsSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"dispatcher_core": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"event_count": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"flow_core": {
 				Type:     schema.TypeList,
@@ -28607,10 +27315,9 @@ func ResourceSeHighEgressProcLatencyEventDetailsSchema() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeInt},
 			},
 			"max_proxy_to_disp_queing_delay": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"se_name": {
 				Type:     schema.TypeString,
@@ -28635,28 +27342,24 @@ func ResourceSeHighIngressProcLatencyEventDetailsSchema() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeInt},
 			},
 			"event_count": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"flow_core": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"max_disp_to_proxy_queing_delay": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"max_dispatcher_proc_time": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"se_name": {
 				Type:     schema.TypeString,
@@ -28861,10 +27564,9 @@ func ResourceSeHmEventServerDetailsSchema() *schema.Resource {
 				Elem:     ResourceIpAddrSchema(),
 			},
 			"port": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"shm": {
 				Type:     schema.TypeList,
@@ -28884,10 +27586,9 @@ func ResourceSeHmEventShmDetailsSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"average_response_time": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"health_monitor": {
 				Type:     schema.TypeString,
@@ -28899,10 +27600,9 @@ func ResourceSeHmEventShmDetailsSchema() *schema.Resource {
 				Computed: true,
 			},
 			"response_code": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 		},
 	}
@@ -29004,10 +27704,9 @@ func ResourceSeIpAddedEventDetailsSchema() *schema.Resource {
 				Computed: true,
 			},
 			"mask": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"mode": {
 				Type:     schema.TypeString,
@@ -29057,10 +27756,9 @@ func ResourceSeIpRemovedEventDetailsSchema() *schema.Resource {
 				Computed: true,
 			},
 			"mask": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"mode": {
 				Type:     schema.TypeString,
@@ -29117,10 +27815,9 @@ func ResourceSeLicensedBandwdithExceededEventDetailsSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"num_pkts_dropped": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"se_ref": {
 				Type:     schema.TypeString,
@@ -29135,34 +27832,29 @@ func ResourceSeListSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"active_on_cloud": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 			"active_on_se": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 			"admin_down_requested": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"attach_ip_in_progress": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 			"cloud_programming_done": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 			"cloud_programming_status": {
 				Type:     schema.TypeString,
@@ -29170,16 +27862,14 @@ func ResourceSeListSchema() *schema.Resource {
 				Computed: true,
 			},
 			"delete_in_progress": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"detach_ip_in_progress": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 			"floating_intf_ip": {
 				Type:     schema.TypeList,
@@ -29192,28 +27882,24 @@ func ResourceSeListSchema() *schema.Resource {
 				Computed: true,
 			},
 			"is_portchannel": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"is_primary": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "true",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 			"is_standby": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"memory": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "2001",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  2001,
 			},
 			"mgmt_ip": {
 				Type:     schema.TypeSet,
@@ -29228,32 +27914,28 @@ func ResourceSeListSchema() *schema.Resource {
 				Elem:     ResourceIpAddrSchema(),
 			},
 			"scaleout_in_progress": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"se_programming_done": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 			"se_ready_in_progress": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 			"se_ref": {
 				Type:     schema.TypeString,
 				Required: true,
 			},
 			"sec_idx": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "1",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  1,
 			},
 			"snat_ip": {
 				Type:     schema.TypeSet,
@@ -29262,16 +27944,14 @@ func ResourceSeListSchema() *schema.Resource {
 				Elem:     ResourceIpAddrSchema(),
 			},
 			"vcpus": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "2",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  2,
 			},
 			"vip6_subnet_mask": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "128",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  128,
 			},
 			"vip_intf_ip": {
 				Type:     schema.TypeSet,
@@ -29290,16 +27970,14 @@ func ResourceSeListSchema() *schema.Resource {
 				Computed: true,
 			},
 			"vip_subnet_mask": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "32",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  32,
 			},
 			"vlan_id": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "0",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  0,
 			},
 			"vnic": {
 				Type:     schema.TypeList,
@@ -29319,28 +27997,24 @@ func ResourceSeMemoryLimitEventDetailsSchema() *schema.Resource {
 				Computed: true,
 			},
 			"heap_config_hard_limit": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"heap_config_soft_limit": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"heap_config_usage": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"heap_conn_usage": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"se_ref": {
 				Type:     schema.TypeString,
@@ -29348,28 +28022,24 @@ func ResourceSeMemoryLimitEventDetailsSchema() *schema.Resource {
 				Computed: true,
 			},
 			"shm_config_hard_limit": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"shm_config_soft_limit": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"shm_config_usage": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"shm_conn_usage": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 		},
 	}
@@ -29422,10 +28092,9 @@ func ResourceSeMgrEventDetailsSchema() *schema.Resource {
 				Computed: true,
 			},
 			"memory": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"migrate_state": {
 				Type:     schema.TypeString,
@@ -29472,10 +28141,9 @@ func ResourceSeMgrEventDetailsSchema() *schema.Resource {
 				Computed: true,
 			},
 			"vcpus": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"vs_name": {
 				Type:     schema.TypeList,
@@ -29488,16 +28156,14 @@ func ResourceSeMgrEventDetailsSchema() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
 			"vsphere_ha_enabled": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 			"vsphere_ha_inprogress": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 		},
 	}
@@ -29507,10 +28173,9 @@ func ResourceSeMigrateEventDetailsSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"num_vs": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"reason": {
 				Type:     schema.TypeList,
@@ -29566,10 +28231,9 @@ func ResourceSePersistenceEventDetailsSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"entries": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"pool": {
 				Type:     schema.TypeString,
@@ -29621,40 +28285,34 @@ func ResourceSeRateLimitersSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"arp_rl": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "2000",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  2000,
 			},
 			"default_rl": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "100",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  100,
 			},
 			"flow_probe_rl": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "250",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  250,
 			},
 			"icmp_rl": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "2000",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  2000,
 			},
 			"icmp_rsp_rl": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "2000",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  2000,
 			},
 			"rst_rl": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "100",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  100,
 			},
 		},
 	}
@@ -29664,16 +28322,14 @@ func ResourceSeReconcileDetailsSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"new_service_cores": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"old_service_cores": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"se_uuid": {
 				Type:     schema.TypeString,
@@ -29698,49 +28354,41 @@ func ResourceSeResourcesSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"cores_per_socket": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"disk": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Required: true,
 			},
 			"hyper_threading": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 			"hypervisor_mode": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 			"memory": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Required: true,
 			},
 			"num_datapath_processes": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"num_vcpus": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Required: true,
 			},
 			"sockets": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 		},
 	}
@@ -29772,22 +28420,19 @@ func ResourceSeRuntimeCompressionPropertiesSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"max_low_rtt": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "10",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  10,
 			},
 			"min_high_rtt": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "200",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  200,
 			},
 			"min_length": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "128",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  128,
 			},
 			"mobile_str": {
 				Type:     schema.TypeList,
@@ -29802,10 +28447,9 @@ func ResourceSeRuntimePropertiesSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"admin_ssh_enabled": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "true",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 			"app_headers": {
 				Type:     schema.TypeList,
@@ -29813,28 +28457,24 @@ func ResourceSeRuntimePropertiesSchema() *schema.Resource {
 				Elem:     ResourceAppHdrSchema(),
 			},
 			"baremetal_dispatcher_handles_flows": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"connections_lossy_log_rate_limiter_threshold": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "1000",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  1000,
 			},
 			"connections_udfnf_log_rate_limiter_threshold": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "1000",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  1000,
 			},
 			"disable_flow_probes": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"dos_profile": {
 				Type:     schema.TypeSet,
@@ -29843,232 +28483,194 @@ func ResourceSeRuntimePropertiesSchema() *schema.Resource {
 				Elem:     ResourceDosThresholdProfileSchema(),
 			},
 			"downstream_send_timeout": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "3600000",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  3600000,
 			},
 			"dp_aggressive_hb_frequency": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "100",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  100,
 			},
 			"dp_aggressive_hb_timeout_count": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "10",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  10,
 			},
 			"dp_hb_frequency": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "100",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  100,
 			},
 			"dp_hb_timeout_count": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "10",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  10,
 			},
 			"dupip_frequency": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "0",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  0,
 			},
 			"dupip_timeout_count": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "5",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  5,
 			},
 			"enable_hsm_log": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"feproxy_vips_enable_proxy_arp": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "true",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 			"flow_table_batch_push_frequency": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "5",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  5,
 			},
 			"global_mtu": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "0",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  0,
 			},
 			"http_rum_console_log": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"http_rum_min_content_length": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "64",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  64,
 			},
 			"lbaction_num_requests_to_dispatch": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "4",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  4,
 			},
 			"lbaction_rq_per_request_max_retries": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "22",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  22,
 			},
 			"log_agent_compress_logs": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "true",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 			"log_agent_file_sz_appl": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "4",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  4,
 			},
 			"log_agent_file_sz_conn": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "4",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  4,
 			},
 			"log_agent_file_sz_debug": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "4",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  4,
 			},
 			"log_agent_file_sz_event": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "4",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  4,
 			},
 			"log_agent_log_storage_min_sz": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "1024",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  1024,
 			},
 			"log_agent_max_concurrent_rsync": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "1024",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  1024,
 			},
 			"log_agent_max_storage_excess_percent": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "110",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  110,
 			},
 			"log_agent_max_storage_ignore_percent": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "20",
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Default:  20.0,
 			},
 			"log_agent_min_storage_per_vs": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "10",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  10,
 			},
 			"log_agent_sleep_interval": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "10",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  10,
 			},
 			"log_agent_unknown_vs_timer": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "1800",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  1800,
 			},
 			"log_message_max_file_list_size": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "64",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  64,
 			},
 			"ngx_free_connection_stack": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"persistence_mem_max": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "0",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  0,
 			},
 			"scaleout_udp_per_pkt": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "true",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 			"se_auth_ldap_bind_timeout": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "5000",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  5000,
 			},
 			"se_auth_ldap_cache_size": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "100000",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  100000,
 			},
 			"se_auth_ldap_connect_timeout": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "10000",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  10000,
 			},
 			"se_auth_ldap_conns_per_server": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "1",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  1,
 			},
 			"se_auth_ldap_reconnect_timeout": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "10000",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  10000,
 			},
 			"se_auth_ldap_request_timeout": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "10000",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  10000,
 			},
 			"se_auth_ldap_servers_failover_only": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"se_dp_compression": {
 				Type:     schema.TypeSet,
@@ -30077,88 +28679,74 @@ func ResourceSeRuntimePropertiesSchema() *schema.Resource {
 				Elem:     ResourceSeRuntimeCompressionPropertiesSchema(),
 			},
 			"se_dp_hm_drops": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "0",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  0,
 			},
 			"se_dp_if_state_poll_interval": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "10",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  10,
 			},
 			"se_dp_log_nf_enqueue_percent": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "70",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  70,
 			},
 			"se_dp_log_udf_enqueue_percent": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "90",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  90,
 			},
 			"se_dump_core_on_assert": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"se_handle_interface_routes": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"se_hb_persist_fudge_bits": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "3",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  3,
 			},
 			"se_mac_error_threshold_to_disable_promiscious": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "1000",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  1000,
 			},
 			"se_memory_poison": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "true",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 			"se_metrics_interval": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "60000",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  60000,
 			},
 			"se_metrics_rt_enabled": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "true",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 			"se_metrics_rt_interval": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "1000",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  1000,
 			},
 			"se_packet_buffer_max": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "0",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  0,
 			},
 			"se_random_tcp_drops": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"se_rate_limiters": {
 				Type:     schema.TypeSet,
@@ -30177,70 +28765,59 @@ func ResourceSeRuntimePropertiesSchema() *schema.Resource {
 				Elem:     ResourcePortRangeSchema(),
 			},
 			"services_accessible_all_interfaces": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"tcp_syncache_max_retransmit_default": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "4",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  4,
 			},
 			"upstream_connect_timeout": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "3600000",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  3600000,
 			},
 			"upstream_connpool_cache_thresh": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "-1",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  -1,
 			},
 			"upstream_connpool_conn_idle_thresh_tmo": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "-1",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  -1,
 			},
 			"upstream_connpool_core_max_cache": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "-1",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  -1,
 			},
 			"upstream_connpool_enable": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "true",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 			"upstream_keepalive": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"upstream_read_timeout": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "3600000",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  3600000,
 			},
 			"upstream_send_timeout": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "3600000",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  3600000,
 			},
 			"user_defined_metric_age": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "60",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  60,
 			},
 		},
 	}
@@ -30250,14 +28827,12 @@ func ResourceSeThreshEventDetailsSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"curr_value": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Required: true,
 			},
 			"thresh": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Required: true,
 			},
 		},
 	}
@@ -30272,10 +28847,9 @@ func ResourceSeUpgradeEventDetailsSchema() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
 			"num_vs": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"se_grp_uuid": {
 				Type:     schema.TypeString,
@@ -30299,22 +28873,19 @@ func ResourceSeUpgradeEventsSchema() *schema.Resource {
 				Computed: true,
 			},
 			"num_se": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"num_se_group": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"num_vs": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"reason": {
 				Type:     schema.TypeList,
@@ -30386,34 +28957,29 @@ func ResourceSeUpgradeParamsSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"disruptive": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"patch": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"patch_rollback": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"resume_from_suspend": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 			"rollback": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"se_group_refs": {
 				Type:     schema.TypeList,
@@ -30421,16 +28987,14 @@ func ResourceSeUpgradeParamsSchema() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
 			"skip_suspended": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 			"suspend_on_failure": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"uuid": {
 				Type:     schema.TypeString,
@@ -30505,10 +29069,9 @@ func ResourceSeVersionSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"fips_mode": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 			"name": {
 				Type:     schema.TypeString,
@@ -30531,10 +29094,9 @@ func ResourceSeVipInterfaceListSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"is_portchannel": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"networks": {
 				Type:     schema.TypeList,
@@ -30558,10 +29120,9 @@ func ResourceSeVipInterfaceListSchema() *schema.Resource {
 				Required: true,
 			},
 			"vlan_id": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "0",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  0,
 			},
 		},
 	}
@@ -30608,10 +29169,9 @@ func ResourceSeVnicTxQueueStallEventDetailsSchema() *schema.Resource {
 				Computed: true,
 			},
 			"queue": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"se_ref": {
 				Type:     schema.TypeString,
@@ -30658,10 +29218,9 @@ func ResourceSeVsDelFlowsDisruptedSchema() *schema.Resource {
 				Computed: true,
 			},
 			"num_vs_flows_disrupted": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"reporting_se_name": {
 				Type:     schema.TypeString,
@@ -30703,16 +29262,14 @@ func ResourceSeVsPktBufHighEventDetailsSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"current_value": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"threshold": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"virtual_service": {
 				Type:     schema.TypeString,
@@ -30782,9 +29339,8 @@ func ResourceSecMgrThresholdSchema() *schema.Resource {
 				Required: true,
 			},
 			"threshold": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Required: true,
 			},
 		},
 	}
@@ -30806,10 +29362,9 @@ func ResourceSecureChannelAvailableLocalIPsSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"end": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"free_ips": {
 				Type:     schema.TypeList,
@@ -30821,10 +29376,9 @@ func ResourceSecureChannelAvailableLocalIPsSchema() *schema.Resource {
 				Required: true,
 			},
 			"start": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"uuid": {
 				Type:     schema.TypeString,
@@ -30861,10 +29415,9 @@ func ResourceSecureChannelMappingSchema() *schema.Resource {
 				Computed: true,
 			},
 			"is_controller": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"local_ip": {
 				Type:     schema.TypeString,
@@ -30872,10 +29425,9 @@ func ResourceSecureChannelMappingSchema() *schema.Resource {
 				Computed: true,
 			},
 			"marked_for_delete": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"metadata": {
 				Type:     schema.TypeList,
@@ -30931,16 +29483,14 @@ func ResourceSecureChannelTokenSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"expiry_time": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"in_use": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"metadata": {
 				Type:     schema.TypeList,
@@ -31021,10 +29571,9 @@ func ResourceSecurityMgrDebugFilterSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"enable_adaptive_config": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "true",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 			"entity_ref": {
 				Type:     schema.TypeString,
@@ -31072,16 +29621,14 @@ func ResourceSensitiveFieldRuleSchema() *schema.Resource {
 				Default:  "LOG_FIELD_REMOVE",
 			},
 			"enabled": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"index": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"match": {
 				Type:     schema.TypeSet,
@@ -31144,10 +29691,9 @@ func ResourceServerSchema() *schema.Resource {
 				Elem:     ResourceDiscoveredNetworkSchema(),
 			},
 			"enabled": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "true",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 			"external_orchestration_id": {
 				Type:     schema.TypeString,
@@ -31186,16 +29732,14 @@ func ResourceServerSchema() *schema.Resource {
 				Computed: true,
 			},
 			"port": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"preference_order": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"prst_hdr_val": {
 				Type:     schema.TypeString,
@@ -31203,22 +29747,19 @@ func ResourceServerSchema() *schema.Resource {
 				Computed: true,
 			},
 			"ratio": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "1",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  1,
 			},
 			"resolve_server_by_dns": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"rewrite_host_header": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"server_node": {
 				Type:     schema.TypeString,
@@ -31226,16 +29767,14 @@ func ResourceServerSchema() *schema.Resource {
 				Computed: true,
 			},
 			"static": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"verify_network": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"vm_ref": {
 				Type:     schema.TypeString,
@@ -31250,14 +29789,12 @@ func ResourceServerAutoScaleFailedInfoSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"num_scalein_servers": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Required: true,
 			},
 			"num_servers_up": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Required: true,
 			},
 			"pool_ref": {
 				Type:     schema.TypeString,
@@ -31280,9 +29817,8 @@ func ResourceServerAutoScaleInCompleteInfoSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"nscalein": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Required: true,
 			},
 			"pool_ref": {
 				Type:     schema.TypeString,
@@ -31320,26 +29856,22 @@ func ResourceServerAutoScaleInInfoSchema() *schema.Resource {
 				Computed: true,
 			},
 			"available_capacity": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"load": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"num_scalein_servers": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Required: true,
 			},
 			"num_servers_up": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Required: true,
 			},
 			"pool_ref": {
 				Type:     schema.TypeString,
@@ -31361,10 +29893,9 @@ func ResourceServerAutoScaleInInfoSchema() *schema.Resource {
 				Elem:     ResourceServerIdSchema(),
 			},
 			"scheduled_desired_capacity": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 		},
 	}
@@ -31379,9 +29910,8 @@ func ResourceServerAutoScaleOutCompleteInfoSchema() *schema.Resource {
 				Computed: true,
 			},
 			"nscaleout": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Required: true,
 			},
 			"pool_ref": {
 				Type:     schema.TypeString,
@@ -31419,26 +29949,22 @@ func ResourceServerAutoScaleOutInfoSchema() *schema.Resource {
 				Computed: true,
 			},
 			"available_capacity": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"load": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"num_scaleout_servers": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Required: true,
 			},
 			"num_servers_up": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Required: true,
 			},
 			"pool_ref": {
 				Type:     schema.TypeString,
@@ -31455,10 +29981,9 @@ func ResourceServerAutoScaleOutInfoSchema() *schema.Resource {
 				Default:  "SYSERR_SUCCESS",
 			},
 			"scheduled_desired_capacity": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 		},
 	}
@@ -31468,10 +29993,9 @@ func ResourceServerConfigSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"def_port": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 			"hostname": {
 				Type:     schema.TypeString,
@@ -31484,9 +30008,8 @@ func ResourceServerConfigSchema() *schema.Resource {
 				Elem:     ResourceIpAddrSchema(),
 			},
 			"is_enabled": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Required: true,
 			},
 			"last_state": {
 				Type:     schema.TypeString,
@@ -31506,21 +30029,18 @@ func ResourceServerConfigSchema() *schema.Resource {
 				Elem:     ResourceOperationalStatusSchema(),
 			},
 			"port": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Required: true,
 			},
 			"propogate_state": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 			"timer_exists": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 		},
 	}
@@ -31540,9 +30060,8 @@ func ResourceServerIdSchema() *schema.Resource {
 				Elem:     ResourceIpAddrSchema(),
 			},
 			"port": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Required: true,
 			},
 		},
 	}
@@ -31552,28 +30071,24 @@ func ResourceServiceSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"enable_http2": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"enable_ssl": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"horizon_internal_ports": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"is_active_ftp_data_port": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"override_application_profile_ref": {
 				Type:     schema.TypeString,
@@ -31586,15 +30101,13 @@ func ResourceServiceSchema() *schema.Resource {
 				Computed: true,
 			},
 			"port": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Required: true,
 			},
 			"port_range_end": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "0",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  0,
 			},
 		},
 	}
@@ -31609,10 +30122,9 @@ func ResourceServiceEngineCloudLimitsSchema() *schema.Resource {
 				Computed: true,
 			},
 			"vrfs_per_serviceengine": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 		},
 	}
@@ -31622,52 +30134,44 @@ func ResourceServiceEngineLimitsSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"all_virtualservices_per_serviceengine": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"ew_virtualservices_per_serviceengine": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"ns_virtualservices_per_serviceengine": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"num_logical_intf_per_se": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"num_phy_intf_per_se": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"num_virtualservices_rt_metrics": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"num_vlan_intf_per_phy_intf": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"num_vlan_intf_per_se": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"serviceengine_cloud_limits": {
 				Type:     schema.TypeList,
@@ -31717,15 +30221,13 @@ func ResourceServicePoolSelectorSchema() *schema.Resource {
 				Computed: true,
 			},
 			"service_port": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Required: true,
 			},
 			"service_port_range_end": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "0",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  0,
 			},
 			"service_protocol": {
 				Type:     schema.TypeString,
@@ -31740,10 +30242,9 @@ func ResourceServiceengineFaultsSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"debug_faults": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "true",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 		},
 	}
@@ -31758,10 +30259,9 @@ func ResourceSidebandProfileSchema() *schema.Resource {
 				Elem:     ResourceIpAddrSchema(),
 			},
 			"sideband_max_request_body_size": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "1024",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  1024,
 			},
 		},
 	}
@@ -31776,34 +30276,29 @@ func ResourceSingleLicenseSchema() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
 			"burst_cores": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"capacity": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "0",
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Default:  0.0,
 			},
 			"ccu": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "0",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  0,
 			},
 			"cores": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"cpu_cores": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "0",
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Default:  0.0,
 			},
 			"created_on": {
 				Type:     schema.TypeString,
@@ -31820,10 +30315,9 @@ func ResourceSingleLicenseSchema() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
 			"expired": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"last_update": {
 				Type:     schema.TypeString,
@@ -31855,10 +30349,9 @@ func ResourceSingleLicenseSchema() *schema.Resource {
 				Computed: true,
 			},
 			"max_ses": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"se_bandwidth_limits": {
 				Type:     schema.TypeList,
@@ -31871,16 +30364,14 @@ func ResourceSingleLicenseSchema() *schema.Resource {
 				Computed: true,
 			},
 			"service_cores": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "0",
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Default:  0.0,
 			},
 			"sockets": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"start_on": {
 				Type:     schema.TypeString,
@@ -31980,10 +30471,9 @@ func ResourceSipMessageSchema() *schema.Resource {
 				Computed: true,
 			},
 			"from_client": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 			"method": {
 				Type:     schema.TypeString,
@@ -31991,16 +30481,14 @@ func ResourceSipMessageSchema() *schema.Resource {
 				Computed: true,
 			},
 			"rcv_timestamp": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"rx_bytes": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"status": {
 				Type:     schema.TypeString,
@@ -32008,16 +30496,14 @@ func ResourceSipMessageSchema() *schema.Resource {
 				Computed: true,
 			},
 			"status_code": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"tx_bytes": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 		},
 	}
@@ -32027,10 +30513,9 @@ func ResourceSipServiceApplicationProfileSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"transaction_timeout": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "32",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  32,
 			},
 		},
 	}
@@ -32047,10 +30532,9 @@ func ResourceSnmpConfigurationSchema() *schema.Resource {
 				DiffSuppressFunc: suppressSensitiveFieldDiffs,
 			},
 			"large_trap_payload": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"snmp_v3_config": {
 				Type:     schema.TypeSet,
@@ -32093,10 +30577,9 @@ func ResourceSnmpTrapServerSchema() *schema.Resource {
 				Elem:     ResourceIpAddrSchema(),
 			},
 			"port": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "162",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  162,
 			},
 			"user": {
 				Type:     schema.TypeSet,
@@ -32188,10 +30671,9 @@ func ResourceStatediffEventSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"duration": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"end_time": {
 				Type:     schema.TypeString,
@@ -32270,16 +30752,14 @@ func ResourceStaticIpRangeRuntimeSchema() *schema.Resource {
 				Elem:     ResourceStaticIpAllocInfoSchema(),
 			},
 			"free_ip_count": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"total_ip_count": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"type": {
 				Type:     schema.TypeString,
@@ -32294,10 +30774,9 @@ func ResourceStaticRouteSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"disable_gateway_monitor": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 			"if_name": {
 				Type:     schema.TypeString,
@@ -32331,16 +30810,14 @@ func ResourceStreamingSyslogConfigSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"facility": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "16",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  16,
 			},
 			"filtered_log_severity": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "5",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  5,
 			},
 			"hostname": {
 				Type:     schema.TypeString,
@@ -32348,16 +30825,14 @@ func ResourceStreamingSyslogConfigSchema() *schema.Resource {
 				Default:  "AviVantage",
 			},
 			"non_significant_log_severity": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "6",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  6,
 			},
 			"significant_log_severity": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "4",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  4,
 			},
 		},
 	}
@@ -32397,10 +30872,9 @@ func ResourceSubJobSchema() *schema.Resource {
 				Computed: true,
 			},
 			"num_retries": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"type": {
 				Type:     schema.TypeString,
@@ -32424,10 +30898,9 @@ func ResourceSubRequestLogSchema() *schema.Resource {
 				Computed: true,
 			},
 			"http_response_code": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"http_version": {
 				Type:     schema.TypeString,
@@ -32450,22 +30923,19 @@ func ResourceSubRequestLogSchema() *schema.Resource {
 				Computed: true,
 			},
 			"request_length": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"response_length": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"server_ip": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"server_name": {
 				Type:     schema.TypeString,
@@ -32473,22 +30943,19 @@ func ResourceSubRequestLogSchema() *schema.Resource {
 				Computed: true,
 			},
 			"server_port": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"source_port": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"total_time": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"uri_path": {
 				Type:     schema.TypeString,
@@ -32508,10 +30975,9 @@ func ResourceSubResourceSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"exclude_subresources": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"subresources": {
 				Type:     schema.TypeList,
@@ -32592,16 +31058,14 @@ func ResourceSupportedMigrationsSchema() *schema.Resource {
 				Computed: true,
 			},
 			"controller_host_min_free_disk_size": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "10",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  10,
 			},
 			"controller_min_cores": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "8",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  8,
 			},
 			"controller_min_docker_version": {
 				Type:     schema.TypeString,
@@ -32609,28 +31073,24 @@ func ResourceSupportedMigrationsSchema() *schema.Resource {
 				Default:  "1.6.1",
 			},
 			"controller_min_free_disk_size": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "10",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  10,
 			},
 			"controller_min_memory": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "24",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  24,
 			},
 			"controller_min_total_disk": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "128",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  128,
 			},
 			"max_active_versions": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "2",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  2,
 			},
 			"min_supported_api_version": {
 				Type:     schema.TypeString,
@@ -32638,58 +31098,49 @@ func ResourceSupportedMigrationsSchema() *schema.Resource {
 				Computed: true,
 			},
 			"podman_controller_host_min_free_disk_size": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "24",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  24,
 			},
 			"podman_se_host_min_free_disk_size": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "12",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  12,
 			},
 			"rollback_controller_disk_space": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "2",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  2,
 			},
 			"rollback_se_disk_space": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "1",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  1,
 			},
 			"se_host_min_free_disk_size": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "5",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  5,
 			},
 			"se_min_cores": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "2",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  2,
 			},
 			"se_min_free_disk_size": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "5",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  5,
 			},
 			"se_min_memory": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "2",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  2,
 			},
 			"se_min_total_disk": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "10",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  10,
 			},
 			"versions": {
 				Type:     schema.TypeList,
@@ -32794,10 +31245,9 @@ func ResourceTCPApplicationProfileSchema() *schema.Resource {
 				Computed: true,
 			},
 			"proxy_protocol_enabled": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"proxy_protocol_version": {
 				Type:     schema.TypeString,
@@ -32823,16 +31273,14 @@ func ResourceTCPFastPathProfileSchema() *schema.Resource {
 				Elem:     ResourceDsrProfileSchema(),
 			},
 			"enable_syn_protection": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"session_idle_timeout": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "300",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  300,
 			},
 		},
 	}
@@ -32842,22 +31290,19 @@ func ResourceTCPProxyProfileSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"aggressive_congestion_avoidance": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"auto_window_growth": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "true",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 			"automatic": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "true",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 			"cc_algo": {
 				Type:     schema.TypeString,
@@ -32865,16 +31310,14 @@ func ResourceTCPProxyProfileSchema() *schema.Resource {
 				Default:  "CC_ALGO_NEW_RENO",
 			},
 			"congestion_recovery_scaling_factor": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "2",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  2,
 			},
 			"idle_connection_timeout": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "600",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  600,
 			},
 			"idle_connection_type": {
 				Type:     schema.TypeString,
@@ -32882,88 +31325,74 @@ func ResourceTCPProxyProfileSchema() *schema.Resource {
 				Default:  "KEEP_ALIVE",
 			},
 			"ignore_time_wait": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"ip_dscp": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "0",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  0,
 			},
 			"keepalive_in_halfclose_state": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "true",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 			"max_retransmissions": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "8",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  8,
 			},
 			"max_segment_size": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"max_syn_retransmissions": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "8",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  8,
 			},
 			"min_rexmt_timeout": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"nagles_algorithm": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"reassembly_queue_size": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "0",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  0,
 			},
 			"receive_window": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "64",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  64,
 			},
 			"reorder_threshold": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"slow_start_scaling_factor": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "1",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  1,
 			},
 			"time_wait_delay": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "2000",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  2000,
 			},
 			"use_interface_mtu": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "true",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 		},
 	}
@@ -33004,10 +31433,9 @@ func ResourceTacacsPlusAuthSettingsSchema() *schema.Resource {
 				DiffSuppressFunc: suppressSensitiveFieldDiffs,
 			},
 			"port": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "49",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  49,
 			},
 			"server": {
 				Type:     schema.TypeList,
@@ -33049,22 +31477,19 @@ func ResourceTenantConfigurationSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"se_in_provider_context": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "true",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 			"tenant_access_to_provider_se": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "true",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 			"tenant_vrf": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 		},
 	}
@@ -33172,14 +31597,12 @@ func ResourceTimeStampSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"secs": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Required: true,
 			},
 			"usecs": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Required: true,
 			},
 		},
 	}
@@ -33194,10 +31617,9 @@ func ResourceTimeTrackerPropertiesSchema() *schema.Resource {
 				Computed: true,
 			},
 			"be_conn_est_threshold": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"fe_conn_est_audit_mode": {
 				Type:     schema.TypeString,
@@ -33205,16 +31627,14 @@ func ResourceTimeTrackerPropertiesSchema() *schema.Resource {
 				Computed: true,
 			},
 			"fe_conn_est_threshold": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"ingress_sig_log": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 		},
 	}
@@ -33229,10 +31649,9 @@ func ResourceTlsClientInfoSchema() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeInt},
 			},
 			"client_hello_tls_version": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"point_formats": {
 				Type:     schema.TypeList,
@@ -33250,10 +31669,9 @@ func ResourceTlsClientInfoSchema() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeInt},
 			},
 			"uses_grease": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 		},
 	}
@@ -33273,10 +31691,9 @@ func ResourceTrueClientIPConfigSchema() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
 			"index_in_header": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "1",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  1,
 			},
 		},
 	}
@@ -33292,22 +31709,19 @@ func ResourceUDPFastPathProfileSchema() *schema.Resource {
 				Elem:     ResourceDsrProfileSchema(),
 			},
 			"per_pkt_loadbalance": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"session_idle_timeout": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "10",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  10,
 			},
 			"snat": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "true",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 		},
 	}
@@ -33317,10 +31731,9 @@ func ResourceUDPProxyProfileSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"session_idle_timeout": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "10",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  10,
 			},
 		},
 	}
@@ -33335,10 +31748,9 @@ func ResourceURIInfoSchema() *schema.Resource {
 				Elem:     ResourceParamInfoSchema(),
 			},
 			"uri_hits": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"uri_key": {
 				Type:     schema.TypeString,
@@ -33374,10 +31786,9 @@ func ResourceURIParamQuerySchema() *schema.Resource {
 				Computed: true,
 			},
 			"keep_query": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "true",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 		},
 	}
@@ -33387,16 +31798,14 @@ func ResourceURIParamTokenSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"end_index": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"start_index": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"str_value": {
 				Type:     schema.TypeString,
@@ -33430,10 +31839,9 @@ func ResourceUpgradeControllerParamsSchema() *schema.Resource {
 				Required: true,
 			},
 			"skip_warnings": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 		},
 	}
@@ -33443,10 +31851,9 @@ func ResourceUpgradeEventSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"duration": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"end_time": {
 				Type:     schema.TypeString,
@@ -33470,10 +31877,9 @@ func ResourceUpgradeEventSchema() *schema.Resource {
 				Computed: true,
 			},
 			"status": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"sub_tasks": {
 				Type:     schema.TypeList,
@@ -33577,10 +31983,9 @@ func ResourceUpgradeOpsStateSchema() *schema.Resource {
 				Computed: true,
 			},
 			"rebooted": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 			"state": {
 				Type:     schema.TypeString,
@@ -33621,16 +32026,14 @@ func ResourceUpgradeParamsSchema() *schema.Resource {
 				Computed: true,
 			},
 			"skip_warnings": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"system": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 		},
 	}
@@ -33645,16 +32048,14 @@ func ResourceUpgradeReadinessCheckObjSchema() *schema.Resource {
 				Elem:     ResourceMustChecksInfoSchema(),
 			},
 			"checks_completed": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"duration": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"end_time": {
 				Type:     schema.TypeString,
@@ -33683,10 +32084,9 @@ func ResourceUpgradeReadinessCheckObjSchema() *schema.Resource {
 				Elem:     ResourceUpgradeOpsStateSchema(),
 			},
 			"total_checks": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"upgrade_ops": {
 				Type:     schema.TypeString,
@@ -33721,10 +32121,9 @@ func ResourceUpgradeSeGroupParamsSchema() *schema.Resource {
 				Computed: true,
 			},
 			"skip_warnings": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 		},
 	}
@@ -33754,10 +32153,9 @@ func ResourceUpgradeSystemParamsSchema() *schema.Resource {
 				Computed: true,
 			},
 			"skip_warnings": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 		},
 	}
@@ -33767,16 +32165,14 @@ func ResourceUserActivitySchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"concurrent_sessions": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "0",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  0,
 			},
 			"failed_login_attempts": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "0",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  0,
 			},
 			"last_login_ip": {
 				Type:     schema.TypeString,
@@ -33794,10 +32190,9 @@ func ResourceUserActivitySchema() *schema.Resource {
 				Computed: true,
 			},
 			"logged_in": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 			"login_failure_timestamps": {
 				Type:     schema.TypeList,
@@ -33827,82 +32222,69 @@ func ResourceUserAgentCacheConfigSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"batch_size": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "100",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  100,
 			},
 			"controller_cache_size": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "300000",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  300000,
 			},
 			"max_age": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "86400",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  86400,
 			},
 			"max_last_hit_time": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "86400",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  86400,
 			},
 			"max_upstream_queries": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "5",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  5,
 			},
 			"max_wait_time": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "60",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  60,
 			},
 			"num_entries_upstream_update": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "100",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  100,
 			},
 			"percent_reserved_for_bad_bots": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "20",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  20,
 			},
 			"percent_reserved_for_browsers": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "50",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  50,
 			},
 			"percent_reserved_for_good_bots": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "20",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  20,
 			},
 			"percent_reserved_for_outstanding": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "10",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  10,
 			},
 			"se_cache_size": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "20000",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  20000,
 			},
 			"upstream_update_interval": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "3600",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  3600,
 			},
 		},
 	}
@@ -33912,10 +32294,9 @@ func ResourceUserAgentDBConfigSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"allowed_batch_size": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "500",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  500,
 			},
 		},
 	}
@@ -33925,10 +32306,9 @@ func ResourceUserRoleSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"all_tenants": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"role_ref": {
 				Type:     schema.TypeString,
@@ -34104,22 +32484,19 @@ func ResourceVIMgrGuestNicRuntimeSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"avi_internal_network": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 			"connected": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 			"del_pending": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"guest_ip": {
 				Type:     schema.TypeList,
@@ -34136,10 +32513,9 @@ func ResourceVIMgrGuestNicRuntimeSchema() *schema.Resource {
 				Required: true,
 			},
 			"mgmt_vnic": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 			"network_name": {
 				Type:     schema.TypeString,
@@ -34183,10 +32559,9 @@ func ResourceVIMgrHostRuntimeSchema() *schema.Resource {
 				Computed: true,
 			},
 			"cntlr_accessible": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "true",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 			"connection_state": {
 				Type:     schema.TypeString,
@@ -34194,26 +32569,23 @@ func ResourceVIMgrHostRuntimeSchema() *schema.Resource {
 				Default:  "connected",
 			},
 			"cpu_hz": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"maintenance_mode": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 			"managed_object_id": {
 				Type:     schema.TypeString,
 				Required: true,
 			},
 			"mem": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"mgmt_portgroup": {
 				Type:     schema.TypeString,
@@ -34230,22 +32602,19 @@ func ResourceVIMgrHostRuntimeSchema() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
 			"num_cpu_cores": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"num_cpu_packages": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"num_cpu_threads": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"pnics": {
 				Type:     schema.TypeList,
@@ -34263,28 +32632,24 @@ func ResourceVIMgrHostRuntimeSchema() *schema.Resource {
 				Computed: true,
 			},
 			"quarantined": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 			"quarantined_periods": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "1",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  1,
 			},
 			"se_fail_cnt": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"se_success_cnt": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"tenant_ref": {
 				Type:     schema.TypeString,
@@ -34313,10 +32678,9 @@ func ResourceVIMgrIPSubnetRuntimeSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"fip_available": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"fip_subnet_uuids": {
 				Type:     schema.TypeList,
@@ -34344,22 +32708,19 @@ func ResourceVIMgrIPSubnetRuntimeSchema() *schema.Resource {
 				Elem:     ResourceIpAddrPrefixSchema(),
 			},
 			"primary": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"ref_count": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"se_ref_count": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "0",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  0,
 			},
 			"uuid": {
 				Type:     schema.TypeString,
@@ -34374,10 +32735,9 @@ func ResourceVIMgrNWRuntimeSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"auto_expand": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 			"availability_zone": {
 				Type:     schema.TypeString,
@@ -34395,10 +32755,9 @@ func ResourceVIMgrNWRuntimeSchema() *schema.Resource {
 				Computed: true,
 			},
 			"dvs": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 			"host_refs": {
 				Type:     schema.TypeList,
@@ -34406,10 +32765,9 @@ func ResourceVIMgrNWRuntimeSchema() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
 			"interested_nw": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 			"ip_subnet": {
 				Type:     schema.TypeList,
@@ -34421,20 +32779,18 @@ func ResourceVIMgrNWRuntimeSchema() *schema.Resource {
 				Required: true,
 			},
 			"mgmtnw": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 			"name": {
 				Type:     schema.TypeString,
 				Required: true,
 			},
 			"num_ports": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"switch_name": {
 				Type:     schema.TypeString,
@@ -34461,10 +32817,9 @@ func ResourceVIMgrNWRuntimeSchema() *schema.Resource {
 				Computed: true,
 			},
 			"vlan": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"vlan_range": {
 				Type:     schema.TypeList,
@@ -34540,16 +32895,14 @@ func ResourceVIMgrSEVMRuntimeSchema() *schema.Resource {
 				Computed: true,
 			},
 			"creation_in_progress": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 			"deletion_in_progress": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 			"discovery_response": {
 				Type:     schema.TypeString,
@@ -34557,16 +32910,14 @@ func ResourceVIMgrSEVMRuntimeSchema() *schema.Resource {
 				Computed: true,
 			},
 			"discovery_status": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"disk_gb": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"flavor": {
 				Type:     schema.TypeString,
@@ -34604,26 +32955,23 @@ func ResourceVIMgrSEVMRuntimeSchema() *schema.Resource {
 				Computed: true,
 			},
 			"init_vnics": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"last_discovery": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"managed_object_id": {
 				Type:     schema.TypeString,
 				Required: true,
 			},
 			"memory_mb": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"name": {
 				Type:     schema.TypeString,
@@ -34699,10 +33047,9 @@ func ResourceVIMgrSEVMRuntimeSchema() *schema.Resource {
 				Computed: true,
 			},
 			"vcenter_template_vm": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 			"vcenter_url": {
 				Type:     schema.TypeString,
@@ -34725,22 +33072,19 @@ func ResourceVIMgrSEVMRuntimeSchema() *schema.Resource {
 				Computed: true,
 			},
 			"vcpus": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"vsphere_ha_enabled": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 			"vsphere_ha_inprogress": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 		},
 	}
@@ -34775,28 +33119,24 @@ func ResourceVIMgrVMRuntimeSchema() *schema.Resource {
 				Computed: true,
 			},
 			"controller_vm": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 			"cpu_reservation": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"cpu_shares": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"creation_in_progress": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 			"guest_nic": {
 				Type:     schema.TypeList,
@@ -34809,42 +33149,37 @@ func ResourceVIMgrVMRuntimeSchema() *schema.Resource {
 				Computed: true,
 			},
 			"init_vnics": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"managed_object_id": {
 				Type:     schema.TypeString,
 				Required: true,
 			},
 			"mem_shares": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"memory": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"memory_reservation": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"name": {
 				Type:     schema.TypeString,
 				Required: true,
 			},
 			"num_cpu": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"ovf_avisetype_field": {
 				Type:     schema.TypeString,
@@ -34857,10 +33192,9 @@ func ResourceVIMgrVMRuntimeSchema() *schema.Resource {
 				Computed: true,
 			},
 			"se_ver": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "1",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  1,
 			},
 			"tenant_ref": {
 				Type:     schema.TypeString,
@@ -34892,10 +33226,9 @@ func ResourceVIMgrVMRuntimeSchema() *schema.Resource {
 				Computed: true,
 			},
 			"vcenter_template_vm": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 			"vcenter_vappname": {
 				Type:     schema.TypeString,
@@ -34913,16 +33246,14 @@ func ResourceVIMgrVMRuntimeSchema() *schema.Resource {
 				Computed: true,
 			},
 			"vcenter_vnic_discovered": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 			"vm_lb_weight": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 		},
 	}
@@ -34972,9 +33303,8 @@ func ResourceVSDataScriptsSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"index": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Required: true,
 			},
 			"vs_datascript_set_ref": {
 				Type:     schema.TypeString,
@@ -35025,10 +33355,9 @@ func ResourceVcenterClustersSchema() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
 			"include": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 		},
 	}
@@ -35054,10 +33383,9 @@ func ResourceVcenterHostsSchema() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
 			"include": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 		},
 	}
@@ -35137,34 +33465,29 @@ func ResourceVinfraDiscSummaryDetailsSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"num_clusters": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"num_dcs": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"num_hosts": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"num_nws": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"num_vms": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"vcenter": {
 				Type:     schema.TypeString,
@@ -35218,10 +33541,9 @@ func ResourceVinfraVcenterBadCredentialsSchema() *schema.Resource {
 				Computed: true,
 			},
 			"previous_count": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"user": {
 				Type:     schema.TypeString,
@@ -35288,14 +33610,12 @@ func ResourceVinfraVcenterNetworkLimitSchema() *schema.Resource {
 				Required: true,
 			},
 			"current": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Required: true,
 			},
 			"limit": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Required: true,
 			},
 		},
 	}
@@ -35341,16 +33661,14 @@ func ResourceVipSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"auto_allocate_floating_ip": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"auto_allocate_ip": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"auto_allocate_ip_type": {
 				Type:     schema.TypeString,
@@ -35363,16 +33681,14 @@ func ResourceVipSchema() *schema.Resource {
 				Computed: true,
 			},
 			"avi_allocated_fip": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"avi_allocated_vip": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"discovered_networks": {
 				Type:     schema.TypeList,
@@ -35380,10 +33696,9 @@ func ResourceVipSchema() *schema.Resource {
 				Elem:     ResourceDiscoveredNetworkSchema(),
 			},
 			"enabled": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "true",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 			"floating_ip": {
 				Type:     schema.TypeSet,
@@ -35441,10 +33756,9 @@ func ResourceVipSchema() *schema.Resource {
 				Computed: true,
 			},
 			"prefix_length": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "32",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  32,
 			},
 			"subnet": {
 				Type:     schema.TypeSet,
@@ -35511,28 +33825,24 @@ func ResourceVipAutoscalePolicySchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"dns_cooldown": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "60",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  60,
 			},
 			"max_size": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "5",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  5,
 			},
 			"min_size": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "1",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  1,
 			},
 			"suspend": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 		},
 	}
@@ -35547,10 +33857,9 @@ func ResourceVipAutoscaleZonesSchema() *schema.Resource {
 				Computed: true,
 			},
 			"fip_capable": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 			"subnet_uuid": {
 				Type:     schema.TypeString,
@@ -35629,10 +33938,9 @@ func ResourceVipRuntimeSchema() *schema.Resource {
 				Elem:     ResourceTimeStampSchema(),
 			},
 			"first_time_placement": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"fsm_state_id": {
 				Type:     schema.TypeString,
@@ -35657,10 +33965,9 @@ func ResourceVipRuntimeSchema() *schema.Resource {
 				Elem:     ResourceScaleStatusSchema(),
 			},
 			"marked_for_delete": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"metrics_mgr_port": {
 				Type:     schema.TypeString,
@@ -35668,10 +33975,9 @@ func ResourceVipRuntimeSchema() *schema.Resource {
 				Computed: true,
 			},
 			"migrate_in_progress": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"migrate_request": {
 				Type:     schema.TypeSet,
@@ -35680,22 +33986,19 @@ func ResourceVipRuntimeSchema() *schema.Resource {
 				Elem:     ResourceVsMigrateParamsSchema(),
 			},
 			"migrate_scalein_pending": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"migrate_scaleout_pending": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"num_additional_se": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "0",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  0,
 			},
 			"prev_metrics_mgr_port": {
 				Type:     schema.TypeString,
@@ -35703,10 +34006,9 @@ func ResourceVipRuntimeSchema() *schema.Resource {
 				Computed: true,
 			},
 			"progress_percent": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "0",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  0,
 			},
 			"requested_resource": {
 				Type:     schema.TypeSet,
@@ -35721,10 +34023,9 @@ func ResourceVipRuntimeSchema() *schema.Resource {
 				Elem:     ResourceScaleStatusSchema(),
 			},
 			"scalein_in_progress": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"scalein_request": {
 				Type:     schema.TypeSet,
@@ -35733,10 +34034,9 @@ func ResourceVipRuntimeSchema() *schema.Resource {
 				Elem:     ResourceVsScaleinParamsSchema(),
 			},
 			"scaleout_in_progress": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"se_list": {
 				Type:     schema.TypeList,
@@ -35750,10 +34050,9 @@ func ResourceVipRuntimeSchema() *schema.Resource {
 				Elem:     ResourceOperationalStatusSchema(),
 			},
 			"user_scaleout_pending": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"vip_id": {
 				Type:     schema.TypeString,
@@ -35761,16 +34060,14 @@ func ResourceVipRuntimeSchema() *schema.Resource {
 				Computed: true,
 			},
 			"warmstart_resync_done": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"warmstart_resync_sent": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 		},
 	}
@@ -35812,40 +34109,34 @@ func ResourceVipSeAssignedSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"active_on_cloud": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 			"active_on_se": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 			"admin_down_requested": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"attach_ip_in_progress": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 			"connected": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 			"detach_ip_in_progress": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 			"mgmt_ip": {
 				Type:     schema.TypeSet,
@@ -35871,10 +34162,9 @@ func ResourceVipSeAssignedSchema() *schema.Resource {
 				Elem:     ResourceOperationalStatusSchema(),
 			},
 			"primary": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 			"ref": {
 				Type:     schema.TypeString,
@@ -35882,22 +34172,19 @@ func ResourceVipSeAssignedSchema() *schema.Resource {
 				Computed: true,
 			},
 			"scalein_in_progress": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"scaleout_in_progress": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 			"se_ready_in_progress": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 			"snat_ip": {
 				Type:     schema.TypeSet,
@@ -35906,10 +34193,9 @@ func ResourceVipSeAssignedSchema() *schema.Resource {
 				Elem:     ResourceIpAddrSchema(),
 			},
 			"standby": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 		},
 	}
@@ -35919,34 +34205,29 @@ func ResourceVipSymmetryDetailsSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"max_num_se_assigned": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"max_num_se_requested": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"min_num_se_assigned": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"min_num_se_requested": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"num_vs": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"reason": {
 				Type:     schema.TypeString,
@@ -35976,28 +34257,24 @@ func ResourceVirtualServiceResourceSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"memory": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"num_se": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"num_standby_se": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"num_vcpus": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 		},
 	}
@@ -36018,10 +34295,9 @@ func ResourceVirtualServiceRuntimeSchema() *schema.Resource {
 				Elem:     ResourceDebugVirtualServiceSchema(),
 			},
 			"east_west": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"gslb_dns_update": {
 				Type:     schema.TypeSet,
@@ -36035,16 +34311,14 @@ func ResourceVirtualServiceRuntimeSchema() *schema.Resource {
 				Elem:     ResourceDnsRecordSchema(),
 			},
 			"is_dns_vs": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"key_rotation_count": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"last_changed_time": {
 				Type:     schema.TypeSet,
@@ -36064,16 +34338,14 @@ func ResourceVirtualServiceRuntimeSchema() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
 			"manual_placement": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"marked_for_delete": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"metrics_mgr_port": {
 				Type:     schema.TypeString,
@@ -36081,16 +34353,14 @@ func ResourceVirtualServiceRuntimeSchema() *schema.Resource {
 				Computed: true,
 			},
 			"num_additional_se": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "0",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  0,
 			},
 			"one_plus_one_ha": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"prev_controller_ip": {
 				Type:     schema.TypeString,
@@ -36103,16 +34373,14 @@ func ResourceVirtualServiceRuntimeSchema() *schema.Resource {
 				Computed: true,
 			},
 			"redis_db": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"redis_port": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"sec_mgr_info": {
 				Type:     schema.TypeSet,
@@ -36121,10 +34389,9 @@ func ResourceVirtualServiceRuntimeSchema() *schema.Resource {
 				Elem:     ResourceSecurityMgrRuntimeSchema(),
 			},
 			"self_se_election": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"tls_ticket_key": {
 				Type:     schema.TypeList,
@@ -36142,10 +34409,9 @@ func ResourceVirtualServiceRuntimeSchema() *schema.Resource {
 				Computed: true,
 			},
 			"version": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "0",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  0,
 			},
 			"vh_child_vs_ref": {
 				Type:     schema.TypeList,
@@ -36171,40 +34437,34 @@ func ResourceVirtualserviceFaultsSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"debug_faults": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "true",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 			"pool_server_faults": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "true",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 			"scaleout_faults": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "true",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 			"shared_vip_faults": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "true",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 			"ssl_cert_expiry_faults": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "true",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 			"ssl_cert_status_faults": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "true",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 		},
 	}
@@ -36214,38 +34474,33 @@ func ResourceVlanInterfaceSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"dhcp_enabled": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 			"enabled": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "true",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 			"if_name": {
 				Type:     schema.TypeString,
 				Required: true,
 			},
 			"ip6_autocfg_enabled": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 			"is_mgmt": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"vlan_id": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "0",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  0,
 			},
 			"vnic_networks": {
 				Type:     schema.TypeList,
@@ -36265,14 +34520,12 @@ func ResourceVlanRangeSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"end": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Required: true,
 			},
 			"start": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Required: true,
 			},
 		},
 	}
@@ -36288,9 +34541,8 @@ func ResourceVsAwaitingSeEventDetailsSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"awaitingse_timeout": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Required: true,
 			},
 			"ip": {
 				Type:     schema.TypeString,
@@ -36405,10 +34657,9 @@ func ResourceVsErrorEventDetailsSchema() *schema.Resource {
 				Computed: true,
 			},
 			"rpc_status": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"se_assigned": {
 				Type:     schema.TypeList,
@@ -36492,10 +34743,9 @@ func ResourceVsInitialPlacementEventDetailsSchema() *schema.Resource {
 				Computed: true,
 			},
 			"rpc_status": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"se_assigned": {
 				Type:     schema.TypeList,
@@ -36535,10 +34785,9 @@ func ResourceVsMigrateEventDetailsSchema() *schema.Resource {
 				Computed: true,
 			},
 			"rpc_status": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"scale_status": {
 				Type:     schema.TypeSet,
@@ -36574,10 +34823,9 @@ func ResourceVsMigrateParamsSchema() *schema.Resource {
 				Computed: true,
 			},
 			"new_vcpus": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"to_host_ref": {
 				Type:     schema.TypeString,
@@ -36585,10 +34833,9 @@ func ResourceVsMigrateParamsSchema() *schema.Resource {
 				Computed: true,
 			},
 			"to_new_se": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"to_se_ref": {
 				Type:     schema.TypeString,
@@ -36647,10 +34894,9 @@ func ResourceVsScaleInEventDetailsSchema() *schema.Resource {
 				Computed: true,
 			},
 			"rpc_status": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"scale_status": {
 				Type:     schema.TypeSet,
@@ -36696,10 +34942,9 @@ func ResourceVsScaleOutEventDetailsSchema() *schema.Resource {
 				Computed: true,
 			},
 			"rpc_status": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"scale_status": {
 				Type:     schema.TypeSet,
@@ -36730,10 +34975,9 @@ func ResourceVsScaleinParamsSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"admin_down": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"from_se_ref": {
 				Type:     schema.TypeString,
@@ -36741,10 +34985,9 @@ func ResourceVsScaleinParamsSchema() *schema.Resource {
 				Computed: true,
 			},
 			"scalein_primary": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 			"uuid": {
 				Type:     schema.TypeString,
@@ -36764,16 +35007,14 @@ func ResourceVsScaleoutParamsSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"admin_up": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"new_vcpus": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"to_host_ref": {
 				Type:     schema.TypeString,
@@ -36781,10 +35022,9 @@ func ResourceVsScaleoutParamsSchema() *schema.Resource {
 				Computed: true,
 			},
 			"to_new_se": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"to_se_ref": {
 				Type:     schema.TypeString,
@@ -36844,10 +35084,9 @@ func ResourceVsSwitchoverEventDetailsSchema() *schema.Resource {
 				Computed: true,
 			},
 			"rpc_status": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"se_assigned": {
 				Type:     schema.TypeList,
@@ -36872,584 +35111,488 @@ func ResourceVserverL4MetricsObjSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"apdexc": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"apdexrtt": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"avg_application_dos_attacks": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"avg_bandwidth": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"avg_bytes_policy_drops": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"avg_complete_conns": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"avg_connections_dropped": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"avg_dos_app_error": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"avg_dos_attacks": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"avg_dos_bad_rst_flood": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"avg_dos_bandwidth": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"avg_dos_conn": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"avg_dos_conn_ip_rl_drop": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"avg_dos_conn_rl_drop": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"avg_dos_fake_session": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"avg_dos_http_abort": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"avg_dos_http_error": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"avg_dos_http_timeout": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"avg_dos_malformed_flood": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"avg_dos_non_syn_flood": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"avg_dos_req": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"avg_dos_req_cookie_rl_drop": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"avg_dos_req_custom_rl_drop": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"avg_dos_req_hdr_rl_drop": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"avg_dos_req_ip_rl_drop": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"avg_dos_req_ip_rl_drop_bad": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"avg_dos_req_ip_scan_bad_rl_drop": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"avg_dos_req_ip_scan_unknown_rl_drop": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"avg_dos_req_ip_uri_rl_drop": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"avg_dos_req_ip_uri_rl_drop_bad": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"avg_dos_req_rl_drop": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"avg_dos_req_uri_rl_drop": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"avg_dos_req_uri_rl_drop_bad": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"avg_dos_req_uri_scan_bad_rl_drop": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"avg_dos_req_uri_scan_unknown_rl_drop": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"avg_dos_rx_bytes": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"avg_dos_slow_uri": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"avg_dos_small_window_stress": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"avg_dos_ssl_error": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"avg_dos_syn_flood": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"avg_dos_total_req": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"avg_dos_tx_bytes": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"avg_dos_zero_window_stress": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"avg_errored_connections": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"avg_half_open_conns": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"avg_l4_client_latency": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"avg_lossy_connections": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"avg_lossy_req": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"avg_network_dos_attacks": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"avg_new_established_conns": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"avg_pkts_policy_drops": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"avg_policy_drops": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"avg_rx_bytes": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"avg_rx_bytes_dropped": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"avg_rx_pkts": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"avg_rx_pkts_dropped": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"avg_syns": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"avg_total_connections": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"avg_total_rtt": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"avg_tx_bytes": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"avg_tx_pkts": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"max_connection_estb_time_fe": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"max_num_active_se": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"max_open_conns": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"max_rx_bytes_absolute": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"max_rx_pkts_absolute": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"max_tx_bytes_absolute": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"max_tx_pkts_absolute": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"node_obj_id": {
 				Type:     schema.TypeString,
 				Required: true,
 			},
 			"pct_application_dos_attacks": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"pct_connection_errors": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"pct_connections_dos_attacks": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"pct_dos_bandwidth": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"pct_dos_rx_bytes": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"pct_network_dos_attacks": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"pct_pkts_dos_attacks": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"pct_policy_drops": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"sum_conn_duration": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"sum_conn_est_time_exceeded_flows_fe": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"sum_connection_dropped_user_limit": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"sum_connection_errors": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"sum_connections_dropped": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"sum_dup_ack_retransmits": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"sum_end_to_end_rtt": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"sum_end_to_end_rtt_bucket1": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"sum_end_to_end_rtt_bucket2": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"sum_finished_conns": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"sum_ingress_latency_exceeded_flows": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"sum_lossy_connections": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"sum_lossy_req": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"sum_out_of_orders": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"sum_packet_dropped_user_bandwidth_limit": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"sum_rtt_valid_connections": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"sum_sack_retransmits": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"sum_server_flow_control": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"sum_timeout_retransmits": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"sum_zero_window_size_events": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 		},
 	}
@@ -37459,1094 +35602,913 @@ func ResourceVserverL7MetricsObjSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"apdexr": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"avg_application_response_time": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"avg_blocking_time": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"avg_browser_rendering_time": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"avg_cache_bytes": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"avg_cache_hits": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"avg_cacheable_bytes": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"avg_cacheable_hits": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"avg_client_data_transfer_time": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"avg_client_rtt": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"avg_client_txn_latency": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"avg_complete_responses": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"avg_connection_time": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"avg_dns_lookup_time": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"avg_dom_content_load_time": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"avg_error_responses": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"avg_errors_excluded": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"avg_frustrated_responses": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"avg_http_headers_bytes": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"avg_http_headers_count": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"avg_http_params_count": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"avg_page_download_time": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"avg_page_load_time": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"avg_params_per_req": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"avg_post_bytes": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"avg_post_compression_bytes": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"avg_pre_compression_bytes": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"avg_redirection_time": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"avg_reqs_per_session": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"avg_resp_1xx": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"avg_resp_2xx": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"avg_resp_3xx": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"avg_resp_4xx": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"avg_resp_4xx_avi_errors": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"avg_resp_5xx": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"avg_resp_5xx_avi_errors": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"avg_rum_client_data_transfer_time": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"avg_satisfactory_responses": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"avg_server_rtt": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"avg_service_time": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"avg_ssl_auth_dsa": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"avg_ssl_auth_ecdsa": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"avg_ssl_auth_rsa": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"avg_ssl_connections": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"avg_ssl_ecdsa_non_pfs": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"avg_ssl_ecdsa_pfs": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"avg_ssl_errors": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"avg_ssl_failed_connections": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"avg_ssl_handshake_network_errors": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"avg_ssl_handshake_protocol_errors": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"avg_ssl_handshakes_new": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"avg_ssl_handshakes_non_pfs": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"avg_ssl_handshakes_pfs": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"avg_ssl_handshakes_reused": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"avg_ssl_handshakes_timedout": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"avg_ssl_kx_dh": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"avg_ssl_kx_ecdh": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"avg_ssl_kx_rsa": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"avg_ssl_rsa_non_pfs": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"avg_ssl_rsa_pfs": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"avg_ssl_ver_ssl30": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"avg_ssl_ver_tls10": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"avg_ssl_ver_tls11": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"avg_ssl_ver_tls12": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"avg_ssl_ver_tls13": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"avg_tolerated_responses": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"avg_total_http2_requests": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"avg_total_requests": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"avg_uri_length": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"avg_waf_attacks": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"avg_waf_disabled": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"avg_waf_evaluated": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"avg_waf_evaluated_request_body_phase": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"avg_waf_evaluated_request_header_phase": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"avg_waf_evaluated_response_body_phase": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"avg_waf_evaluated_response_header_phase": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"avg_waf_flagged": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"avg_waf_flagged_request_body_phase": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"avg_waf_flagged_request_header_phase": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"avg_waf_flagged_response_body_phase": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"avg_waf_flagged_response_header_phase": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"avg_waf_latency_request_body_phase": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"avg_waf_latency_request_header_phase": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"avg_waf_latency_response_body_phase": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"avg_waf_latency_response_header_phase": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"avg_waf_matched": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"avg_waf_matched_request_body_phase": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"avg_waf_matched_request_header_phase": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"avg_waf_matched_response_body_phase": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"avg_waf_matched_response_header_phase": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"avg_waf_rejected": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"avg_waf_rejected_request_body_phase": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"avg_waf_rejected_request_header_phase": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"avg_waf_rejected_response_body_phase": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"avg_waf_rejected_response_header_phase": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"avg_waiting_time": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"max_concurrent_sessions": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"max_ssl_open_sessions": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"node_obj_id": {
 				Type:     schema.TypeString,
 				Required: true,
 			},
 			"pct_cache_hits": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"pct_cacheable_hits": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"pct_get_reqs": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"pct_post_reqs": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"pct_response_errors": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"pct_ssl_failed_connections": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"pct_waf_attacks": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"pct_waf_disabled": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"pct_waf_evaluated": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"pct_waf_flagged": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"pct_waf_matched": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"pct_waf_rejected": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"rum_apdexr": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"ssl_protocol_strength": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"sum_application_response_time": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"sum_blocking_time": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"sum_browser_rendering_time": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"sum_client_data_transfer_time": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"sum_client_rtt": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"sum_connection_time": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"sum_dns_lookup_time": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"sum_dom_content_load_time": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"sum_errors": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"sum_finished_sessions": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"sum_get_client_txn_latency": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"sum_get_client_txn_latency_bucket1": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"sum_get_client_txn_latency_bucket2": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"sum_get_reqs": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"sum_http_headers_bytes": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"sum_http_headers_count": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"sum_http_params_count": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"sum_num_page_load_time_bucket1": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"sum_num_page_load_time_bucket2": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"sum_num_rum_samples": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"sum_other_client_txn_latency": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"sum_other_client_txn_latency_bucket1": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"sum_other_client_txn_latency_bucket2": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"sum_other_reqs": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"sum_page_download_time": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"sum_page_load_time": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"sum_post_bytes": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"sum_post_client_txn_latency": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"sum_post_client_txn_latency_bucket1": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"sum_post_client_txn_latency_bucket2": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"sum_post_reqs": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"sum_redirection_time": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"sum_reqs_finished_sessions": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"sum_reqs_with_params": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"sum_resp_1xx": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"sum_resp_2xx": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"sum_resp_3xx": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"sum_resp_4xx": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"sum_resp_5xx": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"sum_rum_client_data_transfer_time": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"sum_server_rtt": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"sum_service_time": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"sum_total_responses": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"sum_uri_length": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"sum_waf_attacks": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"sum_waf_disabled": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"sum_waf_evaluated_request_body_phase": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"sum_waf_evaluated_request_header_phase": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"sum_waf_evaluated_response_body_phase": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"sum_waf_evaluated_response_header_phase": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"sum_waf_flagged": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"sum_waf_flagged_request_body_phase": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"sum_waf_flagged_request_header_phase": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"sum_waf_flagged_response_body_phase": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"sum_waf_flagged_response_header_phase": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"sum_waf_latency_request_body_phase": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"sum_waf_latency_request_header_phase": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"sum_waf_latency_response_body_phase": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"sum_waf_latency_response_header_phase": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"sum_waf_matched_request_body_phase": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"sum_waf_matched_request_header_phase": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"sum_waf_matched_response_body_phase": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"sum_waf_matched_response_header_phase": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"sum_waf_rejected": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"sum_waf_rejected_request_body_phase": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"sum_waf_rejected_request_header_phase": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"sum_waf_rejected_response_body_phase": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"sum_waf_rejected_response_header_phase": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 			"sum_waiting_time": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateFloat,
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
 			},
 		},
 	}
@@ -38556,16 +36518,14 @@ func ResourceVssPlacementSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"core_nonaffinity": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "2",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  2,
 			},
 			"num_subcores": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "4",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  4,
 			},
 		},
 	}
@@ -38575,94 +36535,79 @@ func ResourceWAFLimitsSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"num_allowed_content_types": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"num_allowed_request_content_type_charsets": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"num_allowlist_policy_rules": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"num_applications": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"num_data_files": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"num_pre_post_crs_groups": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"num_psm_groups": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"num_psm_match_elements": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"num_psm_match_rules_per_loc": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"num_psm_total_locations": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"num_restricted_extensions": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"num_restricted_headers": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"num_rule_tags": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"num_rules_per_rulegroup": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"num_static_extensions": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 		},
 	}
@@ -38699,10 +36644,9 @@ func ResourceWafApplicationSignatureAppVersionSchema() *schema.Resource {
 				Computed: true,
 			},
 			"number_of_rules": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 		},
 	}
@@ -38764,10 +36708,9 @@ func ResourceWafConfigSchema() *schema.Resource {
 				Default:  "&",
 			},
 			"client_request_max_body_size": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "32",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  32,
 			},
 			"content_type_mappings": {
 				Type:     schema.TypeList,
@@ -38775,34 +36718,29 @@ func ResourceWafConfigSchema() *schema.Resource {
 				Elem:     ResourceWafContentTypeMappingSchema(),
 			},
 			"cookie_format_version": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "0",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  0,
 			},
 			"ignore_incomplete_request_body_error": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "true",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 			"max_execution_time": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "50",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  50,
 			},
 			"regex_match_limit": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "30000",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  30000,
 			},
 			"regex_recursion_limit": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "10000",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  10000,
 			},
 			"request_body_default_action": {
 				Type:     schema.TypeString,
@@ -38835,16 +36773,14 @@ func ResourceWafConfigSchema() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
 			"send_status_header": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"server_response_max_body_size": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "128",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  128,
 			},
 			"static_extensions": {
 				Type:     schema.TypeList,
@@ -38862,10 +36798,9 @@ func ResourceWafConfigSchema() *schema.Resource {
 				Default:  "X-WAF-Result",
 			},
 			"xml_xxe_protection": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "true",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 		},
 	}
@@ -38890,16 +36825,14 @@ func ResourceWafCrsConfigSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"enable_auto_download_waf_signatures": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"enable_waf_signatures_notifications": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "true",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 		},
 	}
@@ -38992,10 +36925,9 @@ func ResourceWafLogSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"allowlist_configured": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"allowlist_logs": {
 				Type:     schema.TypeList,
@@ -39003,10 +36935,9 @@ func ResourceWafLogSchema() *schema.Resource {
 				Elem:     ResourceWafAllowlistLogSchema(),
 			},
 			"allowlist_processed": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"application_rule_logs": {
 				Type:     schema.TypeList,
@@ -39014,46 +36945,39 @@ func ResourceWafLogSchema() *schema.Resource {
 				Elem:     ResourceWafRuleLogSchema(),
 			},
 			"application_rules_configured": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"application_rules_processed": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"latency_request_body_phase": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"latency_request_header_phase": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"latency_response_body_phase": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"latency_response_header_phase": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"memory_allocated": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"omitted_app_rule_stats": {
 				Type:     schema.TypeSet,
@@ -39068,10 +36992,9 @@ func ResourceWafLogSchema() *schema.Resource {
 				Elem:     ResourceOmittedWafLogStatsSchema(),
 			},
 			"psm_configured": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"psm_logs": {
 				Type:     schema.TypeList,
@@ -39079,10 +37002,9 @@ func ResourceWafLogSchema() *schema.Resource {
 				Elem:     ResourceWafPSMLogSchema(),
 			},
 			"psm_processed": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"rule_logs": {
 				Type:     schema.TypeList,
@@ -39090,16 +37012,14 @@ func ResourceWafLogSchema() *schema.Resource {
 				Elem:     ResourceWafRuleLogSchema(),
 			},
 			"rules_configured": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"rules_processed": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"status": {
 				Type:     schema.TypeString,
@@ -39119,9 +37039,8 @@ func ResourceWafPSMLocationSchema() *schema.Resource {
 				Computed: true,
 			},
 			"index": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Required: true,
 			},
 			"match": {
 				Type:     schema.TypeSet,
@@ -39213,15 +37132,13 @@ func ResourceWafPSMMatchElementSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"excluded": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"index": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Required: true,
 			},
 			"match_case": {
 				Type:     schema.TypeString,
@@ -39255,15 +37172,13 @@ func ResourceWafPSMRuleSchema() *schema.Resource {
 				Computed: true,
 			},
 			"enable": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "true",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 			"index": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Required: true,
 			},
 			"match_case": {
 				Type:     schema.TypeString,
@@ -39276,10 +37191,9 @@ func ResourceWafPSMRuleSchema() *schema.Resource {
 				Elem:     ResourceWafPSMMatchElementSchema(),
 			},
 			"match_value_max_length": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"match_value_pattern": {
 				Type:     schema.TypeString,
@@ -39344,15 +37258,13 @@ func ResourceWafPolicyAllowlistRuleSchema() *schema.Resource {
 				Computed: true,
 			},
 			"enable": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "true",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 			"index": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Required: true,
 			},
 			"match": {
 				Type:     schema.TypeSet,
@@ -39364,10 +37276,9 @@ func ResourceWafPolicyAllowlistRuleSchema() *schema.Resource {
 				Required: true,
 			},
 			"sampling_percent": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "100",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  100,
 			},
 		},
 	}
@@ -39418,10 +37329,9 @@ func ResourceWafRuleSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"enable": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "true",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 			"exclude_list": {
 				Type:     schema.TypeList,
@@ -39429,15 +37339,13 @@ func ResourceWafRuleSchema() *schema.Resource {
 				Elem:     ResourceWafExcludeListEntrySchema(),
 			},
 			"index": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Required: true,
 			},
 			"is_sensitive": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"mode": {
 				Type:     schema.TypeString,
@@ -39481,10 +37389,9 @@ func ResourceWafRuleGroupSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"enable": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "true",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 			"exclude_list": {
 				Type:     schema.TypeList,
@@ -39492,9 +37399,8 @@ func ResourceWafRuleGroupSchema() *schema.Resource {
 				Elem:     ResourceWafExcludeListEntrySchema(),
 			},
 			"index": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Required: true,
 			},
 			"name": {
 				Type:     schema.TypeString,
@@ -39513,10 +37419,9 @@ func ResourceWafRuleGroupOverridesSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"enable": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 			"exclude_list": {
 				Type:     schema.TypeList,
@@ -39555,10 +37460,9 @@ func ResourceWafRuleLogSchema() *schema.Resource {
 				Computed: true,
 			},
 			"omitted_match_elements": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "0",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  0,
 			},
 			"phase": {
 				Type:     schema.TypeString,
@@ -39571,10 +37475,9 @@ func ResourceWafRuleLogSchema() *schema.Resource {
 				Computed: true,
 			},
 			"rule_id": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
 			},
 			"rule_name": {
 				Type:     schema.TypeString,
@@ -39594,10 +37497,9 @@ func ResourceWafRuleMatchDataSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"is_internal": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"match_element": {
 				Type:     schema.TypeString,
@@ -39617,10 +37519,9 @@ func ResourceWafRuleOverridesSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"enable": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 			"exclude_list": {
 				Type:     schema.TypeList,
@@ -39747,10 +37648,9 @@ func ResourcevCenterConfigurationSchema() *schema.Resource {
 				Computed: true,
 			},
 			"deactivate_vm_discovery": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"management_ip_subnet": {
 				Type:     schema.TypeSet,
@@ -39775,10 +37675,9 @@ func ResourcevCenterConfigurationSchema() *schema.Resource {
 				Required: true,
 			},
 			"use_content_lib": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "true",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 			"username": {
 				Type:     schema.TypeString,
@@ -39849,52 +37748,44 @@ func ResourcevNICSchema() *schema.Resource {
 				Computed: true,
 			},
 			"aggregator_chgd": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"can_se_dp_takeover": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "true",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 			"connected": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 			"del_pending": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"delete_vnic": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"dhcp_enabled": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "true",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 			"dp_deletion_done": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"enabled": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "true",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 			"if_name": {
 				Type:     schema.TypeString,
@@ -39902,46 +37793,39 @@ func ResourcevNICSchema() *schema.Resource {
 				Computed: true,
 			},
 			"ip6_autocfg_enabled": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "true",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 			"is_asm": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"is_avi_internal_network": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"is_hsm": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"is_mgmt": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"is_portchannel": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"link_up": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "true",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 			"linux_name": {
 				Type:     schema.TypeString,
@@ -39958,10 +37842,9 @@ func ResourcevNICSchema() *schema.Resource {
 				Elem:     ResourceMemberInterfaceSchema(),
 			},
 			"mtu": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "1500",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  1500,
 			},
 			"network_name": {
 				Type:     schema.TypeString,
@@ -39984,10 +37867,9 @@ func ResourcevNICSchema() *schema.Resource {
 				Computed: true,
 			},
 			"vlan_id": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "0",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  0,
 			},
 			"vlan_interfaces": {
 				Type:     schema.TypeList,
@@ -40000,10 +37882,9 @@ func ResourcevNICSchema() *schema.Resource {
 				Elem:     ResourcevNICNetworkSchema(),
 			},
 			"vrf_id": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "0",
-				ValidateFunc: validateInteger,
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  0,
 			},
 			"vrf_ref": {
 				Type:     schema.TypeString,
@@ -40018,10 +37899,9 @@ func ResourcevNICNetworkSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"ctlr_alloc": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "false",
-				ValidateFunc: validateBool,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"ip": {
 				Type:     schema.TypeSet,
@@ -4,20 +4,19 @@
 package avi
 
 import (
+	"context"
 	"log"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/vmware/alb-sdk/go/clients"
 )
 
 func ResourceRmCloudOpsProtoSchema() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
 		"last_queried_se_creation_limit": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Computed:     true,
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Computed: true,
 		},
 		"name": {
 			Type:     schema.TypeString,
@@ -25,16 +24,14 @@ func ResourceRmCloudOpsProtoSchema() map[string]*schema.Schema {
 			Computed: true,
 		},
 		"pending_se_creation_count": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Computed:     true,
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Computed: true,
 		},
 		"pending_vnic_op_count": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Computed:     true,
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Computed: true,
 		},
 		"uuid": {
 			Type:     schema.TypeString,
@@ -46,65 +43,50 @@ func ResourceRmCloudOpsProtoSchema() map[string]*schema.Schema {
 
 func resourceAviRmCloudOpsProto() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviRmCloudOpsProtoCreate,
-		Read:   ResourceAviRmCloudOpsProtoRead,
-		Update: resourceAviRmCloudOpsProtoUpdate,
-		Delete: resourceAviRmCloudOpsProtoDelete,
-		Schema: ResourceRmCloudOpsProtoSchema(),
+		CreateContext: resourceAviRmCloudOpsProtoCreate,
+		ReadContext:   ResourceAviRmCloudOpsProtoRead,
+		UpdateContext: resourceAviRmCloudOpsProtoUpdate,
+		DeleteContext: resourceAviRmCloudOpsProtoDelete,
+		Schema:        ResourceRmCloudOpsProtoSchema(),
 		Importer: &schema.ResourceImporter{
-			State: ResourceRmCloudOpsProtoImporter,
+			StateContext: ResourceRmCloudOpsProtoImporter,
 		},
 	}
 }
 
-func ResourceRmCloudOpsProtoImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func ResourceRmCloudOpsProtoImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	s := ResourceRmCloudOpsProtoSchema()
-	return ResourceImporter(d, m, "rmcloudopsproto", s)
+	return ResourceImporter(ctx, d, m, "rmcloudopsproto", s)
 }
 
-func ResourceAviRmCloudOpsProtoRead(d *schema.ResourceData, meta interface{}) error {
+func ResourceAviRmCloudOpsProtoRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceRmCloudOpsProtoSchema()
-	err := APIRead(d, meta, "rmcloudopsproto", s)
-	if err != nil {
-		log.Printf("[ERROR] in reading object %v\n", err)
+	diags := APIRead(ctx, d, meta, "rmcloudopsproto", s)
+	if diags.HasError() {
+		log.Printf("[ERROR] in reading object %v\n", diags)
 	}
-	return err
+	return diags
 }
 
-func resourceAviRmCloudOpsProtoCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviRmCloudOpsProtoCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceRmCloudOpsProtoSchema()
-	err := APICreateOrUpdate(d, meta, "rmcloudopsproto", s)
-	if err == nil {
-		err = ResourceAviRmCloudOpsProtoRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "rmcloudopsproto", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviRmCloudOpsProtoRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviRmCloudOpsProtoUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviRmCloudOpsProtoUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceRmCloudOpsProtoSchema()
-	var err error
-	err = APICreateOrUpdate(d, meta, "rmcloudopsproto", s)
-	if err == nil {
-		err = ResourceAviRmCloudOpsProtoRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "rmcloudopsproto", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviRmCloudOpsProtoRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviRmCloudOpsProtoDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAviRmCloudOpsProtoDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	objType := "rmcloudopsproto"
-	client := meta.(*clients.AviClient)
-	if APIDeleteSystemDefaultCheck(d) {
-		return nil
-	}
-	uuid := d.Get("uuid").(string)
-	if uuid != "" {
-		path := "api/" + objType + "/" + uuid
-		err := client.AviSession.Delete(path)
-		if err != nil && !(strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "204") || strings.Contains(err.Error(), "403")) {
-			log.Println("[INFO] resourceAviRmCloudOpsProtoDelete not found")
-			return err
-		}
-		d.SetId("")
-	}
-	return nil
+	return APIDelete(ctx, d, meta, objType)
 }
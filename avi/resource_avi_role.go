@@ -4,20 +4,19 @@
 package avi
 
 import (
+	"context"
 	"log"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/vmware/alb-sdk/go/clients"
 )
 
 func ResourceRoleSchema() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
 		"allow_unlabelled_access": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "true",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  true,
 		},
 		"configpb_attributes": {
 			Type:     schema.TypeSet,
@@ -54,65 +53,50 @@ func ResourceRoleSchema() map[string]*schema.Schema {
 
 func resourceAviRole() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviRoleCreate,
-		Read:   ResourceAviRoleRead,
-		Update: resourceAviRoleUpdate,
-		Delete: resourceAviRoleDelete,
-		Schema: ResourceRoleSchema(),
+		CreateContext: resourceAviRoleCreate,
+		ReadContext:   ResourceAviRoleRead,
+		UpdateContext: resourceAviRoleUpdate,
+		DeleteContext: resourceAviRoleDelete,
+		Schema:        ResourceRoleSchema(),
 		Importer: &schema.ResourceImporter{
-			State: ResourceRoleImporter,
+			StateContext: ResourceRoleImporter,
 		},
 	}
 }
 
-func ResourceRoleImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func ResourceRoleImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	s := ResourceRoleSchema()
-	return ResourceImporter(d, m, "role", s)
+	return ResourceImporter(ctx, d, m, "role", s)
 }
 
-func ResourceAviRoleRead(d *schema.ResourceData, meta interface{}) error {
+func ResourceAviRoleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceRoleSchema()
-	err := APIRead(d, meta, "role", s)
-	if err != nil {
-		log.Printf("[ERROR] in reading object %v\n", err)
+	diags := APIRead(ctx, d, meta, "role", s)
+	if diags.HasError() {
+		log.Printf("[ERROR] in reading object %v\n", diags)
 	}
-	return err
+	return diags
 }
 
-func resourceAviRoleCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviRoleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceRoleSchema()
-	err := APICreateOrUpdate(d, meta, "role", s)
-	if err == nil {
-		err = ResourceAviRoleRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "role", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviRoleRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviRoleUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviRoleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceRoleSchema()
-	var err error
-	err = APICreateOrUpdate(d, meta, "role", s)
-	if err == nil {
-		err = ResourceAviRoleRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "role", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviRoleRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviRoleDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAviRoleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	objType := "role"
-	client := meta.(*clients.AviClient)
-	if APIDeleteSystemDefaultCheck(d) {
-		return nil
-	}
-	uuid := d.Get("uuid").(string)
-	if uuid != "" {
-		path := "api/" + objType + "/" + uuid
-		err := client.AviSession.Delete(path)
-		if err != nil && !(strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "204") || strings.Contains(err.Error(), "403")) {
-			log.Println("[INFO] resourceAviRoleDelete not found")
-			return err
-		}
-		d.SetId("")
-	}
-	return nil
+	return APIDelete(ctx, d, meta, objType)
 }
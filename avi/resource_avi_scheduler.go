@@ -4,11 +4,11 @@
 package avi
 
 import (
+	"context"
 	"log"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/vmware/alb-sdk/go/clients"
 )
 
 func ResourceSchedulerSchema() map[string]*schema.Schema {
@@ -25,10 +25,9 @@ func ResourceSchedulerSchema() map[string]*schema.Schema {
 			Elem:     ResourceConfigPbAttributesSchema(),
 		},
 		"enabled": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "true",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  true,
 		},
 		"end_date_time": {
 			Type:     schema.TypeString,
@@ -36,10 +35,9 @@ func ResourceSchedulerSchema() map[string]*schema.Schema {
 			Computed: true,
 		},
 		"frequency": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Computed:     true,
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Computed: true,
 		},
 		"frequency_unit": {
 			Type:     schema.TypeString,
@@ -85,65 +83,50 @@ func ResourceSchedulerSchema() map[string]*schema.Schema {
 
 func resourceAviScheduler() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviSchedulerCreate,
-		Read:   ResourceAviSchedulerRead,
-		Update: resourceAviSchedulerUpdate,
-		Delete: resourceAviSchedulerDelete,
-		Schema: ResourceSchedulerSchema(),
+		CreateContext: resourceAviSchedulerCreate,
+		ReadContext:   ResourceAviSchedulerRead,
+		UpdateContext: resourceAviSchedulerUpdate,
+		DeleteContext: resourceAviSchedulerDelete,
+		Schema:        ResourceSchedulerSchema(),
 		Importer: &schema.ResourceImporter{
-			State: ResourceSchedulerImporter,
+			StateContext: ResourceSchedulerImporter,
 		},
 	}
 }
 
-func ResourceSchedulerImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func ResourceSchedulerImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	s := ResourceSchedulerSchema()
-	return ResourceImporter(d, m, "scheduler", s)
+	return ResourceImporter(ctx, d, m, "scheduler", s)
 }
 
-func ResourceAviSchedulerRead(d *schema.ResourceData, meta interface{}) error {
+func ResourceAviSchedulerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceSchedulerSchema()
-	err := APIRead(d, meta, "scheduler", s)
-	if err != nil {
-		log.Printf("[ERROR] in reading object %v\n", err)
+	diags := APIRead(ctx, d, meta, "scheduler", s)
+	if diags.HasError() {
+		log.Printf("[ERROR] in reading object %v\n", diags)
 	}
-	return err
+	return diags
 }
 
-func resourceAviSchedulerCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviSchedulerCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceSchedulerSchema()
-	err := APICreateOrUpdate(d, meta, "scheduler", s)
-	if err == nil {
-		err = ResourceAviSchedulerRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "scheduler", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviSchedulerRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviSchedulerUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviSchedulerUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceSchedulerSchema()
-	var err error
-	err = APICreateOrUpdate(d, meta, "scheduler", s)
-	if err == nil {
-		err = ResourceAviSchedulerRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "scheduler", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviSchedulerRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviSchedulerDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAviSchedulerDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	objType := "scheduler"
-	client := meta.(*clients.AviClient)
-	if APIDeleteSystemDefaultCheck(d) {
-		return nil
-	}
-	uuid := d.Get("uuid").(string)
-	if uuid != "" {
-		path := "api/" + objType + "/" + uuid
-		err := client.AviSession.Delete(path)
-		if err != nil && !(strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "204") || strings.Contains(err.Error(), "403")) {
-			log.Println("[INFO] resourceAviSchedulerDelete not found")
-			return err
-		}
-		d.SetId("")
-	}
-	return nil
+	return APIDelete(ctx, d, meta, objType)
 }
@@ -4,11 +4,11 @@
 package avi
 
 import (
+	"context"
 	"log"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/vmware/alb-sdk/go/clients"
 )
 
 func ResourceSecurityManagerDataSchema() map[string]*schema.Schema {
@@ -37,65 +37,50 @@ func ResourceSecurityManagerDataSchema() map[string]*schema.Schema {
 
 func resourceAviSecurityManagerData() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviSecurityManagerDataCreate,
-		Read:   ResourceAviSecurityManagerDataRead,
-		Update: resourceAviSecurityManagerDataUpdate,
-		Delete: resourceAviSecurityManagerDataDelete,
-		Schema: ResourceSecurityManagerDataSchema(),
+		CreateContext: resourceAviSecurityManagerDataCreate,
+		ReadContext:   ResourceAviSecurityManagerDataRead,
+		UpdateContext: resourceAviSecurityManagerDataUpdate,
+		DeleteContext: resourceAviSecurityManagerDataDelete,
+		Schema:        ResourceSecurityManagerDataSchema(),
 		Importer: &schema.ResourceImporter{
-			State: ResourceSecurityManagerDataImporter,
+			StateContext: ResourceSecurityManagerDataImporter,
 		},
 	}
 }
 
-func ResourceSecurityManagerDataImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func ResourceSecurityManagerDataImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	s := ResourceSecurityManagerDataSchema()
-	return ResourceImporter(d, m, "securitymanagerdata", s)
+	return ResourceImporter(ctx, d, m, "securitymanagerdata", s)
 }
 
-func ResourceAviSecurityManagerDataRead(d *schema.ResourceData, meta interface{}) error {
+func ResourceAviSecurityManagerDataRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceSecurityManagerDataSchema()
-	err := APIRead(d, meta, "securitymanagerdata", s)
-	if err != nil {
-		log.Printf("[ERROR] in reading object %v\n", err)
+	diags := APIRead(ctx, d, meta, "securitymanagerdata", s)
+	if diags.HasError() {
+		log.Printf("[ERROR] in reading object %v\n", diags)
 	}
-	return err
+	return diags
 }
 
-func resourceAviSecurityManagerDataCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviSecurityManagerDataCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceSecurityManagerDataSchema()
-	err := APICreateOrUpdate(d, meta, "securitymanagerdata", s)
-	if err == nil {
-		err = ResourceAviSecurityManagerDataRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "securitymanagerdata", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviSecurityManagerDataRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviSecurityManagerDataUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviSecurityManagerDataUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceSecurityManagerDataSchema()
-	var err error
-	err = APICreateOrUpdate(d, meta, "securitymanagerdata", s)
-	if err == nil {
-		err = ResourceAviSecurityManagerDataRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "securitymanagerdata", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviSecurityManagerDataRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviSecurityManagerDataDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAviSecurityManagerDataDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	objType := "securitymanagerdata"
-	client := meta.(*clients.AviClient)
-	if APIDeleteSystemDefaultCheck(d) {
-		return nil
-	}
-	uuid := d.Get("uuid").(string)
-	if uuid != "" {
-		path := "api/" + objType + "/" + uuid
-		err := client.AviSession.Delete(path)
-		if err != nil && !(strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "204") || strings.Contains(err.Error(), "403")) {
-			log.Println("[INFO] resourceAviSecurityManagerDataDelete not found")
-			return err
-		}
-		d.SetId("")
-	}
-	return nil
+	return APIDelete(ctx, d, meta, objType)
 }
@@ -4,11 +4,11 @@
 package avi
 
 import (
+	"context"
 	"log"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/vmware/alb-sdk/go/clients"
 )
 
 func ResourceSecurityPolicySchema() map[string]*schema.Schema {
@@ -37,10 +37,9 @@ func ResourceSecurityPolicySchema() map[string]*schema.Schema {
 			Elem:     ResourceDnsAttacksSchema(),
 		},
 		"dns_policy_index": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "0",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  0,
 		},
 		"markers": {
 			Type:     schema.TypeList,
@@ -52,10 +51,9 @@ func ResourceSecurityPolicySchema() map[string]*schema.Schema {
 			Required: true,
 		},
 		"network_security_policy_index": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "0",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  0,
 		},
 		"oper_mode": {
 			Type:     schema.TypeString,
@@ -89,65 +87,50 @@ func ResourceSecurityPolicySchema() map[string]*schema.Schema {
 
 func resourceAviSecurityPolicy() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviSecurityPolicyCreate,
-		Read:   ResourceAviSecurityPolicyRead,
-		Update: resourceAviSecurityPolicyUpdate,
-		Delete: resourceAviSecurityPolicyDelete,
-		Schema: ResourceSecurityPolicySchema(),
+		CreateContext: resourceAviSecurityPolicyCreate,
+		ReadContext:   ResourceAviSecurityPolicyRead,
+		UpdateContext: resourceAviSecurityPolicyUpdate,
+		DeleteContext: resourceAviSecurityPolicyDelete,
+		Schema:        ResourceSecurityPolicySchema(),
 		Importer: &schema.ResourceImporter{
-			State: ResourceSecurityPolicyImporter,
+			StateContext: ResourceSecurityPolicyImporter,
 		},
 	}
 }
 
-func ResourceSecurityPolicyImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func ResourceSecurityPolicyImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	s := ResourceSecurityPolicySchema()
-	return ResourceImporter(d, m, "securitypolicy", s)
+	return ResourceImporter(ctx, d, m, "securitypolicy", s)
 }
 
-func ResourceAviSecurityPolicyRead(d *schema.ResourceData, meta interface{}) error {
+func ResourceAviSecurityPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceSecurityPolicySchema()
-	err := APIRead(d, meta, "securitypolicy", s)
-	if err != nil {
-		log.Printf("[ERROR] in reading object %v\n", err)
+	diags := APIRead(ctx, d, meta, "securitypolicy", s)
+	if diags.HasError() {
+		log.Printf("[ERROR] in reading object %v\n", diags)
 	}
-	return err
+	return diags
 }
 
-func resourceAviSecurityPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviSecurityPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceSecurityPolicySchema()
-	err := APICreateOrUpdate(d, meta, "securitypolicy", s)
-	if err == nil {
-		err = ResourceAviSecurityPolicyRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "securitypolicy", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviSecurityPolicyRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviSecurityPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviSecurityPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceSecurityPolicySchema()
-	var err error
-	err = APICreateOrUpdate(d, meta, "securitypolicy", s)
-	if err == nil {
-		err = ResourceAviSecurityPolicyRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "securitypolicy", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviSecurityPolicyRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviSecurityPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAviSecurityPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	objType := "securitypolicy"
-	client := meta.(*clients.AviClient)
-	if APIDeleteSystemDefaultCheck(d) {
-		return nil
-	}
-	uuid := d.Get("uuid").(string)
-	if uuid != "" {
-		path := "api/" + objType + "/" + uuid
-		err := client.AviSession.Delete(path)
-		if err != nil && !(strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "204") || strings.Contains(err.Error(), "403")) {
-			log.Println("[INFO] resourceAviSecurityPolicyDelete not found")
-			return err
-		}
-		d.SetId("")
-	}
-	return nil
+	return APIDelete(ctx, d, meta, objType)
 }
@@ -4,11 +4,11 @@
 package avi
 
 import (
+	"context"
 	"log"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/vmware/alb-sdk/go/clients"
 )
 
 func ResourceSePropertiesSchema() map[string]*schema.Schema {
@@ -47,65 +47,50 @@ func ResourceSePropertiesSchema() map[string]*schema.Schema {
 
 func resourceAviSeProperties() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviSePropertiesCreate,
-		Read:   ResourceAviSePropertiesRead,
-		Update: resourceAviSePropertiesUpdate,
-		Delete: resourceAviSePropertiesDelete,
-		Schema: ResourceSePropertiesSchema(),
+		CreateContext: resourceAviSePropertiesCreate,
+		ReadContext:   ResourceAviSePropertiesRead,
+		UpdateContext: resourceAviSePropertiesUpdate,
+		DeleteContext: resourceAviSePropertiesDelete,
+		Schema:        ResourceSePropertiesSchema(),
 		Importer: &schema.ResourceImporter{
-			State: ResourceSePropertiesImporter,
+			StateContext: ResourceSePropertiesImporter,
 		},
 	}
 }
 
-func ResourceSePropertiesImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func ResourceSePropertiesImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	s := ResourceSePropertiesSchema()
-	return ResourceImporter(d, m, "seproperties", s)
+	return ResourceImporter(ctx, d, m, "seproperties", s)
 }
 
-func ResourceAviSePropertiesRead(d *schema.ResourceData, meta interface{}) error {
+func ResourceAviSePropertiesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceSePropertiesSchema()
-	err := APIRead(d, meta, "seproperties", s)
-	if err != nil {
-		log.Printf("[ERROR] in reading object %v\n", err)
+	diags := APIRead(ctx, d, meta, "seproperties", s)
+	if diags.HasError() {
+		log.Printf("[ERROR] in reading object %v\n", diags)
 	}
-	return err
+	return diags
 }
 
-func resourceAviSePropertiesCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviSePropertiesCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceSePropertiesSchema()
-	err := APICreateOrUpdate(d, meta, "seproperties", s)
-	if err == nil {
-		err = ResourceAviSePropertiesRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "seproperties", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviSePropertiesRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviSePropertiesUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviSePropertiesUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceSePropertiesSchema()
-	var err error
-	err = APICreateOrUpdate(d, meta, "seproperties", s)
-	if err == nil {
-		err = ResourceAviSePropertiesRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "seproperties", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviSePropertiesRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviSePropertiesDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAviSePropertiesDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	objType := "seproperties"
-	client := meta.(*clients.AviClient)
-	if APIDeleteSystemDefaultCheck(d) {
-		return nil
-	}
-	uuid := d.Get("uuid").(string)
-	if uuid != "" {
-		path := "api/" + objType + "/" + uuid
-		err := client.AviSession.Delete(path)
-		if err != nil && !(strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "204") || strings.Contains(err.Error(), "403")) {
-			log.Println("[INFO] resourceAviSePropertiesDelete not found")
-			return err
-		}
-		d.SetId("")
-	}
-	return nil
+	return APIDelete(ctx, d, meta, objType)
 }
@@ -4,11 +4,11 @@
 package avi
 
 import (
+	"context"
 	"log"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/vmware/alb-sdk/go/clients"
 )
 
 func ResourceServerAutoScalePolicySchema() map[string]*schema.Schema {
@@ -20,10 +20,9 @@ func ResourceServerAutoScalePolicySchema() map[string]*schema.Schema {
 			Elem:     ResourceConfigPbAttributesSchema(),
 		},
 		"delay_for_server_garbage_collection": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "0",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  0,
 		},
 		"description": {
 			Type:     schema.TypeString,
@@ -31,22 +30,19 @@ func ResourceServerAutoScalePolicySchema() map[string]*schema.Schema {
 			Computed: true,
 		},
 		"intelligent_autoscale": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "false",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
 		},
 		"intelligent_scalein_margin": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "40",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  40,
 		},
 		"intelligent_scaleout_margin": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "20",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  20,
 		},
 		"markers": {
 			Type:     schema.TypeList,
@@ -54,28 +50,24 @@ func ResourceServerAutoScalePolicySchema() map[string]*schema.Schema {
 			Elem:     ResourceRoleFilterMatchLabelSchema(),
 		},
 		"max_scalein_adjustment_step": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "1",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  1,
 		},
 		"max_scaleout_adjustment_step": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "1",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  1,
 		},
 		"max_size": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Computed:     true,
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Computed: true,
 		},
 		"min_size": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Computed:     true,
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Computed: true,
 		},
 		"name": {
 			Type:     schema.TypeString,
@@ -87,10 +79,9 @@ func ResourceServerAutoScalePolicySchema() map[string]*schema.Schema {
 			Elem:     &schema.Schema{Type: schema.TypeString},
 		},
 		"scalein_cooldown": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "300",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  300,
 		},
 		"scaleout_alertconfig_refs": {
 			Type:     schema.TypeList,
@@ -98,10 +89,9 @@ func ResourceServerAutoScalePolicySchema() map[string]*schema.Schema {
 			Elem:     &schema.Schema{Type: schema.TypeString},
 		},
 		"scaleout_cooldown": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "300",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  300,
 		},
 		"scheduled_scalings": {
 			Type:     schema.TypeList,
@@ -114,10 +104,9 @@ func ResourceServerAutoScalePolicySchema() map[string]*schema.Schema {
 			Computed: true,
 		},
 		"use_predicted_load": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "false",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
 		},
 		"uuid": {
 			Type:     schema.TypeString,
@@ -129,65 +118,50 @@ func ResourceServerAutoScalePolicySchema() map[string]*schema.Schema {
 
 func resourceAviServerAutoScalePolicy() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviServerAutoScalePolicyCreate,
-		Read:   ResourceAviServerAutoScalePolicyRead,
-		Update: resourceAviServerAutoScalePolicyUpdate,
-		Delete: resourceAviServerAutoScalePolicyDelete,
-		Schema: ResourceServerAutoScalePolicySchema(),
+		CreateContext: resourceAviServerAutoScalePolicyCreate,
+		ReadContext:   ResourceAviServerAutoScalePolicyRead,
+		UpdateContext: resourceAviServerAutoScalePolicyUpdate,
+		DeleteContext: resourceAviServerAutoScalePolicyDelete,
+		Schema:        ResourceServerAutoScalePolicySchema(),
 		Importer: &schema.ResourceImporter{
-			State: ResourceServerAutoScalePolicyImporter,
+			StateContext: ResourceServerAutoScalePolicyImporter,
 		},
 	}
 }
 
-func ResourceServerAutoScalePolicyImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func ResourceServerAutoScalePolicyImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	s := ResourceServerAutoScalePolicySchema()
-	return ResourceImporter(d, m, "serverautoscalepolicy", s)
+	return ResourceImporter(ctx, d, m, "serverautoscalepolicy", s)
 }
 
-func ResourceAviServerAutoScalePolicyRead(d *schema.ResourceData, meta interface{}) error {
+func ResourceAviServerAutoScalePolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceServerAutoScalePolicySchema()
-	err := APIRead(d, meta, "serverautoscalepolicy", s)
-	if err != nil {
-		log.Printf("[ERROR] in reading object %v\n", err)
+	diags := APIRead(ctx, d, meta, "serverautoscalepolicy", s)
+	if diags.HasError() {
+		log.Printf("[ERROR] in reading object %v\n", diags)
 	}
-	return err
+	return diags
 }
 
-func resourceAviServerAutoScalePolicyCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviServerAutoScalePolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceServerAutoScalePolicySchema()
-	err := APICreateOrUpdate(d, meta, "serverautoscalepolicy", s)
-	if err == nil {
-		err = ResourceAviServerAutoScalePolicyRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "serverautoscalepolicy", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviServerAutoScalePolicyRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviServerAutoScalePolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviServerAutoScalePolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceServerAutoScalePolicySchema()
-	var err error
-	err = APICreateOrUpdate(d, meta, "serverautoscalepolicy", s)
-	if err == nil {
-		err = ResourceAviServerAutoScalePolicyRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "serverautoscalepolicy", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviServerAutoScalePolicyRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviServerAutoScalePolicyDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAviServerAutoScalePolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	objType := "serverautoscalepolicy"
-	client := meta.(*clients.AviClient)
-	if APIDeleteSystemDefaultCheck(d) {
-		return nil
-	}
-	uuid := d.Get("uuid").(string)
-	if uuid != "" {
-		path := "api/" + objType + "/" + uuid
-		err := client.AviSession.Delete(path)
-		if err != nil && !(strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "204") || strings.Contains(err.Error(), "403")) {
-			log.Println("[INFO] resourceAviServerAutoScalePolicyDelete not found")
-			return err
-		}
-		d.SetId("")
-	}
-	return nil
+	return APIDelete(ctx, d, meta, objType)
 }
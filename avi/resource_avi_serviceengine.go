@@ -4,11 +4,11 @@
 package avi
 
 import (
+	"context"
 	"log"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/vmware/alb-sdk/go/clients"
 )
 
 func ResourceServiceEngineSchema() map[string]*schema.Schema {
@@ -24,10 +24,9 @@ func ResourceServiceEngineSchema() map[string]*schema.Schema {
 			Computed: true,
 		},
 		"container_mode": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "false",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
 		},
 		"container_type": {
 			Type:     schema.TypeString,
@@ -35,10 +34,9 @@ func ResourceServiceEngineSchema() map[string]*schema.Schema {
 			Default:  "CONTAINER_TYPE_HOST",
 		},
 		"controller_created": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "false",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
 		},
 		"controller_ip": {
 			Type:     schema.TypeString,
@@ -107,65 +105,50 @@ func ResourceServiceEngineSchema() map[string]*schema.Schema {
 
 func resourceAviServiceEngine() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviServiceEngineCreate,
-		Read:   ResourceAviServiceEngineRead,
-		Update: resourceAviServiceEngineUpdate,
-		Delete: resourceAviServiceEngineDelete,
-		Schema: ResourceServiceEngineSchema(),
+		CreateContext: resourceAviServiceEngineCreate,
+		ReadContext:   ResourceAviServiceEngineRead,
+		UpdateContext: resourceAviServiceEngineUpdate,
+		DeleteContext: resourceAviServiceEngineDelete,
+		Schema:        ResourceServiceEngineSchema(),
 		Importer: &schema.ResourceImporter{
-			State: ResourceServiceEngineImporter,
+			StateContext: ResourceServiceEngineImporter,
 		},
 	}
 }
 
-func ResourceServiceEngineImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func ResourceServiceEngineImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	s := ResourceServiceEngineSchema()
-	return ResourceImporter(d, m, "serviceengine", s)
+	return ResourceImporter(ctx, d, m, "serviceengine", s)
 }
 
-func ResourceAviServiceEngineRead(d *schema.ResourceData, meta interface{}) error {
+func ResourceAviServiceEngineRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceServiceEngineSchema()
-	err := APIRead(d, meta, "serviceengine", s)
-	if err != nil {
-		log.Printf("[ERROR] in reading object %v\n", err)
+	diags := APIRead(ctx, d, meta, "serviceengine", s)
+	if diags.HasError() {
+		log.Printf("[ERROR] in reading object %v\n", diags)
 	}
-	return err
+	return diags
 }
 
-func resourceAviServiceEngineCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviServiceEngineCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceServiceEngineSchema()
-	err := APICreateOrUpdate(d, meta, "serviceengine", s)
-	if err == nil {
-		err = ResourceAviServiceEngineRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "serviceengine", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviServiceEngineRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviServiceEngineUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviServiceEngineUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceServiceEngineSchema()
-	var err error
-	err = APICreateOrUpdate(d, meta, "serviceengine", s)
-	if err == nil {
-		err = ResourceAviServiceEngineRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "serviceengine", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviServiceEngineRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviServiceEngineDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAviServiceEngineDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	objType := "serviceengine"
-	client := meta.(*clients.AviClient)
-	if APIDeleteSystemDefaultCheck(d) {
-		return nil
-	}
-	uuid := d.Get("uuid").(string)
-	if uuid != "" {
-		path := "api/" + objType + "/" + uuid
-		err := client.AviSession.Delete(path)
-		if err != nil && !(strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "204") || strings.Contains(err.Error(), "403")) {
-			log.Println("[INFO] resourceAviServiceEngineDelete not found")
-			return err
-		}
-		d.SetId("")
-	}
-	return nil
+	return APIDelete(ctx, d, meta, objType)
 }
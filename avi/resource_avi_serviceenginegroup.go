@@ -4,34 +4,34 @@
 package avi
 
 import (
+	"context"
+	"fmt"
 	"log"
 	"strings"
 
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/vmware/alb-sdk/go/clients"
 )
 
 func ResourceServiceEngineGroupSchema() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
 		"accelerated_networking": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Computed:     true,
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Computed: true,
 		},
 		"active_standby": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "false",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
 		},
 		"aggressive_failure_detection": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "false",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
 		},
 		"algo": {
 			Type:     schema.TypeString,
@@ -39,52 +39,44 @@ func ResourceServiceEngineGroupSchema() map[string]*schema.Schema {
 			Default:  "PLACEMENT_ALGO_PACKED",
 		},
 		"allow_burst": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Computed:     true,
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Computed: true,
 		},
 		"app_cache_percent": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "10",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  10,
 		},
 		"app_cache_threshold": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "5",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  5,
 		},
 		"app_learning_memory_percent": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "0",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  0,
 		},
 		"archive_shm_limit": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "8",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  8,
 		},
 		"async_ssl": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "false",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
 		},
 		"async_ssl_threads": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "1",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  1,
 		},
 		"auto_rebalance": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "false",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
 		},
 		"auto_rebalance_capacity_per_se": {
 			Type:     schema.TypeList,
@@ -97,16 +89,14 @@ func ResourceServiceEngineGroupSchema() map[string]*schema.Schema {
 			Elem:     &schema.Schema{Type: schema.TypeString},
 		},
 		"auto_rebalance_interval": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "300",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  300,
 		},
 		"auto_redistribute_active_standby_load": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "false",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
 		},
 		"availability_zone_refs": {
 			Type:     schema.TypeList,
@@ -114,28 +104,24 @@ func ResourceServiceEngineGroupSchema() map[string]*schema.Schema {
 			Elem:     &schema.Schema{Type: schema.TypeString},
 		},
 		"baremetal_dispatcher_handles_flows": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "false",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
 		},
 		"bgp_peer_monitor_failover_enabled": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "false",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
 		},
 		"bgp_state_update_interval": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "60",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  60,
 		},
 		"buffer_se": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "1",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  1,
 		},
 		"cloud_ref": {
 			Type:     schema.TypeString,
@@ -143,16 +129,14 @@ func ResourceServiceEngineGroupSchema() map[string]*schema.Schema {
 			Computed: true,
 		},
 		"compress_ip_rules_for_each_ns_subnet": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "true",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  true,
 		},
 		"config_debugs_on_all_cores": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "false",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
 		},
 		"configpb_attributes": {
 			Type:     schema.TypeSet,
@@ -161,34 +145,29 @@ func ResourceServiceEngineGroupSchema() map[string]*schema.Schema {
 			Elem:     ResourceConfigPbAttributesSchema(),
 		},
 		"connection_memory_percentage": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "50",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  50,
 		},
 		"core_shm_app_cache": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "false",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
 		},
 		"core_shm_app_learning": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "false",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
 		},
 		"cpu_reserve": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "false",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
 		},
 		"cpu_socket_affinity": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "false",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
 		},
 		"custom_securitygroups_data": {
 			Type:     schema.TypeList,
@@ -211,28 +190,24 @@ func ResourceServiceEngineGroupSchema() map[string]*schema.Schema {
 			Computed: true,
 		},
 		"datascript_timeout": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "1000000",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  1000000,
 		},
 		"deactivate_ipv6_discovery": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Computed:     true,
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Computed: true,
 		},
 		"deactivate_kni_filtering_at_dispatcher": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Computed:     true,
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Computed: true,
 		},
 		"dedicated_dispatcher_core": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Computed:     true,
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Computed: true,
 		},
 		"description": {
 			Type:     schema.TypeString,
@@ -240,190 +215,159 @@ func ResourceServiceEngineGroupSchema() map[string]*schema.Schema {
 			Computed: true,
 		},
 		"disable_avi_securitygroups": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "false",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
 		},
 		"disable_csum_offloads": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "false",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
 		},
 		"disable_flow_probes": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "false",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
 		},
 		"disable_gro": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Computed:     true,
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Computed: true,
 		},
 		"disable_se_memory_check": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "false",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
 		},
 		"disable_tso": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "false",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
 		},
 		"disk_per_se": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "15",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  15,
 		},
 		"distribute_load_active_standby": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "false",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
 		},
 		"distribute_queues": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "false",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
 		},
 		"distribute_vnics": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "false",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
 		},
 		"downstream_send_timeout": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "3600000",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  3600000,
 		},
 		"dp_aggressive_deq_interval_msec": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "1",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  1,
 		},
 		"dp_aggressive_enq_interval_msec": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "1",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  1,
 		},
 		"dp_aggressive_hb_frequency": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "100",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  100,
 		},
 		"dp_aggressive_hb_timeout_count": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "10",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  10,
 		},
 		"dp_deq_interval_msec": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "20",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  20,
 		},
 		"dp_enq_interval_msec": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "20",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  20,
 		},
 		"dp_hb_frequency": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "100",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  100,
 		},
 		"dp_hb_timeout_count": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "10",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  10,
 		},
 		"dpdk_gro_timeout_interval": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "50",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  50,
 		},
 		"enable_gratarp_permanent": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "false",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
 		},
 		"enable_hsm_log": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "false",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
 		},
 		"enable_hsm_priming": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "false",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
 		},
 		"enable_multi_lb": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "false",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
 		},
 		"enable_pcap_tx_ring": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Computed:     true,
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Computed: true,
 		},
 		"ephemeral_portrange_end": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Computed:     true,
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Computed: true,
 		},
 		"ephemeral_portrange_start": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Computed:     true,
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Computed: true,
 		},
 		"extra_config_multiplier": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "0",
-			ValidateFunc: validateFloat,
+			Type:     schema.TypeFloat,
+			Optional: true,
+			Default:  0.0,
 		},
 		"extra_shared_config_memory": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "0",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  0,
 		},
 		"flow_table_new_syn_max_entries": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "0",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  0,
 		},
 		"free_list_size": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "1024",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  1024,
 		},
 		"gcp_config": {
 			Type:     schema.TypeSet,
@@ -432,16 +376,14 @@ func ResourceServiceEngineGroupSchema() map[string]*schema.Schema {
 			Elem:     ResourceGCPSeGroupConfigSchema(),
 		},
 		"gratarp_permanent_periodicity": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "10",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  10,
 		},
 		"grpc_channel_connect_timeout": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "15",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  15,
 		},
 		"ha_mode": {
 			Type:     schema.TypeString,
@@ -449,10 +391,9 @@ func ResourceServiceEngineGroupSchema() map[string]*schema.Schema {
 			Default:  "HA_MODE_SHARED",
 		},
 		"handle_per_pkt_attack": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "true",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  true,
 		},
 		"hardwaresecuritymodulegroup_ref": {
 			Type:     schema.TypeString,
@@ -460,16 +401,14 @@ func ResourceServiceEngineGroupSchema() map[string]*schema.Schema {
 			Computed: true,
 		},
 		"heap_minimum_config_memory": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "8",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  8,
 		},
 		"hm_on_standby": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "true",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  true,
 		},
 		"host_attribute_key": {
 			Type:     schema.TypeString,
@@ -482,28 +421,24 @@ func ResourceServiceEngineGroupSchema() map[string]*schema.Schema {
 			Computed: true,
 		},
 		"host_gateway_monitor": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "false",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
 		},
 		"http_rum_console_log": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "false",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
 		},
 		"http_rum_min_content_length": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "64",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  64,
 		},
 		"hybrid_rss_mode": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "false",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
 		},
 		"hypervisor": {
 			Type:     schema.TypeString,
@@ -511,16 +446,14 @@ func ResourceServiceEngineGroupSchema() map[string]*schema.Schema {
 			Computed: true,
 		},
 		"ignore_docker_mac_change": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "true",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  true,
 		},
 		"ignore_rtt_threshold": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "5000",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  5000,
 		},
 		"ingress_access_data": {
 			Type:     schema.TypeString,
@@ -554,16 +487,14 @@ func ResourceServiceEngineGroupSchema() map[string]*schema.Schema {
 			Elem:     ResourceKniPortRangeSchema(),
 		},
 		"l7_conns_per_core": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "16384",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  16384,
 		},
 		"l7_resvd_listen_conns_per_core": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "256",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  256,
 		},
 		"labels": {
 			Type:     schema.TypeList,
@@ -571,22 +502,19 @@ func ResourceServiceEngineGroupSchema() map[string]*schema.Schema {
 			Elem:     ResourceKeyValueSchema(),
 		},
 		"lbaction_num_requests_to_dispatch": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "4",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  4,
 		},
 		"lbaction_rq_per_request_max_retries": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "22",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  22,
 		},
 		"least_load_core_selection": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "true",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  true,
 		},
 		"license_tier": {
 			Type:     schema.TypeString,
@@ -599,106 +527,89 @@ func ResourceServiceEngineGroupSchema() map[string]*schema.Schema {
 			Computed: true,
 		},
 		"log_agent_compress_logs": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "true",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  true,
 		},
 		"log_agent_debug_enabled": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "false",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
 		},
 		"log_agent_file_sz_appl": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "4",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  4,
 		},
 		"log_agent_file_sz_conn": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "4",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  4,
 		},
 		"log_agent_file_sz_debug": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "4",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  4,
 		},
 		"log_agent_file_sz_event": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "4",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  4,
 		},
 		"log_agent_log_storage_min_sz": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "1024",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  1024,
 		},
 		"log_agent_max_concurrent_rsync": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "1024",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  1024,
 		},
 		"log_agent_max_storage_excess_percent": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "110",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  110,
 		},
 		"log_agent_max_storage_ignore_percent": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "20",
-			ValidateFunc: validateFloat,
+			Type:     schema.TypeFloat,
+			Optional: true,
+			Default:  20.0,
 		},
 		"log_agent_min_storage_per_vs": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "10",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  10,
 		},
 		"log_agent_sleep_interval": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "10",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  10,
 		},
 		"log_agent_trace_enabled": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "true",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  true,
 		},
 		"log_agent_unknown_vs_timer": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "1800",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  1800,
 		},
 		"log_disksz": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "10000",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  10000,
 		},
 		"log_malloc_failure": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "true",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  true,
 		},
 		"log_message_max_file_list_size": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "64",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  64,
 		},
 		"markers": {
 			Type:     schema.TypeList,
@@ -706,88 +617,74 @@ func ResourceServiceEngineGroupSchema() map[string]*schema.Schema {
 			Elem:     ResourceRoleFilterMatchLabelSchema(),
 		},
 		"max_concurrent_external_hm": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Computed:     true,
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Computed: true,
 		},
 		"max_cpu_usage": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "80",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  80,
 		},
 		"max_memory_per_mempool": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "64",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  64,
 		},
 		"max_num_se_dps": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Computed:     true,
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Computed: true,
 		},
 		"max_public_ips_per_lb": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "30",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  30,
 		},
 		"max_queues_per_vnic": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "1",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  1,
 		},
 		"max_rules_per_lb": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "150",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  150,
 		},
 		"max_scaleout_per_vs": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "4",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  4,
 		},
 		"max_se": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "10",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  10,
 		},
 		"max_skb_frags": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "17",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  17,
 		},
 		"max_vs_per_se": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "10",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  10,
 		},
 		"mem_reserve": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "true",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  true,
 		},
 		"memory_for_config_update": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "15",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  15,
 		},
 		"memory_per_se": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "2048",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  2048,
 		},
 		"mgmt_network_ref": {
 			Type:     schema.TypeString,
@@ -801,98 +698,83 @@ func ResourceServiceEngineGroupSchema() map[string]*schema.Schema {
 			Elem:     ResourceIpAddrPrefixSchema(),
 		},
 		"min_cpu_usage": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "30",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  30,
 		},
 		"min_scaleout_per_vs": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "1",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  1,
 		},
 		"min_se": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "1",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  1,
 		},
 		"minimum_connection_memory": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "20",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  20,
 		},
 		"n_log_streaming_threads": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "1",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  1,
 		},
 		"name": {
 			Type:     schema.TypeString,
 			Required: true,
 		},
 		"netlink_poller_threads": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "2",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  2,
 		},
 		"netlink_sock_buf_size": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "4",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  4,
 		},
 		"ngx_free_connection_stack": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "false",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
 		},
 		"non_significant_log_throttle": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "100",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  100,
 		},
 		"ns_helper_deq_interval_msec": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "20",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  20,
 		},
 		"ntp_sync_fail_event": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "false",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
 		},
 		"ntp_sync_status_interval": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "0",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  0,
 		},
 		"num_dispatcher_cores": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "0",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  0,
 		},
 		"num_dispatcher_queues": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "1",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  1,
 		},
 		"num_flow_cores_sum_changes_to_ignore": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "8",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  8,
 		},
 		"objsync_config": {
 			Type:     schema.TypeSet,
@@ -901,10 +783,9 @@ func ResourceServiceEngineGroupSchema() map[string]*schema.Schema {
 			Elem:     ResourceObjSyncConfigSchema(),
 		},
 		"objsync_port": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "9001",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  9001,
 		},
 		"openstack_availability_zones": {
 			Type:     schema.TypeList,
@@ -922,10 +803,9 @@ func ResourceServiceEngineGroupSchema() map[string]*schema.Schema {
 			Computed: true,
 		},
 		"os_reserved_memory": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "0",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  0,
 		},
 		"pcap_tx_mode": {
 			Type:     schema.TypeString,
@@ -933,22 +813,19 @@ func ResourceServiceEngineGroupSchema() map[string]*schema.Schema {
 			Default:  "PCAP_TX_AUTO",
 		},
 		"pcap_tx_ring_rd_balancing_factor": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "10",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  10,
 		},
 		"per_app": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "false",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
 		},
 		"per_vs_admission_control": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "false",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
 		},
 		"placement_mode": {
 			Type:     schema.TypeString,
@@ -962,34 +839,29 @@ func ResourceServiceEngineGroupSchema() map[string]*schema.Schema {
 			Elem:     ResourceMetricsRealTimeUpdateSchema(),
 		},
 		"reboot_on_panic": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "true",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  true,
 		},
 		"resync_time_interval": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "65536",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  65536,
 		},
 		"sdb_flush_interval": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "100",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  100,
 		},
 		"sdb_pipeline_size": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "100",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  100,
 		},
 		"sdb_scan_count": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "1000",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  1000,
 		},
 		"se_bandwidth_type": {
 			Type:     schema.TypeString,
@@ -997,16 +869,14 @@ func ResourceServiceEngineGroupSchema() map[string]*schema.Schema {
 			Computed: true,
 		},
 		"se_delayed_flow_delete": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "true",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  true,
 		},
 		"se_deprovision_delay": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "120",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  120,
 		},
 		"se_dos_profile": {
 			Type:     schema.TypeSet,
@@ -1015,106 +885,89 @@ func ResourceServiceEngineGroupSchema() map[string]*schema.Schema {
 			Elem:     ResourceDosThresholdProfileSchema(),
 		},
 		"se_dp_hm_drops": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "0",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  0,
 		},
 		"se_dp_if_state_poll_interval": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "10",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  10,
 		},
 		"se_dp_isolation": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "false",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
 		},
 		"se_dp_isolation_num_non_dp_cpus": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "0",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  0,
 		},
 		"se_dp_log_nf_enqueue_percent": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "70",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  70,
 		},
 		"se_dp_log_udf_enqueue_percent": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "90",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  90,
 		},
 		"se_dp_max_hb_version": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "3",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  3,
 		},
 		"se_dp_vnic_queue_stall_event_sleep": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "0",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  0,
 		},
 		"se_dp_vnic_queue_stall_threshold": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "2000",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  2000,
 		},
 		"se_dp_vnic_queue_stall_timeout": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "10000",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  10000,
 		},
 		"se_dp_vnic_restart_on_queue_stall_count": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "3",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  3,
 		},
 		"se_dp_vnic_stall_se_restart_window": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "3600",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  3600,
 		},
 		"se_dpdk_pmd": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "0",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  0,
 		},
 		"se_dump_core_on_assert": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "false",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
 		},
 		"se_emulated_cores": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "0",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  0,
 		},
 		"se_flow_probe_retries": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "2",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  2,
 		},
 		"se_flow_probe_retry_timer": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "40",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  40,
 		},
 		"se_group_analytics_policy": {
 			Type:     schema.TypeSet,
@@ -1128,58 +981,49 @@ func ResourceServiceEngineGroupSchema() map[string]*schema.Schema {
 			Default:  "SE_CPU_HT_AUTO",
 		},
 		"se_ip_encap_ipc": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "0",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  0,
 		},
 		"se_kni_burst_factor": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "0",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  0,
 		},
 		"se_l3_encap_ipc": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "0",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  0,
 		},
 		"se_log_buffer_app_blocking_dequeue": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "false",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
 		},
 		"se_log_buffer_conn_blocking_dequeue": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "false",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
 		},
 		"se_log_buffer_events_blocking_dequeue": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "true",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  true,
 		},
 		"se_lro": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "true",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  true,
 		},
 		"se_mp_ring_retry_count": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "500",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  500,
 		},
 		"se_mtu": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Computed:     true,
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Computed: true,
 		},
 		"se_name_prefix": {
 			Type:     schema.TypeString,
@@ -1187,52 +1031,44 @@ func ResourceServiceEngineGroupSchema() map[string]*schema.Schema {
 			Default:  "Avi",
 		},
 		"se_packet_buffer_max": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "0",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  0,
 		},
 		"se_pcap_lookahead": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "false",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
 		},
 		"se_pcap_pkt_count": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "0",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  0,
 		},
 		"se_pcap_pkt_sz": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "69632",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  69632,
 		},
 		"se_pcap_qdisc_bypass": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "true",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  true,
 		},
 		"se_pcap_reinit_frequency": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "0",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  0,
 		},
 		"se_pcap_reinit_threshold": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "0",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  0,
 		},
 		"se_probe_port": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "7",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  7,
 		},
 		"se_rl_prop": {
 			Type:     schema.TypeSet,
@@ -1241,46 +1077,39 @@ func ResourceServiceEngineGroupSchema() map[string]*schema.Schema {
 			Elem:     ResourceRateLimiterPropertiesSchema(),
 		},
 		"se_rum_sampling_nav_interval": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "1",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  1,
 		},
 		"se_rum_sampling_nav_percent": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "1",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  1,
 		},
 		"se_rum_sampling_res_interval": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "2",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  2,
 		},
 		"se_rum_sampling_res_percent": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "100",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  100,
 		},
 		"se_sb_dedicated_core": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "false",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
 		},
 		"se_sb_threads": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "1",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  1,
 		},
 		"se_thread_multiplier": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "1",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  1,
 		},
 		"se_time_tracker_props": {
 			Type:     schema.TypeSet,
@@ -1295,76 +1124,64 @@ func ResourceServiceEngineGroupSchema() map[string]*schema.Schema {
 			Elem:     ResourcePortRangeSchema(),
 		},
 		"se_tunnel_mode": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "0",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  0,
 		},
 		"se_tunnel_udp_port": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "1550",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  1550,
 		},
 		"se_tx_batch_size": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "64",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  64,
 		},
 		"se_txq_threshold": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "2048",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  2048,
 		},
 		"se_udp_encap_ipc": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "0",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  0,
 		},
 		"se_use_dpdk": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "0",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  0,
 		},
 		"se_vnic_tx_sw_queue_flush_frequency": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "0",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  0,
 		},
 		"se_vnic_tx_sw_queue_size": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "256",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  256,
 		},
 		"se_vs_hb_max_pkts_in_batch": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "64",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  64,
 		},
 		"se_vs_hb_max_vs_in_pkt": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "256",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  256,
 		},
 		"self_se_election": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "false",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
 		},
 		"send_se_ready_timeout": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "300",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  300,
 		},
 		"service_ip6_subnets": {
 			Type:     schema.TypeList,
@@ -1377,28 +1194,24 @@ func ResourceServiceEngineGroupSchema() map[string]*schema.Schema {
 			Elem:     ResourceIpAddrPrefixSchema(),
 		},
 		"shm_minimum_config_memory": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "4",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  4,
 		},
 		"significant_log_throttle": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "100",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  100,
 		},
 		"ssl_preprocess_sni_hostname": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "true",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  true,
 		},
 		"ssl_sess_cache_per_vs": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "4096",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  4096,
 		},
 		"tenant_ref": {
 			Type:     schema.TypeString,
@@ -1406,64 +1219,54 @@ func ResourceServiceEngineGroupSchema() map[string]*schema.Schema {
 			Computed: true,
 		},
 		"transient_shared_memory_max": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "30",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  30,
 		},
 		"udf_log_throttle": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "100",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  100,
 		},
 		"upstream_connect_timeout": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "3600000",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  3600000,
 		},
 		"upstream_connpool_enable": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "true",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  true,
 		},
 		"upstream_read_timeout": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "3600000",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  3600000,
 		},
 		"upstream_send_timeout": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "3600000",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  3600000,
 		},
 		"use_hyperthreaded_cores": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "true",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  true,
 		},
 		"use_legacy_netlink": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "false",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
 		},
 		"use_objsync": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "true",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  true,
 		},
 		"use_standard_alb": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Computed:     true,
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Computed: true,
 		},
 		"user_agent_cache_config": {
 			Type:     schema.TypeSet,
@@ -1472,10 +1275,9 @@ func ResourceServiceEngineGroupSchema() map[string]*schema.Schema {
 			Elem:     ResourceUserAgentCacheConfigSchema(),
 		},
 		"user_defined_metric_age": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "60",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  60,
 		},
 		"uuid": {
 			Type:     schema.TypeString,
@@ -1499,10 +1301,9 @@ func ResourceServiceEngineGroupSchema() map[string]*schema.Schema {
 			Elem:     ResourceVcenterDatastoreSchema(),
 		},
 		"vcenter_datastores_include": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "false",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
 		},
 		"vcenter_folder": {
 			Type:     schema.TypeString,
@@ -1526,10 +1327,9 @@ func ResourceServiceEngineGroupSchema() map[string]*schema.Schema {
 			Elem:     ResourcePlacementScopeConfigSchema(),
 		},
 		"vcpus_per_se": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "1",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  1,
 		},
 		"vip_asg": {
 			Type:     schema.TypeSet,
@@ -1538,82 +1338,69 @@ func ResourceServiceEngineGroupSchema() map[string]*schema.Schema {
 			Elem:     ResourceVipAutoscaleGroupSchema(),
 		},
 		"vnic_dhcp_ip_check_interval": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "6",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  6,
 		},
 		"vnic_dhcp_ip_max_retries": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "10",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  10,
 		},
 		"vnic_ip_delete_interval": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "5",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  5,
 		},
 		"vnic_probe_interval": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "5",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  5,
 		},
 		"vnic_rpc_retry_interval": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "5",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  5,
 		},
 		"vnicdb_cmd_history_size": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "256",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  256,
 		},
 		"vs_host_redundancy": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "true",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  true,
 		},
 		"vs_scalein_timeout": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "30",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  30,
 		},
 		"vs_scalein_timeout_for_upgrade": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "30",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  30,
 		},
 		"vs_scaleout_timeout": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "600",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  600,
 		},
 		"vs_se_scaleout_additional_wait_time": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "0",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  0,
 		},
 		"vs_se_scaleout_ready_timeout": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "60",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  60,
 		},
 		"vs_switchover_timeout": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "300",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  300,
 		},
 		"vss_placement": {
 			Type:     schema.TypeSet,
@@ -1622,104 +1409,132 @@ func ResourceServiceEngineGroupSchema() map[string]*schema.Schema {
 			Elem:     ResourceVssPlacementSchema(),
 		},
 		"vss_placement_enabled": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "false",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
 		},
 		"waf_mempool": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "true",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  true,
 		},
 		"waf_mempool_size": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "64",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  64,
 		},
 	}
 }
 
 func resourceAviServiceEngineGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviServiceEngineGroupCreate,
-		Read:   ResourceAviServiceEngineGroupRead,
-		Update: resourceAviServiceEngineGroupUpdate,
-		Delete: resourceAviServiceEngineGroupDelete,
-		Schema: ResourceServiceEngineGroupSchema(),
+		CreateContext: resourceAviServiceEngineGroupCreate,
+		ReadContext:   ResourceAviServiceEngineGroupRead,
+		UpdateContext: resourceAviServiceEngineGroupUpdate,
+		DeleteContext: resourceAviServiceEngineGroupDelete,
+		Schema:        ResourceServiceEngineGroupSchema(),
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(150 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: ResourceServiceEngineGroupImporter,
+			StateContext: ResourceServiceEngineGroupImporter,
 		},
 	}
 }
 
-func ResourceServiceEngineGroupImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func ResourceServiceEngineGroupImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	s := ResourceServiceEngineGroupSchema()
-	return ResourceImporter(d, m, "serviceenginegroup", s)
+	return ResourceImporter(ctx, d, m, "serviceenginegroup", s)
 }
 
-func ResourceAviServiceEngineGroupRead(d *schema.ResourceData, meta interface{}) error {
+func ResourceAviServiceEngineGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceServiceEngineGroupSchema()
-	err := APIRead(d, meta, "serviceenginegroup", s)
-	if err != nil {
-		log.Printf("[ERROR] in reading object %v\n", err)
+	diags := APIRead(ctx, d, meta, "serviceenginegroup", s)
+	if diags.HasError() {
+		log.Printf("[ERROR] in reading object %v\n", diags)
 	}
-	return err
+	return diags
 }
 
-func resourceAviServiceEngineGroupCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviServiceEngineGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceServiceEngineGroupSchema()
-	err := APICreateOrUpdate(d, meta, "serviceenginegroup", s)
-	if err == nil {
-		err = ResourceAviServiceEngineGroupRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "serviceenginegroup", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviServiceEngineGroupRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviServiceEngineGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviServiceEngineGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceServiceEngineGroupSchema()
-	var err error
-	err = APICreateOrUpdate(d, meta, "serviceenginegroup", s)
-	if err == nil {
-		err = ResourceAviServiceEngineGroupRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "serviceenginegroup", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviServiceEngineGroupRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviServiceEngineGroupDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAviServiceEngineGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	objType := "serviceenginegroup"
-	client := meta.(*clients.AviClient)
-	seDeprovisionExtraDelay := 2
+	client := meta.(*ProviderClient)
+	tenant, err := resourceTenant(ctx, d, client)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	if cloudRef, ok := d.GetOk("cloud_ref"); ok && strings.Contains(cloudRef.(string), "api/cloud/") {
 		cloudUUID := strings.SplitN(cloudRef.(string), "api/cloud/", 2)[1]
 		cloudPath := "api/cloud/" + cloudUUID
 		var robj interface{}
-		if err := client.AviSession.Get(cloudPath, &robj); err == nil {
+		if err := aviSessionCall(ctx, client, func() error {
+			return client.AviSession.Get(cloudPath, &robj, aviTenantOptions(tenant)...)
+		}); err == nil {
 			if vcenterConfig, isVcenterConfig := robj.(map[string]interface{})["vcenter_configuration"]; isVcenterConfig {
 				if privilege := vcenterConfig.(map[string]interface{})["privilege"].(string); privilege == "WRITE_ACCESS" {
 					seGroupName := d.Get("name").(string)
 					cloudName := robj.(map[string]interface{})["name"].(string)
-					seDeprovisionDelay := d.Get("se_deprovision_delay").(int) + seDeprovisionExtraDelay
-					log.Printf("Waiting for %v minutes to delete SE from SE Group %v of cloud %v", seDeprovisionDelay, seGroupName, cloudName)
-					time.Sleep(time.Duration(seDeprovisionDelay) * time.Minute)
+					log.Printf("Waiting for SEs of SE Group %v of cloud %v to be deprovisioned", seGroupName, cloudName)
+					if err := waitForServiceEnginesDeprovisioned(ctx, client, d.Get("uuid").(string), tenant,
+						d.Timeout(schema.TimeoutDelete)); err != nil {
+						return diag.FromErr(err)
+					}
 				}
 			}
 		}
 	}
-	if APIDeleteSystemDefaultCheck(d) {
-		return nil
+	return APIDelete(ctx, d, meta, objType)
+}
+
+// seDeprovisionPollInterval is the interval between two reads of the SEs of an SE group that is deleted.
+var seDeprovisionPollInterval = 30 * time.Second
+
+// Wait until the controller has deprovisioned all the SEs of the SE group of tenant. SEs are removed only
+// after se_deprovision_delay expires, so the wait is bounded by the delete timeout of the resource.
+func waitForServiceEnginesDeprovisioned(ctx context.Context, client *ProviderClient, seGroupUUID, tenant string,
+	timeout time.Duration) error {
+	path := "api/serviceengine?se_group_ref.uuid=" + seGroupUUID
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"pending"},
+		Target:  []string{"deprovisioned"},
+		Refresh: func() (interface{}, string, error) {
+			var robj map[string]interface{}
+			if err := aviSessionCall(ctx, client, func() error {
+				return client.AviSession.Get(path, &robj, aviTenantOptions(tenant)...)
+			}); err != nil {
+				return nil, "", err
+			}
+			if count, _ := robj["count"].(float64); count > 0 {
+				log.Printf("[DEBUG] %v SEs of SE Group %v are not deprovisioned yet", count, seGroupUUID)
+				return robj, "pending", nil
+			}
+			return robj, "deprovisioned", nil
+		},
+		Timeout:      timeout,
+		PollInterval: seDeprovisionPollInterval,
 	}
-	uuid := d.Get("uuid").(string)
-	if uuid != "" {
-		path := "api/" + objType + "/" + uuid
-		err := client.AviSession.Delete(path)
-		if err != nil && !(strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "204") || strings.Contains(err.Error(), "403")) {
-			log.Println("[INFO] resourceAviServiceEngineGroupDelete not found")
-			return err
-		}
-		d.SetId("")
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("error waiting for SEs of SE Group %s to be deprovisioned: %s", seGroupUUID, err)
 	}
 	return nil
 }
@@ -1,13 +1,14 @@
 package avi
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
-	"github.com/vmware/alb-sdk/go/clients"
 )
 
 func TestAVIServiceEngineGroupBasic(t *testing.T) {
@@ -337,7 +338,7 @@ func TestAVIServiceEngineGroupBasic(t *testing.T) {
 
 func testAccCheckAVIServiceEngineGroupExists(resourcename string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*clients.AviClient).AviSession
+		conn := testAccProvider.Meta().(*ProviderClient).AviSession
 		var obj interface{}
 		rs, ok := s.RootModule().Resources[resourcename]
 		if !ok {
@@ -359,7 +360,7 @@ func testAccCheckAVIServiceEngineGroupExists(resourcename string) resource.TestC
 }
 
 func testAccCheckAVIServiceEngineGroupDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*clients.AviClient).AviSession
+	conn := testAccProvider.Meta().(*ProviderClient).AviSession
 	var obj interface{}
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "avi_serviceenginegroup" {
@@ -577,3 +578,38 @@ resource "avi_serviceenginegroup" "testServiceEngineGroup" {
 	baremetal_dispatcher_handles_flows = false
 }
 `
+
+func TestWaitForServiceEnginesDeprovisioned(t *testing.T) {
+	fc := newFakeController()
+	defer fc.Close()
+	client := newFakeControllerClient(t, fc)
+	ctx := context.Background()
+	defer func(interval time.Duration) { seDeprovisionPollInterval = interval }(seDeprovisionPollInterval)
+	seDeprovisionPollInterval = 10 * time.Millisecond
+
+	if err := client.AviSession.Post("api/tenant", map[string]interface{}{"name": "tenant-a"}, nil); err != nil {
+		t.Fatalf("failed to create tenant: %v", err)
+	}
+	// The SE is only visible in the tenant of its SE group.
+	var se map[string]interface{}
+	if err := client.AviSession.Post("api/serviceengine", map[string]interface{}{
+		"name":         "se-1",
+		"se_group_ref": fc.objectURL("serviceenginegroup", "seg-1"),
+	}, &se, aviTenantOptions("tenant-a")...); err != nil {
+		t.Fatalf("failed to create service engine: %v", err)
+	}
+
+	if err := waitForServiceEnginesDeprovisioned(ctx, client, "seg-1", "tenant-a", 100*time.Millisecond); err == nil {
+		t.Errorf("wait for an SE that is not deprovisioned did not time out")
+	}
+
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		fc.mu.Lock()
+		defer fc.mu.Unlock()
+		fc.removeObject("serviceengine", se["uuid"].(string))
+	}()
+	if err := waitForServiceEnginesDeprovisioned(ctx, client, "seg-1", "tenant-a", 5*time.Second); err != nil {
+		t.Errorf("wait for a deprovisioned SE failed: %v", err)
+	}
+}
@@ -4,11 +4,11 @@
 package avi
 
 import (
+	"context"
 	"log"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/vmware/alb-sdk/go/clients"
 )
 
 func ResourceSiteVersionSchema() map[string]*schema.Schema {
@@ -23,10 +23,9 @@ func ResourceSiteVersionSchema() map[string]*schema.Schema {
 			Required: true,
 		},
 		"prev_target_version": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Computed:     true,
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Computed: true,
 		},
 		"replication_state": {
 			Type:     schema.TypeString,
@@ -44,10 +43,9 @@ func ResourceSiteVersionSchema() map[string]*schema.Schema {
 			Computed: true,
 		},
 		"target_version": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Computed:     true,
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Computed: true,
 		},
 		"tenant_ref": {
 			Type:     schema.TypeString,
@@ -65,10 +63,9 @@ func ResourceSiteVersionSchema() map[string]*schema.Schema {
 			Computed: true,
 		},
 		"version": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Computed:     true,
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Computed: true,
 		},
 		"version_type": {
 			Type:     schema.TypeString,
@@ -80,65 +77,50 @@ func ResourceSiteVersionSchema() map[string]*schema.Schema {
 
 func resourceAviSiteVersion() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviSiteVersionCreate,
-		Read:   ResourceAviSiteVersionRead,
-		Update: resourceAviSiteVersionUpdate,
-		Delete: resourceAviSiteVersionDelete,
-		Schema: ResourceSiteVersionSchema(),
+		CreateContext: resourceAviSiteVersionCreate,
+		ReadContext:   ResourceAviSiteVersionRead,
+		UpdateContext: resourceAviSiteVersionUpdate,
+		DeleteContext: resourceAviSiteVersionDelete,
+		Schema:        ResourceSiteVersionSchema(),
 		Importer: &schema.ResourceImporter{
-			State: ResourceSiteVersionImporter,
+			StateContext: ResourceSiteVersionImporter,
 		},
 	}
 }
 
-func ResourceSiteVersionImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func ResourceSiteVersionImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	s := ResourceSiteVersionSchema()
-	return ResourceImporter(d, m, "siteversion", s)
+	return ResourceImporter(ctx, d, m, "siteversion", s)
 }
 
-func ResourceAviSiteVersionRead(d *schema.ResourceData, meta interface{}) error {
+func ResourceAviSiteVersionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceSiteVersionSchema()
-	err := APIRead(d, meta, "siteversion", s)
-	if err != nil {
-		log.Printf("[ERROR] in reading object %v\n", err)
+	diags := APIRead(ctx, d, meta, "siteversion", s)
+	if diags.HasError() {
+		log.Printf("[ERROR] in reading object %v\n", diags)
 	}
-	return err
+	return diags
 }
 
-func resourceAviSiteVersionCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviSiteVersionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceSiteVersionSchema()
-	err := APICreateOrUpdate(d, meta, "siteversion", s)
-	if err == nil {
-		err = ResourceAviSiteVersionRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "siteversion", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviSiteVersionRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviSiteVersionUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviSiteVersionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceSiteVersionSchema()
-	var err error
-	err = APICreateOrUpdate(d, meta, "siteversion", s)
-	if err == nil {
-		err = ResourceAviSiteVersionRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "siteversion", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviSiteVersionRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviSiteVersionDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAviSiteVersionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	objType := "siteversion"
-	client := meta.(*clients.AviClient)
-	if APIDeleteSystemDefaultCheck(d) {
-		return nil
-	}
-	uuid := d.Get("uuid").(string)
-	if uuid != "" {
-		path := "api/" + objType + "/" + uuid
-		err := client.AviSession.Delete(path)
-		if err != nil && !(strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "204") || strings.Contains(err.Error(), "403")) {
-			log.Println("[INFO] resourceAviSiteVersionDelete not found")
-			return err
-		}
-		d.SetId("")
-	}
-	return nil
+	return APIDelete(ctx, d, meta, objType)
 }
@@ -4,11 +4,11 @@
 package avi
 
 import (
+	"context"
 	"log"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/vmware/alb-sdk/go/clients"
 )
 
 func ResourceSnmpTrapProfileSchema() map[string]*schema.Schema {
@@ -43,65 +43,50 @@ func ResourceSnmpTrapProfileSchema() map[string]*schema.Schema {
 
 func resourceAviSnmpTrapProfile() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviSnmpTrapProfileCreate,
-		Read:   ResourceAviSnmpTrapProfileRead,
-		Update: resourceAviSnmpTrapProfileUpdate,
-		Delete: resourceAviSnmpTrapProfileDelete,
-		Schema: ResourceSnmpTrapProfileSchema(),
+		CreateContext: resourceAviSnmpTrapProfileCreate,
+		ReadContext:   ResourceAviSnmpTrapProfileRead,
+		UpdateContext: resourceAviSnmpTrapProfileUpdate,
+		DeleteContext: resourceAviSnmpTrapProfileDelete,
+		Schema:        ResourceSnmpTrapProfileSchema(),
 		Importer: &schema.ResourceImporter{
-			State: ResourceSnmpTrapProfileImporter,
+			StateContext: ResourceSnmpTrapProfileImporter,
 		},
 	}
 }
 
-func ResourceSnmpTrapProfileImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func ResourceSnmpTrapProfileImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	s := ResourceSnmpTrapProfileSchema()
-	return ResourceImporter(d, m, "snmptrapprofile", s)
+	return ResourceImporter(ctx, d, m, "snmptrapprofile", s)
 }
 
-func ResourceAviSnmpTrapProfileRead(d *schema.ResourceData, meta interface{}) error {
+func ResourceAviSnmpTrapProfileRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceSnmpTrapProfileSchema()
-	err := APIRead(d, meta, "snmptrapprofile", s)
-	if err != nil {
-		log.Printf("[ERROR] in reading object %v\n", err)
+	diags := APIRead(ctx, d, meta, "snmptrapprofile", s)
+	if diags.HasError() {
+		log.Printf("[ERROR] in reading object %v\n", diags)
 	}
-	return err
+	return diags
 }
 
-func resourceAviSnmpTrapProfileCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviSnmpTrapProfileCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceSnmpTrapProfileSchema()
-	err := APICreateOrUpdate(d, meta, "snmptrapprofile", s)
-	if err == nil {
-		err = ResourceAviSnmpTrapProfileRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "snmptrapprofile", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviSnmpTrapProfileRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviSnmpTrapProfileUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviSnmpTrapProfileUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceSnmpTrapProfileSchema()
-	var err error
-	err = APICreateOrUpdate(d, meta, "snmptrapprofile", s)
-	if err == nil {
-		err = ResourceAviSnmpTrapProfileRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "snmptrapprofile", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviSnmpTrapProfileRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviSnmpTrapProfileDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAviSnmpTrapProfileDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	objType := "snmptrapprofile"
-	client := meta.(*clients.AviClient)
-	if APIDeleteSystemDefaultCheck(d) {
-		return nil
-	}
-	uuid := d.Get("uuid").(string)
-	if uuid != "" {
-		path := "api/" + objType + "/" + uuid
-		err := client.AviSession.Delete(path)
-		if err != nil && !(strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "204") || strings.Contains(err.Error(), "403")) {
-			log.Println("[INFO] resourceAviSnmpTrapProfileDelete not found")
-			return err
-		}
-		d.SetId("")
-	}
-	return nil
+	return APIDelete(ctx, d, meta, objType)
 }
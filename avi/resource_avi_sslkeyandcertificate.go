@@ -4,11 +4,11 @@
 package avi
 
 import (
+	"context"
 	"log"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/vmware/alb-sdk/go/clients"
 )
 
 func ResourceSSLKeyAndCertificateSchema() map[string]*schema.Schema {
@@ -24,10 +24,9 @@ func ResourceSSLKeyAndCertificateSchema() map[string]*schema.Schema {
 			Elem:     ResourceSSLCertificateSchema(),
 		},
 		"certificate_base64": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "false",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
 		},
 		"certificate_management_profile_ref": {
 			Type:     schema.TypeString,
@@ -51,10 +50,9 @@ func ResourceSSLKeyAndCertificateSchema() map[string]*schema.Schema {
 			Elem:     ResourceCustomParamsSchema(),
 		},
 		"enable_ocsp_stapling": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "false",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
 		},
 		"enckey_base64": {
 			Type:     schema.TypeString,
@@ -77,16 +75,14 @@ func ResourceSSLKeyAndCertificateSchema() map[string]*schema.Schema {
 			Computed: true,
 		},
 		"import_key_to_hsm": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "false",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
 		},
 		"is_federated": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "false",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
 		},
 		"key": {
 			Type:             schema.TypeString,
@@ -96,10 +92,9 @@ func ResourceSSLKeyAndCertificateSchema() map[string]*schema.Schema {
 			DiffSuppressFunc: suppressSensitiveFieldDiffs,
 		},
 		"key_base64": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "false",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
 		},
 		"key_params": {
 			Type:     schema.TypeSet,
@@ -170,65 +165,50 @@ func ResourceSSLKeyAndCertificateSchema() map[string]*schema.Schema {
 
 func resourceAviSSLKeyAndCertificate() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviSSLKeyAndCertificateCreate,
-		Read:   ResourceAviSSLKeyAndCertificateRead,
-		Update: resourceAviSSLKeyAndCertificateUpdate,
-		Delete: resourceAviSSLKeyAndCertificateDelete,
-		Schema: ResourceSSLKeyAndCertificateSchema(),
+		CreateContext: resourceAviSSLKeyAndCertificateCreate,
+		ReadContext:   ResourceAviSSLKeyAndCertificateRead,
+		UpdateContext: resourceAviSSLKeyAndCertificateUpdate,
+		DeleteContext: resourceAviSSLKeyAndCertificateDelete,
+		Schema:        ResourceSSLKeyAndCertificateSchema(),
 		Importer: &schema.ResourceImporter{
-			State: ResourceSSLKeyAndCertificateImporter,
+			StateContext: ResourceSSLKeyAndCertificateImporter,
 		},
 	}
 }
 
-func ResourceSSLKeyAndCertificateImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func ResourceSSLKeyAndCertificateImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	s := ResourceSSLKeyAndCertificateSchema()
-	return ResourceImporter(d, m, "sslkeyandcertificate", s)
+	return ResourceImporter(ctx, d, m, "sslkeyandcertificate", s)
 }
 
-func ResourceAviSSLKeyAndCertificateRead(d *schema.ResourceData, meta interface{}) error {
+func ResourceAviSSLKeyAndCertificateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceSSLKeyAndCertificateSchema()
-	err := APIRead(d, meta, "sslkeyandcertificate", s)
-	if err != nil {
-		log.Printf("[ERROR] in reading object %v\n", err)
+	diags := APIRead(ctx, d, meta, "sslkeyandcertificate", s)
+	if diags.HasError() {
+		log.Printf("[ERROR] in reading object %v\n", diags)
 	}
-	return err
+	return diags
 }
 
-func resourceAviSSLKeyAndCertificateCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviSSLKeyAndCertificateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceSSLKeyAndCertificateSchema()
-	err := APICreateOrUpdate(d, meta, "sslkeyandcertificate", s)
-	if err == nil {
-		err = ResourceAviSSLKeyAndCertificateRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "sslkeyandcertificate", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviSSLKeyAndCertificateRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviSSLKeyAndCertificateUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviSSLKeyAndCertificateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceSSLKeyAndCertificateSchema()
-	var err error
-	err = APICreateOrUpdate(d, meta, "sslkeyandcertificate", s)
-	if err == nil {
-		err = ResourceAviSSLKeyAndCertificateRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "sslkeyandcertificate", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviSSLKeyAndCertificateRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviSSLKeyAndCertificateDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAviSSLKeyAndCertificateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	objType := "sslkeyandcertificate"
-	client := meta.(*clients.AviClient)
-	if APIDeleteSystemDefaultCheck(d) {
-		return nil
-	}
-	uuid := d.Get("uuid").(string)
-	if uuid != "" {
-		path := "api/" + objType + "/" + uuid
-		err := client.AviSession.Delete(path)
-		if err != nil && !(strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "204") || strings.Contains(err.Error(), "403")) {
-			log.Println("[INFO] resourceAviSSLKeyAndCertificateDelete not found")
-			return err
-		}
-		d.SetId("")
-	}
-	return nil
+	return APIDelete(ctx, d, meta, objType)
 }
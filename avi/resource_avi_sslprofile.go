@@ -4,11 +4,11 @@
 package avi
 
 import (
+	"context"
 	"log"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/vmware/alb-sdk/go/clients"
 )
 
 func ResourceSSLProfileSchema() map[string]*schema.Schema {
@@ -55,22 +55,19 @@ func ResourceSSLProfileSchema() map[string]*schema.Schema {
 			Default:  "auto",
 		},
 		"enable_early_data": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "false",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
 		},
 		"enable_ssl_session_reuse": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "true",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  true,
 		},
 		"is_federated": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "false",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
 		},
 		"markers": {
 			Type:     schema.TypeList,
@@ -82,16 +79,14 @@ func ResourceSSLProfileSchema() map[string]*schema.Schema {
 			Required: true,
 		},
 		"prefer_client_cipher_ordering": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "false",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
 		},
 		"send_close_notify": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "true",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  true,
 		},
 		"signature_algorithm": {
 			Type:     schema.TypeString,
@@ -105,10 +100,9 @@ func ResourceSSLProfileSchema() map[string]*schema.Schema {
 			Elem:     ResourceSSLRatingSchema(),
 		},
 		"ssl_session_timeout": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "86400",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  86400,
 		},
 		"tags": {
 			Type:     schema.TypeList,
@@ -135,65 +129,50 @@ func ResourceSSLProfileSchema() map[string]*schema.Schema {
 
 func resourceAviSSLProfile() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviSSLProfileCreate,
-		Read:   ResourceAviSSLProfileRead,
-		Update: resourceAviSSLProfileUpdate,
-		Delete: resourceAviSSLProfileDelete,
-		Schema: ResourceSSLProfileSchema(),
+		CreateContext: resourceAviSSLProfileCreate,
+		ReadContext:   ResourceAviSSLProfileRead,
+		UpdateContext: resourceAviSSLProfileUpdate,
+		DeleteContext: resourceAviSSLProfileDelete,
+		Schema:        ResourceSSLProfileSchema(),
 		Importer: &schema.ResourceImporter{
-			State: ResourceSSLProfileImporter,
+			StateContext: ResourceSSLProfileImporter,
 		},
 	}
 }
 
-func ResourceSSLProfileImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func ResourceSSLProfileImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	s := ResourceSSLProfileSchema()
-	return ResourceImporter(d, m, "sslprofile", s)
+	return ResourceImporter(ctx, d, m, "sslprofile", s)
 }
 
-func ResourceAviSSLProfileRead(d *schema.ResourceData, meta interface{}) error {
+func ResourceAviSSLProfileRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceSSLProfileSchema()
-	err := APIRead(d, meta, "sslprofile", s)
-	if err != nil {
-		log.Printf("[ERROR] in reading object %v\n", err)
+	diags := APIRead(ctx, d, meta, "sslprofile", s)
+	if diags.HasError() {
+		log.Printf("[ERROR] in reading object %v\n", diags)
 	}
-	return err
+	return diags
 }
 
-func resourceAviSSLProfileCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviSSLProfileCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceSSLProfileSchema()
-	err := APICreateOrUpdate(d, meta, "sslprofile", s)
-	if err == nil {
-		err = ResourceAviSSLProfileRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "sslprofile", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviSSLProfileRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviSSLProfileUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviSSLProfileUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceSSLProfileSchema()
-	var err error
-	err = APICreateOrUpdate(d, meta, "sslprofile", s)
-	if err == nil {
-		err = ResourceAviSSLProfileRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "sslprofile", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviSSLProfileRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviSSLProfileDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAviSSLProfileDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	objType := "sslprofile"
-	client := meta.(*clients.AviClient)
-	if APIDeleteSystemDefaultCheck(d) {
-		return nil
-	}
-	uuid := d.Get("uuid").(string)
-	if uuid != "" {
-		path := "api/" + objType + "/" + uuid
-		err := client.AviSession.Delete(path)
-		if err != nil && !(strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "204") || strings.Contains(err.Error(), "403")) {
-			log.Println("[INFO] resourceAviSSLProfileDelete not found")
-			return err
-		}
-		d.SetId("")
-	}
-	return nil
+	return APIDelete(ctx, d, meta, objType)
 }
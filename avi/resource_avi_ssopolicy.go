@@ -4,11 +4,11 @@
 package avi
 
 import (
+	"context"
 	"log"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/vmware/alb-sdk/go/clients"
 )
 
 func ResourceSSOPolicySchema() map[string]*schema.Schema {
@@ -60,65 +60,50 @@ func ResourceSSOPolicySchema() map[string]*schema.Schema {
 
 func resourceAviSSOPolicy() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviSSOPolicyCreate,
-		Read:   ResourceAviSSOPolicyRead,
-		Update: resourceAviSSOPolicyUpdate,
-		Delete: resourceAviSSOPolicyDelete,
-		Schema: ResourceSSOPolicySchema(),
+		CreateContext: resourceAviSSOPolicyCreate,
+		ReadContext:   ResourceAviSSOPolicyRead,
+		UpdateContext: resourceAviSSOPolicyUpdate,
+		DeleteContext: resourceAviSSOPolicyDelete,
+		Schema:        ResourceSSOPolicySchema(),
 		Importer: &schema.ResourceImporter{
-			State: ResourceSSOPolicyImporter,
+			StateContext: ResourceSSOPolicyImporter,
 		},
 	}
 }
 
-func ResourceSSOPolicyImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func ResourceSSOPolicyImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	s := ResourceSSOPolicySchema()
-	return ResourceImporter(d, m, "ssopolicy", s)
+	return ResourceImporter(ctx, d, m, "ssopolicy", s)
 }
 
-func ResourceAviSSOPolicyRead(d *schema.ResourceData, meta interface{}) error {
+func ResourceAviSSOPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceSSOPolicySchema()
-	err := APIRead(d, meta, "ssopolicy", s)
-	if err != nil {
-		log.Printf("[ERROR] in reading object %v\n", err)
+	diags := APIRead(ctx, d, meta, "ssopolicy", s)
+	if diags.HasError() {
+		log.Printf("[ERROR] in reading object %v\n", diags)
 	}
-	return err
+	return diags
 }
 
-func resourceAviSSOPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviSSOPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceSSOPolicySchema()
-	err := APICreateOrUpdate(d, meta, "ssopolicy", s)
-	if err == nil {
-		err = ResourceAviSSOPolicyRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "ssopolicy", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviSSOPolicyRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviSSOPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviSSOPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceSSOPolicySchema()
-	var err error
-	err = APICreateOrUpdate(d, meta, "ssopolicy", s)
-	if err == nil {
-		err = ResourceAviSSOPolicyRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "ssopolicy", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviSSOPolicyRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviSSOPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAviSSOPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	objType := "ssopolicy"
-	client := meta.(*clients.AviClient)
-	if APIDeleteSystemDefaultCheck(d) {
-		return nil
-	}
-	uuid := d.Get("uuid").(string)
-	if uuid != "" {
-		path := "api/" + objType + "/" + uuid
-		err := client.AviSession.Delete(path)
-		if err != nil && !(strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "204") || strings.Contains(err.Error(), "403")) {
-			log.Println("[INFO] resourceAviSSOPolicyDelete not found")
-			return err
-		}
-		d.SetId("")
-	}
-	return nil
+	return APIDelete(ctx, d, meta, objType)
 }
@@ -4,11 +4,11 @@
 package avi
 
 import (
+	"context"
 	"log"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/vmware/alb-sdk/go/clients"
 )
 
 func ResourceStatediffOperationSchema() map[string]*schema.Schema {
@@ -58,65 +58,50 @@ func ResourceStatediffOperationSchema() map[string]*schema.Schema {
 
 func resourceAviStatediffOperation() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviStatediffOperationCreate,
-		Read:   ResourceAviStatediffOperationRead,
-		Update: resourceAviStatediffOperationUpdate,
-		Delete: resourceAviStatediffOperationDelete,
-		Schema: ResourceStatediffOperationSchema(),
+		CreateContext: resourceAviStatediffOperationCreate,
+		ReadContext:   ResourceAviStatediffOperationRead,
+		UpdateContext: resourceAviStatediffOperationUpdate,
+		DeleteContext: resourceAviStatediffOperationDelete,
+		Schema:        ResourceStatediffOperationSchema(),
 		Importer: &schema.ResourceImporter{
-			State: ResourceStatediffOperationImporter,
+			StateContext: ResourceStatediffOperationImporter,
 		},
 	}
 }
 
-func ResourceStatediffOperationImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func ResourceStatediffOperationImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	s := ResourceStatediffOperationSchema()
-	return ResourceImporter(d, m, "statediffoperation", s)
+	return ResourceImporter(ctx, d, m, "statediffoperation", s)
 }
 
-func ResourceAviStatediffOperationRead(d *schema.ResourceData, meta interface{}) error {
+func ResourceAviStatediffOperationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceStatediffOperationSchema()
-	err := APIRead(d, meta, "statediffoperation", s)
-	if err != nil {
-		log.Printf("[ERROR] in reading object %v\n", err)
+	diags := APIRead(ctx, d, meta, "statediffoperation", s)
+	if diags.HasError() {
+		log.Printf("[ERROR] in reading object %v\n", diags)
 	}
-	return err
+	return diags
 }
 
-func resourceAviStatediffOperationCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviStatediffOperationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceStatediffOperationSchema()
-	err := APICreateOrUpdate(d, meta, "statediffoperation", s)
-	if err == nil {
-		err = ResourceAviStatediffOperationRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "statediffoperation", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviStatediffOperationRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviStatediffOperationUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviStatediffOperationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceStatediffOperationSchema()
-	var err error
-	err = APICreateOrUpdate(d, meta, "statediffoperation", s)
-	if err == nil {
-		err = ResourceAviStatediffOperationRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "statediffoperation", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviStatediffOperationRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviStatediffOperationDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAviStatediffOperationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	objType := "statediffoperation"
-	client := meta.(*clients.AviClient)
-	if APIDeleteSystemDefaultCheck(d) {
-		return nil
-	}
-	uuid := d.Get("uuid").(string)
-	if uuid != "" {
-		path := "api/" + objType + "/" + uuid
-		err := client.AviSession.Delete(path)
-		if err != nil && !(strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "204") || strings.Contains(err.Error(), "403")) {
-			log.Println("[INFO] resourceAviStatediffOperationDelete not found")
-			return err
-		}
-		d.SetId("")
-	}
-	return nil
+	return APIDelete(ctx, d, meta, objType)
 }
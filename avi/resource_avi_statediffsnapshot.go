@@ -4,11 +4,11 @@
 package avi
 
 import (
+	"context"
 	"log"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/vmware/alb-sdk/go/clients"
 )
 
 func ResourceStatediffSnapshotSchema() map[string]*schema.Schema {
@@ -105,65 +105,50 @@ func ResourceStatediffSnapshotSchema() map[string]*schema.Schema {
 
 func resourceAviStatediffSnapshot() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviStatediffSnapshotCreate,
-		Read:   ResourceAviStatediffSnapshotRead,
-		Update: resourceAviStatediffSnapshotUpdate,
-		Delete: resourceAviStatediffSnapshotDelete,
-		Schema: ResourceStatediffSnapshotSchema(),
+		CreateContext: resourceAviStatediffSnapshotCreate,
+		ReadContext:   ResourceAviStatediffSnapshotRead,
+		UpdateContext: resourceAviStatediffSnapshotUpdate,
+		DeleteContext: resourceAviStatediffSnapshotDelete,
+		Schema:        ResourceStatediffSnapshotSchema(),
 		Importer: &schema.ResourceImporter{
-			State: ResourceStatediffSnapshotImporter,
+			StateContext: ResourceStatediffSnapshotImporter,
 		},
 	}
 }
 
-func ResourceStatediffSnapshotImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func ResourceStatediffSnapshotImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	s := ResourceStatediffSnapshotSchema()
-	return ResourceImporter(d, m, "statediffsnapshot", s)
+	return ResourceImporter(ctx, d, m, "statediffsnapshot", s)
 }
 
-func ResourceAviStatediffSnapshotRead(d *schema.ResourceData, meta interface{}) error {
+func ResourceAviStatediffSnapshotRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceStatediffSnapshotSchema()
-	err := APIRead(d, meta, "statediffsnapshot", s)
-	if err != nil {
-		log.Printf("[ERROR] in reading object %v\n", err)
+	diags := APIRead(ctx, d, meta, "statediffsnapshot", s)
+	if diags.HasError() {
+		log.Printf("[ERROR] in reading object %v\n", diags)
 	}
-	return err
+	return diags
 }
 
-func resourceAviStatediffSnapshotCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviStatediffSnapshotCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceStatediffSnapshotSchema()
-	err := APICreateOrUpdate(d, meta, "statediffsnapshot", s)
-	if err == nil {
-		err = ResourceAviStatediffSnapshotRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "statediffsnapshot", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviStatediffSnapshotRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviStatediffSnapshotUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviStatediffSnapshotUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceStatediffSnapshotSchema()
-	var err error
-	err = APICreateOrUpdate(d, meta, "statediffsnapshot", s)
-	if err == nil {
-		err = ResourceAviStatediffSnapshotRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "statediffsnapshot", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviStatediffSnapshotRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviStatediffSnapshotDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAviStatediffSnapshotDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	objType := "statediffsnapshot"
-	client := meta.(*clients.AviClient)
-	if APIDeleteSystemDefaultCheck(d) {
-		return nil
-	}
-	uuid := d.Get("uuid").(string)
-	if uuid != "" {
-		path := "api/" + objType + "/" + uuid
-		err := client.AviSession.Delete(path)
-		if err != nil && !(strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "204") || strings.Contains(err.Error(), "403")) {
-			log.Println("[INFO] resourceAviStatediffSnapshotDelete not found")
-			return err
-		}
-		d.SetId("")
-	}
-	return nil
+	return APIDelete(ctx, d, meta, objType)
 }
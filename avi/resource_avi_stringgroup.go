@@ -4,11 +4,11 @@
 package avi
 
 import (
+	"context"
 	"log"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/vmware/alb-sdk/go/clients"
 )
 
 func ResourceStringGroupSchema() map[string]*schema.Schema {
@@ -30,10 +30,9 @@ func ResourceStringGroupSchema() map[string]*schema.Schema {
 			Elem:     ResourceKeyValueSchema(),
 		},
 		"longest_match": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "false",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
 		},
 		"markers": {
 			Type:     schema.TypeList,
@@ -63,65 +62,50 @@ func ResourceStringGroupSchema() map[string]*schema.Schema {
 
 func resourceAviStringGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviStringGroupCreate,
-		Read:   ResourceAviStringGroupRead,
-		Update: resourceAviStringGroupUpdate,
-		Delete: resourceAviStringGroupDelete,
-		Schema: ResourceStringGroupSchema(),
+		CreateContext: resourceAviStringGroupCreate,
+		ReadContext:   ResourceAviStringGroupRead,
+		UpdateContext: resourceAviStringGroupUpdate,
+		DeleteContext: resourceAviStringGroupDelete,
+		Schema:        ResourceStringGroupSchema(),
 		Importer: &schema.ResourceImporter{
-			State: ResourceStringGroupImporter,
+			StateContext: ResourceStringGroupImporter,
 		},
 	}
 }
 
-func ResourceStringGroupImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func ResourceStringGroupImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	s := ResourceStringGroupSchema()
-	return ResourceImporter(d, m, "stringgroup", s)
+	return ResourceImporter(ctx, d, m, "stringgroup", s)
 }
 
-func ResourceAviStringGroupRead(d *schema.ResourceData, meta interface{}) error {
+func ResourceAviStringGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceStringGroupSchema()
-	err := APIRead(d, meta, "stringgroup", s)
-	if err != nil {
-		log.Printf("[ERROR] in reading object %v\n", err)
+	diags := APIRead(ctx, d, meta, "stringgroup", s)
+	if diags.HasError() {
+		log.Printf("[ERROR] in reading object %v\n", diags)
 	}
-	return err
+	return diags
 }
 
-func resourceAviStringGroupCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviStringGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceStringGroupSchema()
-	err := APICreateOrUpdate(d, meta, "stringgroup", s)
-	if err == nil {
-		err = ResourceAviStringGroupRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "stringgroup", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviStringGroupRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviStringGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviStringGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceStringGroupSchema()
-	var err error
-	err = APICreateOrUpdate(d, meta, "stringgroup", s)
-	if err == nil {
-		err = ResourceAviStringGroupRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "stringgroup", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviStringGroupRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviStringGroupDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAviStringGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	objType := "stringgroup"
-	client := meta.(*clients.AviClient)
-	if APIDeleteSystemDefaultCheck(d) {
-		return nil
-	}
-	uuid := d.Get("uuid").(string)
-	if uuid != "" {
-		path := "api/" + objType + "/" + uuid
-		err := client.AviSession.Delete(path)
-		if err != nil && !(strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "204") || strings.Contains(err.Error(), "403")) {
-			log.Println("[INFO] resourceAviStringGroupDelete not found")
-			return err
-		}
-		d.SetId("")
-	}
-	return nil
+	return APIDelete(ctx, d, meta, objType)
 }
@@ -4,11 +4,11 @@
 package avi
 
 import (
+	"context"
 	"log"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/vmware/alb-sdk/go/clients"
 )
 
 func ResourceSystemConfigurationSchema() map[string]*schema.Schema {
@@ -20,10 +20,9 @@ func ResourceSystemConfigurationSchema() map[string]*schema.Schema {
 			Elem:     ResourceAdminAuthConfigurationSchema(),
 		},
 		"common_criteria_mode": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "false",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
 		},
 		"configpb_attributes": {
 			Type:     schema.TypeSet,
@@ -54,10 +53,9 @@ func ResourceSystemConfigurationSchema() map[string]*schema.Schema {
 			Elem:     &schema.Schema{Type: schema.TypeString},
 		},
 		"docker_mode": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "false",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
 		},
 		"email_configuration": {
 			Type:     schema.TypeSet,
@@ -66,16 +64,14 @@ func ResourceSystemConfigurationSchema() map[string]*schema.Schema {
 			Elem:     ResourceEmailConfigurationSchema(),
 		},
 		"enable_cors": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "false",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
 		},
 		"fips_mode": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "false",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
 		},
 		"global_tenant_config": {
 			Type:     schema.TypeSet,
@@ -141,75 +137,59 @@ func ResourceSystemConfigurationSchema() map[string]*schema.Schema {
 			Computed: true,
 		},
 		"welcome_workflow_complete": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "false",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
 		},
 	}
 }
 
 func resourceAviSystemConfiguration() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviSystemConfigurationCreate,
-		Read:   ResourceAviSystemConfigurationRead,
-		Update: resourceAviSystemConfigurationUpdate,
-		Delete: resourceAviSystemConfigurationDelete,
-		Schema: ResourceSystemConfigurationSchema(),
+		CreateContext: resourceAviSystemConfigurationCreate,
+		ReadContext:   ResourceAviSystemConfigurationRead,
+		UpdateContext: resourceAviSystemConfigurationUpdate,
+		DeleteContext: resourceAviSystemConfigurationDelete,
+		Schema:        ResourceSystemConfigurationSchema(),
 		Importer: &schema.ResourceImporter{
-			State: ResourceSystemConfigurationImporter,
+			StateContext: ResourceSystemConfigurationImporter,
 		},
 	}
 }
 
-func ResourceSystemConfigurationImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func ResourceSystemConfigurationImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	s := ResourceSystemConfigurationSchema()
-	return ResourceImporter(d, m, "systemconfiguration", s)
+	return ResourceImporter(ctx, d, m, "systemconfiguration", s)
 }
 
-func ResourceAviSystemConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+func ResourceAviSystemConfigurationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceSystemConfigurationSchema()
-	err := APIRead(d, meta, "systemconfiguration", s)
-	if err != nil {
-		log.Printf("[ERROR] in reading object %v\n", err)
+	diags := APIRead(ctx, d, meta, "systemconfiguration", s)
+	if diags.HasError() {
+		log.Printf("[ERROR] in reading object %v\n", diags)
 	}
-	return err
+	return diags
 }
 
-func resourceAviSystemConfigurationCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviSystemConfigurationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceSystemConfigurationSchema()
-	err := APICreateOrUpdate(d, meta, "systemconfiguration", s)
-	if err == nil {
-		err = ResourceAviSystemConfigurationRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "systemconfiguration", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviSystemConfigurationRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviSystemConfigurationUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviSystemConfigurationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceSystemConfigurationSchema()
-	var err error
-	err = APICreateOrUpdate(d, meta, "systemconfiguration", s)
-	if err == nil {
-		err = ResourceAviSystemConfigurationRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "systemconfiguration", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviSystemConfigurationRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviSystemConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAviSystemConfigurationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	objType := "systemconfiguration"
-	client := meta.(*clients.AviClient)
-	if APIDeleteSystemDefaultCheck(d) {
-		return nil
-	}
-	uuid := d.Get("uuid").(string)
-	if uuid != "" {
-		path := "api/" + objType + "/" + uuid
-		err := client.AviSession.Delete(path)
-		if err != nil && !(strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "204") || strings.Contains(err.Error(), "403")) {
-			log.Println("[INFO] resourceAviSystemConfigurationDelete not found")
-			return err
-		}
-		d.SetId("")
-	}
-	return nil
+	return APIDelete(ctx, d, meta, objType)
 }
@@ -4,11 +4,11 @@
 package avi
 
 import (
+	"context"
 	"log"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/vmware/alb-sdk/go/clients"
 )
 
 func ResourceSystemLimitsSchema() map[string]*schema.Schema {
@@ -46,65 +46,50 @@ func ResourceSystemLimitsSchema() map[string]*schema.Schema {
 
 func resourceAviSystemLimits() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviSystemLimitsCreate,
-		Read:   ResourceAviSystemLimitsRead,
-		Update: resourceAviSystemLimitsUpdate,
-		Delete: resourceAviSystemLimitsDelete,
-		Schema: ResourceSystemLimitsSchema(),
+		CreateContext: resourceAviSystemLimitsCreate,
+		ReadContext:   ResourceAviSystemLimitsRead,
+		UpdateContext: resourceAviSystemLimitsUpdate,
+		DeleteContext: resourceAviSystemLimitsDelete,
+		Schema:        ResourceSystemLimitsSchema(),
 		Importer: &schema.ResourceImporter{
-			State: ResourceSystemLimitsImporter,
+			StateContext: ResourceSystemLimitsImporter,
 		},
 	}
 }
 
-func ResourceSystemLimitsImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func ResourceSystemLimitsImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	s := ResourceSystemLimitsSchema()
-	return ResourceImporter(d, m, "systemlimits", s)
+	return ResourceImporter(ctx, d, m, "systemlimits", s)
 }
 
-func ResourceAviSystemLimitsRead(d *schema.ResourceData, meta interface{}) error {
+func ResourceAviSystemLimitsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceSystemLimitsSchema()
-	err := APIRead(d, meta, "systemlimits", s)
-	if err != nil {
-		log.Printf("[ERROR] in reading object %v\n", err)
+	diags := APIRead(ctx, d, meta, "systemlimits", s)
+	if diags.HasError() {
+		log.Printf("[ERROR] in reading object %v\n", diags)
 	}
-	return err
+	return diags
 }
 
-func resourceAviSystemLimitsCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviSystemLimitsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceSystemLimitsSchema()
-	err := APICreateOrUpdate(d, meta, "systemlimits", s)
-	if err == nil {
-		err = ResourceAviSystemLimitsRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "systemlimits", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviSystemLimitsRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviSystemLimitsUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviSystemLimitsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceSystemLimitsSchema()
-	var err error
-	err = APICreateOrUpdate(d, meta, "systemlimits", s)
-	if err == nil {
-		err = ResourceAviSystemLimitsRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "systemlimits", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviSystemLimitsRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviSystemLimitsDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAviSystemLimitsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	objType := "systemlimits"
-	client := meta.(*clients.AviClient)
-	if APIDeleteSystemDefaultCheck(d) {
-		return nil
-	}
-	uuid := d.Get("uuid").(string)
-	if uuid != "" {
-		path := "api/" + objType + "/" + uuid
-		err := client.AviSession.Delete(path)
-		if err != nil && !(strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "204") || strings.Contains(err.Error(), "403")) {
-			log.Println("[INFO] resourceAviSystemLimitsDelete not found")
-			return err
-		}
-		d.SetId("")
-	}
-	return nil
+	return APIDelete(ctx, d, meta, objType)
 }
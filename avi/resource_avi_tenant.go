@@ -4,11 +4,11 @@
 package avi
 
 import (
+	"context"
 	"log"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/vmware/alb-sdk/go/clients"
 )
 
 func ResourceTenantSchema() map[string]*schema.Schema {
@@ -36,10 +36,9 @@ func ResourceTenantSchema() map[string]*schema.Schema {
 			Computed: true,
 		},
 		"enforce_label_group": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "false",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
 		},
 		"label_group_refs": {
 			Type:     schema.TypeList,
@@ -47,10 +46,9 @@ func ResourceTenantSchema() map[string]*schema.Schema {
 			Elem:     &schema.Schema{Type: schema.TypeString},
 		},
 		"local": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "true",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  true,
 		},
 		"name": {
 			Type:     schema.TypeString,
@@ -66,65 +64,50 @@ func ResourceTenantSchema() map[string]*schema.Schema {
 
 func resourceAviTenant() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviTenantCreate,
-		Read:   ResourceAviTenantRead,
-		Update: resourceAviTenantUpdate,
-		Delete: resourceAviTenantDelete,
-		Schema: ResourceTenantSchema(),
+		CreateContext: resourceAviTenantCreate,
+		ReadContext:   ResourceAviTenantRead,
+		UpdateContext: resourceAviTenantUpdate,
+		DeleteContext: resourceAviTenantDelete,
+		Schema:        ResourceTenantSchema(),
 		Importer: &schema.ResourceImporter{
-			State: ResourceTenantImporter,
+			StateContext: ResourceTenantImporter,
 		},
 	}
 }
 
-func ResourceTenantImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func ResourceTenantImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	s := ResourceTenantSchema()
-	return ResourceImporter(d, m, "tenant", s)
+	return ResourceImporter(ctx, d, m, "tenant", s)
 }
 
-func ResourceAviTenantRead(d *schema.ResourceData, meta interface{}) error {
+func ResourceAviTenantRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceTenantSchema()
-	err := APIRead(d, meta, "tenant", s)
-	if err != nil {
-		log.Printf("[ERROR] in reading object %v\n", err)
+	diags := APIRead(ctx, d, meta, "tenant", s)
+	if diags.HasError() {
+		log.Printf("[ERROR] in reading object %v\n", diags)
 	}
-	return err
+	return diags
 }
 
-func resourceAviTenantCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviTenantCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceTenantSchema()
-	err := APICreateOrUpdate(d, meta, "tenant", s)
-	if err == nil {
-		err = ResourceAviTenantRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "tenant", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviTenantRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviTenantUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviTenantUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceTenantSchema()
-	var err error
-	err = APICreateOrUpdate(d, meta, "tenant", s)
-	if err == nil {
-		err = ResourceAviTenantRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "tenant", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviTenantRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviTenantDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAviTenantDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	objType := "tenant"
-	client := meta.(*clients.AviClient)
-	if APIDeleteSystemDefaultCheck(d) {
-		return nil
-	}
-	uuid := d.Get("uuid").(string)
-	if uuid != "" {
-		path := "api/" + objType + "/" + uuid
-		err := client.AviSession.Delete(path)
-		if err != nil && !(strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "204") || strings.Contains(err.Error(), "403")) {
-			log.Println("[INFO] resourceAviTenantDelete not found")
-			return err
-		}
-		d.SetId("")
-	}
-	return nil
+	return APIDelete(ctx, d, meta, objType)
 }
@@ -4,11 +4,11 @@
 package avi
 
 import (
+	"context"
 	"log"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/vmware/alb-sdk/go/clients"
 )
 
 func ResourceTestSeDatastoreLevel1Schema() map[string]*schema.Schema {
@@ -43,65 +43,50 @@ func ResourceTestSeDatastoreLevel1Schema() map[string]*schema.Schema {
 
 func resourceAviTestSeDatastoreLevel1() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviTestSeDatastoreLevel1Create,
-		Read:   ResourceAviTestSeDatastoreLevel1Read,
-		Update: resourceAviTestSeDatastoreLevel1Update,
-		Delete: resourceAviTestSeDatastoreLevel1Delete,
-		Schema: ResourceTestSeDatastoreLevel1Schema(),
+		CreateContext: resourceAviTestSeDatastoreLevel1Create,
+		ReadContext:   ResourceAviTestSeDatastoreLevel1Read,
+		UpdateContext: resourceAviTestSeDatastoreLevel1Update,
+		DeleteContext: resourceAviTestSeDatastoreLevel1Delete,
+		Schema:        ResourceTestSeDatastoreLevel1Schema(),
 		Importer: &schema.ResourceImporter{
-			State: ResourceTestSeDatastoreLevel1Importer,
+			StateContext: ResourceTestSeDatastoreLevel1Importer,
 		},
 	}
 }
 
-func ResourceTestSeDatastoreLevel1Importer(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func ResourceTestSeDatastoreLevel1Importer(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	s := ResourceTestSeDatastoreLevel1Schema()
-	return ResourceImporter(d, m, "testsedatastorelevel1", s)
+	return ResourceImporter(ctx, d, m, "testsedatastorelevel1", s)
 }
 
-func ResourceAviTestSeDatastoreLevel1Read(d *schema.ResourceData, meta interface{}) error {
+func ResourceAviTestSeDatastoreLevel1Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceTestSeDatastoreLevel1Schema()
-	err := APIRead(d, meta, "testsedatastorelevel1", s)
-	if err != nil {
-		log.Printf("[ERROR] in reading object %v\n", err)
+	diags := APIRead(ctx, d, meta, "testsedatastorelevel1", s)
+	if diags.HasError() {
+		log.Printf("[ERROR] in reading object %v\n", diags)
 	}
-	return err
+	return diags
 }
 
-func resourceAviTestSeDatastoreLevel1Create(d *schema.ResourceData, meta interface{}) error {
+func resourceAviTestSeDatastoreLevel1Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceTestSeDatastoreLevel1Schema()
-	err := APICreateOrUpdate(d, meta, "testsedatastorelevel1", s)
-	if err == nil {
-		err = ResourceAviTestSeDatastoreLevel1Read(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "testsedatastorelevel1", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviTestSeDatastoreLevel1Read(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviTestSeDatastoreLevel1Update(d *schema.ResourceData, meta interface{}) error {
+func resourceAviTestSeDatastoreLevel1Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceTestSeDatastoreLevel1Schema()
-	var err error
-	err = APICreateOrUpdate(d, meta, "testsedatastorelevel1", s)
-	if err == nil {
-		err = ResourceAviTestSeDatastoreLevel1Read(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "testsedatastorelevel1", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviTestSeDatastoreLevel1Read(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviTestSeDatastoreLevel1Delete(d *schema.ResourceData, meta interface{}) error {
+func resourceAviTestSeDatastoreLevel1Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	objType := "testsedatastorelevel1"
-	client := meta.(*clients.AviClient)
-	if APIDeleteSystemDefaultCheck(d) {
-		return nil
-	}
-	uuid := d.Get("uuid").(string)
-	if uuid != "" {
-		path := "api/" + objType + "/" + uuid
-		err := client.AviSession.Delete(path)
-		if err != nil && !(strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "204") || strings.Contains(err.Error(), "403")) {
-			log.Println("[INFO] resourceAviTestSeDatastoreLevel1Delete not found")
-			return err
-		}
-		d.SetId("")
-	}
-	return nil
+	return APIDelete(ctx, d, meta, objType)
 }
@@ -4,11 +4,11 @@
 package avi
 
 import (
+	"context"
 	"log"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/vmware/alb-sdk/go/clients"
 )
 
 func ResourceTestSeDatastoreLevel2Schema() map[string]*schema.Schema {
@@ -43,65 +43,50 @@ func ResourceTestSeDatastoreLevel2Schema() map[string]*schema.Schema {
 
 func resourceAviTestSeDatastoreLevel2() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviTestSeDatastoreLevel2Create,
-		Read:   ResourceAviTestSeDatastoreLevel2Read,
-		Update: resourceAviTestSeDatastoreLevel2Update,
-		Delete: resourceAviTestSeDatastoreLevel2Delete,
-		Schema: ResourceTestSeDatastoreLevel2Schema(),
+		CreateContext: resourceAviTestSeDatastoreLevel2Create,
+		ReadContext:   ResourceAviTestSeDatastoreLevel2Read,
+		UpdateContext: resourceAviTestSeDatastoreLevel2Update,
+		DeleteContext: resourceAviTestSeDatastoreLevel2Delete,
+		Schema:        ResourceTestSeDatastoreLevel2Schema(),
 		Importer: &schema.ResourceImporter{
-			State: ResourceTestSeDatastoreLevel2Importer,
+			StateContext: ResourceTestSeDatastoreLevel2Importer,
 		},
 	}
 }
 
-func ResourceTestSeDatastoreLevel2Importer(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func ResourceTestSeDatastoreLevel2Importer(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	s := ResourceTestSeDatastoreLevel2Schema()
-	return ResourceImporter(d, m, "testsedatastorelevel2", s)
+	return ResourceImporter(ctx, d, m, "testsedatastorelevel2", s)
 }
 
-func ResourceAviTestSeDatastoreLevel2Read(d *schema.ResourceData, meta interface{}) error {
+func ResourceAviTestSeDatastoreLevel2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceTestSeDatastoreLevel2Schema()
-	err := APIRead(d, meta, "testsedatastorelevel2", s)
-	if err != nil {
-		log.Printf("[ERROR] in reading object %v\n", err)
+	diags := APIRead(ctx, d, meta, "testsedatastorelevel2", s)
+	if diags.HasError() {
+		log.Printf("[ERROR] in reading object %v\n", diags)
 	}
-	return err
+	return diags
 }
 
-func resourceAviTestSeDatastoreLevel2Create(d *schema.ResourceData, meta interface{}) error {
+func resourceAviTestSeDatastoreLevel2Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceTestSeDatastoreLevel2Schema()
-	err := APICreateOrUpdate(d, meta, "testsedatastorelevel2", s)
-	if err == nil {
-		err = ResourceAviTestSeDatastoreLevel2Read(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "testsedatastorelevel2", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviTestSeDatastoreLevel2Read(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviTestSeDatastoreLevel2Update(d *schema.ResourceData, meta interface{}) error {
+func resourceAviTestSeDatastoreLevel2Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceTestSeDatastoreLevel2Schema()
-	var err error
-	err = APICreateOrUpdate(d, meta, "testsedatastorelevel2", s)
-	if err == nil {
-		err = ResourceAviTestSeDatastoreLevel2Read(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "testsedatastorelevel2", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviTestSeDatastoreLevel2Read(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviTestSeDatastoreLevel2Delete(d *schema.ResourceData, meta interface{}) error {
+func resourceAviTestSeDatastoreLevel2Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	objType := "testsedatastorelevel2"
-	client := meta.(*clients.AviClient)
-	if APIDeleteSystemDefaultCheck(d) {
-		return nil
-	}
-	uuid := d.Get("uuid").(string)
-	if uuid != "" {
-		path := "api/" + objType + "/" + uuid
-		err := client.AviSession.Delete(path)
-		if err != nil && !(strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "204") || strings.Contains(err.Error(), "403")) {
-			log.Println("[INFO] resourceAviTestSeDatastoreLevel2Delete not found")
-			return err
-		}
-		d.SetId("")
-	}
-	return nil
+	return APIDelete(ctx, d, meta, objType)
 }
@@ -4,11 +4,11 @@
 package avi
 
 import (
+	"context"
 	"log"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/vmware/alb-sdk/go/clients"
 )
 
 func ResourceTestSeDatastoreLevel3Schema() map[string]*schema.Schema {
@@ -38,65 +38,50 @@ func ResourceTestSeDatastoreLevel3Schema() map[string]*schema.Schema {
 
 func resourceAviTestSeDatastoreLevel3() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviTestSeDatastoreLevel3Create,
-		Read:   ResourceAviTestSeDatastoreLevel3Read,
-		Update: resourceAviTestSeDatastoreLevel3Update,
-		Delete: resourceAviTestSeDatastoreLevel3Delete,
-		Schema: ResourceTestSeDatastoreLevel3Schema(),
+		CreateContext: resourceAviTestSeDatastoreLevel3Create,
+		ReadContext:   ResourceAviTestSeDatastoreLevel3Read,
+		UpdateContext: resourceAviTestSeDatastoreLevel3Update,
+		DeleteContext: resourceAviTestSeDatastoreLevel3Delete,
+		Schema:        ResourceTestSeDatastoreLevel3Schema(),
 		Importer: &schema.ResourceImporter{
-			State: ResourceTestSeDatastoreLevel3Importer,
+			StateContext: ResourceTestSeDatastoreLevel3Importer,
 		},
 	}
 }
 
-func ResourceTestSeDatastoreLevel3Importer(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func ResourceTestSeDatastoreLevel3Importer(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	s := ResourceTestSeDatastoreLevel3Schema()
-	return ResourceImporter(d, m, "testsedatastorelevel3", s)
+	return ResourceImporter(ctx, d, m, "testsedatastorelevel3", s)
 }
 
-func ResourceAviTestSeDatastoreLevel3Read(d *schema.ResourceData, meta interface{}) error {
+func ResourceAviTestSeDatastoreLevel3Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceTestSeDatastoreLevel3Schema()
-	err := APIRead(d, meta, "testsedatastorelevel3", s)
-	if err != nil {
-		log.Printf("[ERROR] in reading object %v\n", err)
+	diags := APIRead(ctx, d, meta, "testsedatastorelevel3", s)
+	if diags.HasError() {
+		log.Printf("[ERROR] in reading object %v\n", diags)
 	}
-	return err
+	return diags
 }
 
-func resourceAviTestSeDatastoreLevel3Create(d *schema.ResourceData, meta interface{}) error {
+func resourceAviTestSeDatastoreLevel3Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceTestSeDatastoreLevel3Schema()
-	err := APICreateOrUpdate(d, meta, "testsedatastorelevel3", s)
-	if err == nil {
-		err = ResourceAviTestSeDatastoreLevel3Read(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "testsedatastorelevel3", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviTestSeDatastoreLevel3Read(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviTestSeDatastoreLevel3Update(d *schema.ResourceData, meta interface{}) error {
+func resourceAviTestSeDatastoreLevel3Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceTestSeDatastoreLevel3Schema()
-	var err error
-	err = APICreateOrUpdate(d, meta, "testsedatastorelevel3", s)
-	if err == nil {
-		err = ResourceAviTestSeDatastoreLevel3Read(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "testsedatastorelevel3", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviTestSeDatastoreLevel3Read(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviTestSeDatastoreLevel3Delete(d *schema.ResourceData, meta interface{}) error {
+func resourceAviTestSeDatastoreLevel3Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	objType := "testsedatastorelevel3"
-	client := meta.(*clients.AviClient)
-	if APIDeleteSystemDefaultCheck(d) {
-		return nil
-	}
-	uuid := d.Get("uuid").(string)
-	if uuid != "" {
-		path := "api/" + objType + "/" + uuid
-		err := client.AviSession.Delete(path)
-		if err != nil && !(strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "204") || strings.Contains(err.Error(), "403")) {
-			log.Println("[INFO] resourceAviTestSeDatastoreLevel3Delete not found")
-			return err
-		}
-		d.SetId("")
-	}
-	return nil
+	return APIDelete(ctx, d, meta, objType)
 }
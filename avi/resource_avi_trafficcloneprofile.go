@@ -4,11 +4,11 @@
 package avi
 
 import (
+	"context"
 	"log"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/vmware/alb-sdk/go/clients"
 )
 
 func ResourceTrafficCloneProfileSchema() map[string]*schema.Schema {
@@ -39,10 +39,9 @@ func ResourceTrafficCloneProfileSchema() map[string]*schema.Schema {
 			Required: true,
 		},
 		"preserve_client_ip": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "false",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
 		},
 		"tenant_ref": {
 			Type:     schema.TypeString,
@@ -59,65 +58,50 @@ func ResourceTrafficCloneProfileSchema() map[string]*schema.Schema {
 
 func resourceAviTrafficCloneProfile() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviTrafficCloneProfileCreate,
-		Read:   ResourceAviTrafficCloneProfileRead,
-		Update: resourceAviTrafficCloneProfileUpdate,
-		Delete: resourceAviTrafficCloneProfileDelete,
-		Schema: ResourceTrafficCloneProfileSchema(),
+		CreateContext: resourceAviTrafficCloneProfileCreate,
+		ReadContext:   ResourceAviTrafficCloneProfileRead,
+		UpdateContext: resourceAviTrafficCloneProfileUpdate,
+		DeleteContext: resourceAviTrafficCloneProfileDelete,
+		Schema:        ResourceTrafficCloneProfileSchema(),
 		Importer: &schema.ResourceImporter{
-			State: ResourceTrafficCloneProfileImporter,
+			StateContext: ResourceTrafficCloneProfileImporter,
 		},
 	}
 }
 
-func ResourceTrafficCloneProfileImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func ResourceTrafficCloneProfileImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	s := ResourceTrafficCloneProfileSchema()
-	return ResourceImporter(d, m, "trafficcloneprofile", s)
+	return ResourceImporter(ctx, d, m, "trafficcloneprofile", s)
 }
 
-func ResourceAviTrafficCloneProfileRead(d *schema.ResourceData, meta interface{}) error {
+func ResourceAviTrafficCloneProfileRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceTrafficCloneProfileSchema()
-	err := APIRead(d, meta, "trafficcloneprofile", s)
-	if err != nil {
-		log.Printf("[ERROR] in reading object %v\n", err)
+	diags := APIRead(ctx, d, meta, "trafficcloneprofile", s)
+	if diags.HasError() {
+		log.Printf("[ERROR] in reading object %v\n", diags)
 	}
-	return err
+	return diags
 }
 
-func resourceAviTrafficCloneProfileCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviTrafficCloneProfileCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceTrafficCloneProfileSchema()
-	err := APICreateOrUpdate(d, meta, "trafficcloneprofile", s)
-	if err == nil {
-		err = ResourceAviTrafficCloneProfileRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "trafficcloneprofile", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviTrafficCloneProfileRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviTrafficCloneProfileUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviTrafficCloneProfileUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceTrafficCloneProfileSchema()
-	var err error
-	err = APICreateOrUpdate(d, meta, "trafficcloneprofile", s)
-	if err == nil {
-		err = ResourceAviTrafficCloneProfileRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "trafficcloneprofile", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviTrafficCloneProfileRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviTrafficCloneProfileDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAviTrafficCloneProfileDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	objType := "trafficcloneprofile"
-	client := meta.(*clients.AviClient)
-	if APIDeleteSystemDefaultCheck(d) {
-		return nil
-	}
-	uuid := d.Get("uuid").(string)
-	if uuid != "" {
-		path := "api/" + objType + "/" + uuid
-		err := client.AviSession.Delete(path)
-		if err != nil && !(strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "204") || strings.Contains(err.Error(), "403")) {
-			log.Println("[INFO] resourceAviTrafficCloneProfileDelete not found")
-			return err
-		}
-		d.SetId("")
-	}
-	return nil
+	return APIDelete(ctx, d, meta, objType)
 }
@@ -4,11 +4,11 @@
 package avi
 
 import (
+	"context"
 	"log"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/vmware/alb-sdk/go/clients"
 )
 
 func ResourceUpgradeStatusInfoSchema() map[string]*schema.Schema {
@@ -24,28 +24,24 @@ func ResourceUpgradeStatusInfoSchema() map[string]*schema.Schema {
 			Computed: true,
 		},
 		"clean": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Computed:     true,
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Computed: true,
 		},
 		"duration": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Computed:     true,
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Computed: true,
 		},
 		"enable_patch_rollback": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "false",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
 		},
 		"enable_rollback": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "false",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
 		},
 		"end_time": {
 			Type:     schema.TypeString,
@@ -58,10 +54,9 @@ func ResourceUpgradeStatusInfoSchema() map[string]*schema.Schema {
 			Computed: true,
 		},
 		"fips_mode": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Computed:     true,
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Computed: true,
 		},
 		"history": {
 			Type:     schema.TypeList,
@@ -115,10 +110,9 @@ func ResourceUpgradeStatusInfoSchema() map[string]*schema.Schema {
 			Elem:     ResourcePatchDataSchema(),
 		},
 		"patch_reboot": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Computed:     true,
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Computed: true,
 		},
 		"patch_version": {
 			Type:     schema.TypeString,
@@ -161,10 +155,9 @@ func ResourceUpgradeStatusInfoSchema() map[string]*schema.Schema {
 			Computed: true,
 		},
 		"progress": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "0",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  0,
 		},
 		"reason": {
 			Type:     schema.TypeString,
@@ -215,16 +208,14 @@ func ResourceUpgradeStatusInfoSchema() map[string]*schema.Schema {
 			Computed: true,
 		},
 		"system": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Computed:     true,
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Computed: true,
 		},
 		"tasks_completed": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Computed:     true,
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Computed: true,
 		},
 		"tenant_ref": {
 			Type:     schema.TypeString,
@@ -232,10 +223,9 @@ func ResourceUpgradeStatusInfoSchema() map[string]*schema.Schema {
 			Computed: true,
 		},
 		"total_tasks": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Computed:     true,
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Computed: true,
 		},
 		"upgrade_events": {
 			Type:     schema.TypeList,
@@ -268,65 +258,50 @@ func ResourceUpgradeStatusInfoSchema() map[string]*schema.Schema {
 
 func resourceAviUpgradeStatusInfo() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviUpgradeStatusInfoCreate,
-		Read:   ResourceAviUpgradeStatusInfoRead,
-		Update: resourceAviUpgradeStatusInfoUpdate,
-		Delete: resourceAviUpgradeStatusInfoDelete,
-		Schema: ResourceUpgradeStatusInfoSchema(),
+		CreateContext: resourceAviUpgradeStatusInfoCreate,
+		ReadContext:   ResourceAviUpgradeStatusInfoRead,
+		UpdateContext: resourceAviUpgradeStatusInfoUpdate,
+		DeleteContext: resourceAviUpgradeStatusInfoDelete,
+		Schema:        ResourceUpgradeStatusInfoSchema(),
 		Importer: &schema.ResourceImporter{
-			State: ResourceUpgradeStatusInfoImporter,
+			StateContext: ResourceUpgradeStatusInfoImporter,
 		},
 	}
 }
 
-func ResourceUpgradeStatusInfoImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func ResourceUpgradeStatusInfoImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	s := ResourceUpgradeStatusInfoSchema()
-	return ResourceImporter(d, m, "upgradestatusinfo", s)
+	return ResourceImporter(ctx, d, m, "upgradestatusinfo", s)
 }
 
-func ResourceAviUpgradeStatusInfoRead(d *schema.ResourceData, meta interface{}) error {
+func ResourceAviUpgradeStatusInfoRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceUpgradeStatusInfoSchema()
-	err := APIRead(d, meta, "upgradestatusinfo", s)
-	if err != nil {
-		log.Printf("[ERROR] in reading object %v\n", err)
+	diags := APIRead(ctx, d, meta, "upgradestatusinfo", s)
+	if diags.HasError() {
+		log.Printf("[ERROR] in reading object %v\n", diags)
 	}
-	return err
+	return diags
 }
 
-func resourceAviUpgradeStatusInfoCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviUpgradeStatusInfoCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceUpgradeStatusInfoSchema()
-	err := APICreateOrUpdate(d, meta, "upgradestatusinfo", s)
-	if err == nil {
-		err = ResourceAviUpgradeStatusInfoRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "upgradestatusinfo", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviUpgradeStatusInfoRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviUpgradeStatusInfoUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviUpgradeStatusInfoUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceUpgradeStatusInfoSchema()
-	var err error
-	err = APICreateOrUpdate(d, meta, "upgradestatusinfo", s)
-	if err == nil {
-		err = ResourceAviUpgradeStatusInfoRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "upgradestatusinfo", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviUpgradeStatusInfoRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviUpgradeStatusInfoDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAviUpgradeStatusInfoDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	objType := "upgradestatusinfo"
-	client := meta.(*clients.AviClient)
-	if APIDeleteSystemDefaultCheck(d) {
-		return nil
-	}
-	uuid := d.Get("uuid").(string)
-	if uuid != "" {
-		path := "api/" + objType + "/" + uuid
-		err := client.AviSession.Delete(path)
-		if err != nil && !(strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "204") || strings.Contains(err.Error(), "403")) {
-			log.Println("[INFO] resourceAviUpgradeStatusInfoDelete not found")
-			return err
-		}
-		d.SetId("")
-	}
-	return nil
+	return APIDelete(ctx, d, meta, objType)
 }
@@ -4,26 +4,24 @@
 package avi
 
 import (
+	"context"
 	"log"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/vmware/alb-sdk/go/clients"
 )
 
 func ResourceUpgradeStatusSummarySchema() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
 		"enable_patch_rollback": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "false",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
 		},
 		"enable_rollback": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "false",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
 		},
 		"end_time": {
 			Type:     schema.TypeString,
@@ -67,10 +65,9 @@ func ResourceUpgradeStatusSummarySchema() map[string]*schema.Schema {
 			Elem:     ResourceUpgradeOpsStateSchema(),
 		},
 		"tasks_completed": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Computed:     true,
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Computed: true,
 		},
 		"tenant_ref": {
 			Type:     schema.TypeString,
@@ -78,10 +75,9 @@ func ResourceUpgradeStatusSummarySchema() map[string]*schema.Schema {
 			Computed: true,
 		},
 		"total_tasks": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Computed:     true,
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Computed: true,
 		},
 		"upgrade_ops": {
 			Type:     schema.TypeString,
@@ -103,65 +99,50 @@ func ResourceUpgradeStatusSummarySchema() map[string]*schema.Schema {
 
 func resourceAviUpgradeStatusSummary() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviUpgradeStatusSummaryCreate,
-		Read:   ResourceAviUpgradeStatusSummaryRead,
-		Update: resourceAviUpgradeStatusSummaryUpdate,
-		Delete: resourceAviUpgradeStatusSummaryDelete,
-		Schema: ResourceUpgradeStatusSummarySchema(),
+		CreateContext: resourceAviUpgradeStatusSummaryCreate,
+		ReadContext:   ResourceAviUpgradeStatusSummaryRead,
+		UpdateContext: resourceAviUpgradeStatusSummaryUpdate,
+		DeleteContext: resourceAviUpgradeStatusSummaryDelete,
+		Schema:        ResourceUpgradeStatusSummarySchema(),
 		Importer: &schema.ResourceImporter{
-			State: ResourceUpgradeStatusSummaryImporter,
+			StateContext: ResourceUpgradeStatusSummaryImporter,
 		},
 	}
 }
 
-func ResourceUpgradeStatusSummaryImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func ResourceUpgradeStatusSummaryImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	s := ResourceUpgradeStatusSummarySchema()
-	return ResourceImporter(d, m, "upgradestatussummary", s)
+	return ResourceImporter(ctx, d, m, "upgradestatussummary", s)
 }
 
-func ResourceAviUpgradeStatusSummaryRead(d *schema.ResourceData, meta interface{}) error {
+func ResourceAviUpgradeStatusSummaryRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceUpgradeStatusSummarySchema()
-	err := APIRead(d, meta, "upgradestatussummary", s)
-	if err != nil {
-		log.Printf("[ERROR] in reading object %v\n", err)
+	diags := APIRead(ctx, d, meta, "upgradestatussummary", s)
+	if diags.HasError() {
+		log.Printf("[ERROR] in reading object %v\n", diags)
 	}
-	return err
+	return diags
 }
 
-func resourceAviUpgradeStatusSummaryCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviUpgradeStatusSummaryCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceUpgradeStatusSummarySchema()
-	err := APICreateOrUpdate(d, meta, "upgradestatussummary", s)
-	if err == nil {
-		err = ResourceAviUpgradeStatusSummaryRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "upgradestatussummary", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviUpgradeStatusSummaryRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviUpgradeStatusSummaryUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviUpgradeStatusSummaryUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceUpgradeStatusSummarySchema()
-	var err error
-	err = APICreateOrUpdate(d, meta, "upgradestatussummary", s)
-	if err == nil {
-		err = ResourceAviUpgradeStatusSummaryRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "upgradestatussummary", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviUpgradeStatusSummaryRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviUpgradeStatusSummaryDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAviUpgradeStatusSummaryDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	objType := "upgradestatussummary"
-	client := meta.(*clients.AviClient)
-	if APIDeleteSystemDefaultCheck(d) {
-		return nil
-	}
-	uuid := d.Get("uuid").(string)
-	if uuid != "" {
-		path := "api/" + objType + "/" + uuid
-		err := client.AviSession.Delete(path)
-		if err != nil && !(strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "204") || strings.Contains(err.Error(), "403")) {
-			log.Println("[INFO] resourceAviUpgradeStatusSummaryDelete not found")
-			return err
-		}
-		d.SetId("")
-	}
-	return nil
+	return APIDelete(ctx, d, meta, objType)
 }
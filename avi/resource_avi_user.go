@@ -4,11 +4,11 @@
 package avi
 
 import (
+	"context"
 	"log"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/vmware/alb-sdk/go/clients"
 )
 
 func ResourceUserSchema() map[string]*schema.Schema {
@@ -34,16 +34,14 @@ func ResourceUserSchema() map[string]*schema.Schema {
 			Computed: true,
 		},
 		"is_superuser": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "false",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
 		},
 		"local": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Computed:     true,
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Computed: true,
 		},
 		"name": {
 			Type:     schema.TypeString,
@@ -76,65 +74,50 @@ func ResourceUserSchema() map[string]*schema.Schema {
 
 func resourceAviUser() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviUserCreate,
-		Read:   ResourceAviUserRead,
-		Update: resourceAviUserUpdate,
-		Delete: resourceAviUserDelete,
-		Schema: ResourceUserSchema(),
+		CreateContext: resourceAviUserCreate,
+		ReadContext:   ResourceAviUserRead,
+		UpdateContext: resourceAviUserUpdate,
+		DeleteContext: resourceAviUserDelete,
+		Schema:        ResourceUserSchema(),
 		Importer: &schema.ResourceImporter{
-			State: ResourceUserImporter,
+			StateContext: ResourceUserImporter,
 		},
 	}
 }
 
-func ResourceUserImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func ResourceUserImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	s := ResourceUserSchema()
-	return ResourceImporter(d, m, "user", s)
+	return ResourceImporter(ctx, d, m, "user", s)
 }
 
-func ResourceAviUserRead(d *schema.ResourceData, meta interface{}) error {
+func ResourceAviUserRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceUserSchema()
-	err := APIRead(d, meta, "user", s)
-	if err != nil {
-		log.Printf("[ERROR] in reading object %v\n", err)
+	diags := APIRead(ctx, d, meta, "user", s)
+	if diags.HasError() {
+		log.Printf("[ERROR] in reading object %v\n", diags)
 	}
-	return err
+	return diags
 }
 
-func resourceAviUserCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviUserCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceUserSchema()
-	err := APICreateOrUpdate(d, meta, "user", s)
-	if err == nil {
-		err = ResourceAviUserRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "user", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviUserRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviUserUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviUserUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceUserSchema()
-	var err error
-	err = APICreateOrUpdate(d, meta, "user", s)
-	if err == nil {
-		err = ResourceAviUserRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "user", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviUserRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviUserDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAviUserDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	objType := "user"
-	client := meta.(*clients.AviClient)
-	if APIDeleteSystemDefaultCheck(d) {
-		return nil
-	}
-	uuid := d.Get("uuid").(string)
-	if uuid != "" {
-		path := "api/" + objType + "/" + uuid
-		err := client.AviSession.Delete(path)
-		if err != nil && !(strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "204") || strings.Contains(err.Error(), "403")) {
-			log.Println("[INFO] resourceAviUserDelete not found")
-			return err
-		}
-		d.SetId("")
-	}
-	return nil
+	return APIDelete(ctx, d, meta, objType)
 }
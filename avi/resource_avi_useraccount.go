@@ -12,11 +12,15 @@
 package avi
 
 import (
+	"context"
 	"log"
+	"net/http"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/vmware/alb-sdk/go/clients"
+	"github.com/vmware/alb-sdk/go/session"
 )
 
 func ResourceUserAccountSchema() map[string]*schema.Schema {
@@ -26,12 +30,14 @@ func ResourceUserAccountSchema() map[string]*schema.Schema {
 			Optional: true,
 		},
 		"old_password": {
-			Type:     schema.TypeString,
-			Optional: true,
+			Type:      schema.TypeString,
+			Optional:  true,
+			Sensitive: true,
 		},
 		"password": {
-			Type:     schema.TypeString,
-			Optional: true,
+			Type:      schema.TypeString,
+			Optional:  true,
+			Sensitive: true,
 		},
 		"local": {
 			Type:     schema.TypeBool,
@@ -54,60 +60,149 @@ func ResourceUserAccountSchema() map[string]*schema.Schema {
 
 func resourceAviUserAccount() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviUserAccountCreate,
-		Read:   ResourceAviUserAccountRead,
-		Update: resourceAviUserAccountUpdate,
-		Delete: resourceAviUserAccountDelete,
-		Schema: ResourceUserAccountSchema(),
+		CreateContext: resourceAviUserAccountCreate,
+		ReadContext:   ResourceAviUserAccountRead,
+		UpdateContext: resourceAviUserAccountUpdate,
+		DeleteContext: resourceAviUserAccountDelete,
+		Schema:        ResourceUserAccountSchema(),
+		Importer: &schema.ResourceImporter{
+			StateContext: ResourceUserAccountImporter,
+		},
 	}
 }
 
-func ResourceAviUserAccountRead(d *schema.ResourceData, meta interface{}) error {
+// ResourceUserAccountImporter imports the account of the provider user by its username.
+func ResourceUserAccountImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	d.Set("username", d.Id())
+	return []*schema.ResourceData{d}, nil
+}
+
+// ResourceAviUserAccountRead reads the account of the provider user from api/useraccount. The
+// controller never returns the password, so a password in the state is checked by logging in
+// with it and is cleared from the state when the login is rejected.
+func ResourceAviUserAccountRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ProviderClient)
+	var robj map[string]interface{}
+	err := aviSessionCall(ctx, client, func() error {
+		return client.AviSession.Get("api/useraccount", &robj)
+	})
+	if err != nil {
+		if isAviObjectNotFound(err) {
+			log.Printf("[ERROR] ResourceAviUserAccountRead useraccount not found %v\n", err)
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+	username, _ := robj["username"].(string)
+	if d.Id() != "" && d.Id() != username {
+		return diag.Errorf("useraccount %q is not the account of the provider user %q; "+
+			"avi_useraccount can only manage the account used by the provider", d.Id(), username)
+	}
+	d.SetId(username)
+	d.Set("username", username)
+	for _, k := range []string{"name", "full_name", "email", "local"} {
+		if v, ok := robj[k]; ok {
+			d.Set(k, v)
+		}
+	}
+	if password := d.Get("password").(string); password != "" {
+		valid, err := validateUserAccountPassword(ctx, client, username, password)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if !valid {
+			log.Printf("[INFO] ResourceAviUserAccountRead password of %v was changed outside of terraform", username)
+			d.Set("password", "")
+		}
+	}
 	return nil
 }
 
-func resourceAviUserAccountCreate(d *schema.ResourceData, meta interface{}) error {
-	if strings.Compare(d.Get("old_password").(string), d.Get("password").(string)) == 0 {
+// validateUserAccountPassword reports whether the controller accepts password for username by
+// opening a new session with it. The session is logged out right away, so that the refreshes do
+// not pile up sessions on the controller.
+func validateUserAccountPassword(ctx context.Context, client *ProviderClient, username, password string) (bool, error) {
+	config := client.credentials()
+	config.Username = username
+	config.Password = password
+	config.AuthToken = ""
+	options, err := aviSessionOptions(config, client.throttle)
+	if err != nil {
+		return false, err
+	}
+	options = append(options, session.SetLazyAuthentication(false))
+	// A 401 here rejects the password, it must not re-authenticate the provider session.
+	err = aviSessionAttempt(ctx, client, func() error {
+		probe, err := clients.NewAviClient(aviControllerAddress(config), config.Username, options...)
+		if err != nil {
+			return err
+		}
+		if err := probe.AviSession.Logout(); err != nil {
+			log.Printf("[WARN] validateUserAccountPassword failed to log out of %v: %v\n", username, err)
+		}
 		return nil
+	})
+	if aviErrorStatus(err) == http.StatusUnauthorized {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func resourceAviUserAccountCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if strings.Compare(d.Get("old_password").(string), d.Get("password").(string)) == 0 {
+		return ResourceAviUserAccountRead(ctx, d, meta)
 	}
-	err := resourceAviUserAccountUpdate(d, meta)
-	return err
+	return resourceAviUserAccountUpdate(ctx, d, meta)
 }
 
-func resourceAviUserAccountUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviUserAccountUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceUserAccountSchema()
-	var err error
-	client := meta.(*clients.AviClient)
+	client := meta.(*ProviderClient)
 	var robj interface{}
-	obj := d
-	username := d.Get("username")
-	name := d.Get("name")
-	fullName := d.Get("full_name")
-	email := d.Get("email")
-	local := d.Get("local")
-
-	if data, err := SchemaToAviData(obj, s); err == nil {
-		path := "api/useraccount"
-		err = client.AviSession.Put(path, data, &robj)
-		if err != nil {
-			log.Printf("[ERROR] in updating the object %v\n", err)
-		} else {
-			// we dont get UUID because of nil response and username is unique in useraccount
-			d.SetId(username.(string))
-			d.Set("username", username)
-			d.Set("name", name)
-			d.Set("full_name", fullName)
-			d.Set("email", email)
-			d.Set("local", local)
-			if err = client.AviSession.ResetPassword(d.Get("password").(string)); err != nil {
-				log.Printf("[ERROR] while resetting password %v\n", err)
-			}
+	data, err := SchemaToAviData(d, s)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	// Send the passwords only to change the password, old_password is stale once it is changed.
+	if !d.IsNewResource() && !d.HasChange("password") {
+		delete(data.(map[string]interface{}), "old_password")
+		delete(data.(map[string]interface{}), "password")
+	}
+	path := "api/useraccount"
+	err = aviSessionCall(ctx, client, func() error {
+		return client.AviSession.Put(path, data, &robj)
+	})
+	if err != nil {
+		log.Printf("[ERROR] in updating the object %v\n", err)
+		return diagFromAviError(err, "failed to update useraccount", s)
+	}
+	// we dont get UUID because of nil response and username is unique in useraccount
+	d.SetId(d.Get("username").(string))
+	if password := d.Get("password").(string); password != "" && (d.IsNewResource() || d.HasChange("password")) {
+		if err = aviSessionCall(ctx, client, func() error {
+			return client.AviSession.ResetPassword(password)
+		}); err != nil {
+			log.Printf("[ERROR] while resetting password %v\n", err)
+			return diag.FromErr(err)
 		}
+		// The provider session is re-authenticated with the new password once it expires.
+		client.setPassword(password)
 	}
-	return err
+	return ResourceAviUserAccountRead(ctx, d, meta)
 }
 
-func resourceAviUserAccountDelete(d *schema.ResourceData, meta interface{}) error {
+// The account of the provider user cannot be deleted through api/useraccount, so deleting the
+// resource only removes it from the state.
+func resourceAviUserAccountDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	username := d.Id()
 	d.SetId("")
-	return nil
+	return diag.Diagnostics{{
+		Severity: diag.Warning,
+		Summary:  "useraccount removed from state only",
+		Detail:   "The account " + username + " is not deleted from the controller.",
+	}}
 }
@@ -0,0 +1,81 @@
+package avi
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAVIUserAccountReadAndPasswordDrift(t *testing.T) {
+	fc := newFakeController()
+	defer fc.Close()
+	client := newFakeControllerClient(t, fc)
+	ctx := context.Background()
+
+	d := schema.TestResourceDataRaw(t, ResourceUserAccountSchema(), map[string]interface{}{
+		"username":     fakeControllerUsername,
+		"old_password": fakeControllerPassword,
+		"password":     "new-password",
+		"full_name":    "Admin User",
+		"email":        "admin@example.com",
+	})
+	d.MarkNewResource()
+	if diags := resourceAviUserAccountCreate(ctx, d, client); diags.HasError() {
+		t.Fatalf("useraccount create failed: %v", diags)
+	}
+	if d.Id() != fakeControllerUsername {
+		t.Errorf("unexpected id %v", d.Id())
+	}
+	sessions := fc.SessionCount()
+	if diags := ResourceAviUserAccountRead(ctx, d, client); diags.HasError() {
+		t.Fatalf("useraccount read failed: %v", diags)
+	}
+	if n := fc.SessionCount(); n != sessions {
+		t.Errorf("got %d sessions after the password check, want %d", n, sessions)
+	}
+	if d.Get("full_name") != "Admin User" || d.Get("email") != "admin@example.com" {
+		t.Errorf("account fields were not read back: %v %v", d.Get("full_name"), d.Get("email"))
+	}
+	if d.Get("password") != "new-password" {
+		t.Errorf("valid password was cleared from state")
+	}
+	// A new provider session logs in with the new password.
+	if err := client.reauthenticate(ctx, client.currentSessionGeneration()); err != nil {
+		t.Errorf("re-authentication after the password change failed: %v", err)
+	}
+
+	fc.SetPassword("changed-elsewhere")
+	if diags := ResourceAviUserAccountRead(ctx, d, client); diags.HasError() {
+		t.Fatalf("useraccount read failed: %v", diags)
+	}
+	if d.Get("password") != "" {
+		t.Errorf("password changed outside of terraform was not detected")
+	}
+}
+
+func TestAVIUserAccountImport(t *testing.T) {
+	fc := newFakeController()
+	defer fc.Close()
+	client := newFakeControllerClient(t, fc)
+	ctx := context.Background()
+
+	d := schema.TestResourceDataRaw(t, ResourceUserAccountSchema(), map[string]interface{}{})
+	d.SetId(fakeControllerUsername)
+	results, err := ResourceUserAccountImporter(ctx, d, client)
+	if err != nil {
+		t.Fatalf("import failed: %v", err)
+	}
+	if diags := ResourceAviUserAccountRead(ctx, results[0], client); diags.HasError() {
+		t.Fatalf("read of imported useraccount failed: %v", diags)
+	}
+	if results[0].Get("username") != fakeControllerUsername {
+		t.Errorf("unexpected username %v", results[0].Get("username"))
+	}
+
+	other := schema.TestResourceDataRaw(t, ResourceUserAccountSchema(), map[string]interface{}{})
+	other.SetId("someone-else")
+	if diags := ResourceAviUserAccountRead(ctx, other, client); !diags.HasError() {
+		t.Errorf("account of another user was read as the provider user")
+	}
+}
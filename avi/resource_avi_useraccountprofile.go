@@ -4,20 +4,19 @@
 package avi
 
 import (
+	"context"
 	"log"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/vmware/alb-sdk/go/clients"
 )
 
 func ResourceUserAccountProfileSchema() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
 		"account_lock_timeout": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "30",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  30,
 		},
 		"configpb_attributes": {
 			Type:     schema.TypeSet,
@@ -26,34 +25,29 @@ func ResourceUserAccountProfileSchema() map[string]*schema.Schema {
 			Elem:     ResourceConfigPbAttributesSchema(),
 		},
 		"credentials_timeout_threshold": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "180",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  180,
 		},
 		"login_failure_count_expiry_window": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "0",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  0,
 		},
 		"max_concurrent_sessions": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "0",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  0,
 		},
 		"max_login_failure_count": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "3",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  3,
 		},
 		"max_password_history_count": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "4",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  4,
 		},
 		"name": {
 			Type:     schema.TypeString,
@@ -69,65 +63,50 @@ func ResourceUserAccountProfileSchema() map[string]*schema.Schema {
 
 func resourceAviUserAccountProfile() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviUserAccountProfileCreate,
-		Read:   ResourceAviUserAccountProfileRead,
-		Update: resourceAviUserAccountProfileUpdate,
-		Delete: resourceAviUserAccountProfileDelete,
-		Schema: ResourceUserAccountProfileSchema(),
+		CreateContext: resourceAviUserAccountProfileCreate,
+		ReadContext:   ResourceAviUserAccountProfileRead,
+		UpdateContext: resourceAviUserAccountProfileUpdate,
+		DeleteContext: resourceAviUserAccountProfileDelete,
+		Schema:        ResourceUserAccountProfileSchema(),
 		Importer: &schema.ResourceImporter{
-			State: ResourceUserAccountProfileImporter,
+			StateContext: ResourceUserAccountProfileImporter,
 		},
 	}
 }
 
-func ResourceUserAccountProfileImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func ResourceUserAccountProfileImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	s := ResourceUserAccountProfileSchema()
-	return ResourceImporter(d, m, "useraccountprofile", s)
+	return ResourceImporter(ctx, d, m, "useraccountprofile", s)
 }
 
-func ResourceAviUserAccountProfileRead(d *schema.ResourceData, meta interface{}) error {
+func ResourceAviUserAccountProfileRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceUserAccountProfileSchema()
-	err := APIRead(d, meta, "useraccountprofile", s)
-	if err != nil {
-		log.Printf("[ERROR] in reading object %v\n", err)
+	diags := APIRead(ctx, d, meta, "useraccountprofile", s)
+	if diags.HasError() {
+		log.Printf("[ERROR] in reading object %v\n", diags)
 	}
-	return err
+	return diags
 }
 
-func resourceAviUserAccountProfileCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviUserAccountProfileCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceUserAccountProfileSchema()
-	err := APICreateOrUpdate(d, meta, "useraccountprofile", s)
-	if err == nil {
-		err = ResourceAviUserAccountProfileRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "useraccountprofile", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviUserAccountProfileRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviUserAccountProfileUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviUserAccountProfileUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceUserAccountProfileSchema()
-	var err error
-	err = APICreateOrUpdate(d, meta, "useraccountprofile", s)
-	if err == nil {
-		err = ResourceAviUserAccountProfileRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "useraccountprofile", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviUserAccountProfileRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviUserAccountProfileDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAviUserAccountProfileDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	objType := "useraccountprofile"
-	client := meta.(*clients.AviClient)
-	if APIDeleteSystemDefaultCheck(d) {
-		return nil
-	}
-	uuid := d.Get("uuid").(string)
-	if uuid != "" {
-		path := "api/" + objType + "/" + uuid
-		err := client.AviSession.Delete(path)
-		if err != nil && !(strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "204") || strings.Contains(err.Error(), "403")) {
-			log.Println("[INFO] resourceAviUserAccountProfileDelete not found")
-			return err
-		}
-		d.SetId("")
-	}
-	return nil
+	return APIDelete(ctx, d, meta, objType)
 }
@@ -4,11 +4,11 @@
 package avi
 
 import (
+	"context"
 	"log"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/vmware/alb-sdk/go/clients"
 )
 
 func ResourceVCenterServerSchema() map[string]*schema.Schema {
@@ -56,65 +56,50 @@ func ResourceVCenterServerSchema() map[string]*schema.Schema {
 
 func resourceAviVCenterServer() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviVCenterServerCreate,
-		Read:   ResourceAviVCenterServerRead,
-		Update: resourceAviVCenterServerUpdate,
-		Delete: resourceAviVCenterServerDelete,
-		Schema: ResourceVCenterServerSchema(),
+		CreateContext: resourceAviVCenterServerCreate,
+		ReadContext:   ResourceAviVCenterServerRead,
+		UpdateContext: resourceAviVCenterServerUpdate,
+		DeleteContext: resourceAviVCenterServerDelete,
+		Schema:        ResourceVCenterServerSchema(),
 		Importer: &schema.ResourceImporter{
-			State: ResourceVCenterServerImporter,
+			StateContext: ResourceVCenterServerImporter,
 		},
 	}
 }
 
-func ResourceVCenterServerImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func ResourceVCenterServerImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	s := ResourceVCenterServerSchema()
-	return ResourceImporter(d, m, "vcenterserver", s)
+	return ResourceImporter(ctx, d, m, "vcenterserver", s)
 }
 
-func ResourceAviVCenterServerRead(d *schema.ResourceData, meta interface{}) error {
+func ResourceAviVCenterServerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceVCenterServerSchema()
-	err := APIRead(d, meta, "vcenterserver", s)
-	if err != nil {
-		log.Printf("[ERROR] in reading object %v\n", err)
+	diags := APIRead(ctx, d, meta, "vcenterserver", s)
+	if diags.HasError() {
+		log.Printf("[ERROR] in reading object %v\n", diags)
 	}
-	return err
+	return diags
 }
 
-func resourceAviVCenterServerCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviVCenterServerCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceVCenterServerSchema()
-	err := APICreateOrUpdate(d, meta, "vcenterserver", s)
-	if err == nil {
-		err = ResourceAviVCenterServerRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "vcenterserver", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviVCenterServerRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviVCenterServerUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviVCenterServerUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceVCenterServerSchema()
-	var err error
-	err = APICreateOrUpdate(d, meta, "vcenterserver", s)
-	if err == nil {
-		err = ResourceAviVCenterServerRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "vcenterserver", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviVCenterServerRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviVCenterServerDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAviVCenterServerDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	objType := "vcenterserver"
-	client := meta.(*clients.AviClient)
-	if APIDeleteSystemDefaultCheck(d) {
-		return nil
-	}
-	uuid := d.Get("uuid").(string)
-	if uuid != "" {
-		path := "api/" + objType + "/" + uuid
-		err := client.AviSession.Delete(path)
-		if err != nil && !(strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "204") || strings.Contains(err.Error(), "403")) {
-			log.Println("[INFO] resourceAviVCenterServerDelete not found")
-			return err
-		}
-		d.SetId("")
-	}
-	return nil
+	return APIDelete(ctx, d, meta, objType)
 }
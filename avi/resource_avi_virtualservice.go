@@ -4,11 +4,12 @@
 package avi
 
 import (
+	"context"
 	"log"
-	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/vmware/alb-sdk/go/clients"
 )
 
 func ResourceVirtualServiceSchema() map[string]*schema.Schema {
@@ -19,16 +20,14 @@ func ResourceVirtualServiceSchema() map[string]*schema.Schema {
 			Default:  "ACTIVE_STANDBY_SE_1",
 		},
 		"advertise_down_vs": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "false",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
 		},
 		"allow_invalid_client_cert": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "false",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
 		},
 		"analytics_policy": {
 			Type:     schema.TypeSet,
@@ -62,16 +61,14 @@ func ResourceVirtualServiceSchema() map[string]*schema.Schema {
 			Computed: true,
 		},
 		"bulk_sync_kvcache": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "false",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
 		},
 		"close_client_conn_on_config_update": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "false",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
 		},
 		"cloud_config_cksum": {
 			Type:     schema.TypeString,
@@ -112,10 +109,9 @@ func ResourceVirtualServiceSchema() map[string]*schema.Schema {
 			Computed: true,
 		},
 		"delay_fairness": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "false",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
 		},
 		"description": {
 			Type:     schema.TypeString,
@@ -133,34 +129,29 @@ func ResourceVirtualServiceSchema() map[string]*schema.Schema {
 			Elem:     ResourceDnsPoliciesSchema(),
 		},
 		"east_west_placement": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "false",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
 		},
 		"enable_autogw": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "true",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  true,
 		},
 		"enable_rhi": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Computed:     true,
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Computed: true,
 		},
 		"enable_rhi_snat": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Computed:     true,
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Computed: true,
 		},
 		"enabled": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "true",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  true,
 		},
 		"error_page_profile_ref": {
 			Type:     schema.TypeString,
@@ -198,10 +189,9 @@ func ResourceVirtualServiceSchema() map[string]*schema.Schema {
 			Elem:     &schema.Schema{Type: schema.TypeString},
 		},
 		"ign_pool_net_reach": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "false",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
 		},
 		"jwt_config": {
 			Type:     schema.TypeSet,
@@ -221,10 +211,9 @@ func ResourceVirtualServiceSchema() map[string]*schema.Schema {
 			Elem:     ResourceLDAPVSConfigSchema(),
 		},
 		"limit_doser": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "false",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
 		},
 		"markers": {
 			Type:     schema.TypeList,
@@ -232,10 +221,9 @@ func ResourceVirtualServiceSchema() map[string]*schema.Schema {
 			Elem:     ResourceRoleFilterMatchLabelSchema(),
 		},
 		"max_cps_per_client": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "0",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  0,
 		},
 		"microservice_ref": {
 			Type:     schema.TypeString,
@@ -243,10 +231,9 @@ func ResourceVirtualServiceSchema() map[string]*schema.Schema {
 			Computed: true,
 		},
 		"min_pools_up": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Computed:     true,
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Computed: true,
 		},
 		"name": {
 			Type:     schema.TypeString,
@@ -290,10 +277,9 @@ func ResourceVirtualServiceSchema() map[string]*schema.Schema {
 			Computed: true,
 		},
 		"remove_listening_port_on_vs_down": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "false",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
 		},
 		"requests_rate_limit": {
 			Type:     schema.TypeSet,
@@ -308,10 +294,9 @@ func ResourceVirtualServiceSchema() map[string]*schema.Schema {
 			Elem:     ResourceSAMLSPConfigSchema(),
 		},
 		"scaleout_ecmp": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "false",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
 		},
 		"se_group_ref": {
 			Type:     schema.TypeString,
@@ -375,10 +360,9 @@ func ResourceVirtualServiceSchema() map[string]*schema.Schema {
 			Elem:     ResourceSSLProfileSelectorSchema(),
 		},
 		"ssl_sess_cache_avg_size": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "1024",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  1024,
 		},
 		"sso_policy_ref": {
 			Type:     schema.TypeString,
@@ -411,10 +395,9 @@ func ResourceVirtualServiceSchema() map[string]*schema.Schema {
 			Computed: true,
 		},
 		"traffic_enabled": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "true",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  true,
 		},
 		"type": {
 			Type:     schema.TypeString,
@@ -422,16 +405,14 @@ func ResourceVirtualServiceSchema() map[string]*schema.Schema {
 			Default:  "VS_TYPE_NORMAL",
 		},
 		"use_bridge_ip_as_vip": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "false",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
 		},
 		"use_vip_as_snat": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "false",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
 		},
 		"uuid": {
 			Type:     schema.TypeString,
@@ -489,75 +470,64 @@ func ResourceVirtualServiceSchema() map[string]*schema.Schema {
 			Computed: true,
 		},
 		"weight": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "1",
-			ValidateFunc: validateInteger,
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  1,
 		},
 	}
 }
 
 func resourceAviVirtualService() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviVirtualServiceCreate,
-		Read:   ResourceAviVirtualServiceRead,
-		Update: resourceAviVirtualServiceUpdate,
-		Delete: resourceAviVirtualServiceDelete,
-		Schema: ResourceVirtualServiceSchema(),
+		CreateContext: resourceAviVirtualServiceCreate,
+		ReadContext:   ResourceAviVirtualServiceRead,
+		UpdateContext: resourceAviVirtualServiceUpdate,
+		DeleteContext: resourceAviVirtualServiceDelete,
+		Schema:        ResourceVirtualServiceSchema(),
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
-			State: ResourceVirtualServiceImporter,
+			StateContext: ResourceVirtualServiceImporter,
 		},
 	}
 }
 
-func ResourceVirtualServiceImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func ResourceVirtualServiceImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	s := ResourceVirtualServiceSchema()
-	return ResourceImporter(d, m, "virtualservice", s)
+	return ResourceImporter(ctx, d, m, "virtualservice", s)
 }
 
-func ResourceAviVirtualServiceRead(d *schema.ResourceData, meta interface{}) error {
+func ResourceAviVirtualServiceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceVirtualServiceSchema()
-	err := APIRead(d, meta, "virtualservice", s)
-	if err != nil {
-		log.Printf("[ERROR] in reading object %v\n", err)
+	diags := APIRead(ctx, d, meta, "virtualservice", s)
+	if diags.HasError() {
+		log.Printf("[ERROR] in reading object %v\n", diags)
 	}
-	return err
+	return diags
 }
 
-func resourceAviVirtualServiceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviVirtualServiceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceVirtualServiceSchema()
-	err := APICreateOrUpdate(d, meta, "virtualservice", s)
-	if err == nil {
-		err = ResourceAviVirtualServiceRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "virtualservice", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviVirtualServiceRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviVirtualServiceUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviVirtualServiceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceVirtualServiceSchema()
-	var err error
-	err = APICreateOrUpdate(d, meta, "virtualservice", s)
-	if err == nil {
-		err = ResourceAviVirtualServiceRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "virtualservice", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviVirtualServiceRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviVirtualServiceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAviVirtualServiceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	objType := "virtualservice"
-	client := meta.(*clients.AviClient)
-	if APIDeleteSystemDefaultCheck(d) {
-		return nil
-	}
-	uuid := d.Get("uuid").(string)
-	if uuid != "" {
-		path := "api/" + objType + "/" + uuid
-		err := client.AviSession.Delete(path)
-		if err != nil && !(strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "204") || strings.Contains(err.Error(), "403")) {
-			log.Println("[INFO] resourceAviVirtualServiceDelete not found")
-			return err
-		}
-		d.SetId("")
-	}
-	return nil
+	return APIDelete(ctx, d, meta, objType)
 }
@@ -13,7 +13,6 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
-	"github.com/vmware/alb-sdk/go/clients"
 	"github.com/vmware/alb-sdk/go/models"
 )
 
@@ -55,7 +54,7 @@ func TestAVIVirtualServiceBasic(t *testing.T) {
 
 func testAccCheckAVIVirtualServiceExists(resourcename string, vs *models.VirtualService) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*clients.AviClient).AviSession
+		conn := testAccProvider.Meta().(*ProviderClient).AviSession
 		var obj *models.VirtualService
 		rs, ok := s.RootModule().Resources[resourcename]
 		if !ok {
@@ -102,7 +101,7 @@ func testAccCheckAVIVirtualServiceValuesUpdated(vs *models.VirtualService, key s
 }
 
 func testAccCheckAVIVirtualServiceDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*clients.AviClient).AviSession
+	conn := testAccProvider.Meta().(*ProviderClient).AviSession
 	var obj interface{}
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "avi_virtualservice" {
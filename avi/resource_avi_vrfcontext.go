@@ -4,11 +4,11 @@
 package avi
 
 import (
+	"context"
 	"log"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/vmware/alb-sdk/go/clients"
 )
 
 func ResourceVrfContextSchema() map[string]*schema.Schema {
@@ -64,10 +64,9 @@ func ResourceVrfContextSchema() map[string]*schema.Schema {
 			Elem:     ResourceInternalGatewayMonitorSchema(),
 		},
 		"lldp_enable": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "true",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  true,
 		},
 		"markers": {
 			Type:     schema.TypeList,
@@ -84,10 +83,9 @@ func ResourceVrfContextSchema() map[string]*schema.Schema {
 			Elem:     ResourceStaticRouteSchema(),
 		},
 		"system_default": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "false",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
 		},
 		"tenant_ref": {
 			Type:     schema.TypeString,
@@ -104,65 +102,50 @@ func ResourceVrfContextSchema() map[string]*schema.Schema {
 
 func resourceAviVrfContext() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviVrfContextCreate,
-		Read:   ResourceAviVrfContextRead,
-		Update: resourceAviVrfContextUpdate,
-		Delete: resourceAviVrfContextDelete,
-		Schema: ResourceVrfContextSchema(),
+		CreateContext: resourceAviVrfContextCreate,
+		ReadContext:   ResourceAviVrfContextRead,
+		UpdateContext: resourceAviVrfContextUpdate,
+		DeleteContext: resourceAviVrfContextDelete,
+		Schema:        ResourceVrfContextSchema(),
 		Importer: &schema.ResourceImporter{
-			State: ResourceVrfContextImporter,
+			StateContext: ResourceVrfContextImporter,
 		},
 	}
 }
 
-func ResourceVrfContextImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func ResourceVrfContextImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	s := ResourceVrfContextSchema()
-	return ResourceImporter(d, m, "vrfcontext", s)
+	return ResourceImporter(ctx, d, m, "vrfcontext", s)
 }
 
-func ResourceAviVrfContextRead(d *schema.ResourceData, meta interface{}) error {
+func ResourceAviVrfContextRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceVrfContextSchema()
-	err := APIRead(d, meta, "vrfcontext", s)
-	if err != nil {
-		log.Printf("[ERROR] in reading object %v\n", err)
+	diags := APIRead(ctx, d, meta, "vrfcontext", s)
+	if diags.HasError() {
+		log.Printf("[ERROR] in reading object %v\n", diags)
 	}
-	return err
+	return diags
 }
 
-func resourceAviVrfContextCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviVrfContextCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceVrfContextSchema()
-	err := APICreateOrUpdate(d, meta, "vrfcontext", s)
-	if err == nil {
-		err = ResourceAviVrfContextRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "vrfcontext", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviVrfContextRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviVrfContextUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviVrfContextUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceVrfContextSchema()
-	var err error
-	err = APICreateOrUpdate(d, meta, "vrfcontext", s)
-	if err == nil {
-		err = ResourceAviVrfContextRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "vrfcontext", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviVrfContextRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviVrfContextDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAviVrfContextDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	objType := "vrfcontext"
-	client := meta.(*clients.AviClient)
-	if APIDeleteSystemDefaultCheck(d) {
-		return nil
-	}
-	uuid := d.Get("uuid").(string)
-	if uuid != "" {
-		path := "api/" + objType + "/" + uuid
-		err := client.AviSession.Delete(path)
-		if err != nil && !(strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "204") || strings.Contains(err.Error(), "403")) {
-			log.Println("[INFO] resourceAviVrfContextDelete not found")
-			return err
-		}
-		d.SetId("")
-	}
-	return nil
+	return APIDelete(ctx, d, meta, objType)
 }
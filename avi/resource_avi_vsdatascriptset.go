@@ -4,11 +4,11 @@
 package avi
 
 import (
+	"context"
 	"log"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/vmware/alb-sdk/go/clients"
 )
 
 func ResourceVSDataScriptSetSchema() map[string]*schema.Schema {
@@ -113,65 +113,50 @@ func ResourceVSDataScriptSetSchema() map[string]*schema.Schema {
 
 func resourceAviVSDataScriptSet() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviVSDataScriptSetCreate,
-		Read:   ResourceAviVSDataScriptSetRead,
-		Update: resourceAviVSDataScriptSetUpdate,
-		Delete: resourceAviVSDataScriptSetDelete,
-		Schema: ResourceVSDataScriptSetSchema(),
+		CreateContext: resourceAviVSDataScriptSetCreate,
+		ReadContext:   ResourceAviVSDataScriptSetRead,
+		UpdateContext: resourceAviVSDataScriptSetUpdate,
+		DeleteContext: resourceAviVSDataScriptSetDelete,
+		Schema:        ResourceVSDataScriptSetSchema(),
 		Importer: &schema.ResourceImporter{
-			State: ResourceVSDataScriptSetImporter,
+			StateContext: ResourceVSDataScriptSetImporter,
 		},
 	}
 }
 
-func ResourceVSDataScriptSetImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func ResourceVSDataScriptSetImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	s := ResourceVSDataScriptSetSchema()
-	return ResourceImporter(d, m, "vsdatascriptset", s)
+	return ResourceImporter(ctx, d, m, "vsdatascriptset", s)
 }
 
-func ResourceAviVSDataScriptSetRead(d *schema.ResourceData, meta interface{}) error {
+func ResourceAviVSDataScriptSetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceVSDataScriptSetSchema()
-	err := APIRead(d, meta, "vsdatascriptset", s)
-	if err != nil {
-		log.Printf("[ERROR] in reading object %v\n", err)
+	diags := APIRead(ctx, d, meta, "vsdatascriptset", s)
+	if diags.HasError() {
+		log.Printf("[ERROR] in reading object %v\n", diags)
 	}
-	return err
+	return diags
 }
 
-func resourceAviVSDataScriptSetCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviVSDataScriptSetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceVSDataScriptSetSchema()
-	err := APICreateOrUpdate(d, meta, "vsdatascriptset", s)
-	if err == nil {
-		err = ResourceAviVSDataScriptSetRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "vsdatascriptset", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviVSDataScriptSetRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviVSDataScriptSetUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviVSDataScriptSetUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceVSDataScriptSetSchema()
-	var err error
-	err = APICreateOrUpdate(d, meta, "vsdatascriptset", s)
-	if err == nil {
-		err = ResourceAviVSDataScriptSetRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "vsdatascriptset", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviVSDataScriptSetRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviVSDataScriptSetDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAviVSDataScriptSetDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	objType := "vsdatascriptset"
-	client := meta.(*clients.AviClient)
-	if APIDeleteSystemDefaultCheck(d) {
-		return nil
-	}
-	uuid := d.Get("uuid").(string)
-	if uuid != "" {
-		path := "api/" + objType + "/" + uuid
-		err := client.AviSession.Delete(path)
-		if err != nil && !(strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "204") || strings.Contains(err.Error(), "403")) {
-			log.Println("[INFO] resourceAviVSDataScriptSetDelete not found")
-			return err
-		}
-		d.SetId("")
-	}
-	return nil
+	return APIDelete(ctx, d, meta, objType)
 }
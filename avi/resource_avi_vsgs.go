@@ -4,11 +4,11 @@
 package avi
 
 import (
+	"context"
 	"log"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/vmware/alb-sdk/go/clients"
 )
 
 func ResourceVsGsSchema() map[string]*schema.Schema {
@@ -64,65 +64,50 @@ func ResourceVsGsSchema() map[string]*schema.Schema {
 
 func resourceAviVsGs() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviVsGsCreate,
-		Read:   ResourceAviVsGsRead,
-		Update: resourceAviVsGsUpdate,
-		Delete: resourceAviVsGsDelete,
-		Schema: ResourceVsGsSchema(),
+		CreateContext: resourceAviVsGsCreate,
+		ReadContext:   ResourceAviVsGsRead,
+		UpdateContext: resourceAviVsGsUpdate,
+		DeleteContext: resourceAviVsGsDelete,
+		Schema:        ResourceVsGsSchema(),
 		Importer: &schema.ResourceImporter{
-			State: ResourceVsGsImporter,
+			StateContext: ResourceVsGsImporter,
 		},
 	}
 }
 
-func ResourceVsGsImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func ResourceVsGsImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	s := ResourceVsGsSchema()
-	return ResourceImporter(d, m, "vsgs", s)
+	return ResourceImporter(ctx, d, m, "vsgs", s)
 }
 
-func ResourceAviVsGsRead(d *schema.ResourceData, meta interface{}) error {
+func ResourceAviVsGsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceVsGsSchema()
-	err := APIRead(d, meta, "vsgs", s)
-	if err != nil {
-		log.Printf("[ERROR] in reading object %v\n", err)
+	diags := APIRead(ctx, d, meta, "vsgs", s)
+	if diags.HasError() {
+		log.Printf("[ERROR] in reading object %v\n", diags)
 	}
-	return err
+	return diags
 }
 
-func resourceAviVsGsCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviVsGsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceVsGsSchema()
-	err := APICreateOrUpdate(d, meta, "vsgs", s)
-	if err == nil {
-		err = ResourceAviVsGsRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "vsgs", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviVsGsRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviVsGsUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviVsGsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceVsGsSchema()
-	var err error
-	err = APICreateOrUpdate(d, meta, "vsgs", s)
-	if err == nil {
-		err = ResourceAviVsGsRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "vsgs", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviVsGsRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviVsGsDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAviVsGsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	objType := "vsgs"
-	client := meta.(*clients.AviClient)
-	if APIDeleteSystemDefaultCheck(d) {
-		return nil
-	}
-	uuid := d.Get("uuid").(string)
-	if uuid != "" {
-		path := "api/" + objType + "/" + uuid
-		err := client.AviSession.Delete(path)
-		if err != nil && !(strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "204") || strings.Contains(err.Error(), "403")) {
-			log.Println("[INFO] resourceAviVsGsDelete not found")
-			return err
-		}
-		d.SetId("")
-	}
-	return nil
+	return APIDelete(ctx, d, meta, objType)
 }
@@ -4,11 +4,11 @@
 package avi
 
 import (
+	"context"
 	"log"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/vmware/alb-sdk/go/clients"
 )
 
 func ResourceVsVipSchema() map[string]*schema.Schema {
@@ -35,10 +35,9 @@ func ResourceVsVipSchema() map[string]*schema.Schema {
 			Elem:     ResourceDnsInfoSchema(),
 		},
 		"east_west_placement": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "false",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
 		},
 		"ipam_selector": {
 			Type:     schema.TypeSet,
@@ -66,10 +65,9 @@ func ResourceVsVipSchema() map[string]*schema.Schema {
 			Computed: true,
 		},
 		"use_standard_alb": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Computed:     true,
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Computed: true,
 		},
 		"uuid": {
 			Type:     schema.TypeString,
@@ -96,49 +94,51 @@ func ResourceVsVipSchema() map[string]*schema.Schema {
 
 func resourceAviVsVip() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviVsVipCreate,
-		Read:   ResourceAviVsVipRead,
-		Update: resourceAviVsVipUpdate,
-		Delete: resourceAviVsVipDelete,
-		Schema: ResourceVsVipSchema(),
+		CreateContext: resourceAviVsVipCreate,
+		ReadContext:   ResourceAviVsVipRead,
+		UpdateContext: resourceAviVsVipUpdate,
+		DeleteContext: resourceAviVsVipDelete,
+		Schema:        ResourceVsVipSchema(),
 		Importer: &schema.ResourceImporter{
-			State: ResourceVsVipImporter,
+			StateContext: ResourceVsVipImporter,
 		},
 	}
 }
 
-func ResourceVsVipImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func ResourceVsVipImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	s := ResourceVsVipSchema()
-	return ResourceImporter(d, m, "vsvip", s)
+	return ResourceImporter(ctx, d, m, "vsvip", s)
 }
 
-func ResourceAviVsVipRead(d *schema.ResourceData, meta interface{}) error {
+func ResourceAviVsVipRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceVsVipSchema()
-	err := APIRead(d, meta, "vsvip", s)
-	if err != nil {
-		log.Printf("[ERROR] in reading object %v\n", err)
+	diags := APIRead(ctx, d, meta, "vsvip", s)
+	if diags.HasError() {
+		log.Printf("[ERROR] in reading object %v\n", diags)
 	}
-	return err
+	return diags
 }
 
-func resourceAviVsVipCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviVsVipCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceVsVipSchema()
-	err := APICreateOrUpdate(d, meta, "vsvip", s)
-	if err == nil {
-		err = ResourceAviVsVipRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "vsvip", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviVsVipRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviVsVipUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviVsVipUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceVsVipSchema()
 	var err error
 	var existingvsvip interface{}
 	var apiResponse interface{}
-	client := meta.(*clients.AviClient)
+	client := meta.(*ProviderClient)
 	uuid := d.Get("uuid").(string)
 	vsvippath := "api/vsvip/" + uuid
-	err = client.AviSession.Get(vsvippath, &existingvsvip)
+	err = aviSessionCall(ctx, client, func() error {
+		return client.AviSession.Get(vsvippath, &existingvsvip)
+	})
 	var vipobjs []interface{}
 	autoAllocFlag := false
 	if err == nil {
@@ -146,7 +146,7 @@ func resourceAviVsVipUpdate(d *schema.ResourceData, meta interface{}) error {
 		//Before GO lang sets zero value to fields which are absent in api response
 		//setting those fields to schema default and then overwritting d (local state)
 		if localData, err := SchemaToAviData(d, s); err == nil {
-			apiResponse, _ = SetDefaultsInAPIRes(existingvsvip, localData, s)
+			apiResponse, _ = SetDefaultsInAPIRes(existingvsvip, localData, s, "avi_vsvip")
 		} else {
 			log.Printf("[ERROR] resourceAviVsVipUpdate in SchemaToAviData: %v\n", err)
 		}
@@ -163,7 +163,7 @@ func resourceAviVsVipUpdate(d *schema.ResourceData, meta interface{}) error {
 				}
 			}
 			if autoAllocFlag {
-				err = d.Set("vip", vipobjs)
+				err = d.Set("vip", setsToLists(vipobjs))
 				if err != nil {
 					log.Printf("[ERROR] resourceAviVSVIPUpdate in Setting vip: %v\n", err)
 				}
@@ -174,28 +174,14 @@ func resourceAviVsVipUpdate(d *schema.ResourceData, meta interface{}) error {
 	} else {
 		log.Printf("[ERROR] resourceAviVsVipUpdate in GET: %v\n", err)
 	}
-	err = APICreateOrUpdate(d, meta, "vsvip", s)
-	if err == nil {
-		err = ResourceAviVsVipRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "vsvip", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviVsVipRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviVsVipDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAviVsVipDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	objType := "vsvip"
-	client := meta.(*clients.AviClient)
-	if APIDeleteSystemDefaultCheck(d) {
-		return nil
-	}
-	uuid := d.Get("uuid").(string)
-	if uuid != "" {
-		path := "api/" + objType + "/" + uuid
-		err := client.AviSession.Delete(path)
-		if err != nil && !(strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "204") || strings.Contains(err.Error(), "403")) {
-			log.Println("[INFO] resourceAviVsVipDelete not found")
-			return err
-		}
-		d.SetId("")
-	}
-	return nil
+	return APIDelete(ctx, d, meta, objType)
 }
@@ -4,11 +4,11 @@
 package avi
 
 import (
+	"context"
 	"log"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/vmware/alb-sdk/go/clients"
 )
 
 func ResourceWafApplicationSignatureProviderSchema() map[string]*schema.Schema {
@@ -60,65 +60,50 @@ func ResourceWafApplicationSignatureProviderSchema() map[string]*schema.Schema {
 
 func resourceAviWafApplicationSignatureProvider() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviWafApplicationSignatureProviderCreate,
-		Read:   ResourceAviWafApplicationSignatureProviderRead,
-		Update: resourceAviWafApplicationSignatureProviderUpdate,
-		Delete: resourceAviWafApplicationSignatureProviderDelete,
-		Schema: ResourceWafApplicationSignatureProviderSchema(),
+		CreateContext: resourceAviWafApplicationSignatureProviderCreate,
+		ReadContext:   ResourceAviWafApplicationSignatureProviderRead,
+		UpdateContext: resourceAviWafApplicationSignatureProviderUpdate,
+		DeleteContext: resourceAviWafApplicationSignatureProviderDelete,
+		Schema:        ResourceWafApplicationSignatureProviderSchema(),
 		Importer: &schema.ResourceImporter{
-			State: ResourceWafApplicationSignatureProviderImporter,
+			StateContext: ResourceWafApplicationSignatureProviderImporter,
 		},
 	}
 }
 
-func ResourceWafApplicationSignatureProviderImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func ResourceWafApplicationSignatureProviderImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	s := ResourceWafApplicationSignatureProviderSchema()
-	return ResourceImporter(d, m, "wafapplicationsignatureprovider", s)
+	return ResourceImporter(ctx, d, m, "wafapplicationsignatureprovider", s)
 }
 
-func ResourceAviWafApplicationSignatureProviderRead(d *schema.ResourceData, meta interface{}) error {
+func ResourceAviWafApplicationSignatureProviderRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceWafApplicationSignatureProviderSchema()
-	err := APIRead(d, meta, "wafapplicationsignatureprovider", s)
-	if err != nil {
-		log.Printf("[ERROR] in reading object %v\n", err)
+	diags := APIRead(ctx, d, meta, "wafapplicationsignatureprovider", s)
+	if diags.HasError() {
+		log.Printf("[ERROR] in reading object %v\n", diags)
 	}
-	return err
+	return diags
 }
 
-func resourceAviWafApplicationSignatureProviderCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviWafApplicationSignatureProviderCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceWafApplicationSignatureProviderSchema()
-	err := APICreateOrUpdate(d, meta, "wafapplicationsignatureprovider", s)
-	if err == nil {
-		err = ResourceAviWafApplicationSignatureProviderRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "wafapplicationsignatureprovider", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviWafApplicationSignatureProviderRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviWafApplicationSignatureProviderUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviWafApplicationSignatureProviderUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceWafApplicationSignatureProviderSchema()
-	var err error
-	err = APICreateOrUpdate(d, meta, "wafapplicationsignatureprovider", s)
-	if err == nil {
-		err = ResourceAviWafApplicationSignatureProviderRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "wafapplicationsignatureprovider", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviWafApplicationSignatureProviderRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviWafApplicationSignatureProviderDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAviWafApplicationSignatureProviderDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	objType := "wafapplicationsignatureprovider"
-	client := meta.(*clients.AviClient)
-	if APIDeleteSystemDefaultCheck(d) {
-		return nil
-	}
-	uuid := d.Get("uuid").(string)
-	if uuid != "" {
-		path := "api/" + objType + "/" + uuid
-		err := client.AviSession.Delete(path)
-		if err != nil && !(strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "204") || strings.Contains(err.Error(), "403")) {
-			log.Println("[INFO] resourceAviWafApplicationSignatureProviderDelete not found")
-			return err
-		}
-		d.SetId("")
-	}
-	return nil
+	return APIDelete(ctx, d, meta, objType)
 }
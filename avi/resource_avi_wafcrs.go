@@ -4,11 +4,11 @@
 package avi
 
 import (
+	"context"
 	"log"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/vmware/alb-sdk/go/clients"
 )
 
 func ResourceWafCRSSchema() map[string]*schema.Schema {
@@ -64,65 +64,50 @@ func ResourceWafCRSSchema() map[string]*schema.Schema {
 
 func resourceAviWafCRS() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviWafCRSCreate,
-		Read:   ResourceAviWafCRSRead,
-		Update: resourceAviWafCRSUpdate,
-		Delete: resourceAviWafCRSDelete,
-		Schema: ResourceWafCRSSchema(),
+		CreateContext: resourceAviWafCRSCreate,
+		ReadContext:   ResourceAviWafCRSRead,
+		UpdateContext: resourceAviWafCRSUpdate,
+		DeleteContext: resourceAviWafCRSDelete,
+		Schema:        ResourceWafCRSSchema(),
 		Importer: &schema.ResourceImporter{
-			State: ResourceWafCRSImporter,
+			StateContext: ResourceWafCRSImporter,
 		},
 	}
 }
 
-func ResourceWafCRSImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func ResourceWafCRSImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	s := ResourceWafCRSSchema()
-	return ResourceImporter(d, m, "wafcrs", s)
+	return ResourceImporter(ctx, d, m, "wafcrs", s)
 }
 
-func ResourceAviWafCRSRead(d *schema.ResourceData, meta interface{}) error {
+func ResourceAviWafCRSRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceWafCRSSchema()
-	err := APIRead(d, meta, "wafcrs", s)
-	if err != nil {
-		log.Printf("[ERROR] in reading object %v\n", err)
+	diags := APIRead(ctx, d, meta, "wafcrs", s)
+	if diags.HasError() {
+		log.Printf("[ERROR] in reading object %v\n", diags)
 	}
-	return err
+	return diags
 }
 
-func resourceAviWafCRSCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviWafCRSCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceWafCRSSchema()
-	err := APICreateOrUpdate(d, meta, "wafcrs", s)
-	if err == nil {
-		err = ResourceAviWafCRSRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "wafcrs", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviWafCRSRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviWafCRSUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviWafCRSUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceWafCRSSchema()
-	var err error
-	err = APICreateOrUpdate(d, meta, "wafcrs", s)
-	if err == nil {
-		err = ResourceAviWafCRSRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "wafcrs", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviWafCRSRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviWafCRSDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAviWafCRSDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	objType := "wafcrs"
-	client := meta.(*clients.AviClient)
-	if APIDeleteSystemDefaultCheck(d) {
-		return nil
-	}
-	uuid := d.Get("uuid").(string)
-	if uuid != "" {
-		path := "api/" + objType + "/" + uuid
-		err := client.AviSession.Delete(path)
-		if err != nil && !(strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "204") || strings.Contains(err.Error(), "403")) {
-			log.Println("[INFO] resourceAviWafCRSDelete not found")
-			return err
-		}
-		d.SetId("")
-	}
-	return nil
+	return APIDelete(ctx, d, meta, objType)
 }
@@ -4,20 +4,19 @@
 package avi
 
 import (
+	"context"
 	"log"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/vmware/alb-sdk/go/clients"
 )
 
 func ResourceWafPolicySchema() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
 		"allow_mode_delegation": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "true",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  true,
 		},
 		"allowlist": {
 			Type:     schema.TypeSet,
@@ -32,16 +31,14 @@ func ResourceWafPolicySchema() map[string]*schema.Schema {
 			Elem:     ResourceWafApplicationSignaturesSchema(),
 		},
 		"auto_update_crs": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "false",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
 		},
 		"bypass_static_extensions": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "true",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  true,
 		},
 		"confidence_override": {
 			Type:     schema.TypeSet,
@@ -71,22 +68,19 @@ func ResourceWafPolicySchema() map[string]*schema.Schema {
 			Computed: true,
 		},
 		"enable_app_learning": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "false",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
 		},
 		"enable_auto_rule_updates": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "true",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  true,
 		},
 		"enable_regex_learning": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "false",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
 		},
 		"failure_mode": {
 			Type:     schema.TypeString,
@@ -155,10 +149,9 @@ func ResourceWafPolicySchema() map[string]*schema.Schema {
 			Computed: true,
 		},
 		"updated_crs_rules_in_detection_mode": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "true",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  true,
 		},
 		"uuid": {
 			Type:     schema.TypeString,
@@ -179,65 +172,50 @@ func ResourceWafPolicySchema() map[string]*schema.Schema {
 
 func resourceAviWafPolicy() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviWafPolicyCreate,
-		Read:   ResourceAviWafPolicyRead,
-		Update: resourceAviWafPolicyUpdate,
-		Delete: resourceAviWafPolicyDelete,
-		Schema: ResourceWafPolicySchema(),
+		CreateContext: resourceAviWafPolicyCreate,
+		ReadContext:   ResourceAviWafPolicyRead,
+		UpdateContext: resourceAviWafPolicyUpdate,
+		DeleteContext: resourceAviWafPolicyDelete,
+		Schema:        ResourceWafPolicySchema(),
 		Importer: &schema.ResourceImporter{
-			State: ResourceWafPolicyImporter,
+			StateContext: ResourceWafPolicyImporter,
 		},
 	}
 }
 
-func ResourceWafPolicyImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func ResourceWafPolicyImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	s := ResourceWafPolicySchema()
-	return ResourceImporter(d, m, "wafpolicy", s)
+	return ResourceImporter(ctx, d, m, "wafpolicy", s)
 }
 
-func ResourceAviWafPolicyRead(d *schema.ResourceData, meta interface{}) error {
+func ResourceAviWafPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceWafPolicySchema()
-	err := APIRead(d, meta, "wafpolicy", s)
-	if err != nil {
-		log.Printf("[ERROR] in reading object %v\n", err)
+	diags := APIRead(ctx, d, meta, "wafpolicy", s)
+	if diags.HasError() {
+		log.Printf("[ERROR] in reading object %v\n", diags)
 	}
-	return err
+	return diags
 }
 
-func resourceAviWafPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviWafPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceWafPolicySchema()
-	err := APICreateOrUpdate(d, meta, "wafpolicy", s)
-	if err == nil {
-		err = ResourceAviWafPolicyRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "wafpolicy", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviWafPolicyRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviWafPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviWafPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceWafPolicySchema()
-	var err error
-	err = APICreateOrUpdate(d, meta, "wafpolicy", s)
-	if err == nil {
-		err = ResourceAviWafPolicyRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "wafpolicy", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviWafPolicyRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviWafPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAviWafPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	objType := "wafpolicy"
-	client := meta.(*clients.AviClient)
-	if APIDeleteSystemDefaultCheck(d) {
-		return nil
-	}
-	uuid := d.Get("uuid").(string)
-	if uuid != "" {
-		path := "api/" + objType + "/" + uuid
-		err := client.AviSession.Delete(path)
-		if err != nil && !(strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "204") || strings.Contains(err.Error(), "403")) {
-			log.Println("[INFO] resourceAviWafPolicyDelete not found")
-			return err
-		}
-		d.SetId("")
-	}
-	return nil
+	return APIDelete(ctx, d, meta, objType)
 }
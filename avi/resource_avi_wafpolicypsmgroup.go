@@ -4,11 +4,11 @@
 package avi
 
 import (
+	"context"
 	"log"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/vmware/alb-sdk/go/clients"
 )
 
 func ResourceWafPolicyPSMGroupSchema() map[string]*schema.Schema {
@@ -25,10 +25,9 @@ func ResourceWafPolicyPSMGroupSchema() map[string]*schema.Schema {
 			Computed: true,
 		},
 		"enable": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "true",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  true,
 		},
 		"hit_action": {
 			Type:     schema.TypeString,
@@ -36,10 +35,9 @@ func ResourceWafPolicyPSMGroupSchema() map[string]*schema.Schema {
 			Default:  "WAF_ACTION_ALLOW_PARAMETER",
 		},
 		"is_learning_group": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "false",
-			ValidateFunc: validateBool,
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
 		},
 		"locations": {
 			Type:     schema.TypeList,
@@ -75,65 +73,50 @@ func ResourceWafPolicyPSMGroupSchema() map[string]*schema.Schema {
 
 func resourceAviWafPolicyPSMGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviWafPolicyPSMGroupCreate,
-		Read:   ResourceAviWafPolicyPSMGroupRead,
-		Update: resourceAviWafPolicyPSMGroupUpdate,
-		Delete: resourceAviWafPolicyPSMGroupDelete,
-		Schema: ResourceWafPolicyPSMGroupSchema(),
+		CreateContext: resourceAviWafPolicyPSMGroupCreate,
+		ReadContext:   ResourceAviWafPolicyPSMGroupRead,
+		UpdateContext: resourceAviWafPolicyPSMGroupUpdate,
+		DeleteContext: resourceAviWafPolicyPSMGroupDelete,
+		Schema:        ResourceWafPolicyPSMGroupSchema(),
 		Importer: &schema.ResourceImporter{
-			State: ResourceWafPolicyPSMGroupImporter,
+			StateContext: ResourceWafPolicyPSMGroupImporter,
 		},
 	}
 }
 
-func ResourceWafPolicyPSMGroupImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func ResourceWafPolicyPSMGroupImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	s := ResourceWafPolicyPSMGroupSchema()
-	return ResourceImporter(d, m, "wafpolicypsmgroup", s)
+	return ResourceImporter(ctx, d, m, "wafpolicypsmgroup", s)
 }
 
-func ResourceAviWafPolicyPSMGroupRead(d *schema.ResourceData, meta interface{}) error {
+func ResourceAviWafPolicyPSMGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceWafPolicyPSMGroupSchema()
-	err := APIRead(d, meta, "wafpolicypsmgroup", s)
-	if err != nil {
-		log.Printf("[ERROR] in reading object %v\n", err)
+	diags := APIRead(ctx, d, meta, "wafpolicypsmgroup", s)
+	if diags.HasError() {
+		log.Printf("[ERROR] in reading object %v\n", diags)
 	}
-	return err
+	return diags
 }
 
-func resourceAviWafPolicyPSMGroupCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviWafPolicyPSMGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceWafPolicyPSMGroupSchema()
-	err := APICreateOrUpdate(d, meta, "wafpolicypsmgroup", s)
-	if err == nil {
-		err = ResourceAviWafPolicyPSMGroupRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "wafpolicypsmgroup", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviWafPolicyPSMGroupRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviWafPolicyPSMGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviWafPolicyPSMGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceWafPolicyPSMGroupSchema()
-	var err error
-	err = APICreateOrUpdate(d, meta, "wafpolicypsmgroup", s)
-	if err == nil {
-		err = ResourceAviWafPolicyPSMGroupRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "wafpolicypsmgroup", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviWafPolicyPSMGroupRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviWafPolicyPSMGroupDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAviWafPolicyPSMGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	objType := "wafpolicypsmgroup"
-	client := meta.(*clients.AviClient)
-	if APIDeleteSystemDefaultCheck(d) {
-		return nil
-	}
-	uuid := d.Get("uuid").(string)
-	if uuid != "" {
-		path := "api/" + objType + "/" + uuid
-		err := client.AviSession.Delete(path)
-		if err != nil && !(strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "204") || strings.Contains(err.Error(), "403")) {
-			log.Println("[INFO] resourceAviWafPolicyPSMGroupDelete not found")
-			return err
-		}
-		d.SetId("")
-	}
-	return nil
+	return APIDelete(ctx, d, meta, objType)
 }
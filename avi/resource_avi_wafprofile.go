@@ -4,11 +4,11 @@
 package avi
 
 import (
+	"context"
 	"log"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/vmware/alb-sdk/go/clients"
 )
 
 func ResourceWafProfileSchema() map[string]*schema.Schema {
@@ -58,65 +58,50 @@ func ResourceWafProfileSchema() map[string]*schema.Schema {
 
 func resourceAviWafProfile() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviWafProfileCreate,
-		Read:   ResourceAviWafProfileRead,
-		Update: resourceAviWafProfileUpdate,
-		Delete: resourceAviWafProfileDelete,
-		Schema: ResourceWafProfileSchema(),
+		CreateContext: resourceAviWafProfileCreate,
+		ReadContext:   ResourceAviWafProfileRead,
+		UpdateContext: resourceAviWafProfileUpdate,
+		DeleteContext: resourceAviWafProfileDelete,
+		Schema:        ResourceWafProfileSchema(),
 		Importer: &schema.ResourceImporter{
-			State: ResourceWafProfileImporter,
+			StateContext: ResourceWafProfileImporter,
 		},
 	}
 }
 
-func ResourceWafProfileImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func ResourceWafProfileImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	s := ResourceWafProfileSchema()
-	return ResourceImporter(d, m, "wafprofile", s)
+	return ResourceImporter(ctx, d, m, "wafprofile", s)
 }
 
-func ResourceAviWafProfileRead(d *schema.ResourceData, meta interface{}) error {
+func ResourceAviWafProfileRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceWafProfileSchema()
-	err := APIRead(d, meta, "wafprofile", s)
-	if err != nil {
-		log.Printf("[ERROR] in reading object %v\n", err)
+	diags := APIRead(ctx, d, meta, "wafprofile", s)
+	if diags.HasError() {
+		log.Printf("[ERROR] in reading object %v\n", diags)
 	}
-	return err
+	return diags
 }
 
-func resourceAviWafProfileCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviWafProfileCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceWafProfileSchema()
-	err := APICreateOrUpdate(d, meta, "wafprofile", s)
-	if err == nil {
-		err = ResourceAviWafProfileRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "wafprofile", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviWafProfileRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviWafProfileUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviWafProfileUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceWafProfileSchema()
-	var err error
-	err = APICreateOrUpdate(d, meta, "wafprofile", s)
-	if err == nil {
-		err = ResourceAviWafProfileRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "wafprofile", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviWafProfileRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviWafProfileDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAviWafProfileDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	objType := "wafprofile"
-	client := meta.(*clients.AviClient)
-	if APIDeleteSystemDefaultCheck(d) {
-		return nil
-	}
-	uuid := d.Get("uuid").(string)
-	if uuid != "" {
-		path := "api/" + objType + "/" + uuid
-		err := client.AviSession.Delete(path)
-		if err != nil && !(strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "204") || strings.Contains(err.Error(), "403")) {
-			log.Println("[INFO] resourceAviWafProfileDelete not found")
-			return err
-		}
-		d.SetId("")
-	}
-	return nil
+	return APIDelete(ctx, d, meta, objType)
 }
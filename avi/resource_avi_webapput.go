@@ -4,11 +4,11 @@
 package avi
 
 import (
+	"context"
 	"log"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/vmware/alb-sdk/go/clients"
 )
 
 func ResourceWebappUTSchema() map[string]*schema.Schema {
@@ -65,65 +65,50 @@ func ResourceWebappUTSchema() map[string]*schema.Schema {
 
 func resourceAviWebappUT() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviWebappUTCreate,
-		Read:   ResourceAviWebappUTRead,
-		Update: resourceAviWebappUTUpdate,
-		Delete: resourceAviWebappUTDelete,
-		Schema: ResourceWebappUTSchema(),
+		CreateContext: resourceAviWebappUTCreate,
+		ReadContext:   ResourceAviWebappUTRead,
+		UpdateContext: resourceAviWebappUTUpdate,
+		DeleteContext: resourceAviWebappUTDelete,
+		Schema:        ResourceWebappUTSchema(),
 		Importer: &schema.ResourceImporter{
-			State: ResourceWebappUTImporter,
+			StateContext: ResourceWebappUTImporter,
 		},
 	}
 }
 
-func ResourceWebappUTImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func ResourceWebappUTImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	s := ResourceWebappUTSchema()
-	return ResourceImporter(d, m, "webapput", s)
+	return ResourceImporter(ctx, d, m, "webapput", s)
 }
 
-func ResourceAviWebappUTRead(d *schema.ResourceData, meta interface{}) error {
+func ResourceAviWebappUTRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceWebappUTSchema()
-	err := APIRead(d, meta, "webapput", s)
-	if err != nil {
-		log.Printf("[ERROR] in reading object %v\n", err)
+	diags := APIRead(ctx, d, meta, "webapput", s)
+	if diags.HasError() {
+		log.Printf("[ERROR] in reading object %v\n", diags)
 	}
-	return err
+	return diags
 }
 
-func resourceAviWebappUTCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviWebappUTCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceWebappUTSchema()
-	err := APICreateOrUpdate(d, meta, "webapput", s)
-	if err == nil {
-		err = ResourceAviWebappUTRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "webapput", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviWebappUTRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviWebappUTUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviWebappUTUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceWebappUTSchema()
-	var err error
-	err = APICreateOrUpdate(d, meta, "webapput", s)
-	if err == nil {
-		err = ResourceAviWebappUTRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "webapput", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviWebappUTRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviWebappUTDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAviWebappUTDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	objType := "webapput"
-	client := meta.(*clients.AviClient)
-	if APIDeleteSystemDefaultCheck(d) {
-		return nil
-	}
-	uuid := d.Get("uuid").(string)
-	if uuid != "" {
-		path := "api/" + objType + "/" + uuid
-		err := client.AviSession.Delete(path)
-		if err != nil && !(strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "204") || strings.Contains(err.Error(), "403")) {
-			log.Println("[INFO] resourceAviWebappUTDelete not found")
-			return err
-		}
-		d.SetId("")
-	}
-	return nil
+	return APIDelete(ctx, d, meta, objType)
 }
@@ -4,11 +4,11 @@
 package avi
 
 import (
+	"context"
 	"log"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/vmware/alb-sdk/go/clients"
 )
 
 func ResourceWebhookSchema() map[string]*schema.Schema {
@@ -58,65 +58,50 @@ func ResourceWebhookSchema() map[string]*schema.Schema {
 
 func resourceAviWebhook() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviWebhookCreate,
-		Read:   ResourceAviWebhookRead,
-		Update: resourceAviWebhookUpdate,
-		Delete: resourceAviWebhookDelete,
-		Schema: ResourceWebhookSchema(),
+		CreateContext: resourceAviWebhookCreate,
+		ReadContext:   ResourceAviWebhookRead,
+		UpdateContext: resourceAviWebhookUpdate,
+		DeleteContext: resourceAviWebhookDelete,
+		Schema:        ResourceWebhookSchema(),
 		Importer: &schema.ResourceImporter{
-			State: ResourceWebhookImporter,
+			StateContext: ResourceWebhookImporter,
 		},
 	}
 }
 
-func ResourceWebhookImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func ResourceWebhookImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	s := ResourceWebhookSchema()
-	return ResourceImporter(d, m, "webhook", s)
+	return ResourceImporter(ctx, d, m, "webhook", s)
 }
 
-func ResourceAviWebhookRead(d *schema.ResourceData, meta interface{}) error {
+func ResourceAviWebhookRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceWebhookSchema()
-	err := APIRead(d, meta, "webhook", s)
-	if err != nil {
-		log.Printf("[ERROR] in reading object %v\n", err)
+	diags := APIRead(ctx, d, meta, "webhook", s)
+	if diags.HasError() {
+		log.Printf("[ERROR] in reading object %v\n", diags)
 	}
-	return err
+	return diags
 }
 
-func resourceAviWebhookCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviWebhookCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceWebhookSchema()
-	err := APICreateOrUpdate(d, meta, "webhook", s)
-	if err == nil {
-		err = ResourceAviWebhookRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "webhook", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviWebhookRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviWebhookUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviWebhookUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s := ResourceWebhookSchema()
-	var err error
-	err = APICreateOrUpdate(d, meta, "webhook", s)
-	if err == nil {
-		err = ResourceAviWebhookRead(d, meta)
+	diags := APICreateOrUpdate(ctx, d, meta, "webhook", s)
+	if !diags.HasError() {
+		diags = append(diags, ResourceAviWebhookRead(ctx, d, meta)...)
 	}
-	return err
+	return diags
 }
 
-func resourceAviWebhookDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAviWebhookDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	objType := "webhook"
-	client := meta.(*clients.AviClient)
-	if APIDeleteSystemDefaultCheck(d) {
-		return nil
-	}
-	uuid := d.Get("uuid").(string)
-	if uuid != "" {
-		path := "api/" + objType + "/" + uuid
-		err := client.AviSession.Delete(path)
-		if err != nil && !(strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "204") || strings.Contains(err.Error(), "403")) {
-			log.Println("[INFO] resourceAviWebhookDelete not found")
-			return err
-		}
-		d.SetId("")
-	}
-	return nil
+	return APIDelete(ctx, d, meta, objType)
 }
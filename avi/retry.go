@@ -0,0 +1,150 @@
+// Copyright 2022 VMware, Inc.
+// SPDX-License-Identifier: Mozilla Public License 2.0
+
+package avi
+
+import (
+	"math/rand"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// defaultRetryStatusCodes are the HTTP statuses retried when avi_retry_status_codes is not set:
+// stale updates, throttling, and a controller that is unavailable during a leader failover. A
+// conflict is not retried by default, as a create that timed out on the client may have created
+// the object and its retry would then fail with 409 too.
+var defaultRetryStatusCodes = []int{
+	http.StatusPreconditionFailed,
+	http.StatusTooManyRequests,
+	http.StatusBadGateway,
+	http.StatusServiceUnavailable,
+	http.StatusGatewayTimeout,
+}
+
+// retryPolicy decides how often and after which delay a failed session call is retried.
+type retryPolicy struct {
+	// MaxAttempts is the number of attempts of a call, including the first one.
+	MaxAttempts int
+	// MinBackoff is the delay before the first retry. It doubles with every further retry.
+	MinBackoff time.Duration
+	// MaxBackoff limits the delay between two attempts.
+	MaxBackoff time.Duration
+	// StatusCodes are the HTTP statuses of the responses that are retried.
+	StatusCodes []int
+}
+
+// retryable reports whether err is a response of the controller with one of the retried statuses.
+// Errors without response, such as a cancelled context, are not retried.
+func (p retryPolicy) retryable(err error) bool {
+	status := aviErrorStatus(err)
+	for _, code := range p.StatusCodes {
+		if status == code {
+			return true
+		}
+	}
+	return false
+}
+
+// postRetryStatusCodes are the HTTP statuses with which a POST is retried, if they are in the
+// policy. The controller rejects the request with them before processing it, while a POST that
+// failed with another status or without response may have created the object.
+var postRetryStatusCodes = []int{
+	http.StatusTooManyRequests,
+	http.StatusServiceUnavailable,
+}
+
+// retryablePost reports whether err of a POST, which is not idempotent, is retried.
+func (p retryPolicy) retryablePost(err error) bool {
+	status := aviErrorStatus(err)
+	for _, code := range postRetryStatusCodes {
+		if status == code {
+			return p.retryable(err)
+		}
+	}
+	return false
+}
+
+// backoff returns the delay before the retry that follows the given attempt. The delay grows
+// exponentially, and a random jitter of up to half the delay spreads out the retries of resources
+// that failed together.
+func (p retryPolicy) backoff(attempt int) time.Duration {
+	backoff := p.MinBackoff
+	for i := 1; i < attempt; i++ {
+		backoff *= 2
+		if p.MaxBackoff > 0 && backoff >= p.MaxBackoff {
+			break
+		}
+	}
+	if p.MaxBackoff > 0 && backoff > p.MaxBackoff {
+		backoff = p.MaxBackoff
+	}
+	if backoff <= 0 {
+		return 0
+	}
+	return backoff/2 + time.Duration(rand.Int63n(int64(backoff/2)+1))
+}
+
+// retryThrottle holds the time until which the controller asked the client to wait with the
+// Retry-After header of a 429 response. It is shared by all the sessions of a provider.
+type retryThrottle struct {
+	mu    sync.Mutex
+	until time.Time
+}
+
+// record sets the time to wait until from the Retry-After header, in seconds or as an HTTP date.
+func (t *retryThrottle) record(header http.Header) {
+	value := header.Get("Retry-After")
+	if value == "" {
+		return
+	}
+	var until time.Time
+	if seconds, err := strconv.Atoi(value); err == nil {
+		until = time.Now().Add(time.Duration(seconds) * time.Second)
+	} else if date, err := http.ParseTime(value); err == nil {
+		until = date
+	} else {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if until.After(t.until) {
+		t.until = until
+	}
+}
+
+// wait returns how long the controller asked to wait, 0 for a nil throttle.
+func (t *retryThrottle) wait() time.Duration {
+	if t == nil {
+		return 0
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if wait := time.Until(t.until); wait > 0 {
+		return wait
+	}
+	return 0
+}
+
+// retryAfterRoundTripper records the Retry-After header of the 429 responses in a throttle.
+type retryAfterRoundTripper struct {
+	next     http.RoundTripper
+	throttle *retryThrottle
+}
+
+func (rt *retryAfterRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := rt.next.RoundTrip(req)
+	if err == nil && resp.StatusCode == http.StatusTooManyRequests {
+		rt.throttle.record(resp.Header)
+	}
+	return resp, err
+}
+
+// withRetryAfter returns the wrapper of the round trips of a transport that records the
+// Retry-After header of throttled responses in throttle.
+func withRetryAfter(throttle *retryThrottle) func(http.RoundTripper) http.RoundTripper {
+	return func(next http.RoundTripper) http.RoundTripper {
+		return &retryAfterRoundTripper{next: next, throttle: throttle}
+	}
+}
@@ -0,0 +1,202 @@
+// Copyright 2022 VMware, Inc.
+// SPDX-License-Identifier: Mozilla Public License 2.0
+
+package avi
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAviSessionCallRetry(t *testing.T) {
+	fc := newFakeController()
+	defer fc.Close()
+	client := newFakeControllerClient(t, fc)
+	client.retry = retryPolicy{
+		MaxAttempts: 3,
+		MinBackoff:  time.Millisecond,
+		MaxBackoff:  5 * time.Millisecond,
+		StatusCodes: defaultRetryStatusCodes,
+	}
+	ctx := context.Background()
+	getClouds := func() (int, error) {
+		before := len(fc.Requests())
+		var obj interface{}
+		err := aviSessionCall(ctx, client, func() error {
+			return client.AviSession.Get("api/cloud", &obj)
+		})
+		attempts := 0
+		for _, r := range fc.Requests()[before:] {
+			if strings.HasPrefix(r, "GET /api/cloud") {
+				attempts++
+			}
+		}
+		return attempts, err
+	}
+
+	fc.FailNext(2, http.StatusServiceUnavailable)
+	if attempts, err := getClouds(); err != nil || attempts != 3 {
+		t.Errorf("got %d attempts and error %v, want 3 attempts and success", attempts, err)
+	}
+	fc.FailNext(1, http.StatusConflict)
+	if attempts, err := getClouds(); aviErrorStatus(err) != http.StatusConflict || attempts != 1 {
+		t.Errorf("got %d attempts and error %v, want 1 attempt and HTTP 409", attempts, err)
+	}
+	fc.FailNext(1, http.StatusBadRequest)
+	if attempts, err := getClouds(); aviErrorStatus(err) != http.StatusBadRequest || attempts != 1 {
+		t.Errorf("got %d attempts and error %v, want 1 attempt and HTTP 400", attempts, err)
+	}
+
+	client.retry.MinBackoff = time.Hour
+	client.retry.MaxBackoff = time.Hour
+	fc.FailNext(1, http.StatusServiceUnavailable)
+	cancelCtx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+	defer cancel()
+	err := aviSessionCall(cancelCtx, client, func() error {
+		return client.AviSession.Get("api/cloud", nil)
+	})
+	if err != context.DeadlineExceeded {
+		t.Errorf("got %v while waiting for a retry, want %v", err, context.DeadlineExceeded)
+	}
+}
+
+func TestAviSessionPostRetry(t *testing.T) {
+	fc := newFakeController()
+	defer fc.Close()
+	client := newFakeControllerClient(t, fc)
+	client.retry = retryPolicy{
+		MaxAttempts: 3,
+		MinBackoff:  time.Millisecond,
+		MaxBackoff:  5 * time.Millisecond,
+		StatusCodes: defaultRetryStatusCodes,
+	}
+	ctx := context.Background()
+	postGroup := func(name string) (int, error) {
+		before := len(fc.Requests())
+		var obj interface{}
+		err := aviSessionPost(ctx, client, func() error {
+			return client.AviSession.Post("api/stringgroup", map[string]interface{}{"name": name}, &obj)
+		})
+		attempts := 0
+		for _, r := range fc.Requests()[before:] {
+			if strings.HasPrefix(r, "POST /api/stringgroup") {
+				attempts++
+			}
+		}
+		return attempts, err
+	}
+
+	// The controller may have processed a POST that failed with a gateway error.
+	for _, status := range []int{http.StatusBadGateway, http.StatusGatewayTimeout} {
+		fc.FailNext(1, status)
+		if attempts, err := postGroup("group-1"); aviErrorStatus(err) != status || attempts != 1 {
+			t.Errorf("got %d attempts and error %v, want 1 attempt and HTTP %d", attempts, err, status)
+		}
+	}
+	fc.FailNext(2, http.StatusServiceUnavailable)
+	if attempts, err := postGroup("group-2"); err != nil || attempts != 3 {
+		t.Errorf("got %d attempts and error %v, want 3 attempts and success", attempts, err)
+	}
+
+	fc.FailNextRetryAfter(1, http.StatusTooManyRequests, "1")
+	start := time.Now()
+	if attempts, err := postGroup("group-3"); err != nil || attempts != 2 {
+		t.Errorf("got %d attempts and error %v, want 2 attempts and success", attempts, err)
+	}
+	if elapsed := time.Since(start); elapsed < 900*time.Millisecond {
+		t.Errorf("retried after %v, want to wait for the Retry-After of 1s", elapsed)
+	}
+}
+
+func TestRetryThrottle(t *testing.T) {
+	var throttle *retryThrottle
+	if wait := throttle.wait(); wait != 0 {
+		t.Errorf("nil throttle waits %v, want 0", wait)
+	}
+	throttle = &retryThrottle{}
+	throttle.record(http.Header{"Retry-After": []string{"invalid"}})
+	if wait := throttle.wait(); wait != 0 {
+		t.Errorf("throttle with invalid Retry-After waits %v, want 0", wait)
+	}
+	throttle.record(http.Header{"Retry-After": []string{"10"}})
+	if wait := throttle.wait(); wait <= 9*time.Second || wait > 10*time.Second {
+		t.Errorf("throttle with Retry-After of 10s waits %v", wait)
+	}
+	date := time.Now().Add(time.Minute).UTC().Format(http.TimeFormat)
+	throttle.record(http.Header{"Retry-After": []string{date}})
+	if wait := throttle.wait(); wait <= 58*time.Second || wait > time.Minute {
+		t.Errorf("throttle with Retry-After of %s waits %v", date, wait)
+	}
+	// A shorter Retry-After does not shorten the wait.
+	throttle.record(http.Header{"Retry-After": []string{"1"}})
+	if wait := throttle.wait(); wait <= 58*time.Second {
+		t.Errorf("throttle waits %v after a shorter Retry-After, want about a minute", wait)
+	}
+}
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	policy := retryPolicy{MinBackoff: time.Second, MaxBackoff: 10 * time.Second}
+	tests := []struct {
+		attempt int
+		max     time.Duration
+	}{
+		{attempt: 1, max: time.Second},
+		{attempt: 2, max: 2 * time.Second},
+		{attempt: 3, max: 4 * time.Second},
+		{attempt: 4, max: 8 * time.Second},
+		{attempt: 5, max: 10 * time.Second},
+		{attempt: 50, max: 10 * time.Second},
+	}
+	for _, tt := range tests {
+		got := policy.backoff(tt.attempt)
+		if got < tt.max/2 || got > tt.max {
+			t.Errorf("backoff(%d) = %v, want between %v and %v", tt.attempt, got, tt.max/2, tt.max)
+		}
+	}
+	if got := (retryPolicy{}).backoff(3); got != 0 {
+		t.Errorf("backoff without delay = %v, want 0", got)
+	}
+}
+
+func TestProviderRetryPolicy(t *testing.T) {
+	if v, ok := os.LookupEnv("AVI_RETRY_STATUS_CODES"); ok {
+		defer os.Setenv("AVI_RETRY_STATUS_CODES", v)
+	} else {
+		defer os.Unsetenv("AVI_RETRY_STATUS_CODES")
+	}
+	if err := os.Setenv("AVI_RETRY_STATUS_CODES", "503, 504"); err != nil {
+		t.Fatal(err)
+	}
+
+	d := schema.TestResourceDataRaw(t, Provider().Schema, map[string]interface{}{
+		"avi_retry_max_attempts": 5,
+		"avi_retry_min_backoff":  2,
+	})
+	policy, err := providerRetryPolicy(d)
+	if err != nil {
+		t.Fatalf("providerRetryPolicy() failed: %v", err)
+	}
+	want := retryPolicy{
+		MaxAttempts: 5,
+		MinBackoff:  2 * time.Second,
+		MaxBackoff:  30 * time.Second,
+		StatusCodes: []int{503, 504},
+	}
+	if !reflect.DeepEqual(policy, want) {
+		t.Errorf("got %+v, want %+v", policy, want)
+	}
+
+	d = schema.TestResourceDataRaw(t, Provider().Schema, map[string]interface{}{
+		"avi_retry_status_codes": []interface{}{409},
+	})
+	if policy, err := providerRetryPolicy(d); err != nil || !reflect.DeepEqual(policy.StatusCodes, []int{409}) {
+		t.Errorf("got status codes %v and error %v, want [409]", policy.StatusCodes, err)
+	}
+}
@@ -0,0 +1,88 @@
+// Copyright 2022 VMware, Inc.
+// SPDX-License-Identifier: Mozilla Public License 2.0
+
+package avi
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// sensitiveFields is the registry of fields that hold secrets. A plain name is secret wherever it
+// appears, at the top level or nested in any block. A dotted path, starting with the resource
+// name, is secret only at that path, for names like key that are not secret elsewhere.
+var sensitiveFields = map[string]bool{
+	"access_key":                   true,
+	"aes_key":                      true,
+	"auth_passphrase":              true,
+	"auth_password":                true,
+	"authentication_token":         true,
+	"avi_authtoken":                true,
+	"avi_client_key":               true,
+	"avi_password":                 true,
+	"aws_access_key":               true,
+	"aws_secret_access":            true,
+	"backup_passphrase":            true,
+	"client_priv_key":              true,
+	"client_secret":                true,
+	"crypto_user_password":         true,
+	"encryption_key":               true,
+	"hmac_key":                     true,
+	"jwt_token":                    true,
+	"key_content":                  true,
+	"key_passphrase":               true,
+	"md5_secret":                   true,
+	"nuage_password":               true,
+	"old_password":                 true,
+	"partition_passwd":             true,
+	"pass_phrase":                  true,
+	"password":                     true,
+	"portal_token":                 true,
+	"priv_passphrase":              true,
+	"private_key":                  true,
+	"secret_access_key":            true,
+	"secret_key":                   true,
+	"server_secret":                true,
+	"service_account_keyfile_data": true,
+	"shared_secret":                true,
+	"vca_password":                 true,
+	"verification_token":           true,
+
+	"avi_controllerportalregistration.portal_auth.access_token":             true,
+	"avi_jwtserverprofile.controller_internal_auth.symmetric_jwks_keys.key": true,
+	"avi_snmptrapprofile.trap_servers.community":                            true,
+	"avi_sslkeyandcertificate.key":                                          true,
+	"avi_systemconfiguration.snmp_configuration.community":                  true,
+}
+
+// markSensitiveFields marks the fields of the registry Sensitive in the schema of the provider and
+// of all its resources and data sources, so that their values are hidden in plans and logs.
+func markSensitiveFields(provider *schema.Provider) {
+	markSensitiveSchema("", provider.Schema)
+	for name, resource := range provider.ResourcesMap {
+		markSensitiveSchema(name, resource.Schema)
+	}
+	for name, resource := range provider.DataSourcesMap {
+		markSensitiveSchema(name, resource.Schema)
+	}
+}
+
+func markSensitiveSchema(path string, s map[string]*schema.Schema) {
+	for name, field := range s {
+		fieldPath := name
+		if path != "" {
+			fieldPath = path + "." + name
+		}
+		if sensitiveFields[name] || sensitiveFields[fieldPath] {
+			field.Sensitive = true
+			// The controller does not return secrets as they were configured. Provider arguments
+			// and computed only fields of data sources are never diffed.
+			if path != "" && field.Type == schema.TypeString && field.DiffSuppressFunc == nil &&
+				(field.Optional || field.Required) {
+				field.DiffSuppressFunc = suppressSensitiveFieldDiffs
+			}
+		}
+		if elem, ok := field.Elem.(*schema.Resource); ok {
+			markSensitiveSchema(fieldPath, elem.Schema)
+		}
+	}
+}
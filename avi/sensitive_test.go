@@ -0,0 +1,63 @@
+// Copyright 2022 VMware, Inc.
+// SPDX-License-Identifier: Mozilla Public License 2.0
+
+package avi
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// secretFieldPattern matches the names of fields that are expected to hold secrets.
+var secretFieldPattern = regexp.MustCompile(`password|passwd|passphrase|pass_phrase|secret|priv_key|private_key`)
+
+// notSecretFields match secretFieldPattern without holding a secret.
+var notSecretFields = map[string]bool{
+	"avi_password_file":          true,
+	"max_password_history_count": true,
+	"minimum_password_length":    true,
+	"password_strength_check":    true,
+}
+
+func TestSensitiveFields(t *testing.T) {
+	provider := Provider()
+	found := map[string]bool{}
+	var check func(path string, s map[string]*schema.Schema)
+	check = func(path string, s map[string]*schema.Schema) {
+		for name, field := range s {
+			fieldPath := name
+			if path != "" {
+				fieldPath = path + "." + name
+			}
+			registered := sensitiveFields[name] || sensitiveFields[fieldPath]
+			if registered {
+				found[name] = true
+				found[fieldPath] = true
+			}
+			if registered && !field.Sensitive {
+				t.Errorf("secret field %s is not marked Sensitive", fieldPath)
+			}
+			if field.Type == schema.TypeString && secretFieldPattern.MatchString(name) && !notSecretFields[name] &&
+				!field.Sensitive {
+				t.Errorf("field %s looks like a secret but is not in the registry of sensitive fields", fieldPath)
+			}
+			if elem, ok := field.Elem.(*schema.Resource); ok {
+				check(fieldPath, elem.Schema)
+			}
+		}
+	}
+	check("", provider.Schema)
+	for name, resource := range provider.ResourcesMap {
+		check(name, resource.Schema)
+	}
+	for name, resource := range provider.DataSourcesMap {
+		check(name, resource.Schema)
+	}
+	for name := range sensitiveFields {
+		if !found[name] {
+			t.Errorf("sensitive field %s of the registry does not exist in any schema", name)
+		}
+	}
+}
@@ -0,0 +1,122 @@
+// Copyright 2022 VMware, Inc.
+// SPDX-License-Identifier: Mozilla Public License 2.0
+
+package avi
+
+import (
+	"context"
+	"log"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// nativeTypesSchemaVersion is the schema version from which the int, bool and float fields of
+// the resources have native types. Up to version 0 they were strings with a validate function.
+const nativeTypesSchemaVersion = 1
+
+// addNativeTypesStateUpgraders upgrades the state of every resource written with schema
+// version 0, converting the string values of int, bool and float fields to native values.
+func addNativeTypesStateUpgraders(resources map[string]*schema.Resource) {
+	for _, r := range resources {
+		if r.SchemaVersion >= nativeTypesSchemaVersion {
+			continue
+		}
+		s := r.Schema
+		r.SchemaVersion = nativeTypesSchemaVersion
+		r.StateUpgraders = append(r.StateUpgraders, schema.StateUpgrader{
+			Version: 0,
+			Type:    (&schema.Resource{Schema: stringTypedSchema(s)}).CoreConfigSchema().ImpliedType(),
+			Upgrade: func(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+				return upgradeNativeTypes(rawState, s), nil
+			},
+		})
+	}
+}
+
+// stringTypedSchema returns a copy of s, with int, bool and float fields of type string as in
+// schema version 0.
+func stringTypedSchema(s map[string]*schema.Schema) map[string]*schema.Schema {
+	copied := make(map[string]*schema.Schema, len(s))
+	for name, field := range s {
+		c := stringTypedField(field)
+		copied[name] = &c
+	}
+	return copied
+}
+
+func stringTypedField(field *schema.Schema) schema.Schema {
+	c := *field
+	switch c.Type {
+	case schema.TypeInt, schema.TypeBool, schema.TypeFloat:
+		c.Type = schema.TypeString
+		c.Default = nil
+	}
+	switch elem := c.Elem.(type) {
+	case *schema.Resource:
+		c.Elem = &schema.Resource{Schema: stringTypedSchema(elem.Schema)}
+	case *schema.Schema:
+		e := stringTypedField(elem)
+		c.Elem = &e
+	}
+	return c
+}
+
+// upgradeNativeTypes converts the values of the int, bool and float fields of s in state from
+// strings. An empty string was the value of an unset field.
+func upgradeNativeTypes(state map[string]interface{}, s map[string]*schema.Schema) map[string]interface{} {
+	for k, v := range state {
+		if field, ok := s[k]; ok {
+			state[k] = upgradeNativeValue(k, v, field)
+		}
+	}
+	return state
+}
+
+func upgradeNativeValue(k string, v interface{}, field *schema.Schema) interface{} {
+	switch field.Type {
+	case schema.TypeInt, schema.TypeBool, schema.TypeFloat:
+		str, ok := v.(string)
+		if !ok {
+			return v
+		}
+		if str == "" {
+			return nil
+		}
+		var value interface{}
+		var err error
+		switch field.Type {
+		case schema.TypeInt:
+			value, err = strconv.ParseInt(str, 10, 64)
+		case schema.TypeBool:
+			value, err = strconv.ParseBool(str)
+		case schema.TypeFloat:
+			value, err = strconv.ParseFloat(str, 64)
+		}
+		if err != nil {
+			log.Printf("[WARN] upgradeNativeTypes dropping invalid value %q of %s: %v", str, k, err)
+			return nil
+		}
+		return value
+	case schema.TypeList, schema.TypeSet:
+		items, _ := v.([]interface{})
+		for i, item := range items {
+			switch elem := field.Elem.(type) {
+			case *schema.Resource:
+				if m, ok := item.(map[string]interface{}); ok {
+					items[i] = upgradeNativeTypes(m, elem.Schema)
+				}
+			case *schema.Schema:
+				items[i] = upgradeNativeValue(k, item, elem)
+			}
+		}
+	case schema.TypeMap:
+		values, _ := v.(map[string]interface{})
+		if elem, ok := field.Elem.(*schema.Schema); ok {
+			for key, value := range values {
+				values[key] = upgradeNativeValue(k, value, elem)
+			}
+		}
+	}
+	return v
+}
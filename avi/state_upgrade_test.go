@@ -0,0 +1,74 @@
+// Copyright 2022 VMware, Inc.
+// SPDX-License-Identifier: Mozilla Public License 2.0
+
+package avi
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+)
+
+func TestNativeTypesStateUpgrade(t *testing.T) {
+	provider := Provider()
+	pool := provider.ResourcesMap["avi_pool"]
+	if pool.SchemaVersion != nativeTypesSchemaVersion || len(pool.StateUpgraders) != 1 {
+		t.Fatalf("got schema version %d with %d state upgraders", pool.SchemaVersion, len(pool.StateUpgraders))
+	}
+	upgrader := pool.StateUpgraders[0]
+	if !upgrader.Type.AttributeType("default_server_port").Equals(cty.String) {
+		t.Errorf("got type %#v of default_server_port in version 0", upgrader.Type.AttributeType("default_server_port"))
+	}
+
+	state := map[string]interface{}{
+		"name":                     "pool-1",
+		"default_server_port":      "80",
+		"capacity_estimation":      "false",
+		"connection_ramp_duration": "",
+		"servers": []interface{}{
+			map[string]interface{}{
+				"port":    "8080",
+				"enabled": "true",
+				"ratio":   "",
+				"ip": []interface{}{
+					map[string]interface{}{"addr": "10.0.0.1", "type": "V4"},
+				},
+			},
+		},
+	}
+	upgraded, err := upgrader.Upgrade(context.Background(), state, nil)
+	if err != nil {
+		t.Fatalf("state upgrade failed: %v", err)
+	}
+	want := map[string]interface{}{
+		"name":                     "pool-1",
+		"default_server_port":      int64(80),
+		"capacity_estimation":      false,
+		"connection_ramp_duration": nil,
+		"servers": []interface{}{
+			map[string]interface{}{
+				"port":    int64(8080),
+				"enabled": true,
+				"ratio":   nil,
+				"ip": []interface{}{
+					map[string]interface{}{"addr": "10.0.0.1", "type": "V4"},
+				},
+			},
+		},
+	}
+	if !reflect.DeepEqual(upgraded, want) {
+		t.Errorf("got upgraded state %v, want %v", upgraded, want)
+	}
+
+	seGroup := provider.ResourcesMap["avi_serviceenginegroup"]
+	upgraded, err = seGroup.StateUpgraders[0].Upgrade(context.Background(),
+		map[string]interface{}{"extra_config_multiplier": "0.5"}, nil)
+	if err != nil {
+		t.Fatalf("state upgrade failed: %v", err)
+	}
+	if upgraded["extra_config_multiplier"] != 0.5 {
+		t.Errorf("got extra_config_multiplier %#v, want 0.5", upgraded["extra_config_multiplier"])
+	}
+}
@@ -0,0 +1,150 @@
+// Copyright 2022 VMware, Inc.
+// SPDX-License-Identifier: Mozilla Public License 2.0
+
+package avi
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/alb-sdk/go/session"
+)
+
+// addTenantSchema adds the tenant argument to every resource or data source with a tenant_ref.
+// The argument selects the tenant of the requests for the object, so that one provider session
+// can manage objects of all tenants. Like adoption_mode it is not part of the object schemas
+// and is never sent to the controller. Objects can not move between tenants, so a resource is
+// replaced when its tenant changes, or when tenant_ref changes to another tenant.
+func addTenantSchema(resources map[string]*schema.Resource, forceNew bool) {
+	for _, r := range resources {
+		if _, ok := r.Schema["tenant_ref"]; !ok {
+			continue
+		}
+		if _, ok := r.Schema["tenant"]; ok {
+			continue
+		}
+		r.Schema["tenant"] = &schema.Schema{
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			ForceNew:    forceNew,
+			Description: "Name of the tenant of the object. Defaults to the tenant of tenant_ref, or avi_tenant of the provider.",
+		}
+		if forceNew {
+			var funcs []schema.CustomizeDiffFunc
+			if r.CustomizeDiff != nil {
+				funcs = append(funcs, r.CustomizeDiff)
+			}
+			r.CustomizeDiff = customdiff.All(append(funcs, tenantRefDiff)...)
+		}
+	}
+}
+
+// tenantRefDiff replaces the object when tenant is not configured and tenant_ref moves it to
+// another tenant. The computed tenant of the state would otherwise keep the requests in the old
+// tenant.
+func tenantRefDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" || !configAttr(d.GetRawConfig(), "tenant").IsNull() || !d.HasChange("tenant_ref") {
+		return nil
+	}
+	if !d.NewValueKnown("tenant_ref") {
+		if err := d.SetNewComputed("tenant"); err != nil {
+			return err
+		}
+		return d.ForceNew("tenant")
+	}
+	o, n := d.GetChange("tenant_ref")
+	if n.(string) == "" {
+		return nil
+	}
+	oldTenant, newTenant := o.(string), n.(string)
+	if client, ok := meta.(*ProviderClient); ok {
+		var err error
+		if oldTenant, err = client.tenantName(ctx, oldTenant); err != nil {
+			return err
+		}
+		if newTenant, err = client.tenantName(ctx, newTenant); err != nil {
+			return err
+		}
+	}
+	if oldTenant == newTenant || newTenant == d.Get("tenant").(string) {
+		return nil
+	}
+	if err := d.SetNew("tenant", newTenant); err != nil {
+		return err
+	}
+	return d.ForceNew("tenant")
+}
+
+// resourceTenant returns the name of the tenant to send the requests for the object of d to: the
+// tenant argument, or else the tenant of tenant_ref. The tenant in the state, computed from a
+// previous tenant_ref, is only used when the configuration is not available, as during refresh
+// and import, so that a changed tenant_ref takes effect. It returns "" for the session tenant.
+func resourceTenant(ctx context.Context, d *schema.ResourceData, client *ProviderClient) (string, error) {
+	config := d.GetRawConfig()
+	if tenant, ok := d.GetOk("tenant"); ok && (config.IsNull() || !configAttr(config, "tenant").IsNull()) {
+		return tenant.(string), nil
+	}
+	tenantRef, _ := d.Get("tenant_ref").(string)
+	return client.tenantName(ctx, tenantRef)
+}
+
+// tenantName returns the name of the tenant of tenantRef, which is either a name reference like
+// /api/tenant/?name=foo or a tenant url, optionally ending in #<name>. Tenant uuids without name
+// are looked up once and cached for the provider session.
+func (client *ProviderClient) tenantName(ctx context.Context, tenantRef string) (string, error) {
+	if tenantRef == "" {
+		return "", nil
+	}
+	if i := strings.Index(tenantRef, "?name="); i >= 0 {
+		name := strings.SplitN(strings.SplitN(tenantRef[i+len("?name="):], "&", 2)[0], "#", 2)[0]
+		if unescaped, err := url.QueryUnescape(name); err == nil {
+			name = unescaped
+		}
+		return name, nil
+	}
+	// References returned with include_name end in #<name>.
+	if i := strings.LastIndex(tenantRef, "#"); i >= 0 && i < len(tenantRef)-1 {
+		return tenantRef[i+1:], nil
+	}
+	if !strings.Contains(tenantRef, "api/tenant/") {
+		return "", fmt.Errorf("unexpected format of tenant_ref %q", tenantRef)
+	}
+	uuid := UUIDFromID(strings.SplitN(tenantRef, "?", 2)[0])
+	if name, ok := client.tenantNames.Load(uuid); ok {
+		return name.(string), nil
+	}
+	var tenant map[string]interface{}
+	err := aviSessionCall(ctx, client, func() error {
+		return client.AviSession.Get("api/tenant/"+uuid, &tenant)
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to look up tenant of tenant_ref %q: %s", tenantRef, err)
+	}
+	name, _ := tenant["name"].(string)
+	log.Printf("[DEBUG] tenantName tenant %v has name %v\n", uuid, name)
+	client.tenantNames.Store(uuid, name)
+	return name, nil
+}
+
+// aviTenantOptions returns opts with the option to send a request to tenant, if it is not empty.
+func aviTenantOptions(tenant string, opts ...session.ApiOptionsParams) []session.ApiOptionsParams {
+	if tenant != "" {
+		opts = append(opts, session.SetOptTenant(tenant))
+	}
+	return opts
+}
+
+// splitTenantImportID splits an import id of the form <tenant>/<uuid>.
+func splitTenantImportID(id string) (string, string, bool) {
+	parts := strings.Split(id, "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", false
+	}
+	return parts[0], parts[1], true
+}
@@ -0,0 +1,203 @@
+// Copyright 2022 VMware, Inc.
+// SPDX-License-Identifier: Mozilla Public License 2.0
+
+package avi
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestResourceTenantOverride(t *testing.T) {
+	fc := newFakeController()
+	defer fc.Close()
+	client := newFakeControllerClient(t, fc)
+	ctx := context.Background()
+	var tenant map[string]interface{}
+	if err := client.AviSession.Post("api/tenant", map[string]interface{}{"name": "tenant-a"}, &tenant); err != nil {
+		t.Fatalf("failed to create tenant: %v", err)
+	}
+	s := ResourcePoolSchema()
+	resourceSchema := ResourcePoolSchema()
+	addTenantSchema(map[string]*schema.Resource{"avi_pool": {Schema: resourceSchema}}, true)
+
+	inTenant := func(uuid, tenant string) bool {
+		var obj interface{}
+		err := aviSessionCall(ctx, client, func() error {
+			return client.AviSession.Get("api/pool/"+uuid, &obj, aviTenantOptions(tenant)...)
+		})
+		if err != nil && !isAviObjectNotFound(err) {
+			t.Fatalf("failed to read pool %s: %v", uuid, err)
+		}
+		return err == nil
+	}
+
+	for _, config := range []map[string]interface{}{
+		{"name": "tenant-pool", "tenant": "tenant-a"},
+		{"name": "tenant-ref-pool", "tenant_ref": "/api/tenant/?name=tenant-a"},
+	} {
+		d := schema.TestResourceDataRaw(t, resourceSchema, config)
+		if diags := APICreateOrUpdate(ctx, d, client, "pool", s); diags.HasError() {
+			t.Fatalf("create of %v failed: %v", config, diags)
+		}
+		uuid := d.Get("uuid").(string)
+		if !inTenant(uuid, "tenant-a") || inTenant(uuid, "") {
+			t.Fatalf("pool %v was not created in tenant-a", config)
+		}
+		if diags := APIRead(ctx, d, client, "pool", s); diags.HasError() || d.Id() == "" {
+			t.Fatalf("read of %v failed: %v", config, diags)
+		}
+		if d.Get("tenant") != "tenant-a" {
+			t.Errorf("read of %v set tenant %q", config, d.Get("tenant"))
+		}
+
+		imported := schema.TestResourceDataRaw(t, resourceSchema, map[string]interface{}{})
+		imported.SetId("tenant-a/" + uuid)
+		if _, err := ResourceImporter(ctx, imported, client, "pool", s); err != nil {
+			t.Fatalf("import of %v failed: %v", config, err)
+		}
+		if diags := APIRead(ctx, imported, client, "pool", s); diags.HasError() || imported.Get("uuid") != uuid {
+			t.Fatalf("read of imported %v failed: %v", config, diags)
+		}
+
+		if diags := APIDelete(ctx, d, client, "pool"); diags.HasError() {
+			t.Fatalf("delete of %v failed: %v", config, diags)
+		}
+		if inTenant(uuid, "tenant-a") {
+			t.Errorf("pool %v was not deleted", config)
+		}
+	}
+
+	// Without a tenant the object of tenant-a is not visible to the session.
+	d := schema.TestResourceDataRaw(t, resourceSchema, map[string]interface{}{"name": "other-pool", "tenant": "tenant-a"})
+	if diags := APICreateOrUpdate(ctx, d, client, "pool", s); diags.HasError() {
+		t.Fatalf("create failed: %v", diags)
+	}
+	other := schema.TestResourceDataRaw(t, resourceSchema, map[string]interface{}{})
+	other.SetId(d.Id())
+	if diags := APIRead(ctx, other, client, "pool", s); diags.HasError() || other.Id() != "" {
+		t.Errorf("object of tenant-a was read from the session tenant: %v", diags)
+	}
+}
+
+func TestTenantName(t *testing.T) {
+	fc := newFakeController()
+	defer fc.Close()
+	client := newFakeControllerClient(t, fc)
+	ctx := context.Background()
+	var tenant map[string]interface{}
+	if err := client.AviSession.Post("api/tenant", map[string]interface{}{"name": "tenant-b"}, &tenant); err != nil {
+		t.Fatalf("failed to create tenant: %v", err)
+	}
+
+	tests := []struct {
+		ref  string
+		want string
+	}{
+		{ref: "", want: ""},
+		{ref: "/api/tenant/?name=tenant-b", want: "tenant-b"},
+		{ref: "/api/tenant/?name=tenant%20c", want: "tenant c"},
+		{ref: "https://10.10.10.10/api/tenant/tenant-1234#tenant-d", want: "tenant-d"},
+		{ref: tenant["url"].(string), want: "tenant-b"},
+		{ref: "/api/tenant/" + tenant["uuid"].(string), want: "tenant-b"},
+	}
+	for _, tt := range tests {
+		got, err := client.tenantName(ctx, tt.ref)
+		if err != nil {
+			t.Fatalf("tenantName(%q) failed: %v", tt.ref, err)
+		}
+		if got != tt.want {
+			t.Errorf("tenantName(%q) = %q, want %q", tt.ref, got, tt.want)
+		}
+	}
+
+	lookups := 0
+	for _, r := range fc.Requests() {
+		if strings.HasPrefix(r, "GET /api/tenant/") {
+			lookups++
+		}
+	}
+	if lookups != 1 {
+		t.Errorf("tenant uuid was looked up %d times, want 1", lookups)
+	}
+	if _, err := client.tenantName(ctx, "tenant-b"); err == nil {
+		t.Errorf("tenantName accepted a tenant_ref without tenant")
+	}
+}
+
+// A tenant_ref that moves the object to another tenant takes effect when tenant is not configured,
+// although the state holds the tenant computed from the previous tenant_ref.
+func TestResourceTenantRefChange(t *testing.T) {
+	fc := newFakeController()
+	defer fc.Close()
+	client := newFakeControllerClient(t, fc)
+	ctx := context.Background()
+	r := Provider().ResourcesMap["avi_pool"]
+	// rawConfig returns the configuration with attrs that terraform sends with the state.
+	rawConfig := func(attrs map[string]string) cty.Value {
+		values := map[string]cty.Value{}
+		for name, ty := range r.CoreConfigSchema().ImpliedType().AttributeTypes() {
+			values[name] = cty.NullVal(ty)
+			if v, ok := attrs[name]; ok {
+				values[name] = cty.StringVal(v)
+			}
+		}
+		return cty.ObjectVal(values)
+	}
+	state := func(config map[string]string) *terraform.InstanceState {
+		return &terraform.InstanceState{
+			ID: "https://controller/api/pool/pool-1",
+			Attributes: map[string]string{
+				"name":       "pool-1",
+				"tenant":     "tenant-a",
+				"tenant_ref": "/api/tenant/?name=tenant-a",
+			},
+			RawConfig: rawConfig(config),
+		}
+	}
+
+	moved := state(map[string]string{"name": "pool-1", "tenant_ref": "/api/tenant/?name=tenant-b"})
+	moved.Attributes["tenant_ref"] = "/api/tenant/?name=tenant-b"
+	if tenant, err := resourceTenant(ctx, r.Data(moved), client); err != nil || tenant != "tenant-b" {
+		t.Errorf("got tenant %q and error %v, want tenant-b of tenant_ref", tenant, err)
+	}
+	// Without configuration, as during refresh, the tenant of the state is used.
+	refreshed := state(nil)
+	refreshed.RawConfig = cty.NullVal(cty.DynamicPseudoType)
+	refreshed.Attributes["tenant_ref"] = "/api/tenant/?name=tenant-b"
+	if tenant, err := resourceTenant(ctx, r.Data(refreshed), client); err != nil || tenant != "tenant-a" {
+		t.Errorf("got tenant %q and error %v during refresh, want tenant-a of the state", tenant, err)
+	}
+
+	tests := []struct {
+		name    string
+		config  map[string]string
+		replace bool
+	}{
+		{"same tenant", map[string]string{"name": "pool-1", "tenant_ref": "/api/tenant/?name=tenant-a"}, false},
+		{"other tenant", map[string]string{"name": "pool-1", "tenant_ref": "/api/tenant/?name=tenant-b"}, true},
+		{"tenant configured", map[string]string{
+			"name": "pool-1", "tenant": "tenant-a", "tenant_ref": "/api/tenant/?name=tenant-b",
+		}, false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			config := map[string]interface{}{}
+			for k, v := range test.config {
+				config[k] = v
+			}
+			diff, err := r.Diff(ctx, state(test.config), terraform.NewResourceConfigRaw(config), client)
+			if err != nil {
+				t.Fatalf("diff failed: %v", err)
+			}
+			if replace := diff != nil && diff.RequiresNew(); replace != test.replace {
+				t.Errorf("got replace %v, want %v", replace, test.replace)
+			}
+		})
+	}
+}
@@ -0,0 +1,180 @@
+// Copyright 2022 VMware, Inc.
+// SPDX-License-Identifier: Mozilla Public License 2.0
+
+package avi
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+)
+
+// newAviTransport returns the HTTP transport used by the AviSession for config. It verifies the
+// controller certificate against the system roots and the CA bundle of config, unless config is
+// insecure, and presents the client certificate of config for mutual TLS. The Retry-After header
+// of the throttled responses is recorded in throttle, unless it is nil.
+func newAviTransport(config Credentials, throttle *retryThrottle) (*http.Transport, error) {
+	tlsConfig := &tls.Config{
+		InsecureSkipVerify: config.Insecure, //nolint:gosec
+	}
+	if config.CABundle != "" {
+		caBundle, err := readPEM(config.CABundle)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read avi_ca_bundle: %s", err)
+		}
+		rootCAs, err := x509.SystemCertPool()
+		if err != nil || rootCAs == nil {
+			rootCAs = x509.NewCertPool()
+		}
+		if !rootCAs.AppendCertsFromPEM(caBundle) {
+			return nil, errors.New("no certificates found in avi_ca_bundle")
+		}
+		tlsConfig.RootCAs = rootCAs
+	}
+	if config.ClientCert != "" || config.ClientKey != "" {
+		if config.ClientCert == "" || config.ClientKey == "" {
+			return nil, errors.New("avi_client_cert and avi_client_key must be set together")
+		}
+		certPEM, err := readPEM(config.ClientCert)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read avi_client_cert: %s", err)
+		}
+		keyPEM, err := readPEM(config.ClientKey)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read avi_client_key: %s", err)
+		}
+		cert, err := tls.X509KeyPair(certPEM, keyPEM)
+		if err != nil {
+			return nil, fmt.Errorf("invalid client certificate: %s", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+	proxy, err := aviProxyFunc(config.ProxyURL)
+	if err != nil {
+		return nil, err
+	}
+	dialer := &net.Dialer{
+		Timeout:   30 * time.Second,
+		KeepAlive: config.KeepAlive,
+	}
+	transport := &http.Transport{
+		Proxy:               proxy,
+		DialContext:         dialer.DialContext,
+		TLSClientConfig:     tlsConfig,
+		TLSHandshakeTimeout: 10 * time.Second,
+		MaxIdleConns:        config.PoolSize,
+		MaxIdleConnsPerHost: config.PoolSize,
+		IdleConnTimeout:     90 * time.Second,
+		DisableKeepAlives:   config.KeepAlive < 0,
+	}
+	var wrappers []func(http.RoundTripper) http.RoundTripper
+	if throttle != nil {
+		wrappers = append(wrappers, withRetryAfter(throttle))
+	}
+	if config.AuditLog != "" {
+		auditLog, err := withAuditLog(config.AuditLog)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open audit log: %s", err)
+		}
+		wrappers = append(wrappers, auditLog)
+	}
+	wrapRoundTrips(transport, wrappers...)
+	return transport, nil
+}
+
+// wrapRoundTrips makes transport send its https requests through the round trippers returned by
+// wrappers, the last one outermost. The requests are sent by a copy of transport registered for
+// the https scheme.
+func wrapRoundTrips(transport *http.Transport, wrappers ...func(http.RoundTripper) http.RoundTripper) {
+	if len(wrappers) == 0 {
+		return
+	}
+	var rt http.RoundTripper = transport.Clone()
+	for _, wrap := range wrappers {
+		rt = wrap(rt)
+	}
+	// A non-nil TLSNextProto keeps the outer transport from configuring HTTP/2 for https, which
+	// the registered round tripper handles instead.
+	transport.TLSNextProto = map[string]func(string, *tls.Conn) http.RoundTripper{}
+	transport.RegisterProtocol("https", rt)
+}
+
+// aviControllerAddress returns the host[:port] of the controller for config. The port of config
+// is used only when the controller address does not have one.
+func aviControllerAddress(config Credentials) string {
+	if config.Port == "" {
+		return config.Controller
+	}
+	if _, _, err := net.SplitHostPort(config.Controller); err == nil {
+		return config.Controller
+	}
+	return net.JoinHostPort(strings.Trim(config.Controller, "[]"), config.Port)
+}
+
+// aviProxyFunc returns the proxy selection of the transport. Without proxyURL the proxy is taken
+// from the HTTPS_PROXY, HTTP_PROXY and NO_PROXY environment variables. With proxyURL every
+// request goes through it, except for the hosts excluded by NO_PROXY.
+func aviProxyFunc(proxyURL string) (func(*http.Request) (*url.URL, error), error) {
+	if proxyURL == "" {
+		return http.ProxyFromEnvironment, nil
+	}
+	u, err := url.Parse(proxyURL)
+	if err != nil || u.Host == "" {
+		return nil, fmt.Errorf("invalid avi_proxy_url %q", proxyURL)
+	}
+	noProxy := os.Getenv("NO_PROXY")
+	if noProxy == "" {
+		noProxy = os.Getenv("no_proxy")
+	}
+	return func(req *http.Request) (*url.URL, error) {
+		if noProxyMatch(noProxy, req.URL.Hostname()) {
+			return nil, nil
+		}
+		return u, nil
+	}, nil
+}
+
+// noProxyMatch reports whether host is excluded from proxying by the comma separated noProxy
+// list. An entry matches the host itself and, for domain names, all its subdomains.
+func noProxyMatch(noProxy, host string) bool {
+	host = strings.ToLower(host)
+	for _, entry := range strings.Split(noProxy, ",") {
+		entry = strings.ToLower(strings.TrimSpace(entry))
+		if entry == "" {
+			continue
+		}
+		if entry == "*" {
+			return true
+		}
+		if h, _, err := net.SplitHostPort(entry); err == nil {
+			entry = h
+		}
+		if _, cidr, err := net.ParseCIDR(entry); err == nil {
+			if ip := net.ParseIP(host); ip != nil && cidr.Contains(ip) {
+				return true
+			}
+			continue
+		}
+		entry = strings.TrimPrefix(entry, "*")
+		if host == strings.TrimPrefix(entry, ".") || strings.HasSuffix(host, "."+strings.TrimPrefix(entry, ".")) {
+			return true
+		}
+	}
+	return false
+}
+
+// readPEM returns value if it holds PEM data, or else the contents of the file it names.
+func readPEM(value string) ([]byte, error) {
+	if strings.Contains(value, "-----BEGIN") {
+		return []byte(value), nil
+	}
+	return ioutil.ReadFile(value)
+}
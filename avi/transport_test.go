@@ -0,0 +1,142 @@
+/***************************************************************************
+ * ========================================================================
+ * Copyright 2022 VMware, Inc.  All rights reserved. VMware Confidential
+ * ========================================================================
+ */
+
+package avi
+
+import (
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/vmware/alb-sdk/go/clients"
+	"github.com/vmware/alb-sdk/go/session"
+)
+
+func TestAviTransportTLS(t *testing.T) {
+	fc := newFakeController()
+	defer fc.Close()
+
+	caFile := filepath.Join(t.TempDir(), "ca.pem")
+	if err := ioutil.WriteFile(caFile, []byte(fc.CABundle()), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		config  Credentials
+		wantErr bool
+	}{
+		{name: "verify without ca bundle", config: Credentials{}, wantErr: true},
+		{name: "ca bundle contents", config: Credentials{CABundle: fc.CABundle()}},
+		{name: "ca bundle file", config: Credentials{CABundle: caFile}},
+		{name: "insecure", config: Credentials{Insecure: true}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := tt.config
+			config.Username = fakeControllerUsername
+			config.Password = fakeControllerPassword
+			config.Controller = fc.Host()
+			config.Tenant = fakeControllerTenant
+			config.Version = fakeControllerVersion
+			options, err := aviSessionOptions(config, nil)
+			if err != nil {
+				t.Fatalf("aviSessionOptions() failed: %v", err)
+			}
+			_, err = clients.NewAviClient(config.Controller, config.Username,
+				append(options, session.SetLazyAuthentication(false))...)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NewAviClient() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestNewAviTransportInvalidConfig(t *testing.T) {
+	tests := []struct {
+		name   string
+		config Credentials
+	}{
+		{name: "ca bundle without certificates", config: Credentials{CABundle: "-----BEGIN CERTIFICATE-----\n-----END CERTIFICATE-----\n"}},
+		{name: "missing ca bundle file", config: Credentials{CABundle: filepath.Join(t.TempDir(), "missing.pem")}},
+		{name: "client cert without key", config: Credentials{ClientCert: "cert.pem"}},
+		{name: "client key without cert", config: Credentials{ClientKey: "key.pem"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := newAviTransport(tt.config, nil); err == nil {
+				t.Fatalf("newAviTransport() succeeded, want error")
+			}
+		})
+	}
+}
+
+func TestAviControllerAddress(t *testing.T) {
+	tests := []struct {
+		controller string
+		port       string
+		want       string
+	}{
+		{controller: "10.10.10.10", want: "10.10.10.10"},
+		{controller: "10.10.10.10", port: "8443", want: "10.10.10.10:8443"},
+		{controller: "10.10.10.10:443", port: "8443", want: "10.10.10.10:443"},
+		{controller: "controller.example.com", port: "8443", want: "controller.example.com:8443"},
+		{controller: "fd00::10", port: "8443", want: "[fd00::10]:8443"},
+		{controller: "[fd00::10]", port: "8443", want: "[fd00::10]:8443"},
+	}
+	for _, tt := range tests {
+		got := aviControllerAddress(Credentials{Controller: tt.controller, Port: tt.port})
+		if got != tt.want {
+			t.Errorf("aviControllerAddress(%q, %q) = %q, want %q", tt.controller, tt.port, got, tt.want)
+		}
+	}
+}
+
+func TestAviProxyFunc(t *testing.T) {
+	for _, k := range []string{"NO_PROXY", "no_proxy"} {
+		if v, ok := os.LookupEnv(k); ok {
+			defer os.Setenv(k, v)
+		} else {
+			defer os.Unsetenv(k)
+		}
+		os.Unsetenv(k)
+	}
+	if err := os.Setenv("NO_PROXY", "localhost, .internal.example.com,10.0.0.0/8"); err != nil {
+		t.Fatal(err)
+	}
+
+	proxy, err := aviProxyFunc("http://proxy.example.com:3128")
+	if err != nil {
+		t.Fatalf("aviProxyFunc() failed: %v", err)
+	}
+	tests := []struct {
+		url       string
+		wantProxy bool
+	}{
+		{url: "https://controller.example.com/api/pool", wantProxy: true},
+		{url: "https://localhost:8443/api/pool"},
+		{url: "https://controller.internal.example.com/api/pool"},
+		{url: "https://internal.example.com/api/pool"},
+		{url: "https://10.1.2.3/api/pool"},
+		{url: "https://192.168.1.1/api/pool", wantProxy: true},
+	}
+	for _, tt := range tests {
+		req, _ := http.NewRequest(http.MethodGet, tt.url, nil)
+		u, err := proxy(req)
+		if err != nil {
+			t.Fatalf("proxy(%s) failed: %v", tt.url, err)
+		}
+		if (u != nil) != tt.wantProxy {
+			t.Errorf("proxy(%s) = %v, want proxy %v", tt.url, u, tt.wantProxy)
+		}
+	}
+
+	if _, err := aviProxyFunc("proxy.example.com"); err == nil {
+		t.Errorf("aviProxyFunc() accepted a proxy url without host")
+	}
+}
@@ -12,18 +12,27 @@
 package avi
 
 import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
 	"io/ioutil"
 	"log"
+	"net/http"
 	"os"
 	"path/filepath"
 	"reflect"
 	"regexp"
-	"runtime"
+	"sort"
 	"strconv"
 	"strings"
+	"sync"
+	"time"
 
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/vmware/alb-sdk/go/clients"
 	"github.com/vmware/alb-sdk/go/session"
 )
 
@@ -33,33 +42,29 @@ var postNotAllowed = [...]string{"systemconfiguration", "cluster", "seproperties
 // It recursively resolves the data type of the terraform schema and converts scalar to scalar, Set to dictionary,
 // and list to list.
 func SchemaToAviData(d interface{}, s interface{}) (interface{}, error) {
+	config := cty.NullVal(cty.DynamicPseudoType)
+	if r, ok := d.(*schema.ResourceData); ok {
+		config = r.GetRawConfig()
+	}
+	return schemaToAviData(d, s, config)
+}
+
+// schemaToAviData converts d like SchemaToAviData. config is the part of the raw configuration
+// that d was planned from, it tells unset int, bool and float fields apart from zero ones.
+func schemaToAviData(d interface{}, s interface{}, config cty.Value) (interface{}, error) {
 	switch dType := d.(type) {
 	default:
-		// Convert schema data to expected data type for API payload
-		if validateFunctionName := getValidateFunction(s.(*schema.Schema)); validateFunctionName != "" && d != "" {
-			var err error
-			switch validateFunctionName {
-			case "validateInteger":
-				if d, err = strconv.ParseInt(d.(string), 10, 64); err != nil {
-					log.Printf("[ERROR] SchemaToAviData in converting string %v to integer. Error: %v", d, err)
-				}
-			case "validateBool":
-				if d, err = strconv.ParseBool(d.(string)); err != nil {
-					log.Printf("[ERROR] SchemaToAviData in converting string %v to bool. Error: %v", d, err)
-				}
-			case "validateFloat":
-				if d, err = strconv.ParseFloat(d.(string), 64); err != nil {
-					log.Printf("[ERROR] SchemaToAviData in converting string %v to float. Error: %v", d, err)
-				}
-			}
-		}
 	case map[string]interface{}:
 		m := make(map[string]interface{})
 		for k, v := range d.(map[string]interface{}) {
-			if obj, err := SchemaToAviData(v, s.(map[string]*schema.Schema)[k]); err == nil && obj != nil && obj != "" {
+			kSchema := s.(map[string]*schema.Schema)[k]
+			kConfig := configAttr(config, k)
+			if obj, err := schemaToAviData(v, kSchema, kConfig); err == nil && obj != nil && obj != "" &&
+				!isUnsetZero(obj, kSchema, kConfig) {
 				m[k] = obj
 			} else if err != nil {
-				log.Printf("[ERROR] SchemaToAviData %v in parsing k: %v v: %v type: %v", err, k, v, dType)
+				log.Printf("[ERROR] SchemaToAviData %v in parsing k: %v v: %v type: %v", err, k,
+					redactSensitiveField(k, v), dType)
 			}
 		}
 		return m, nil
@@ -76,7 +81,7 @@ func SchemaToAviData(d interface{}, s interface{}) (interface{}, error) {
 			listSchema = s.(*schema.Schema).Elem.(*schema.Schema)
 		}
 		for i := 0; i < len(varray); i++ {
-			obj, err := SchemaToAviData(varray[i], listSchema)
+			obj, err := schemaToAviData(varray[i], listSchema, configElem(config, i))
 			if err == nil && obj != nil {
 				objList = append(objList, obj)
 			}
@@ -90,7 +95,14 @@ func SchemaToAviData(d interface{}, s interface{}) (interface{}, error) {
 		if len(d.(*schema.Set).List()) == 0 {
 			return nil, nil
 		}
-		obj, err := SchemaToAviData(d.(*schema.Set).List()[0], s.(*schema.Schema).Elem.(*schema.Resource).Schema)
+		// The elements of a set are ordered by hash in the data and by value in the configuration,
+		// so the configuration of the element is only known when the set has a single element.
+		elemConfig := configElem(config, 0)
+		if d.(*schema.Set).Len() > 1 {
+			elemConfig = cty.DynamicVal
+		}
+		obj, err := schemaToAviData(d.(*schema.Set).List()[0], s.(*schema.Schema).Elem.(*schema.Resource).Schema,
+			elemConfig)
 		return obj, err
 
 	case *schema.ResourceData:
@@ -99,7 +111,9 @@ func SchemaToAviData(d interface{}, s interface{}) (interface{}, error) {
 		r := d.(*schema.ResourceData)
 		for k, v := range s.(map[string]*schema.Schema) {
 			if data, ok := r.GetOkExists(k); ok {
-				if obj, err := SchemaToAviData(data, v); err == nil && obj != nil && obj != "" {
+				kConfig := configAttr(config, k)
+				if obj, err := schemaToAviData(data, v, kConfig); err == nil && obj != nil && obj != "" &&
+					!isUnsetZero(obj, v, kConfig) {
 					m[k] = obj
 				} else if err != nil {
 					log.Printf("[ERROR] SchemaToAviData %v in converting k: %v v: %v", err, k, v)
@@ -112,12 +126,187 @@ func SchemaToAviData(d interface{}, s interface{}) (interface{}, error) {
 	return d, nil
 }
 
+// isUnsetZero reports whether v is the zero value of an int, bool or float field without default
+// that is not set in config. The plan data has no unset state for these types, so such a field is
+// left out of the Avi JSON like an empty string field, for the controller to apply its default.
+// A field whose configuration is unknown is kept.
+func isUnsetZero(v interface{}, s *schema.Schema, config cty.Value) bool {
+	if s == nil || s.Default != nil || !config.IsNull() {
+		return false
+	}
+	switch s.Type {
+	case schema.TypeInt, schema.TypeBool, schema.TypeFloat:
+		return reflect.ValueOf(v).IsZero()
+	}
+	return false
+}
+
+// configAttr returns the attribute name of the object config, or null if config is not an object
+// that has the attribute. It is unknown if config is unknown.
+func configAttr(config cty.Value, name string) cty.Value {
+	if !config.IsKnown() {
+		return cty.DynamicVal
+	}
+	if config.IsNull() || !config.Type().IsObjectType() || !config.Type().HasAttribute(name) {
+		return cty.NullVal(cty.DynamicPseudoType)
+	}
+	return config.GetAttr(name)
+}
+
+// configElem returns the element at index of the list or set config, or null if config does not
+// have that many elements. It is unknown if config is unknown.
+func configElem(config cty.Value, index int) cty.Value {
+	if !config.IsKnown() {
+		return cty.DynamicVal
+	}
+	if config.IsNull() || !config.CanIterateElements() || config.LengthInt() <= index {
+		return cty.NullVal(cty.DynamicPseudoType)
+	}
+	i := 0
+	for it := config.ElementIterator(); it.Next(); i++ {
+		if _, elem := it.Element(); i == index {
+			return elem
+		}
+	}
+	return cty.NullVal(cty.DynamicPseudoType)
+}
+
+// CommonHash is the hash function of the sets built from Avi JSON by APIDataToSchema. It hashes
+// the contents of the element, so that elements that differ are kept apart.
 func CommonHash(v interface{}) int {
-	return schema.HashString("avi")
+	var key strings.Builder
+	writeHashKey(&key, v)
+	return schema.HashString(key.String())
+}
+
+// writeHashKey writes a canonical representation of v, with the keys of maps sorted.
+func writeHashKey(key *strings.Builder, v interface{}) {
+	switch value := v.(type) {
+	case map[string]interface{}:
+		keys := make([]string, 0, len(value))
+		for k := range value {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		key.WriteString("{")
+		for _, k := range keys {
+			key.WriteString(k)
+			key.WriteString("=")
+			writeHashKey(key, value[k])
+			key.WriteString(";")
+		}
+		key.WriteString("}")
+	case []interface{}:
+		key.WriteString("[")
+		for _, item := range value {
+			writeHashKey(key, item)
+			key.WriteString(";")
+		}
+		key.WriteString("]")
+	case *schema.Set:
+		writeHashKey(key, value.List())
+	default:
+		fmt.Fprintf(key, "%#v", value)
+	}
+}
+
+// addSetHashFuncs sets the hash function of the sets of blocks in the schemas of resources to
+// hashConfigured.
+func addSetHashFuncs(resources map[string]*schema.Resource) {
+	for _, r := range resources {
+		addSetHashFuncsSchema(r.Schema)
+	}
+}
+
+func addSetHashFuncsSchema(s map[string]*schema.Schema) {
+	for _, field := range s {
+		elem, ok := field.Elem.(*schema.Resource)
+		if !ok {
+			continue
+		}
+		if field.Type == schema.TypeSet && field.Set == nil {
+			field.Set = hashConfigured(elem)
+		}
+		addSetHashFuncsSchema(elem.Schema)
+	}
+}
+
+// hashConfigured returns the hash function of a set of blocks r. The default hash function of the
+// sdk hashes the optional computed fields, which the controller fills in when the configuration
+// leaves them unset, so that the element read from the controller would get another hash code
+// than the element of the configuration and be planned for removal. Only the fields that are not
+// computed are hashed, at every level of nesting.
+func hashConfigured(r *schema.Resource) schema.SchemaSetFunc {
+	return func(v interface{}) int {
+		var buf bytes.Buffer
+		serializeConfigured(&buf, v, r)
+		return schema.HashString(buf.String())
+	}
+}
+
+func serializeConfigured(buf *bytes.Buffer, v interface{}, r *schema.Resource) {
+	m, _ := v.(map[string]interface{})
+	keys := make([]string, 0, len(r.Schema))
+	allComputed := true
+	for k, field := range r.Schema {
+		keys = append(keys, k)
+		allComputed = allComputed && field.Computed
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		field := r.Schema[k]
+		// A block of computed fields only is hashed on all of them, as the sdk does.
+		if field.Computed && !allComputed {
+			continue
+		}
+		buf.WriteString(k)
+		buf.WriteRune(':')
+		elem, ok := field.Elem.(*schema.Resource)
+		if !ok || m[k] == nil {
+			schema.SerializeValueForHash(buf, m[k], field)
+			continue
+		}
+		buf.WriteRune('(')
+		for _, item := range singleList(m[k]) {
+			buf.WriteRune('<')
+			serializeConfigured(buf, item, elem)
+			buf.WriteString(">;")
+		}
+		buf.WriteString(");")
+	}
+}
+
+// setsToLists returns v with the sets built by APIDataToSchema replaced by lists. ResourceData.Set
+// keeps the hash codes of a set it is given, so the elements are passed as lists to be hashed by
+// the hash function of the schema, hashConfigured, like the elements of the configuration. The
+// fields filled in by the controller are not hashed, so the element read keeps the hash code of
+// the configured element, and a changed field of a nested block shows up in the plan as that
+// field rather than as a replaced block.
+func setsToLists(v interface{}) interface{} {
+	switch value := v.(type) {
+	case *schema.Set:
+		return setsToLists(value.List())
+	case []interface{}:
+		list := make([]interface{}, len(value))
+		for i, item := range value {
+			list[i] = setsToLists(item)
+		}
+		return list
+	case map[string]interface{}:
+		m := make(map[string]interface{}, len(value))
+		for k, item := range value {
+			m[k] = setsToLists(item)
+		}
+		return m
+	}
+	return v
 }
 
 // It sets default values in the terraform resources to avoid diffs for scalars.
-func SetDefaultsInAPIRes(apiRes interface{}, dLocal interface{}, s map[string]*schema.Schema) (interface{}, error) {
+// path is the resource name followed by the dotted path of apiRes in the resource, and selects the
+// ordering policy of its lists.
+func SetDefaultsInAPIRes(apiRes interface{}, dLocal interface{}, s map[string]*schema.Schema,
+	path string) (interface{}, error) {
 	if apiRes == nil {
 		log.Printf("[ERROR] SetDefaultsInAPIRes got nil for %v", s)
 		return apiRes, nil
@@ -151,7 +340,8 @@ func SetDefaultsInAPIRes(apiRes interface{}, dLocal interface{}, s map[string]*s
 					default:
 					case *schema.Resource:
 						if apiRes.(map[string]interface{})[k] != nil {
-							apiRes1, err := SetDefaultsInAPIRes(apiRes.(map[string]interface{})[k], v, s2.Elem.(*schema.Resource).Schema)
+							apiRes1, err := SetDefaultsInAPIRes(apiRes.(map[string]interface{})[k], v,
+								s2.Elem.(*schema.Resource).Schema, path+"."+k)
 							if err != nil {
 								log.Printf("[ERROR] SetDefaultsInAPIRes %v", err)
 							} else {
@@ -164,6 +354,15 @@ func SetDefaultsInAPIRes(apiRes interface{}, dLocal interface{}, s map[string]*s
 				}
 			//dLocal is array of dictionaries.
 			case []interface{}:
+				if policy, ok := listOrderPolicies[path+"."+k]; ok && apiRes.(map[string]interface{})[k] != nil {
+					// The elements of the lists of the registry are matched by key, not position.
+					if s2, ok := s[k]; ok {
+						apiList, _ := apiRes.(map[string]interface{})[k].([]interface{})
+						apiRes.(map[string]interface{})[k] = policy.setDefaults(apiList, v.([]interface{}), s2,
+							path+"."+k)
+						continue
+					}
+				}
 				var objList []interface{}
 				if apiRes.(map[string]interface{})[k] != nil {
 					varray2 := apiRes.(map[string]interface{})[k].([]interface{})
@@ -185,9 +384,10 @@ func SetDefaultsInAPIRes(apiRes interface{}, dLocal interface{}, s map[string]*s
 						switch s2.Elem.(type) {
 						default:
 						case *schema.Resource:
-							obj, err := SetDefaultsInAPIRes(dst[x], y, s2.Elem.(*schema.Resource).Schema)
+							obj, err := SetDefaultsInAPIRes(dst[x], y, s2.Elem.(*schema.Resource).Schema, path+"."+k)
 							if err != nil {
-								log.Printf("[ERROR] SetDefaultsInAPIRes err %v in x %v y %v", err, x, y)
+								log.Printf("[ERROR] SetDefaultsInAPIRes err %v in x %v y %v", err, x,
+									redactSensitivePath(path+"."+k, y))
 							} else {
 								objList = append(objList, obj)
 							}
@@ -217,7 +417,8 @@ func APIDataToSchema(adata interface{}, d interface{}, t map[string]*schema.Sche
 				if obj, err := APIDataToSchema(v, nil, nil); err == nil {
 					m[k] = obj
 				} else if err != nil {
-					log.Printf("[ERROR] APIDataToSchema %v in converting k: %v v: %v", err, k, v)
+					log.Printf("[ERROR] APIDataToSchema %v in converting k: %v v: %v", err, k,
+						redactSensitiveField(k, v))
 				}
 			}
 			objs := []interface{}{}
@@ -232,8 +433,9 @@ func APIDataToSchema(adata interface{}, d interface{}, t map[string]*schema.Sche
 						switch dType := d.(type) {
 						default:
 						case *schema.ResourceData:
-							if err := d.(*schema.ResourceData).Set(k, obj); err != nil {
-								log.Printf("[ERROR] APIDataToSchema %v in setting %v   type %v", err, obj, dType)
+							if err := d.(*schema.ResourceData).Set(k, setsToLists(obj)); err != nil {
+								log.Printf("[ERROR] APIDataToSchema %v in setting %v   type %v", err,
+									redactSensitiveField(k, v), dType)
 							}
 						case map[string]interface{}:
 							d.(map[string]interface{})[k] = obj
@@ -266,6 +468,208 @@ func APIDataToSchema(adata interface{}, d interface{}, t map[string]*schema.Sche
 	return adata, nil
 }
 
+// aviSessionCall runs a request made through the AviSession and stops waiting
+// for it as soon as ctx is done, so that a cancelled or timed out operation is
+// not held up by a slow controller. The SDK calls take no context, so the
+// request cannot be cancelled: an abandoned request is left to finish in the
+// background and is reported by an *abandonedRequestError, as the controller
+// may still apply it. A caller that must know the outcome, such as a create,
+// re-reads the object once the request finished. Errors returned by the
+// controller are converted to *apiError, and the request is retried according
+// to the retry policy of client. Every attempt is subject to the request limits
+// of client. A request rejected with 401, because the session or its token
+// expired or the credentials were rotated, is retried once with a new session,
+// and a request that got no response is retried once with another healthy
+// controller of the cluster. A 429 is retried no sooner than the controller
+// asked for with Retry-After.
+func aviSessionCall(ctx context.Context, client *ProviderClient, call func() error) error {
+	return aviSessionRetry(ctx, client, true, call)
+}
+
+// aviSessionPost runs a POST like aviSessionCall. As a POST is not idempotent,
+// it is retried only when the controller certainly did not process it: after a
+// 401, or with a status of postRetryStatusCodes. After a failure without
+// response the session still fails over to another controller, but the POST is
+// not retried, as it may have created the object.
+func aviSessionPost(ctx context.Context, client *ProviderClient, call func() error) error {
+	return aviSessionRetry(ctx, client, false, call)
+}
+
+func aviSessionRetry(ctx context.Context, client *ProviderClient, idempotent bool, call func() error) error {
+	policy := client.retry
+	retryable := policy.retryable
+	if !idempotent {
+		retryable = policy.retryablePost
+	}
+	reauthenticated, failedOver := false, false
+	for attempt := 1; ; attempt++ {
+		generation := client.currentSessionGeneration()
+		err := aviSessionAttempt(ctx, client, call)
+		if isControllerUnreachable(err) && !failedOver {
+			failedOver = true
+			ferr := client.failover(ctx, generation)
+			if ferr == nil && idempotent {
+				log.Printf("[DEBUG] aviSessionCall retrying with another controller: %v\n", err)
+				attempt--
+				continue
+			}
+			if ferr != nil {
+				log.Printf("[DEBUG] aviSessionCall failed to fail over: %v\n", ferr)
+			}
+		}
+		if aviErrorStatus(err) == http.StatusUnauthorized && !reauthenticated {
+			reauthenticated = true
+			if rerr := client.reauthenticate(ctx, generation); rerr != nil {
+				log.Printf("[ERROR] aviSessionCall failed to re-authenticate: %v\n", rerr)
+				return err
+			}
+			log.Printf("[DEBUG] aviSessionCall retrying with a new session: %v\n", err)
+			attempt--
+			continue
+		}
+		if err == nil || attempt >= policy.MaxAttempts || !retryable(err) {
+			return err
+		}
+		backoff := policy.backoff(attempt)
+		if aviErrorStatus(err) == http.StatusTooManyRequests {
+			if wait := client.throttle.wait(); wait > backoff {
+				backoff = wait
+			}
+		}
+		log.Printf("[DEBUG] aviSessionCall attempt %d of %d failed, retrying in %v: %v\n", attempt,
+			policy.MaxAttempts, backoff, err)
+		select {
+		case <-time.After(backoff):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// aviSessionAttempt makes one attempt of a session call once the limiter of client allows it. The
+// request holds its place in the limiter until it finishes, even when ctx is done before, and the
+// session of client is not replaced while the request uses it.
+func aviSessionAttempt(ctx context.Context, client *ProviderClient, call func() error) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	release, err := client.limiter.acquire(ctx)
+	if err != nil {
+		return err
+	}
+	errc := make(chan error, 1)
+	go func() {
+		defer release()
+		client.sessionMu.RLock()
+		defer client.sessionMu.RUnlock()
+		errc <- call()
+	}()
+	select {
+	case err := <-errc:
+		return newAPIError(err)
+	case <-ctx.Done():
+		return &abandonedRequestError{err: ctx.Err(), done: errc}
+	}
+}
+
+// abandonedCreateTimeout bounds the wait for an abandoned create to finish and the lookup of the
+// object it may have created.
+const abandonedCreateTimeout = 2 * time.Minute
+
+// recoverAbandonedCreate keeps track of the object that an abandoned POST of a create may have
+// created. Once the request finished, the object is read by name and its id is set in d, so that
+// terraform saves it in the state as tainted instead of leaving it unmanaged on the controller.
+func recoverAbandonedCreate(client *ProviderClient, d *schema.ResourceData, objType, name, tenant string,
+	abandoned *abandonedRequestError) {
+	ctx, cancel := context.WithTimeout(context.Background(), abandonedCreateTimeout)
+	defer cancel()
+	if err := abandoned.wait(ctx); err != nil {
+		log.Printf("[DEBUG] recoverAbandonedCreate: abandoned create of %v %v failed: %v\n", objType, name, err)
+		if aviErrorStatus(err) != 0 {
+			return
+		}
+	}
+	var obj interface{}
+	err := aviSessionCall(ctx, client, func() error {
+		return client.AviSession.GetObject(objType, aviTenantOptions(tenant,
+			session.SetName(name), session.SetResult(&obj), session.SetSkipDefault(true))...)
+	})
+	if err != nil || obj == nil {
+		log.Printf("[DEBUG] recoverAbandonedCreate: %v %v not found after the abandoned create: %v\n",
+			objType, name, err)
+		return
+	}
+	log.Printf("[WARN] recoverAbandonedCreate: abandoned create of %v %v created it\n", objType, name)
+	SetIDFromObj(d, obj)
+}
+
+// keyedMutex serializes operations that share a key, such as the read-modify-write of the
+// servers of one pool by many avi_server resources.
+type keyedMutex struct {
+	mu    sync.Mutex
+	locks map[string]*keyedMutexEntry
+}
+
+type keyedMutexEntry struct {
+	sem  chan struct{}
+	refs int
+}
+
+func newKeyedMutex() *keyedMutex {
+	return &keyedMutex{locks: map[string]*keyedMutexEntry{}}
+}
+
+// Lock waits until the lock of key is acquired or ctx is done. The returned function releases
+// the lock.
+func (k *keyedMutex) Lock(ctx context.Context, key string) (func(), error) {
+	k.mu.Lock()
+	entry, ok := k.locks[key]
+	if !ok {
+		entry = &keyedMutexEntry{sem: make(chan struct{}, 1)}
+		k.locks[key] = entry
+	}
+	entry.refs++
+	k.mu.Unlock()
+
+	release := func() {
+		k.mu.Lock()
+		entry.refs--
+		if entry.refs == 0 {
+			delete(k.locks, key)
+		}
+		k.mu.Unlock()
+	}
+	select {
+	case entry.sem <- struct{}{}:
+		return func() {
+			<-entry.sem
+			release()
+		}, nil
+	case <-ctx.Done():
+		release()
+		return nil, ctx.Err()
+	}
+}
+
+var aviErrorFieldRe = regexp.MustCompile(`['"]([a-z0-9_]+)['"]`)
+
+// diagFromAviError converts an error returned by the controller into diagnostics. When the
+// error message names a top level attribute of the schema, the diagnostic points at it.
+func diagFromAviError(err error, summary string, s map[string]*schema.Schema) diag.Diagnostics {
+	diagnostic := diag.Diagnostic{
+		Severity: diag.Error,
+		Summary:  summary,
+		Detail:   err.Error(),
+	}
+	for _, match := range aviErrorFieldRe.FindAllStringSubmatch(err.Error(), -1) {
+		if _, ok := s[match[1]]; ok {
+			diagnostic.AttributePath = cty.GetAttrPath(match[1])
+			break
+		}
+	}
+	return diag.Diagnostics{diagnostic}
+}
+
 func SetIDFromObj(d *schema.ResourceData, robj interface{}) {
 	uuid := robj.(map[string]interface{})["uuid"].(string)
 	_ = d.Set("uuid", uuid)
@@ -279,11 +683,15 @@ func SetIDFromObj(d *schema.ResourceData, robj interface{}) {
 // It is generic API to create and update any Avi REST resource. It handles special situations with cloud
 // and tenant filters as objects may already be present. If the resource does not exist it will try to
 // create it. In case, it is present then automatically converts to PUT semantics.
-func APICreateOrUpdate(d *schema.ResourceData, meta interface{}, objType string, s map[string]*schema.Schema,
-	opts ...bool) error {
-	client := meta.(*clients.AviClient)
+func APICreateOrUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}, objType string,
+	s map[string]*schema.Schema, opts ...bool) diag.Diagnostics {
+	client := meta.(*ProviderClient)
 	var robj interface{}
 	obj := d
+	tenant, err := resourceTenant(ctx, d, client)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
 	usePatchForUpdate := false
 	if len(opts) > 0 {
@@ -293,9 +701,14 @@ func APICreateOrUpdate(d *schema.ResourceData, meta interface{}, objType string,
 	if data, err := SchemaToAviData(obj, s); err == nil {
 		path := "api/" + objType
 		specialobj := IsPostNotAllowed(objType)
+		if resourceAdoptionMode(d, meta) == adoptionModeMarked {
+			addProviderMarker(data, s, providerOwner(meta))
+		}
 		if specialobj {
 			path = path + "?skip_default=true"
-			err = client.AviSession.Put(path, data, &robj)
+			err = aviSessionCall(ctx, client, func() error {
+				return client.AviSession.Put(path, data, &robj, aviTenantOptions(tenant)...)
+			})
 			if err != nil {
 				log.Printf("[ERROR] APICreateOrUpdate: PUT on %v Error %v path %v id %v\n", objType, err, path,
 					d.Id())
@@ -304,11 +717,12 @@ func APICreateOrUpdate(d *schema.ResourceData, meta interface{}, objType string,
 			}
 		} else if uuid, ok := d.GetOk("uuid"); ok {
 			path = path + "/" + uuid.(string) + "?skip_default=true"
-			if !usePatchForUpdate {
-				err = client.AviSession.Put(path, data, &robj)
-			} else {
-				err = client.AviSession.Patch(path, data, "replace", &robj)
-			}
+			err = aviSessionCall(ctx, client, func() error {
+				if !usePatchForUpdate {
+					return client.AviSession.Put(path, data, &robj, aviTenantOptions(tenant)...)
+				}
+				return client.AviSession.Patch(path, data, "replace", &robj, aviTenantOptions(tenant)...)
+			})
 			if err != nil {
 				log.Printf("[ERROR] APICreateOrUpdate: PUT Error %v path %v id %v\n", err, path, d.Id())
 			}
@@ -322,50 +736,69 @@ func APICreateOrUpdate(d *schema.ResourceData, meta interface{}, objType string,
 					cloudUUID = strings.Split(cloudUUID, "#")[0]
 					log.Printf("[INFO] APICreateOrUpdate: using cloud %v for obj %v name %s \n",
 						cloudUUID, objType, name)
-					err = client.AviSession.GetObject(objType, session.SetName(name.(string)),
-						session.SetResult(&existingObj), session.SetCloudUUID(cloudUUID),
-						session.SetSkipDefault(true))
+					err = aviSessionCall(ctx, client, func() error {
+						return client.AviSession.GetObject(objType, aviTenantOptions(tenant,
+							session.SetName(name.(string)), session.SetResult(&existingObj),
+							session.SetCloudUUID(cloudUUID), session.SetSkipDefault(true))...)
+					})
 					if err != nil {
 						log.Printf("[ERROR] APICreateOrUpdate: GET Error %v path %v id %v\n", err, path, d.Id())
 					}
 				} else {
 					log.Printf("[INFO] APICreateOrUpdate: reading obj %v name %s \n",
 						objType, name)
-					err = client.AviSession.GetObject(objType, session.SetName(name.(string)),
-						session.SetResult(&existingObj), session.SetSkipDefault(true))
+					err = aviSessionCall(ctx, client, func() error {
+						return client.AviSession.GetObject(objType, aviTenantOptions(tenant,
+							session.SetName(name.(string)), session.SetResult(&existingObj),
+							session.SetSkipDefault(true))...)
+					})
 					if err != nil {
 						log.Printf("[ERROR] APICreateOrUpdate: GET Error %v path %v id %v\n", err, path, d.Id())
 					}
 				}
+				if ctxErr := ctx.Err(); ctxErr != nil {
+					return diag.FromErr(ctxErr)
+				}
 
 				if existingObj == nil {
 					// object not found
-					log.Printf("[INFO] APICreateOrUpdate: Creating obj type %v schema %v data %v\n", objType, d,
-						data)
-					err = client.AviSession.Post(path, data, &robj)
+					log.Printf("[INFO] APICreateOrUpdate: Creating obj type %v data %v\n", objType,
+						redactSensitive(objType, data))
+					err = aviSessionPost(ctx, client, func() error {
+						return client.AviSession.Post(path, data, &robj, aviTenantOptions(tenant)...)
+					})
+					var abandoned *abandonedRequestError
 					if err == nil && robj != nil {
 						SetIDFromObj(d, robj)
+					} else if errors.As(err, &abandoned) {
+						recoverAbandonedCreate(client, d, objType, name.(string), tenant, abandoned)
 					} else {
 						log.Printf("[ERROR] APICreateOrUpdate creation failed %v object with name %v\n", err,
 							name)
 					}
 				} else {
 					// found existing object.
+					if diags := checkAdoption(d, meta, objType, existingObj); diags.HasError() {
+						return diags
+					}
 					SetIDFromObj(d, existingObj)
 					uuid = existingObj.(map[string]interface{})["uuid"].(string)
 					path = path + "/" + uuid.(string) + "?skip_default=true"
-					if !usePatchForUpdate {
-						err = client.AviSession.Put(path, data, &robj)
-					} else {
-						err = client.AviSession.Patch(path, data, "replace", &robj)
-					}
+					err = aviSessionCall(ctx, client, func() error {
+						if !usePatchForUpdate {
+							return client.AviSession.Put(path, data, &robj, aviTenantOptions(tenant)...)
+						}
+						return client.AviSession.Patch(path, data, "replace", &robj, aviTenantOptions(tenant)...)
+					})
 					if err != nil {
 						log.Printf("[ERROR] APICreateOrUpdate: PUT Error %v path %v id %v\n", err, path, d.Id())
 					}
 				}
 			} else {
-				log.Printf("[INFO] APICreateOrUpdate: Creating obj %v schema %v data %v\n", objType, d, data)
-				err = client.AviSession.Post(path, data, &robj)
+				log.Printf("[INFO] APICreateOrUpdate: Creating obj %v data %v\n", objType, redactSensitive(objType, data))
+				err = aviSessionPost(ctx, client, func() error {
+					return client.AviSession.Post(path, data, &robj, aviTenantOptions(tenant)...)
+				})
 				if err != nil {
 					log.Printf("[ERROR] APICreateOrUpdate creation failed %v\n", err)
 				} else {
@@ -373,20 +806,29 @@ func APICreateOrUpdate(d *schema.ResourceData, meta interface{}, objType string,
 				}
 			}
 		}
-		return err
+		warnings := fieldVersionWarnings(d.GetRawConfig(), objType, client.DetectedVersion)
+		if err != nil {
+			return append(warnings, diagFromAviError(err, "failed to create or update "+objType, s)...)
+		}
+		return warnings
 	} else { //nolint
 		log.Printf("[ERROR] APICreateOrUpdate: Error %v", err)
-		return err
+		return diag.FromErr(err)
 	}
 }
 
-func APIRead(d *schema.ResourceData, meta interface{}, objType string, s map[string]*schema.Schema) error {
-	client := meta.(*clients.AviClient)
+func APIRead(ctx context.Context, d *schema.ResourceData, meta interface{}, objType string,
+	s map[string]*schema.Schema) diag.Diagnostics {
+	client := meta.(*ProviderClient)
 	var obj interface{}
 	var path string
 	uuid := ""
 	url := ""
 	specialobj := IsPostNotAllowed(objType)
+	tenant, err := resourceTenant(ctx, d, client)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	log.Printf("[DEBUG] APIRead reading object with objType %v id %v\n", objType, d.Id())
 	if d.Id() != "" {
 		// extract the uuid from it.
@@ -406,8 +848,14 @@ func APIRead(d *schema.ResourceData, meta interface{}, objType string, s map[str
 			path = "api/" + objType + "/" + uuid + "?skip_default=true"
 		}
 		log.Printf("[DEBUG] APIRead reading object with id %v path %v\n", uuid, path)
-		err := client.AviSession.Get(path, &obj)
+		err := aviSessionCall(ctx, client, func() error {
+			return client.AviSession.Get(path, &obj, aviTenantOptions(tenant)...)
+		})
 		if err != nil {
+			if !isAviObjectNotFound(err) {
+				log.Printf("[ERROR] APIRead object with uuid %v failed err %v\n", uuid, err)
+				return diagFromAviError(err, "failed to read "+objType, s)
+			}
 			d.SetId("")
 			log.Printf("[ERROR] APIRead object with uuid %v not found err %v\n", uuid, err)
 			return nil
@@ -419,14 +867,22 @@ func APIRead(d *schema.ResourceData, meta interface{}, objType string, s map[str
 			cloudUUID = strings.Split(cloudUUID, "#")[0]
 			log.Printf("[DEBUG] APIRead using cloud %v obj %v name %v\n", cloudUUID,
 				objType, name)
-			err = client.AviSession.GetObject(objType, session.SetName(name.(string)),
-				session.SetResult(&obj), session.SetCloudUUID(cloudUUID), session.SetSkipDefault(true))
+			err = aviSessionCall(ctx, client, func() error {
+				return client.AviSession.GetObject(objType, aviTenantOptions(tenant, session.SetName(name.(string)),
+					session.SetResult(&obj), session.SetCloudUUID(cloudUUID), session.SetSkipDefault(true))...)
+			})
 		} else {
 			log.Printf("[DEBUG] APIRead using name %v \n", name)
-			err = client.AviSession.GetObject(objType, session.SetName(name.(string)),
-				session.SetResult(&obj), session.SetSkipDefault(true))
+			err = aviSessionCall(ctx, client, func() error {
+				return client.AviSession.GetObject(objType, aviTenantOptions(tenant, session.SetName(name.(string)),
+					session.SetResult(&obj), session.SetSkipDefault(true))...)
+			})
 		}
 		if err != nil {
+			if !isAviObjectNotFound(err) {
+				log.Printf("[ERROR] APIRead object with name %v:%v failed err %v\n", objType, name, err)
+				return diagFromAviError(err, "failed to read "+objType, s)
+			}
 			d.SetId("")
 			log.Printf("[ERROR] APIRead object with name %v:%v not found err %v\n", objType, name, err)
 			return nil
@@ -434,8 +890,14 @@ func APIRead(d *schema.ResourceData, meta interface{}, objType string, s map[str
 	} else if specialobj {
 		path := "api/" + objType
 		log.Printf("[DEBUG] APIRead reading special object with path %v\n", path)
-		err := client.AviSession.Get(path, &obj)
+		err := aviSessionCall(ctx, client, func() error {
+			return client.AviSession.Get(path, &obj, aviTenantOptions(tenant)...)
+		})
 		if err != nil {
+			if !isAviObjectNotFound(err) {
+				log.Printf("[ERROR] APIRead special object with path %v failed err %v\n", path, err)
+				return diagFromAviError(err, "failed to read "+objType, s)
+			}
 			d.SetId("")
 			log.Printf("[ERROR] APIRead special object with path %v not found err %v\n", path, err)
 			return nil
@@ -445,8 +907,9 @@ func APIRead(d *schema.ResourceData, meta interface{}, objType string, s map[str
 		log.Printf("[ERROR] APIRead not found %v\n", d.Get("uuid"))
 		return nil
 	}
+	stripProviderMarker(obj, providerOwner(meta))
 	if localData, err := SchemaToAviData(d, s); err == nil {
-		modAPIRes, err := SetDefaultsInAPIRes(obj, localData, s)
+		modAPIRes, err := SetDefaultsInAPIRes(obj, localData, s, "avi_"+objType)
 		if err != nil {
 			log.Printf("[ERROR] APIRead in modifying api response object %v\n", err)
 		}
@@ -462,6 +925,9 @@ func APIRead(d *schema.ResourceData, meta interface{}, objType string, s map[str
 				url = modAPIRes.(map[string]interface{})["url"].(string)
 			}
 			//url = strings.SplitN(url, "#", 2)[0]
+			if _, ok := s["tenant_ref"]; ok && tenant != "" {
+				d.Set("tenant", tenant)
+			}
 			if url != "" {
 				d.SetId(url)
 				log.Printf("[DEBUG] APIRead read object with id %v\n", url)
@@ -472,23 +938,38 @@ func APIRead(d *schema.ResourceData, meta interface{}, objType string, s map[str
 		} else {
 			log.Printf("[ERROR] APIRead in setting read object %v\n", err)
 		}
-		log.Printf("[DEBUG] type: %v localData : %v", objType, localData)
-		log.Printf("[DEBUG] type: %v modAPIRes: %v", objType, modAPIRes)
+		log.Printf("[DEBUG] type: %v localData : %v", objType, redactSensitive(objType, localData))
+		log.Printf("[DEBUG] type: %v modAPIRes: %v", objType, redactSensitive(objType, modAPIRes))
 	}
 
 	return nil
 }
 
-func ResourceImporter(d *schema.ResourceData, meta interface{}, objType string, s map[string]*schema.Schema) ([]*schema.ResourceData, error) {
+func ResourceImporter(ctx context.Context, d *schema.ResourceData, meta interface{}, objType string,
+	s map[string]*schema.Schema) ([]*schema.ResourceData, error) {
 	log.Printf("[DEBUG] ResourceImporter obuType%v id %v\n", objType, d.Id())
 	if d.Id() != "" {
+		// <tenant>/<uuid> imports an object of another tenant than the provider session.
+		if tenant, uuid, ok := splitTenantImportID(d.Id()); ok {
+			if _, ok := s["tenant_ref"]; !ok {
+				return nil, fmt.Errorf("avi_%s does not support import with a tenant", objType)
+			}
+			d.Set("tenant", tenant)
+			d.SetId(uuid)
+		}
 		// return the ID based import
 		return []*schema.ResourceData{d}, nil
 	}
 	var data interface{}
-	client := meta.(*clients.AviClient)
+	client := meta.(*ProviderClient)
+	tenant, err := resourceTenant(ctx, d, client)
+	if err != nil {
+		return nil, err
+	}
 	path := "api/" + objType + "?skip_default=true"
-	err := client.AviSession.Get(path, &data)
+	err = aviSessionCall(ctx, client, func() error {
+		return client.AviSession.Get(path, &data, aviTenantOptions(tenant)...)
+	})
 	if err != nil {
 		log.Printf("[ERROR] ResourceImporter %v in GET of path %v\n", err, path)
 		return nil, err
@@ -500,10 +981,11 @@ func ResourceImporter(d *schema.ResourceData, meta interface{}, objType string,
 		apiResults := (data.(map[string]interface{})["results"]).([]interface{})
 		for index := 0; index < count; index++ {
 			obj := apiResults[index].(map[string]interface{})
-			log.Printf("[DEBUG] ResourceImporter processing obj %v results %v\n", obj, results[index])
+			log.Printf("[DEBUG] ResourceImporter processing obj %v\n", redactSensitive(objType, obj))
+			stripProviderMarker(obj, providerOwner(meta))
 			result := new(schema.ResourceData)
 			if _, err := APIDataToSchema(obj, result, s); err == nil {
-				log.Printf("[DEBUG] ResourceImporter Processing obj %v\n", obj)
+				log.Printf("[DEBUG] ResourceImporter Processing obj %v\n", redactSensitive(objType, obj))
 				url := obj["url"].(string)
 				uuid := obj["uuid"].(string)
 				//url = strings.SplitN(url, "#", 2)[0]
@@ -518,14 +1000,47 @@ func ResourceImporter(d *schema.ResourceData, meta interface{}, objType string,
 	return nil, nil
 }
 
+// APIDelete deletes the object of objType with the uuid of d. An object that is already gone
+// counts as deleted; any other failure, including a 403 from the controller, is returned.
+func APIDelete(ctx context.Context, d *schema.ResourceData, meta interface{}, objType string) diag.Diagnostics {
+	client := meta.(*ProviderClient)
+	if APIDeleteSystemDefaultCheck(d) {
+		return nil
+	}
+	// The singleton objects of the controller can not be deleted, they are only removed from the
+	// state as the cluster is.
+	if IsPostNotAllowed(objType) {
+		log.Printf("[WARNING] APIDelete can not delete %v, removing it from state\n", objType)
+		d.SetId("")
+		return nil
+	}
+	uuid := d.Get("uuid").(string)
+	if uuid != "" {
+		tenant, err := resourceTenant(ctx, d, client)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		path := "api/" + objType + "/" + uuid
+		err = aviSessionCall(ctx, client, func() error {
+			return client.AviSession.DeleteObject(path, aviTenantOptions(tenant)...)
+		})
+		if err != nil && !isAviObjectNotFound(err) && aviErrorStatus(err) != http.StatusNoContent {
+			log.Printf("[ERROR] APIDelete failed to delete %v %v: %v\n", objType, uuid, err)
+			return diag.FromErr(err)
+		}
+		if isAviObjectNotFound(err) {
+			log.Printf("[INFO] APIDelete %v %v not found\n", objType, uuid)
+		}
+		d.SetId("")
+	}
+	return nil
+}
+
 func APIDeleteSystemDefaultCheck(d *schema.ResourceData) bool {
 	var systemDefault bool
 	var sysName string
-	var err error
 	if sysdef, ok := d.GetOk("system_default"); ok {
-		if systemDefault, err = strconv.ParseBool(sysdef.(string)); err != nil {
-			log.Printf("[ERROR] Error %v while converting system_default value %s to bool.", err, sysdef)
-		}
+		systemDefault = sysdef.(bool)
 	}
 	if name, ok := d.GetOk("name"); ok {
 		sysName = name.(string)
@@ -566,9 +1081,10 @@ func createFilePointer(path string) (*os.File, error) {
 	}
 }
 
-func MultipartUploadOrDownload(d *schema.ResourceData, meta interface{}, s map[string]*schema.Schema) error {
+func MultipartUploadOrDownload(ctx context.Context, d *schema.ResourceData, meta interface{},
+	s map[string]*schema.Schema) error {
 	//Function to make REST API call for upload and download.
-	client := meta.(*clients.AviClient)
+	client := meta.(*ProviderClient)
 	uri := d.Get("uri").(string)
 	localFile := d.Get("local_file").(string)
 	var err error
@@ -588,7 +1104,9 @@ func MultipartUploadOrDownload(d *schema.ResourceData, meta interface{}, s map[s
 				"license_text": strData,
 			}
 			uri = "/api/" + uri
-			err = client.AviSession.Put(uri, licenseData, &res)
+			err = aviSessionCall(ctx, client, func() error {
+				return client.AviSession.Put(uri, licenseData, &res)
+			})
 			if err != nil {
 				log.Printf("[ERROR] MultipartUploadOrDownload %v in PUT of URI %v\n", err, uri)
 				return err
@@ -612,7 +1130,12 @@ func MultipartUploadOrDownload(d *schema.ResourceData, meta interface{}, s map[s
 				return err
 			}
 			localFilePtr := mustOpen(localFile)
-			err := client.AviSession.PostMultipartRequest("POST", uri, localFilePtr)
+			err := aviSessionCall(ctx, client, func() error {
+				if _, err := localFilePtr.Seek(0, io.SeekStart); err != nil {
+					return err
+				}
+				return client.AviSession.PostMultipartRequest("POST", uri, localFilePtr)
+			})
 			if err != nil {
 				log.Printf("[ERROR] MultipartUploadOrDownload Error uploading file %v %v", localFile, err)
 				return err
@@ -627,7 +1150,10 @@ func MultipartUploadOrDownload(d *schema.ResourceData, meta interface{}, s map[s
 		if err != nil {
 			log.Printf("[ERROR] MultipartUploadOrDownload Error for creation of file %v", localFile)
 		}
-		err = client.AviSession.GetMultipartRaw("GET", uri, downloadFilePtr)
+		err = aviSessionCall(ctx, client, func() error {
+			rewindDownload(downloadFilePtr)
+			return client.AviSession.GetMultipartRaw("GET", uri, downloadFilePtr)
+		})
 		if err != nil {
 			log.Printf("[ERROR] MultipartUploadOrDownload Error downloaing file using uri %v %v", uri, err)
 			return err
@@ -641,7 +1167,10 @@ func MultipartUploadOrDownload(d *schema.ResourceData, meta interface{}, s map[s
 		if err != nil {
 			log.Printf("[ERROR] MultipartUploadOrDownload Error for creation of file %v", localFile)
 		}
-		err = client.AviSession.GetMultipartRaw("GET", uri, downloadFilePtr)
+		err = aviSessionCall(ctx, client, func() error {
+			rewindDownload(downloadFilePtr)
+			return client.AviSession.GetMultipartRaw("GET", uri, downloadFilePtr)
+		})
 		if err != nil {
 			log.Printf("[ERROR] MultipartUploadOrDownload Error downloaing file using uri %v %v", uri, err)
 			return err
@@ -652,6 +1181,20 @@ func MultipartUploadOrDownload(d *schema.ResourceData, meta interface{}, s map[s
 	return err
 }
 
+// rewindDownload empties the file of a download, which may hold part of the response of an
+// earlier attempt.
+func rewindDownload(f *os.File) {
+	if f == nil {
+		return
+	}
+	if err := f.Truncate(0); err != nil {
+		log.Printf("[ERROR] rewindDownload failed to truncate %v: %v", f.Name(), err)
+	}
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		log.Printf("[ERROR] rewindDownload failed to rewind %v: %v", f.Name(), err)
+	}
+}
+
 func UUIDFromID(ID string) string {
 	urlParts := strings.Split(ID, "/")
 	idParts := urlParts[len(urlParts)-1]
@@ -672,7 +1215,7 @@ func IsPostNotAllowed(objtype string) bool {
 	return specialobj
 }
 
-// Preprocess API response to convert int/bool/float data from respose to string
+// Preprocess API response to convert int/bool/float data from response to the types of the schema
 func PreprocessAPIRes(apiRes interface{}, s map[string]*schema.Schema) (interface{}, error) {
 	if apiRes == nil {
 		log.Printf("[ERROR] PreprocessAPIRes got nil for %v", s)
@@ -685,36 +1228,8 @@ func PreprocessAPIRes(apiRes interface{}, s map[string]*schema.Schema) (interfac
 			switch v.(type) {
 			//Getting key, value for response apiRes
 			default:
-				if _, ok := apiRes.(map[string]interface{})[k]; ok {
-					if dval, ok := s[k]; ok {
-						validateFunctionName := getValidateFunction(dval)
-						if validateFunctionName != "" {
-							switch validateFunctionName {
-							default:
-							case "validateInteger":
-								if reflect.TypeOf(apiRes.(map[string]interface{})[k]).Kind() == reflect.Float64 {
-									apiRes.(map[string]interface{})[k] = strconv.FormatFloat(apiRes.(map[string]interface{})[k].(float64), 'f', -1, 64)
-								} else if reflect.TypeOf(apiRes.(map[string]interface{})[k]).Kind() == reflect.Int64 {
-									apiRes.(map[string]interface{})[k] = strconv.FormatInt(apiRes.(map[string]interface{})[k].(int64), 10)
-								}
-							case "validateBool":
-								if reflect.TypeOf(apiRes.(map[string]interface{})[k]).Kind() == reflect.Bool {
-									apiRes.(map[string]interface{})[k] = strconv.FormatBool(apiRes.(map[string]interface{})[k].(bool))
-								}
-							case "validateFloat":
-								if reflect.TypeOf(apiRes.(map[string]interface{})[k]).Kind() == reflect.Float64 {
-									apiRes.(map[string]interface{})[k] = strconv.FormatFloat(apiRes.(map[string]interface{})[k].(float64), 'f', -1, 64)
-								} else if reflect.TypeOf(apiRes.(map[string]interface{})[k]).Kind() == reflect.String {
-									// Remove trailing zeroes from response
-									if strFloat, err := strconv.ParseFloat(apiRes.(map[string]interface{})[k].(string), 64); err == nil {
-										apiRes.(map[string]interface{})[k] = strconv.FormatFloat(strFloat, 'f', -1, 64)
-									} else {
-										log.Printf("[ERROR] PreprocessAPIRes in converting string %v to float. Error: %v", apiRes.(map[string]interface{})[k], err)
-									}
-								}
-							}
-						}
-					}
+				if dval, ok := s[k]; ok {
+					apiRes.(map[string]interface{})[k] = nativeSchemaValue(v, dval)
 				}
 			//apiRes nested dictionary.
 			case map[string]interface{}:
@@ -751,7 +1266,7 @@ func PreprocessAPIRes(apiRes interface{}, s map[string]*schema.Schema) (interfac
 						case *schema.Resource:
 							obj, err := PreprocessAPIRes(y, s2.Elem.(*schema.Resource).Schema)
 							if err != nil {
-								log.Printf("[ERROR] PreprocessAPIRes err %v in x %v y %v", err, x, y)
+								log.Printf("[ERROR] PreprocessAPIRes err %v in x %v y %v", err, x, redactSensitive("", y))
 							} else {
 								objList = append(objList, obj)
 							}
@@ -767,12 +1282,37 @@ func PreprocessAPIRes(apiRes interface{}, s map[string]*schema.Schema) (interfac
 	return apiRes, nil
 }
 
-func getValidateFunction(schemaVal *schema.Schema) string {
-	if isValidateFunction := schemaVal.ValidateFunc; isValidateFunction != nil {
-		validateFunctionRef := runtime.FuncForPC(reflect.ValueOf(schemaVal.ValidateFunc).Pointer()).Name()
-		validateFunctionSplit := strings.Split(validateFunctionRef, ".")
-		validateFunctionName := validateFunctionSplit[len(validateFunctionSplit)-1]
-		return validateFunctionName
+// nativeSchemaValue converts v, a scalar of the Avi JSON, to the type of the field s. Numbers are
+// decoded from JSON as float64, and some are returned by the controller as strings.
+func nativeSchemaValue(v interface{}, s *schema.Schema) interface{} {
+	switch s.Type {
+	case schema.TypeInt:
+		switch value := v.(type) {
+		case float64:
+			return int64(value)
+		case string:
+			if i, err := strconv.ParseInt(value, 10, 64); err == nil {
+				return i
+			}
+			log.Printf("[ERROR] PreprocessAPIRes in converting string %v to integer", value)
+		}
+	case schema.TypeBool:
+		if value, ok := v.(string); ok {
+			if b, err := strconv.ParseBool(value); err == nil {
+				return b
+			}
+			log.Printf("[ERROR] PreprocessAPIRes in converting string %v to bool", value)
+		}
+	case schema.TypeFloat:
+		switch value := v.(type) {
+		case int64:
+			return float64(value)
+		case string:
+			if f, err := strconv.ParseFloat(value, 64); err == nil {
+				return f
+			}
+			log.Printf("[ERROR] PreprocessAPIRes in converting string %v to float", value)
+		}
 	}
-	return ""
+	return v
 }
@@ -7,11 +7,19 @@
 package avi
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
 	"reflect"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/alb-sdk/go/session"
 )
 
 var apipooldata = `{
@@ -105,9 +113,268 @@ func TestAPIDataToSchemaWithUpdatedApiData(t *testing.T) {
 
 		// Converting schema into avidata.
 		data, _ := SchemaToAviData(schemadata.(*schema.Set).List()[0], s)
-		// Return false for not equal,
-		if reflect.DeepEqual(apidata, data) {
+		// Return false for not equal, the updated configuration differs from the api data.
+		if reflect.DeepEqual(initialData, data) {
 			t.Fail()
 		}
 	}
 }
+
+// APIRead must only drop an object from the state when the controller reports it as missing.
+func TestAPIReadNotFound(t *testing.T) {
+	fc := newFakeController()
+	defer fc.Close()
+	client := newFakeControllerClient(t, fc)
+	s := ResourcePoolSchema()
+	missing := fc.objectURL("pool", "pool-missing")
+
+	d := schema.TestResourceDataRaw(t, s, map[string]interface{}{"name": "missing-pool"})
+	d.SetId(missing)
+	if diags := APIRead(context.Background(), d, client, "pool", s); diags.HasError() {
+		t.Fatalf("reading a deleted object failed: %v", diags)
+	}
+	if d.Id() != "" {
+		t.Errorf("deleted object was not removed from state, id %v", d.Id())
+	}
+
+	for _, status := range []int{http.StatusForbidden, http.StatusInternalServerError} {
+		d := schema.TestResourceDataRaw(t, s, map[string]interface{}{"name": "missing-pool"})
+		d.SetId(missing)
+		fc.FailNext(100, status)
+		diags := APIRead(context.Background(), d, client, "pool", s)
+		fc.FailNext(0, 0)
+		if !diags.HasError() {
+			t.Errorf("HTTP %d was not reported as an error", status)
+		}
+		if d.Id() != missing {
+			t.Errorf("HTTP %d removed the object from state", status)
+		}
+	}
+}
+
+// A forbidden delete must fail while deleting an object that is already gone succeeds.
+func TestAPIDelete(t *testing.T) {
+	fc := newFakeController()
+	defer fc.Close()
+	client := newFakeControllerClient(t, fc)
+	var pool map[string]interface{}
+	if err := client.AviSession.Post("api/pool", map[string]interface{}{"name": "delete-pool"}, &pool); err != nil {
+		t.Fatalf("failed to create pool: %v", err)
+	}
+	s := ResourcePoolSchema()
+	d := schema.TestResourceDataRaw(t, s, map[string]interface{}{"name": "delete-pool", "uuid": pool["uuid"]})
+	d.SetId(pool["url"].(string))
+
+	fc.FailNext(1, http.StatusForbidden)
+	if diags := APIDelete(context.Background(), d, client, "pool"); !diags.HasError() {
+		t.Errorf("forbidden delete did not fail")
+	}
+	if d.Id() == "" {
+		t.Errorf("forbidden delete removed the object from state")
+	}
+	if diags := APIDelete(context.Background(), d, client, "pool"); diags.HasError() {
+		t.Fatalf("delete failed: %v", diags)
+	}
+	d.SetId(pool["url"].(string))
+	if diags := APIDelete(context.Background(), d, client, "pool"); diags.HasError() {
+		t.Errorf("deleting a missing object failed: %v", diags)
+	}
+	if d.Id() != "" {
+		t.Errorf("missing object was not removed from state")
+	}
+}
+
+// The singleton objects must be removed from the state without a DELETE request to the controller.
+func TestAPIDeleteSingleton(t *testing.T) {
+	fc := newFakeController()
+	defer fc.Close()
+	client := newFakeControllerClient(t, fc)
+	for _, objType := range []string{"systemconfiguration", "controllerproperties", "seproperties"} {
+		d := schema.TestResourceDataRaw(t, Provider().ResourcesMap["avi_"+objType].Schema,
+			map[string]interface{}{"uuid": objType})
+		d.SetId(fc.objectURL(objType, objType))
+		if diags := APIDelete(context.Background(), d, client, objType); diags.HasError() {
+			t.Errorf("deleting %s failed: %v", objType, diags)
+		}
+		if d.Id() != "" {
+			t.Errorf("%s was not removed from state", objType)
+		}
+	}
+	for _, request := range fc.Requests() {
+		if strings.HasPrefix(request, http.MethodDelete) {
+			t.Errorf("got request %s, want no delete of singleton objects", request)
+		}
+	}
+}
+
+// A create whose POST is abandoned when the context is done must keep track of the object that
+// the controller created afterwards.
+func TestAPICreateOrUpdateAbandoned(t *testing.T) {
+	fc := newFakeController()
+	defer fc.Close()
+	client := newFakeControllerClient(t, fc)
+	fc.postDelay = 200 * time.Millisecond
+	d := schema.TestResourceDataRaw(t, ResourcePoolSchema(), map[string]interface{}{"name": "slow-pool"})
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	if diags := APICreateOrUpdate(ctx, d, client, "pool", ResourcePoolSchema()); !diags.HasError() {
+		t.Fatalf("abandoned create did not fail")
+	}
+	if d.Id() == "" {
+		t.Errorf("object created by the abandoned request is not tracked")
+	}
+}
+
+func TestAPIErrorClassification(t *testing.T) {
+	fc := newFakeController()
+	defer fc.Close()
+	client := newFakeControllerClient(t, fc)
+	ctx := context.Background()
+
+	err := aviSessionCall(ctx, client, func() error {
+		return client.AviSession.Get("api/pool/pool-missing", nil)
+	})
+	var ae *apiError
+	if !errors.As(err, &ae) || !ae.NotFound() {
+		t.Fatalf("expected a not found apiError, got %#v", err)
+	}
+	if !strings.Contains(ae.Path, "api/pool/pool-missing") {
+		t.Errorf("unexpected path %q", ae.Path)
+	}
+	err = aviSessionCall(ctx, client, func() error {
+		var obj interface{}
+		return client.AviSession.GetObject("pool", session.SetName("pool-missing"), session.SetResult(&obj))
+	})
+	if !isAviObjectNotFound(err) {
+		t.Errorf("name lookup without results is not reported as not found: %v", err)
+	}
+	fc.FailNext(1, http.StatusForbidden)
+	err = aviSessionCall(ctx, client, func() error {
+		return client.AviSession.Delete("api/pool/pool-missing")
+	})
+	if !errors.As(err, &ae) || !ae.Forbidden() || isAviObjectNotFound(err) {
+		t.Errorf("expected a forbidden apiError, got %#v", err)
+	}
+}
+
+func TestKeyedMutex(t *testing.T) {
+	k := newKeyedMutex()
+	ctx := context.Background()
+	unlock, err := k.Lock(ctx, "pool-1")
+	if err != nil {
+		t.Fatalf("lock failed: %v", err)
+	}
+	unlockOther, err := k.Lock(ctx, "pool-2")
+	if err != nil {
+		t.Fatalf("lock of another key failed: %v", err)
+	}
+	unlockOther()
+
+	waitCtx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+	defer cancel()
+	if _, err := k.Lock(waitCtx, "pool-1"); err == nil {
+		t.Fatalf("lock of a held key did not wait")
+	}
+	unlock()
+	unlock, err = k.Lock(ctx, "pool-1")
+	if err != nil {
+		t.Fatalf("lock after unlock failed: %v", err)
+	}
+	unlock()
+	if len(k.locks) != 0 {
+		t.Errorf("released locks were not removed: %v", k.locks)
+	}
+}
+
+func TestCommonHash(t *testing.T) {
+	a := map[string]interface{}{"type": "FAIL_ACTION_CLOSE_CONN", "local_rsp": []interface{}{"a", "b"}}
+	b := map[string]interface{}{"local_rsp": []interface{}{"a", "b"}, "type": "FAIL_ACTION_CLOSE_CONN"}
+	c := map[string]interface{}{"type": "FAIL_ACTION_HTTP_REDIRECT"}
+	if CommonHash(a) != CommonHash(b) {
+		t.Errorf("elements with the same contents got different hashes")
+	}
+	if CommonHash(a) == CommonHash(c) {
+		t.Errorf("elements with different contents got the same hash")
+	}
+	if set := schema.NewSet(CommonHash, []interface{}{a, c}); set.Len() != 2 {
+		t.Errorf("got %d elements in a set of two different elements", set.Len())
+	}
+}
+
+// A set read from the api must be stored under the hash codes of the schema, like the configuration.
+func TestAPIDataToSchemaSetHash(t *testing.T) {
+	s := ResourcePoolSchema()
+	var apidata interface{}
+	if err := json.Unmarshal([]byte(apipooldata), &apidata); err != nil {
+		t.Fatalf("ERROR: Error during JSON unmarshal: %v", err)
+	}
+	apidata, _ = PreprocessAPIRes(apidata, s)
+	d := schema.TestResourceDataRaw(t, Provider().ResourcesMap["avi_pool"].Schema, map[string]interface{}{"name": "Pool-abc"})
+	d.SetId("pool-abc")
+	if _, err := APIDataToSchema(apidata, d, s); err != nil {
+		t.Fatalf("APIDataToSchema failed: %v", err)
+	}
+	failAction := d.Get("fail_action").(*schema.Set).List()
+	if len(failAction) != 1 {
+		t.Fatalf("got fail_action %v", failAction)
+	}
+	code := hashConfigured(ResourceFailActionSchema())(failAction[0])
+	key := fmt.Sprintf("fail_action.%d.type", code)
+	if got := d.State().Attributes[key]; got != "FAIL_ACTION_CLOSE_CONN" {
+		t.Errorf("got %q for %s in state %v", got, key, d.State().Attributes)
+	}
+}
+
+// The zero fields of a set element are only dropped when the configuration of the element is known.
+func TestSchemaToAviDataSetConfig(t *testing.T) {
+	elem := &schema.Resource{Schema: map[string]*schema.Schema{
+		"name": {Type: schema.TypeString, Optional: true},
+		"port": {Type: schema.TypeInt, Optional: true},
+	}}
+	field := &schema.Schema{Type: schema.TypeSet, Optional: true, Elem: elem}
+	configElem := func(name string, port cty.Value) cty.Value {
+		return cty.ObjectVal(map[string]cty.Value{"name": cty.StringVal(name), "port": port})
+	}
+
+	set := schema.NewSet(schema.HashResource(elem), []interface{}{
+		map[string]interface{}{"name": "a", "port": 0},
+	})
+	obj, _ := schemaToAviData(set, field, cty.SetVal([]cty.Value{configElem("a", cty.NullVal(cty.Number))}))
+	if _, ok := obj.(map[string]interface{})["port"]; ok {
+		t.Errorf("got unset zero port in %v", obj)
+	}
+
+	set = schema.NewSet(schema.HashResource(elem), []interface{}{
+		map[string]interface{}{"name": "a", "port": 0},
+		map[string]interface{}{"name": "b", "port": 0},
+	})
+	obj, _ = schemaToAviData(set, field, cty.SetVal([]cty.Value{
+		configElem("a", cty.NumberIntVal(0)),
+		configElem("b", cty.NullVal(cty.Number)),
+	}))
+	if _, ok := obj.(map[string]interface{})["port"]; !ok {
+		t.Errorf("got no port in %v of a set of several elements", obj)
+	}
+}
+
+// The fields that the controller fills in must not change the hash code of a configured element.
+func TestHashConfigured(t *testing.T) {
+	hash := hashConfigured(ResourceFailActionSchema())
+	configured := map[string]interface{}{"type": "FAIL_ACTION_HTTP_REDIRECT"}
+	read := map[string]interface{}{
+		"type": "FAIL_ACTION_HTTP_REDIRECT",
+		"redirect": schema.NewSet(CommonHash, []interface{}{
+			map[string]interface{}{"host": "www.example.com", "protocol": "HTTPS"},
+		}),
+	}
+	if hash(configured) != hash(read) {
+		t.Errorf("computed fields filled in by the controller changed the hash code")
+	}
+	if hash(configured) == hash(map[string]interface{}{"type": "FAIL_ACTION_CLOSE_CONN"}) {
+		t.Errorf("elements with different configured fields got the same hash code")
+	}
+	field := Provider().ResourcesMap["avi_pool"].Schema["fail_action"]
+	if field.Set == nil || field.Set(read) != hash(read) {
+		t.Errorf("fail_action of avi_pool is not hashed on its configured fields")
+	}
+}
@@ -0,0 +1,132 @@
+// Copyright 2022 VMware, Inc.
+// SPDX-License-Identifier: Mozilla Public License 2.0
+
+package avi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+// aviVersionAuto is the avi_version that makes the provider use the version of the controller.
+const aviVersionAuto = "auto"
+
+// detectControllerVersion returns the version of the controller of config, as reported by the
+// api/initial-data endpoint which does not need authentication.
+func detectControllerVersion(ctx context.Context, config Credentials) (string, error) {
+	transport, err := newAviTransport(config, nil)
+	if err != nil {
+		return "", err
+	}
+	timeout := config.Timeout
+	if timeout <= 0 {
+		timeout = time.Minute
+	}
+	httpClient := &http.Client{Transport: transport, Timeout: timeout}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet,
+		"https://"+aviControllerAddress(config)+"/api/initial-data", nil)
+	if err != nil {
+		return "", err
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to detect the controller version: %s", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("failed to detect the controller version: GET api/initial-data returned HTTP %d",
+			resp.StatusCode)
+	}
+	var initialData struct {
+		Version struct {
+			Version string `json:"Version"`
+		} `json:"version"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&initialData); err != nil {
+		return "", fmt.Errorf("failed to detect the controller version: %s", err)
+	}
+	if initialData.Version.Version == "" {
+		return "", fmt.Errorf("failed to detect the controller version: api/initial-data has no version")
+	}
+	return initialData.Version.Version, nil
+}
+
+// compareAviVersions compares two controller versions such as 21.1.3 and 22.1.2-2p1, and returns
+// -1, 0 or 1 when a is older than, the same as or newer than b. Only the leading numbers of each
+// dotted part are compared.
+func compareAviVersions(a, b string) int {
+	pa, pb := aviVersionParts(a), aviVersionParts(b)
+	for i := 0; i < len(pa) || i < len(pb); i++ {
+		var va, vb int
+		if i < len(pa) {
+			va = pa[i]
+		}
+		if i < len(pb) {
+			vb = pb[i]
+		}
+		if va < vb {
+			return -1
+		}
+		if va > vb {
+			return 1
+		}
+	}
+	return 0
+}
+
+func aviVersionParts(version string) []int {
+	var parts []int
+	for _, part := range strings.Split(version, ".") {
+		end := 0
+		for end < len(part) && part[end] >= '0' && part[end] <= '9' {
+			end++
+		}
+		n, err := strconv.Atoi(part[:end])
+		if err != nil {
+			break
+		}
+		parts = append(parts, n)
+		if end < len(part) {
+			break
+		}
+	}
+	return parts
+}
+
+// fieldVersionWarnings returns a warning for every field of objType set in config, the raw
+// configuration of a resource, that was introduced after the controller version.
+func fieldVersionWarnings(config cty.Value, objType, version string) diag.Diagnostics {
+	fields := aviFieldVersions[objType]
+	if version == "" || len(fields) == 0 {
+		return nil
+	}
+	if config.IsNull() || !config.IsKnown() || !config.Type().IsObjectType() {
+		return nil
+	}
+	var diags diag.Diagnostics
+	for field, introduced := range fields {
+		if !config.Type().HasAttribute(field) || config.GetAttr(field).IsNull() {
+			continue
+		}
+		if compareAviVersions(introduced, version) > 0 {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("%s is not supported by the controller", field),
+				Detail: fmt.Sprintf("%s of %s was introduced in version %s, the controller runs version %s.",
+					field, objType, introduced, version),
+				AttributePath: cty.GetAttrPath(field),
+			})
+		}
+	}
+	sort.Slice(diags, func(i, j int) bool { return diags[i].Summary < diags[j].Summary })
+	return diags
+}
@@ -0,0 +1,81 @@
+/***************************************************************************
+ * ========================================================================
+ * Copyright 2022 VMware, Inc.  All rights reserved. VMware Confidential
+ * ========================================================================
+ */
+
+package avi
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestCompareAviVersions(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{a: "22.1.2", b: "22.1.2", want: 0},
+		{a: "22.1.2", b: "22.1.3", want: -1},
+		{a: "18.2.10", b: "18.2.8", want: 1},
+		{a: "21.1", b: "21.1.0", want: 0},
+		{a: "22.1.2-2p1", b: "22.1.2", want: 0},
+		{a: "20.1.1", b: "18.2.8", want: 1},
+	}
+	for _, tt := range tests {
+		if got := compareAviVersions(tt.a, tt.b); got != tt.want {
+			t.Errorf("compareAviVersions(%q, %q) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestProviderConfigureAutoVersion(t *testing.T) {
+	fc := newFakeController()
+	defer fc.Close()
+
+	d := schema.TestResourceDataRaw(t, Provider().Schema, map[string]interface{}{
+		"avi_controller": fc.Host(),
+		"avi_username":   fakeControllerUsername,
+		"avi_password":   fakeControllerPassword,
+		"avi_tenant":     fakeControllerTenant,
+		"avi_version":    aviVersionAuto,
+		"avi_ca_bundle":  fc.CABundle(),
+	})
+	meta, diags := providerConfigure(context.Background(), d)
+	if diags.HasError() {
+		t.Fatalf("providerConfigure() failed: %v", diags)
+	}
+	client := meta.(*ProviderClient)
+	if client.Credentials.Version != fakeControllerVersion || client.DetectedVersion != fakeControllerVersion {
+		t.Fatalf("got version %q detected %q, want %q", client.Credentials.Version, client.DetectedVersion,
+			fakeControllerVersion)
+	}
+}
+
+func TestFieldVersionWarnings(t *testing.T) {
+	config := cty.ObjectVal(map[string]cty.Value{
+		"name":                     cty.StringVal("hm"),
+		"allow_duplicate_monitors": cty.StringVal("true"),
+		"disable_quickstart":       cty.StringVal("false"),
+		"configpb_attributes":      cty.NullVal(cty.String),
+	})
+
+	diags := fieldVersionWarnings(config, "healthmonitor", "18.2.7")
+	if len(diags) != 1 || diags.HasError() {
+		t.Fatalf("got %v, want one warning", diags)
+	}
+	if !diags[0].AttributePath.Equals(cty.GetAttrPath("allow_duplicate_monitors")) {
+		t.Errorf("warning is for %v, want allow_duplicate_monitors", diags[0].AttributePath)
+	}
+
+	if diags := fieldVersionWarnings(config, "healthmonitor", "22.1.2"); len(diags) != 0 {
+		t.Errorf("got %v for a newer controller, want no warnings", diags)
+	}
+	if diags := fieldVersionWarnings(config, "healthmonitor", ""); len(diags) != 0 {
+		t.Errorf("got %v without a detected version, want no warnings", diags)
+	}
+}
@@ -0,0 +1,234 @@
+// Copyright 2022 VMware, Inc.
+// SPDX-License-Identifier: Mozilla Public License 2.0
+
+// Command genenums writes the registry of the enum fields of the provider from the models of the
+// Avi SDK. The models document the allowed values of an enum field as "Enum options - A, B, C."
+// in the comment of the field. The enum fields of every object with a url are written by dotted
+// path starting with the resource name, including the fields of nested objects. Lists of values
+// that the SDK truncates with "..." are left out, as they would reject valid values.
+//
+// It is run by go generate in the avi directory:
+//
+//	go run ../scripts/genenums -o enum_fields.go
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"log"
+	"os/exec"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const sdkModule = "github.com/vmware/alb-sdk"
+
+// maxDepth bounds the nesting of the objects walked, as some models refer to themselves.
+const maxDepth = 10
+
+var enumOptions = regexp.MustCompile(`Enum options - ([A-Za-z0-9_]+(?:, [A-Za-z0-9_]+)*)(\.\.\.)?`)
+
+// modelField is a field of a model of the SDK.
+type modelField struct {
+	name   string
+	model  string
+	enum   []string
+	isEnum bool
+}
+
+func main() {
+	out := flag.String("o", "enum_fields.go", "file to write the registry to")
+	sdkDir := flag.String("sdk", "", "directory of the Avi SDK module, by default the one required by go.mod")
+	flag.Parse()
+
+	if *sdkDir == "" {
+		dir, err := exec.Command("go", "list", "-m", "-f", "{{.Dir}}", sdkModule).Output()
+		if err != nil {
+			log.Fatalf("failed to find the directory of %s: %v", sdkModule, err)
+		}
+		*sdkDir = strings.TrimSpace(string(dir))
+	}
+	models, err := parseModels(filepath.Join(*sdkDir, "go", "models"))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	fields := map[string][]string{}
+	for name, model := range models {
+		if !hasField(model, "url") {
+			continue
+		}
+		walk(fields, "avi_"+strings.ToLower(name), name, models, map[string]bool{})
+	}
+	source, err := render(fields)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := ioutil.WriteFile(*out, source, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// parseModels returns the fields of the structs of the model files in dir, by type name.
+func parseModels(dir string) (map[string][]modelField, error) {
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, dir, nil, parser.ParseComments)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse the models in %s: %v", dir, err)
+	}
+	models := map[string][]modelField{}
+	for _, pkg := range pkgs {
+		for _, file := range pkg.Files {
+			for _, decl := range file.Decls {
+				gen, ok := decl.(*ast.GenDecl)
+				if !ok || gen.Tok != token.TYPE {
+					continue
+				}
+				for _, spec := range gen.Specs {
+					typeSpec := spec.(*ast.TypeSpec)
+					if st, ok := typeSpec.Type.(*ast.StructType); ok {
+						models[typeSpec.Name.Name] = structFields(st)
+					}
+				}
+			}
+		}
+	}
+	return models, nil
+}
+
+func structFields(st *ast.StructType) []modelField {
+	var fields []modelField
+	for _, f := range st.Fields.List {
+		if f.Tag == nil {
+			continue
+		}
+		tag, err := strconv.Unquote(f.Tag.Value)
+		if err != nil {
+			continue
+		}
+		name := strings.Split(reflect.StructTag(tag).Get("json"), ",")[0]
+		if name == "" || name == "-" {
+			continue
+		}
+		field := modelField{name: name}
+		typeName := elemTypeName(f.Type)
+		if typeName == "string" && f.Doc != nil {
+			doc := strings.Join(strings.Fields(f.Doc.Text()), " ")
+			if m := enumOptions.FindStringSubmatch(doc); m != nil && m[2] == "" {
+				field.enum = strings.Split(m[1], ", ")
+				field.isEnum = true
+			}
+		} else if typeName != "string" {
+			field.model = typeName
+		}
+		fields = append(fields, field)
+	}
+	return fields
+}
+
+// elemTypeName returns the name of the type of a field, of its elements for a list.
+func elemTypeName(expr ast.Expr) string {
+	switch t := expr.(type) {
+	case *ast.StarExpr:
+		return elemTypeName(t.X)
+	case *ast.ArrayType:
+		return elemTypeName(t.Elt)
+	case *ast.Ident:
+		return t.Name
+	}
+	return ""
+}
+
+func hasField(fields []modelField, name string) bool {
+	for _, f := range fields {
+		if f.name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// walk adds the enum fields of the model name, at path, and of its nested models to fields.
+func walk(fields map[string][]string, path, name string, models map[string][]modelField, stack map[string]bool) {
+	if stack[name] || len(stack) >= maxDepth {
+		return
+	}
+	stack[name] = true
+	defer delete(stack, name)
+	for _, f := range models[name] {
+		fieldPath := path + "." + f.name
+		if f.isEnum {
+			fields[fieldPath] = f.enum
+		} else if _, ok := models[f.model]; ok {
+			walk(fields, fieldPath, f.model, models, stack)
+		}
+	}
+}
+
+// render returns the source of the registry, with the values of long entries wrapped.
+func render(fields map[string][]string) ([]byte, error) {
+	paths := make([]string, 0, len(fields))
+	for path := range fields {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	var buf bytes.Buffer
+	buf.WriteString(header)
+	for _, path := range paths {
+		values := make([]string, len(fields[path]))
+		for i, v := range fields[path] {
+			values[i] = strconv.Quote(v)
+		}
+		line := fmt.Sprintf("\t%q: {%s},\n", path, strings.Join(values, ", "))
+		if len(line) <= 110 {
+			buf.WriteString(line)
+			continue
+		}
+		fmt.Fprintf(&buf, "\t%q: {\n", path)
+		width := 0
+		for i, v := range values {
+			if width > 0 && width+len(v)+2 > 100 {
+				buf.WriteString("\n")
+				width = 0
+			}
+			if width == 0 {
+				buf.WriteString("\t\t")
+				width = 2
+			} else {
+				buf.WriteString(" ")
+				width++
+			}
+			buf.WriteString(v)
+			buf.WriteString(",")
+			width += len(v) + 1
+			if i == len(values)-1 {
+				buf.WriteString("\n")
+			}
+		}
+		buf.WriteString("\t},\n")
+	}
+	buf.WriteString("}\n")
+	return format.Source(buf.Bytes())
+}
+
+const header = `// Copyright 2022 VMware, Inc.
+// SPDX-License-Identifier: Mozilla Public License 2.0
+
+package avi
+
+// enumFields is the registry of the values allowed by the controller for enum fields, by dotted
+// path starting with the resource name, as defined by the object model of the Avi SDK. It is
+// written by scripts/genenums, run go generate after updating the SDK.
+var enumFields = map[string][]string{
+`